@@ -0,0 +1,292 @@
+package httpServer
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) addSpeaker(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	speaker, err := s.readSpeaker(r)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	speaker.ID, err = s.eventsService.AddSpeaker(r.Context(), int64(id), urlTitle, speaker)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(speaker, w, http.StatusCreated)
+}
+
+func (s *server) updateSpeaker(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	speakerID, err := strconv.ParseInt(chi.URLParam(r, "speaker-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	speaker, err := s.readSpeaker(r)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+	speaker.ID = speakerID
+
+	err = s.eventsService.UpdateSpeaker(r.Context(), int64(id), urlTitle, speaker)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "speaker was updated successfully",
+	}, w)
+}
+
+func (s *server) deleteSpeaker(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	speakerID, err := strconv.ParseInt(chi.URLParam(r, "speaker-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.DeleteSpeaker(r.Context(), int64(id), urlTitle, speakerID)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "speaker was deleted successfully",
+	}, w)
+}
+
+func (s *server) addSession(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var session models.Session
+	err = utils.ReadReqJSON(w, r, &session)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&session)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	session.ID, err = s.eventsService.AddSession(r.Context(), int64(id), urlTitle, &session)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&session, w, http.StatusCreated)
+}
+
+func (s *server) updateSession(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	sessionID, err := strconv.ParseInt(chi.URLParam(r, "session-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	var session models.Session
+	err = utils.ReadReqJSON(w, r, &session)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+	session.ID = sessionID
+
+	err = validation.Struct(&session)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.UpdateSession(r.Context(), int64(id), urlTitle, &session)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "session was updated successfully",
+	}, w)
+}
+
+func (s *server) deleteSession(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	sessionID, err := strconv.ParseInt(chi.URLParam(r, "session-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.DeleteSession(r.Context(), int64(id), urlTitle, sessionID)
+	if err != nil {
+		s.writeAgendaError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "session was deleted successfully",
+	}, w)
+}
+
+// readSpeaker reads speaker from multipart form with json in the "speaker" field
+// and optional photo in the "photo" one
+func (s *server) readSpeaker(r *http.Request) (*models.Speaker, error) {
+	err := r.ParseMultipartForm(32 << 20)
+	if err != nil {
+		return nil, api.ErrWrongInput
+	}
+
+	var speaker models.Speaker
+	err = utils.ReadJSON(bytes.NewBuffer([]byte(r.Form.Get("speaker"))), &speaker)
+	if err != nil {
+		return nil, api.ErrWrongInput
+	}
+
+	err = validation.Struct(&speaker)
+	if err != nil {
+		return nil, err
+	}
+
+	photos, err := s.saveMultipartImages(r, "photo")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(photos) > 0 {
+		speaker.Photo = photos[0]
+	}
+
+	return &speaker, nil
+}
+
+func (s *server) writeAgendaError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.As(err, new(validation.Errors)):
+		s.writeValidationError(err, w)
+	case errors.Is(err, api.ErrWrongInput),
+		errors.Is(err, utils.ErrUnsupportedImage),
+		errors.Is(err, utils.ErrImageTooLarge),
+		errors.Is(err, service.ErrSessionTime),
+		errors.Is(err, service.ErrSessionOutsideEvent),
+		errors.Is(err, service.ErrWrongSpeakers):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing event agenda", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
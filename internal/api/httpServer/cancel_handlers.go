@@ -0,0 +1,96 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) cancelEvent(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var req models.CancelEventRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.CancelEvent(r.Context(), int64(id), urlTitle, req.Reason)
+	if err != nil {
+		s.writeCancellationError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "Event was cancelled successfully",
+	}, w)
+}
+
+func (s *server) cancellationProgress(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	progress, err := s.eventsService.CancellationProgress(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		s.writeCancellationError(err, w)
+		return
+	}
+
+	utils.WriteJSON(progress, w)
+}
+
+func (s *server) writeCancellationError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.Is(err, service.ErrEventNotActive):
+		utils.WriteJSONError(err, w, http.StatusConflict)
+	default:
+		slog.Error("Error cancelling event", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
@@ -14,14 +14,27 @@ import (
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
 )
 
 func (s *server) event(w http.ResponseWriter, r *http.Request) {
 	urlTitle := chi.URLParam(r, "url-title")
-	resp, err := s.eventsService.Event(r.Context(), urlTitle)
+	userID := s.optionalUserID(r)
+	resp, err := s.eventsService.Event(r.Context(), userID, urlTitle)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			// previously shared links keep working after slug changes
+			current, redirectErr := s.eventsService.EventRedirect(r.Context(), urlTitle)
+			if redirectErr == nil {
+				http.Redirect(w, r, "/api/events/"+current, http.StatusMovedPermanently)
+				return
+			}
+			if !errors.Is(redirectErr, pgx.ErrNoRows) {
+				slog.Error("Error getting event redirect", slog.Any("error", redirectErr))
+			}
+		}
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, service.ErrEventNotPublished) {
 			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
 			return
 		}
@@ -31,10 +44,17 @@ func (s *server) event(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.eventsService.RecordView(resp, visitorID(r, userID))
+
 	utils.WriteJSON(resp, w)
 }
 
 func (s *server) events(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("near") {
+		s.eventsNear(w, r)
+		return
+	}
+
 	p := r.URL.Query().Get("page")
 	page, err := strconv.Atoi(p)
 	if err != nil || page < 1 {
@@ -54,6 +74,49 @@ func (s *server) events(w http.ResponseWriter, r *http.Request) {
 	utils.WriteJSON(events, w)
 }
 
+func (s *server) eventsNear(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	lat, lng, err := parseCoordinates(query.Get("near"))
+	if err != nil {
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	var radius float64
+	if v := query.Get("radius_km"); v != "" {
+		radius, err = parseFloat(v)
+		if err != nil {
+			utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	page := 1
+	if p := query.Get("page"); p != "" {
+		page, err = strconv.Atoi(p)
+		if err != nil || page < 1 {
+			utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	events, err := s.eventsService.EventsNear(r.Context(), lat, lng, radius, page)
+	if err != nil {
+		if errors.Is(err, service.ErrWrongCoordinates) || errors.Is(err, service.ErrWrongRadius) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.Error("Error getting events near", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+			return
+		}
+	}
+
+	utils.WriteJSON(events, w)
+}
+
 func (s *server) myEvents(w http.ResponseWriter, r *http.Request) {
 	_, claims, err := s.getAndVerifyHeaderToken(r)
 	if err != nil {
@@ -91,37 +154,39 @@ func (s *server) createEvent(w http.ResponseWriter, r *http.Request) {
 	err = r.ParseMultipartForm(32 << 20)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
 		return
 	}
 
-	imgs, err := saveMultipartImages(r, "image", s.httpConfig.StaticDir())
+	var event models.Event
+	err = utils.ReadJSON(bytes.NewBuffer([]byte(r.Form.Get("event"))), &event)
 	if err != nil {
-		slog.Error("Error saving event image", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
 		return
 	}
 
-	var event models.Event
-	err = utils.ReadJSON(bytes.NewBuffer([]byte(r.Form.Get("event"))), &event)
+	err = validation.Struct(&event)
 	if err != nil {
-		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		s.writeValidationError(err, w)
 		return
 	}
 
-	if event.Title == "" ||
-		event.Description == "" ||
-		event.BeginningTime.IsZero() ||
-		event.EndTime.IsZero() ||
-		event.Location == "" {
+	imgs, err := s.saveMultipartImages(r, "image")
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedImage) || errors.Is(err, utils.ErrImageTooLarge) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
 
-		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusUnprocessableEntity)
+		slog.Error("Error saving event image", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
 		return
 	}
 
 	if len(imgs) > 0 {
 		event.PreviewImage = imgs[0]
+		event.Images = eventImages(imgs)
 	}
 
 	id, err := strconv.Atoi(claims.Subject)
@@ -139,6 +204,17 @@ func (s *server) createEvent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteJSONError(err, w)
 			return
 		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, service.ErrWrongEventStatus) ||
+			errors.Is(err, service.ErrPublishTime) ||
+			errors.Is(err, service.ErrWrongCoordinates) ||
+			errors.Is(err, utils.ErrWrongTimeZone) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
 
 		slog.Error("Error creating new event", slog.Any("error", err))
 		utils.WriteJSONError(api.ErrInternal, w)
@@ -164,13 +240,7 @@ func (s *server) updateEvent(w http.ResponseWriter, r *http.Request) {
 	err = r.ParseMultipartForm(32 << 20)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
-		return
-	}
-	imgs, err := saveMultipartImages(r, "image", s.httpConfig.StaticDir())
-	if err != nil {
-		slog.Error("Error saving event image", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
 		return
 	}
 
@@ -178,16 +248,47 @@ func (s *server) updateEvent(w http.ResponseWriter, r *http.Request) {
 	err = utils.ReadJSON(bytes.NewBuffer([]byte(r.Form.Get("event"))), &event)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Partial(&event)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	imgs, err := s.saveMultipartImages(r, "image")
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedImage) || errors.Is(err, utils.ErrImageTooLarge) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
+
+		slog.Error("Error saving event image", slog.Any("error", err))
 		utils.WriteJSONError(api.ErrInternal, w)
 		return
 	}
 
 	if len(imgs) > 0 {
-		event.PreviewImage = imgs[0]
+		event.Images = eventImages(imgs)
 	}
 
 	err = s.eventsService.UpdateEvent(r.Context(), int64(id), &event)
 	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, service.ErrWrongEventStatus) ||
+			errors.Is(err, service.ErrStatusTransition) ||
+			errors.Is(err, service.ErrPublishTime) ||
+			errors.Is(err, service.ErrWrongCoordinates) ||
+			errors.Is(err, utils.ErrWrongTimeZone) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
+
 		slog.Error("Error updating event", slog.Any("error", err))
 		utils.WriteJSONError(api.ErrInternal, w)
 		return
@@ -199,6 +300,104 @@ func (s *server) updateEvent(w http.ResponseWriter, r *http.Request) {
 	}, w)
 }
 
+func (s *server) updateEventSlug(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var req models.UpdateSlugRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	req.Slug, err = s.eventsService.UpdateEventSlug(r.Context(), int64(id), urlTitle, req.Slug)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		case errors.Is(err, service.ErrSlugTaken):
+			utils.WriteJSONError(err, w, http.StatusConflict)
+		case errors.Is(err, service.ErrWrongSlug):
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+		default:
+			slog.Error("Error updating event slug", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(&req, w)
+}
+
+func (s *server) cloneEvent(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var req models.CloneEventRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	event, err := s.eventsService.CloneEvent(r.Context(), int64(id), urlTitle, &req)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, service.ErrPermissionDenied) || errors.Is(err, service.ErrEmailNotVerified) {
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+			return
+		}
+
+		slog.Error("Error cloning event", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(event, w, http.StatusCreated)
+}
+
 func (s *server) deleteEvent(w http.ResponseWriter, r *http.Request) {
 	_, claims, err := s.getAndVerifyHeaderToken(r)
 	if err != nil {
@@ -215,9 +414,13 @@ func (s *server) deleteEvent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteJSONError(service.ErrPermissionDenied, w, http.StatusForbidden)
 			return
 		}
+		if errors.Is(err, service.ErrEventHasTickets) {
+			utils.WriteJSONError(err, w, http.StatusConflict)
+			return
+		}
 
 		slog.Error("Error deleting event", slog.Any("error", err))
-		utils.WriteJSONError(errors.New("Error. Maybe someone already has bought a ticket..."), w)
+		utils.WriteJSONError(api.ErrInternal, w)
 		return
 	}
 
@@ -226,3 +429,35 @@ func (s *server) deleteEvent(w http.ResponseWriter, r *http.Request) {
 		Message: "Event was deleted successfully",
 	}, w)
 }
+
+func (s *server) eventHistory(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	revisions, err := s.eventsService.EventHistory(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		default:
+			slog.Error("Error getting event history", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(revisions, w)
+}
@@ -0,0 +1,205 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func (s *server) bookmarkEvent(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	err = s.eventsService.BookmarkEvent(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		s.writeFollowsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "event was bookmarked successfully",
+	}, w)
+}
+
+func (s *server) deleteBookmark(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	err = s.eventsService.DeleteBookmark(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		s.writeFollowsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "bookmark was deleted successfully",
+	}, w)
+}
+
+func (s *server) bookmarks(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	events, err := s.eventsService.Bookmarks(r.Context(), int64(id))
+	if err != nil {
+		slog.Error("Error getting bookmarks", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(events, w)
+}
+
+func (s *server) followOrganizer(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	organizerID, err := strconv.ParseInt(chi.URLParam(r, "organizer-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(service.ErrOrganizerNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.FollowOrganizer(r.Context(), int64(id), organizerID)
+	if err != nil {
+		s.writeFollowsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "organizer was followed successfully",
+	}, w)
+}
+
+func (s *server) unfollowOrganizer(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	organizerID, err := strconv.ParseInt(chi.URLParam(r, "organizer-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(service.ErrOrganizerNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.UnfollowOrganizer(r.Context(), int64(id), organizerID)
+	if err != nil {
+		s.writeFollowsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "organizer was unfollowed successfully",
+	}, w)
+}
+
+func (s *server) feed(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	page := 1
+	if p := r.URL.Query().Get("page"); p != "" {
+		page, err = strconv.Atoi(p)
+		if err != nil || page < 1 {
+			utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	events, err := s.eventsService.Feed(r.Context(), int64(id), page)
+	if err != nil {
+		slog.Error("Error getting feed", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(events, w)
+}
+
+func (s *server) writeFollowsError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, service.ErrEventNotPublished):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrOrganizerNotFound):
+		utils.WriteJSONError(err, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrFollowSelf):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing bookmarks or follows", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
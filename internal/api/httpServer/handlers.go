@@ -1,23 +1,179 @@
 package httpServer
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"mime"
+	"net"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
 )
 
-func saveMultipartImages(r *http.Request, formField string, staticDir string) ([]string, error) {
+// writeValidationError writes field errors of invalid input with 422 status
+func (s *server) writeValidationError(err error, w http.ResponseWriter) {
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusUnprocessableEntity)
+		return
+	}
+
+	utils.WriteJSON(&models.ValidationErrorResponse{
+		Error:   true,
+		Message: "validation failed",
+		Errors:  errs,
+	}, w, http.StatusUnprocessableEntity)
+}
+
+func (s *server) saveMultipartImages(r *http.Request, formField string) ([]string, error) {
 	reqImages := r.MultipartForm.File[formField]
 	var images []string
 
 	for _, img := range reqImages {
-		filename, err := utils.SaveStaticImage(img, staticDir)
+		if img.Size > utils.MaxImageSize {
+			return nil, utils.ErrImageTooLarge
+		}
+
+		file, err := img.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		processed, err := utils.ProcessImage(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.saveProcessedImage(r.Context(), processed)
 		if err != nil {
 			return nil, err
 		}
 
-		images = append(images, filename)
+		images = append(images, processed.Name)
 	}
 
 	return images, nil
 }
+
+// saveProcessedImage puts every variant of image to the storage
+func (s *server) saveProcessedImage(ctx context.Context, img *utils.ProcessedImage) error {
+	contentType := utils.ImageContentType(img.Name)
+
+	for variant, data := range img.Variants {
+		err := s.storage.Put(
+			ctx,
+			utils.ImageVariantName(img.Name, variant),
+			bytes.NewReader(data),
+			int64(len(data)),
+			contentType,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *server) static(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "*")
+
+	object, err := s.storage.Get(r.Context(), name)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+			return
+		}
+
+		slog.Error("Error getting static object", slog.Any("error", err), slog.String("name", name))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	defer object.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+
+	_, err = io.Copy(w, object)
+	if err != nil {
+		slog.Error("Error writing static object", slog.Any("error", err), slog.String("name", name))
+	}
+}
+
+// eventImages makes gallery images from saved files, the first one becomes event's cover
+func eventImages(filenames []string) []*models.EventImage {
+	images := make([]*models.EventImage, 0, len(filenames))
+	for i, filename := range filenames {
+		images = append(images, &models.EventImage{
+			Image:   filename,
+			IsCover: i == 0,
+		})
+	}
+
+	return images
+}
+
+// parseCoordinates parses coordinates in "lat,lng" format
+func parseCoordinates(s string) (float64, float64, error) {
+	latStr, lngStr, found := strings.Cut(s, ",")
+	if !found {
+		return 0, 0, errors.New("coordinates must be in lat,lng format")
+	}
+
+	lat, err := parseFloat(strings.TrimSpace(latStr))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lng, err := parseFloat(strings.TrimSpace(lngStr))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lat, lng, nil
+}
+
+// parseFloat parses finite number, NaN and infinities pass any range check, so they are rejected
+func parseFloat(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errors.New("number must be finite")
+	}
+
+	return f, nil
+}
+
+// visitorID identifies visitor of the page: by user id if authorized, otherwise by ip and user agent.
+// Forwarding headers are set by the client, so they aren't trusted and the ip is taken from the connection
+func visitorID(r *http.Request, userID int64) string {
+	if userID != 0 {
+		return "user:" + strconv.FormatInt(userID, 10)
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return ip + "|" + r.UserAgent()
+}
@@ -0,0 +1,205 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) addEventImages(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	err = r.ParseMultipartForm(32 << 20)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	imgs, err := s.saveMultipartImages(r, "image")
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedImage) || errors.Is(err, utils.ErrImageTooLarge) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
+
+		slog.Error("Error saving event image", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	if len(imgs) == 0 {
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusUnprocessableEntity)
+		return
+	}
+
+	images := make([]*models.EventImage, 0, len(imgs))
+	for _, img := range imgs {
+		images = append(images, &models.EventImage{Image: img})
+	}
+
+	err = s.eventsService.AddEventImages(r.Context(), int64(id), urlTitle, images)
+	if err != nil {
+		s.writeEventImagesError(err, w)
+		return
+	}
+
+	utils.WriteJSON(images, w, http.StatusCreated)
+}
+
+func (s *server) updateEventImage(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	imageID, err := strconv.ParseInt(chi.URLParam(r, "image-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	var req models.UpdateEventImageRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.UpdateEventImage(r.Context(), int64(id), urlTitle, imageID, &req)
+	if err != nil {
+		s.writeEventImagesError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "image was updated successfully",
+	}, w)
+}
+
+func (s *server) reorderEventImages(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var req models.ReorderImagesRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.ReorderEventImages(r.Context(), int64(id), urlTitle, req.IDs)
+	if err != nil {
+		s.writeEventImagesError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "images were reordered successfully",
+	}, w)
+}
+
+func (s *server) deleteEventImage(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	imageID, err := strconv.ParseInt(chi.URLParam(r, "image-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.DeleteEventImage(r.Context(), int64(id), urlTitle, imageID)
+	if err != nil {
+		s.writeEventImagesError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "image was deleted successfully",
+	}, w)
+}
+
+func (s *server) writeEventImagesError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.Is(err, service.ErrWrongImagesOrder):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing event images", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
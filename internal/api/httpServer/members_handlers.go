@@ -0,0 +1,126 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) eventMembers(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	members, err := s.eventsService.EventMembers(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		s.writeEventMembersError(err, w)
+		return
+	}
+
+	utils.WriteJSON(members, w)
+}
+
+func (s *server) inviteEventMember(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var member models.EventMember
+	err = utils.ReadReqJSON(w, r, &member)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&member)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	member.ID, err = s.eventsService.InviteEventMember(r.Context(), int64(id), urlTitle, &member)
+	if err != nil {
+		s.writeEventMembersError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&member, w, http.StatusCreated)
+}
+
+func (s *server) deleteEventMember(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	memberID, err := strconv.ParseInt(chi.URLParam(r, "member-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.DeleteEventMember(r.Context(), int64(id), urlTitle, memberID)
+	if err != nil {
+		s.writeEventMembersError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "member was deleted successfully",
+	}, w)
+}
+
+func (s *server) writeEventMembersError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.Is(err, service.ErrWrongEmail), errors.Is(err, service.ErrWrongRole):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing event members", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
@@ -82,15 +82,7 @@ func (s *server) oauthCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		Expires:  time.Now().Add(s.authConfig.RefreshTokenTTL()),
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-	})
+	s.setRefreshCookie(refreshToken, w)
 
 	http.Redirect(w, r, s.oauth.RedirectURL(), http.StatusFound)
 }
@@ -0,0 +1,102 @@
+package httpServer
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func (s *server) organizerProfile(w http.ResponseWriter, r *http.Request) {
+	handle := chi.URLParam(r, "handle")
+
+	profile, err := s.eventsService.OrganizerProfile(r.Context(), handle)
+	if err != nil {
+		s.writeOrganizersError(err, w)
+		return
+	}
+
+	utils.WriteJSON(profile, w)
+}
+
+func (s *server) updateOrganizerProfile(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	profile, err := s.readOrganizerProfile(r)
+	if err != nil {
+		s.writeOrganizersError(err, w)
+		return
+	}
+
+	profile, err = s.eventsService.UpdateOrganizerProfile(r.Context(), int64(id), profile)
+	if err != nil {
+		s.writeOrganizersError(err, w)
+		return
+	}
+
+	utils.WriteJSON(profile, w)
+}
+
+// readOrganizerProfile reads profile from multipart form with json in the "profile" field
+// and optional avatar in the "avatar" one
+func (s *server) readOrganizerProfile(r *http.Request) (*models.OrganizerProfile, error) {
+	err := r.ParseMultipartForm(32 << 20)
+	if err != nil {
+		return nil, api.ErrWrongInput
+	}
+
+	var profile models.OrganizerProfile
+	err = utils.ReadJSON(bytes.NewBuffer([]byte(r.Form.Get("profile"))), &profile)
+	if err != nil {
+		return nil, api.ErrWrongInput
+	}
+
+	avatars, err := s.saveMultipartImages(r, "avatar")
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Avatar = ""
+	if len(avatars) > 0 {
+		profile.Avatar = avatars[0]
+	}
+
+	return &profile, nil
+}
+
+func (s *server) writeOrganizersError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, service.ErrOrganizerNotFound):
+		utils.WriteJSONError(err, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrHandleTaken):
+		utils.WriteJSONError(err, w, http.StatusConflict)
+	case errors.Is(err, api.ErrWrongInput),
+		errors.Is(err, utils.ErrUnsupportedImage),
+		errors.Is(err, utils.ErrImageTooLarge),
+		errors.Is(err, service.ErrWrongHandle),
+		errors.Is(err, service.ErrWrongProfile):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing organizer profile", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
@@ -0,0 +1,150 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) addQuestion(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var question models.EventQuestion
+	err = utils.ReadReqJSON(w, r, &question)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&question)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	question.ID, err = s.eventsService.AddQuestion(r.Context(), int64(id), urlTitle, &question)
+	if err != nil {
+		s.writeEventQuestionsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&question, w, http.StatusCreated)
+}
+
+func (s *server) updateQuestion(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	questionID, err := strconv.ParseInt(chi.URLParam(r, "question-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	var question models.EventQuestion
+	err = utils.ReadReqJSON(w, r, &question)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+	question.ID = questionID
+
+	err = validation.Struct(&question)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.UpdateQuestion(r.Context(), int64(id), urlTitle, &question)
+	if err != nil {
+		s.writeEventQuestionsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "question was updated successfully",
+	}, w)
+}
+
+func (s *server) deleteQuestion(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	questionID, err := strconv.ParseInt(chi.URLParam(r, "question-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	err = s.eventsService.DeleteQuestion(r.Context(), int64(id), urlTitle, questionID)
+	if err != nil {
+		s.writeEventQuestionsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "question was deleted successfully",
+	}, w)
+}
+
+func (s *server) writeEventQuestionsError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.Is(err, service.ErrWrongQuestion):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing event questions", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
@@ -0,0 +1,130 @@
+package httpServer
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func (s *server) reviews(w http.ResponseWriter, r *http.Request) {
+	urlTitle := chi.URLParam(r, "url-title")
+
+	reviews, err := s.eventsService.Reviews(r.Context(), urlTitle)
+	if err != nil {
+		s.writeReviewsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(reviews, w)
+}
+
+func (s *server) addReview(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var review models.Review
+	err = utils.ReadReqJSON(w, r, &review)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&review)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	review.ID, err = s.eventsService.AddReview(r.Context(), int64(id), urlTitle, &review)
+	if err != nil {
+		s.writeReviewsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&review, w, http.StatusCreated)
+}
+
+func (s *server) replyToReview(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	reviewID, err := strconv.ParseInt(chi.URLParam(r, "review-id"), 10, 64)
+	if err != nil {
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		return
+	}
+
+	var req models.ReplyReviewRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.eventsService.ReplyToReview(r.Context(), int64(id), urlTitle, reviewID, req.Reply)
+	if err != nil {
+		s.writeReviewsError(err, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "reply was saved successfully",
+	}, w)
+}
+
+func (s *server) writeReviewsError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, service.ErrEventNotPublished):
+		utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+	case errors.Is(err, service.ErrPermissionDenied), errors.Is(err, service.ErrReviewNotAllowed):
+		utils.WriteJSONError(err, w, http.StatusForbidden)
+	case errors.Is(err, service.ErrAlreadyReviewed), errors.Is(err, service.ErrEventNotEnded):
+		utils.WriteJSONError(err, w, http.StatusConflict)
+	case errors.Is(err, service.ErrWrongReview), errors.Is(err, service.ErrWrongReply):
+		utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+	default:
+		slog.Error("Error managing event reviews", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+	}
+}
@@ -1,8 +1,6 @@
 package httpServer
 
 import (
-	"net/http"
-
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
@@ -14,13 +12,13 @@ func (s *server) setRoutes() {
 	mux.Use(middleware.Recoverer)
 	mux.Use(s.enableCORS)
 
-	fs := http.FileServer(http.Dir(s.httpConfig.StaticDir()))
-	mux.Handle("/api/static/*", http.StripPrefix("/api/static/", fs))
+	mux.Get("/api/static/*", s.static)
 
 	mux.Route("/api", func(mux chi.Router) {
 		mux.Route("/events", func(mux chi.Router) {
 			mux.Get("/", s.events)
 			mux.Get("/{url-title}", s.event)
+			mux.Get("/{url-title}/reviews", s.reviews)
 
 			mux.Group(func(mux chi.Router) {
 				mux.Use(s.authRequired)
@@ -28,6 +26,66 @@ func (s *server) setRoutes() {
 				mux.Post("/", s.createEvent)
 				mux.Put("/{url-title}", s.updateEvent)
 				mux.Delete("/{url-title}", s.deleteEvent)
+				mux.Put("/{url-title}/slug", s.updateEventSlug)
+				mux.Post("/{url-title}/clone", s.cloneEvent)
+				mux.Get("/{url-title}/history", s.eventHistory)
+				mux.Put("/{url-title}/bookmark", s.bookmarkEvent)
+				mux.Delete("/{url-title}/bookmark", s.deleteBookmark)
+
+				mux.Route("/{url-title}/images", func(mux chi.Router) {
+					mux.Post("/", s.addEventImages)
+					mux.Put("/order", s.reorderEventImages)
+					mux.Patch("/{image-id}", s.updateEventImage)
+					mux.Delete("/{image-id}", s.deleteEventImage)
+				})
+
+				mux.Route("/{url-title}/members", func(mux chi.Router) {
+					mux.Get("/", s.eventMembers)
+					mux.Post("/", s.inviteEventMember)
+					mux.Delete("/{member-id}", s.deleteEventMember)
+				})
+
+				mux.Route("/{url-title}/speakers", func(mux chi.Router) {
+					mux.Post("/", s.addSpeaker)
+					mux.Put("/{speaker-id}", s.updateSpeaker)
+					mux.Delete("/{speaker-id}", s.deleteSpeaker)
+				})
+
+				mux.Route("/{url-title}/sessions", func(mux chi.Router) {
+					mux.Post("/", s.addSession)
+					mux.Put("/{session-id}", s.updateSession)
+					mux.Delete("/{session-id}", s.deleteSession)
+				})
+
+				mux.Route("/{url-title}/questions", func(mux chi.Router) {
+					mux.Post("/", s.addQuestion)
+					mux.Put("/{question-id}", s.updateQuestion)
+					mux.Delete("/{question-id}", s.deleteQuestion)
+				})
+
+				mux.Post("/{url-title}/reviews", s.addReview)
+				mux.Put("/{url-title}/reviews/{review-id}/reply", s.replyToReview)
+
+				mux.Post("/{url-title}/check-in", s.checkIn)
+				mux.Get("/{url-title}/sales", s.salesReport)
+				mux.Get("/{url-title}/stats", s.eventStats)
+				mux.Get("/{url-title}/funnel", s.funnel)
+				mux.Get("/{url-title}/attendees", s.attendees)
+				mux.Get("/{url-title}/attendees/export", s.exportAttendees)
+
+				mux.Post("/{url-title}/cancel", s.cancelEvent)
+				mux.Get("/{url-title}/cancellation", s.cancellationProgress)
+			})
+		})
+
+		mux.Route("/organizers", func(mux chi.Router) {
+			mux.Get("/{handle}", s.organizerProfile)
+
+			mux.Group(func(mux chi.Router) {
+				mux.Use(s.authRequired)
+
+				mux.Post("/{organizer-id}/follow", s.followOrganizer)
+				mux.Delete("/{organizer-id}/follow", s.unfollowOrganizer)
 			})
 		})
 
@@ -42,6 +100,15 @@ func (s *server) setRoutes() {
 			mux.Post("/login", s.login)
 			mux.Post("/refresh", s.refresh)
 			mux.Post("/logout", s.logout)
+			mux.With(s.authRequired).Post("/logout-all", s.logoutAll)
+			mux.Post("/verify-email", s.verifyEmail)
+			mux.With(s.authRequired).Post("/verify-email/resend", s.resendVerification)
+
+			mux.Route("/password", func(mux chi.Router) {
+				mux.Post("/forgot", s.forgotPassword)
+				mux.Post("/reset", s.resetPassword)
+				mux.With(s.authRequired).Post("/change", s.changePassword)
+			})
 
 			mux.Route("/oauth/{provider}", func(mux chi.Router) {
 				mux.Get("/callback", s.oauthCallback)
@@ -54,6 +121,10 @@ func (s *server) setRoutes() {
 
 			mux.Get("/tickets", s.userTickets)
 			mux.Get("/events", s.myEvents)
+			mux.Get("/stats", s.userStats)
+			mux.Get("/bookmarks", s.bookmarks)
+			mux.Get("/feed", s.feed)
+			mux.Put("/organizer-profile", s.updateOrganizerProfile)
 			mux.Route("/profile", func(mux chi.Router) {
 				mux.Get("/", s.profile)
 				mux.Put("/", s.updateProfile)
@@ -2,6 +2,7 @@ package httpServer
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/wDRxxx/eventflow-backend/internal/api"
@@ -9,6 +10,7 @@ import (
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/oauth"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
@@ -22,7 +24,8 @@ type server struct {
 	ticketsService service.TicketsService
 	usersService   service.UsersService
 
-	oauth *oauth.OAuth
+	oauth   *oauth.OAuth
+	storage storage.Storage
 }
 
 func NewHTTPServer(
@@ -32,6 +35,7 @@ func NewHTTPServer(
 	ticketsService service.TicketsService,
 	usersService service.UsersService,
 	oauth *oauth.OAuth,
+	storage storage.Storage,
 ) api.HTTPServer {
 	s := &server{
 		authConfig:     authConfig,
@@ -40,6 +44,7 @@ func NewHTTPServer(
 		ticketsService: ticketsService,
 		usersService:   usersService,
 		oauth:          oauth,
+		storage:        storage,
 	}
 
 	s.setRoutes()
@@ -70,3 +75,18 @@ func (s *server) getAndVerifyHeaderToken(r *http.Request) (string, *models.UserC
 
 	return token, claims, nil
 }
+
+// optionalUserID returns id of the authorized user or 0 for anonymous request
+func (s *server) optionalUserID(r *http.Request) int64 {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		return 0
+	}
+
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 	"time"
 
@@ -56,7 +57,7 @@ func TestEvents(t *testing.T) {
 				IsPublic:      false,
 				IsFree:        true,
 				PreviewImage:  gofakeit.UUID(),
-				UTCOffset:     gofakeit.Int64(),
+				TimeZone:      "Europe/Moscow",
 				Capacity:      gofakeit.Int64(),
 				MinimalAge:    gofakeit.Int64(),
 			},
@@ -117,6 +118,7 @@ func TestEvents(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 			server := httptest.NewServer(api.Handler())
 			defer server.Close()
@@ -191,7 +193,7 @@ func TestMyEvents(t *testing.T) {
 				IsPublic:      false,
 				IsFree:        true,
 				PreviewImage:  gofakeit.UUID(),
-				UTCOffset:     gofakeit.Int64(),
+				TimeZone:      "Europe/Moscow",
 				Capacity:      gofakeit.Int64(),
 				MinimalAge:    gofakeit.Int64(),
 			},
@@ -255,6 +257,7 @@ func TestMyEvents(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -317,7 +320,7 @@ func TestEvent(t *testing.T) {
 			IsPublic:      false,
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 		}
@@ -336,7 +339,8 @@ func TestEvent(t *testing.T) {
 			statusCode: http.StatusOK,
 			apiServiceMock: func(mc *minimock.Controller) service.EventsService {
 				mock := mocks.NewEventsServiceMock(mc)
-				mock.EventMock.Expect(minimock.AnyContext, urlTitle).Return(event, nil)
+				mock.EventMock.Expect(minimock.AnyContext, int64(0), urlTitle).Return(event, nil)
+				mock.RecordViewMock.Return()
 				return mock
 			},
 		},
@@ -346,7 +350,8 @@ func TestEvent(t *testing.T) {
 			statusCode: http.StatusNotFound,
 			apiServiceMock: func(mc *minimock.Controller) service.EventsService {
 				mock := mocks.NewEventsServiceMock(mc)
-				mock.EventMock.Expect(minimock.AnyContext, urlTitle).Return(nil, pgx.ErrNoRows)
+				mock.EventMock.Expect(minimock.AnyContext, int64(0), urlTitle).Return(nil, pgx.ErrNoRows)
+				mock.EventRedirectMock.Expect(minimock.AnyContext, urlTitle).Return("", pgx.ErrNoRows)
 				return mock
 			},
 		},
@@ -356,7 +361,7 @@ func TestEvent(t *testing.T) {
 			statusCode: http.StatusInternalServerError,
 			apiServiceMock: func(mc *minimock.Controller) service.EventsService {
 				mock := mocks.NewEventsServiceMock(mc)
-				mock.EventMock.Expect(minimock.AnyContext, urlTitle).Return(nil, serviceErr)
+				mock.EventMock.Expect(minimock.AnyContext, int64(0), urlTitle).Return(nil, serviceErr)
 				return mock
 			},
 		},
@@ -374,6 +379,7 @@ func TestEvent(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -398,6 +404,52 @@ func TestEvent(t *testing.T) {
 	}
 }
 
+func TestEventRedirect(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		oldTitle = "summer-fest"
+		urlTitle = "summer-fest-2030"
+	)
+
+	mock := mocks.NewEventsServiceMock(mc)
+	mock.EventMock.Expect(minimock.AnyContext, int64(0), oldTitle).Return(nil, pgx.ErrNoRows)
+	mock.EventRedirectMock.Expect(minimock.AnyContext, oldTitle).Return(urlTitle, nil)
+
+	api := httpServer.NewHTTPServer(
+		authCfg,
+		httpCfg,
+		mock,
+		nil,
+		nil,
+		oauth,
+		staticStorage,
+	)
+
+	server := httptest.NewServer(api.Handler())
+	defer server.Close()
+
+	client := server.Client()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/events/"+oldTitle, nil)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusMovedPermanently, resp.StatusCode)
+	require.Equal(t, "/api/events/"+urlTitle, resp.Header.Get("Location"))
+}
+
 func TestCreateEvent(t *testing.T) {
 	t.Parallel()
 
@@ -432,14 +484,14 @@ func TestCreateEvent(t *testing.T) {
 			Location:      gofakeit.ProgrammingLanguage(),
 			Description:   gofakeit.ProductDescription(),
 			BeginningTime: now,
-			EndTime:       now,
+			EndTime:       now.Add(2 * time.Hour),
 			CreatorID:     creatorID,
 			IsPublic:      false,
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
-			Capacity:      gofakeit.Int64(),
-			MinimalAge:    gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
+			Capacity:      int64(gofakeit.Number(1, 1000)),
+			MinimalAge:    int64(gofakeit.Number(0, 21)),
 		}
 
 		wrongEvent = &models.Event{
@@ -452,15 +504,22 @@ func TestCreateEvent(t *testing.T) {
 			IsPublic:      false,
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 		}
 	)
 
+	notImage, err := os.CreateTemp(t.TempDir(), "*.jpg")
+	require.NoError(t, err)
+	_, err = notImage.WriteString(gofakeit.Sentence(10))
+	require.NoError(t, err)
+	require.NoError(t, notImage.Close())
+
 	tests := []struct {
 		name         string
 		event        *models.Event
+		image        string
 		want         *models.DefaultResponse
 		statusCode   int
 		isAuthorized bool
@@ -505,6 +564,18 @@ func TestCreateEvent(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name:         "unsupported image case",
+			want:         nil,
+			isAuthorized: true,
+			event:        event,
+			image:        "@" + notImage.Name(),
+			statusCode:   http.StatusUnprocessableEntity,
+			apiServiceMock: func(mc *minimock.Controller) service.EventsService {
+				mock := mocks.NewEventsServiceMock(mc)
+				return mock
+			},
+		},
 		{
 			name:         "wrong input case",
 			want:         nil,
@@ -530,6 +601,7 @@ func TestCreateEvent(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -537,7 +609,7 @@ func TestCreateEvent(t *testing.T) {
 
 			data, _ := json.Marshal(tt.event)
 			form := map[string]string{
-				"image": "",
+				"image": tt.image,
 				"event": string(data),
 			}
 
@@ -601,14 +673,14 @@ func TestUpdateEvent(t *testing.T) {
 			Location:      gofakeit.ProgrammingLanguage(),
 			Description:   gofakeit.ProductDescription(),
 			BeginningTime: now,
-			EndTime:       now,
+			EndTime:       now.Add(2 * time.Hour),
 			CreatorID:     creatorID,
 			IsPublic:      false,
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
-			Capacity:      gofakeit.Int64(),
-			MinimalAge:    gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
+			Capacity:      int64(gofakeit.Number(1, 1000)),
+			MinimalAge:    int64(gofakeit.Number(0, 21)),
 		}
 	)
 
@@ -669,6 +741,7 @@ func TestUpdateEvent(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -745,7 +818,7 @@ func TestDeleteEvent(t *testing.T) {
 			IsPublic:      false,
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 		}
@@ -808,6 +881,7 @@ func TestDeleteEvent(t *testing.T) {
 				nil,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -844,3 +918,72 @@ func TestDeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestReviews(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx      = context.Background()
+		mc       = minimock.NewController(t)
+		urlTitle = gofakeit.UUID()
+		reviews  = []*models.Review{{ID: gofakeit.Int64(), Rating: 5, Comment: gofakeit.Sentence(5)}}
+	)
+
+	mock := mocks.NewEventsServiceMock(mc)
+	mock.ReviewsMock.Expect(minimock.AnyContext, urlTitle).Return(reviews, nil)
+
+	api := httpServer.NewHTTPServer(
+		authCfg,
+		httpCfg,
+		mock,
+		nil,
+		nil,
+		oauth,
+		staticStorage,
+	)
+
+	server := httptest.NewServer(api.Handler())
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		status int
+	}{
+		{
+			name:   "anonymous list case",
+			method: http.MethodGet,
+			status: http.StatusOK,
+		},
+		{
+			name:   "anonymous review case",
+			method: http.MethodPost,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequestWithContext(ctx, tt.method, server.URL+"/api/events/"+urlTitle+"/reviews", nil)
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tt.status, resp.StatusCode)
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var res []*models.Review
+			err = json.NewDecoder(resp.Body).Decode(&res)
+			require.NoError(t, err)
+			require.Equal(t, reviews, res)
+		})
+	}
+}
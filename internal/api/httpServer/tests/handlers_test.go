@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/api/httpServer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/oauth"
+)
+
+func TestStatic(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx     = context.Background()
+		name    = gofakeit.UUID() + ".png"
+		content = []byte(gofakeit.Sentence(5))
+
+		method = http.MethodGet
+		url    = "/api/static/"
+	)
+
+	err := staticStorage.Put(ctx, name, bytes.NewReader(content), int64(len(content)), "image/png")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		staticStorage.Delete(ctx, name)
+	})
+
+	tests := []struct {
+		name       string
+		object     string
+		want       []byte
+		statusCode int
+	}{
+		{
+			name:       "success case",
+			object:     name,
+			want:       content,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "not found case",
+			object:     gofakeit.UUID() + ".png",
+			want:       nil,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			api := httpServer.NewHTTPServer(
+				authCfg,
+				httpCfg,
+				nil,
+				nil,
+				nil,
+				oauth,
+				staticStorage,
+			)
+
+			server := httptest.NewServer(api.Handler())
+			defer server.Close()
+
+			req, _ := http.NewRequestWithContext(
+				ctx,
+				method,
+				server.URL+url+tt.object,
+				nil,
+			)
+
+			resp, _ := server.Client().Do(req)
+
+			require.Equal(t, tt.statusCode, resp.StatusCode)
+			if tt.want != nil {
+				body, _ := io.ReadAll(resp.Body)
+				require.Equal(t, tt.want, body)
+				require.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+			}
+		})
+	}
+}
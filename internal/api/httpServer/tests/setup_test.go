@@ -1,10 +1,15 @@
 package tests
 
 import (
+	"os"
+
 	"github.com/wDRxxx/eventflow-backend/internal/config"
 	"github.com/wDRxxx/eventflow-backend/internal/metrics"
+	"github.com/wDRxxx/eventflow-backend/internal/storage/fs"
 )
 
+var staticStorage = fs.NewFSStorage(os.TempDir(), "/api/static/")
+
 func init() {
 	metrics.Init("test")
 	config.Load(".env")
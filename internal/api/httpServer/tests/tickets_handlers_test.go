@@ -113,6 +113,7 @@ func TestUserTickets(t *testing.T) {
 				apiServiceMock,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -235,6 +236,7 @@ func TestBuyTicket(t *testing.T) {
 				apiServiceMock,
 				nil,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -266,3 +268,65 @@ func TestBuyTicket(t *testing.T) {
 		})
 	}
 }
+
+func TestBuyTicketValidation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		user = &models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: fmt.Sprint(gofakeit.Int64())},
+			Email:            gofakeit.Email(),
+		}
+		request = &models.BuyTicketRequest{
+			EventUrlTitle: gofakeit.UUID(),
+			FirstName:     gofakeit.FirstName(),
+			LastName:      gofakeit.LastName(),
+			Answers: []*models.TicketAnswer{
+				{QuestionID: gofakeit.Int64(), Values: []string{"yes"}},
+				{Values: []string{"no"}},
+			},
+		}
+	)
+
+	api := httpServer.NewHTTPServer(
+		authCfg,
+		httpCfg,
+		nil,
+		mocks.NewTicketsServiceMock(mc),
+		nil,
+		oauth,
+		staticStorage,
+	)
+
+	server := httptest.NewServer(api.Handler())
+	defer server.Close()
+
+	data, _ := json.Marshal(request)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/tickets", bytes.NewBuffer(data))
+
+	token, _ := utils.GenerateToken(user, authCfg.AccessTokenSecret(), authCfg.AccessTokenTTL())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	var res *models.ValidationErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, &models.ValidationErrorResponse{
+		Error:   true,
+		Message: "validation failed",
+		Errors: []*models.FieldError{
+			{Field: "answers[1].question_id", Code: "required", Message: "is required"},
+		},
+	}, res)
+}
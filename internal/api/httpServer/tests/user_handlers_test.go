@@ -122,6 +122,7 @@ func TestRegister(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -238,6 +239,7 @@ func TestLogin(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -308,7 +310,18 @@ func TestRefresh(t *testing.T) {
 			statusCode: http.StatusOK,
 			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
 				mock := mocks.NewUsersServiceMock(mc)
-				mock.AccessTokenMock.Expect(minimock.AnyContext, refreshToken).Return(accessToken, nil)
+				mock.AccessTokenMock.Expect(minimock.AnyContext, refreshToken).Return(accessToken, "next", nil)
+				return mock
+			},
+		},
+		{
+			name:       "invalid refresh token case",
+			want:       nil,
+			hasCookie:  true,
+			statusCode: http.StatusUnauthorized,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.AccessTokenMock.Expect(minimock.AnyContext, refreshToken).Return("", "", service.ErrInvalidRefreshToken)
 				return mock
 			},
 		},
@@ -319,7 +332,7 @@ func TestRefresh(t *testing.T) {
 			statusCode: http.StatusInternalServerError,
 			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
 				mock := mocks.NewUsersServiceMock(mc)
-				mock.AccessTokenMock.Expect(minimock.AnyContext, refreshToken).Return("", serviceErr)
+				mock.AccessTokenMock.Expect(minimock.AnyContext, refreshToken).Return("", "", serviceErr)
 				return mock
 			},
 		},
@@ -346,6 +359,7 @@ func TestRefresh(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -392,12 +406,15 @@ func TestLogout(t *testing.T) {
 
 		method = http.MethodPost
 		url    = "/api/auth/logout"
+
+		refreshToken = "refresh"
 	)
 
 	tests := []struct {
 		name       string
 		want       string
 		statusCode int
+		hasCookie  bool
 
 		apiServiceMock apiServiceMockFunc
 	}{
@@ -405,6 +422,18 @@ func TestLogout(t *testing.T) {
 			name:       "success case",
 			want:       "",
 			statusCode: http.StatusAccepted,
+			hasCookie:  true,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.LogoutMock.Expect(minimock.AnyContext, refreshToken).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:       "no refresh token cookie case",
+			want:       "",
+			statusCode: http.StatusAccepted,
+			hasCookie:  false,
 			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
 				mock := mocks.NewUsersServiceMock(mc)
 				return mock
@@ -423,6 +452,7 @@ func TestLogout(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -434,6 +464,12 @@ func TestLogout(t *testing.T) {
 				server.URL+url,
 				nil,
 			)
+			if tt.hasCookie {
+				req.AddCookie(&http.Cookie{
+					Name:  "refresh_token",
+					Value: refreshToken,
+				})
+			}
 
 			resp, _ := server.Client().Do(req)
 			require.Equal(t, tt.statusCode, resp.StatusCode)
@@ -442,6 +478,107 @@ func TestLogout(t *testing.T) {
 	}
 }
 
+func TestLogoutAll(t *testing.T) {
+	t.Parallel()
+
+	type apiServiceMockFunc func(mc *minimock.Controller) service.UsersService
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		method = http.MethodPost
+		url    = "/api/auth/logout-all"
+
+		serviceErr = errors.New("service error")
+
+		userID     = gofakeit.Int64()
+		userClaims = &models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: fmt.Sprint(userID)},
+			Email:            gofakeit.Email(),
+		}
+	)
+
+	tests := []struct {
+		name         string
+		isAuthorized bool
+		statusCode   int
+
+		apiServiceMock apiServiceMockFunc
+	}{
+		{
+			name:         "success case",
+			isAuthorized: true,
+			statusCode:   http.StatusAccepted,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.LogoutAllMock.Expect(minimock.AnyContext, userID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "unauthorized case",
+			isAuthorized: false,
+			statusCode:   http.StatusUnauthorized,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				return mocks.NewUsersServiceMock(mc)
+			},
+		},
+		{
+			name:         "failure case",
+			isAuthorized: true,
+			statusCode:   http.StatusInternalServerError,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.LogoutAllMock.Expect(minimock.AnyContext, userID).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServiceMock := tt.apiServiceMock(mc)
+
+			api := httpServer.NewHTTPServer(
+				authCfg,
+				httpCfg,
+				nil,
+				nil,
+				apiServiceMock,
+				oauth,
+				staticStorage,
+			)
+
+			server := httptest.NewServer(api.Handler())
+			defer server.Close()
+
+			req, _ := http.NewRequestWithContext(
+				ctx,
+				method,
+				server.URL+url,
+				nil,
+			)
+			if tt.isAuthorized {
+				token, _ := utils.GenerateToken(userClaims, authCfg.AccessTokenSecret(), authCfg.AccessTokenTTL())
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			}
+
+			resp, _ := server.Client().Do(req)
+			require.Equal(t, tt.statusCode, resp.StatusCode)
+			if tt.statusCode == http.StatusAccepted {
+				require.Equal(t, "", resp.Cookies()[0].Value)
+			}
+		})
+	}
+}
+
 func TestProfile(t *testing.T) {
 	t.Parallel()
 
@@ -528,6 +665,7 @@ func TestProfile(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -645,6 +783,7 @@ func TestUpdateProfile(t *testing.T) {
 				nil,
 				apiServiceMock,
 				oauth,
+				staticStorage,
 			)
 
 			server := httptest.NewServer(api.Handler())
@@ -674,3 +813,301 @@ func TestUpdateProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	type apiServiceMockFunc func(mc *minimock.Controller) service.UsersService
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		method = http.MethodPost
+		url    = "/api/auth/verify-email"
+
+		token = gofakeit.UUID()
+	)
+
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+
+		apiServiceMock apiServiceMockFunc
+	}{
+		{
+			name:       "success case",
+			body:       fmt.Sprintf(`{"token":"%s"}`, token),
+			statusCode: http.StatusOK,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.VerifyEmailMock.Expect(minimock.AnyContext, token).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:       "invalid token case",
+			body:       fmt.Sprintf(`{"token":"%s"}`, token),
+			statusCode: http.StatusBadRequest,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.VerifyEmailMock.Expect(minimock.AnyContext, token).Return(service.ErrInvalidVerifyToken)
+				return mock
+			},
+		},
+		{
+			name:       "no token case",
+			body:       `{}`,
+			statusCode: http.StatusUnprocessableEntity,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				return mocks.NewUsersServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServiceMock := tt.apiServiceMock(mc)
+
+			api := httpServer.NewHTTPServer(
+				authCfg,
+				httpCfg,
+				nil,
+				nil,
+				apiServiceMock,
+				oauth,
+				staticStorage,
+			)
+
+			server := httptest.NewServer(api.Handler())
+			defer server.Close()
+
+			req, _ := http.NewRequestWithContext(
+				ctx,
+				method,
+				server.URL+url,
+				bytes.NewBufferString(tt.body),
+			)
+
+			resp, _ := server.Client().Do(req)
+			require.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	t.Parallel()
+
+	type apiServiceMockFunc func(mc *minimock.Controller) service.UsersService
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		method = http.MethodPost
+		url    = "/api/auth/verify-email/resend"
+
+		userEmail  = gofakeit.Email()
+		userClaims = &models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: fmt.Sprint(gofakeit.Int64())},
+			Email:            userEmail,
+		}
+	)
+
+	tests := []struct {
+		name         string
+		isAuthorized bool
+		statusCode   int
+
+		apiServiceMock apiServiceMockFunc
+	}{
+		{
+			name:         "success case",
+			isAuthorized: true,
+			statusCode:   http.StatusAccepted,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.ResendVerificationMock.Expect(minimock.AnyContext, userEmail).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "rate limited case",
+			isAuthorized: true,
+			statusCode:   http.StatusTooManyRequests,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.ResendVerificationMock.Expect(minimock.AnyContext, userEmail).Return(service.ErrVerificationRateLimit)
+				return mock
+			},
+		},
+		{
+			name:         "already verified case",
+			isAuthorized: true,
+			statusCode:   http.StatusConflict,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.ResendVerificationMock.Expect(minimock.AnyContext, userEmail).Return(service.ErrEmailAlreadyVerified)
+				return mock
+			},
+		},
+		{
+			name:         "unauthorized case",
+			isAuthorized: false,
+			statusCode:   http.StatusUnauthorized,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				return mocks.NewUsersServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServiceMock := tt.apiServiceMock(mc)
+
+			api := httpServer.NewHTTPServer(
+				authCfg,
+				httpCfg,
+				nil,
+				nil,
+				apiServiceMock,
+				oauth,
+				staticStorage,
+			)
+
+			server := httptest.NewServer(api.Handler())
+			defer server.Close()
+
+			req, _ := http.NewRequestWithContext(
+				ctx,
+				method,
+				server.URL+url,
+				nil,
+			)
+			if tt.isAuthorized {
+				token, _ := utils.GenerateToken(userClaims, authCfg.AccessTokenSecret(), authCfg.AccessTokenTTL())
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			}
+
+			resp, _ := server.Client().Do(req)
+			require.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Parallel()
+
+	type apiServiceMockFunc func(mc *minimock.Controller) service.UsersService
+
+	var (
+		authCfg  = config.NewAuthConfig()
+		httpCfg  = config.NewHttpConfig()
+		oauthCfg = config.NewOAuthConfig()
+
+		oauth = oauth.NewOAuth(oauthCfg)
+
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		method = http.MethodPost
+		url    = "/api/auth/password/change"
+
+		userEmail  = gofakeit.Email()
+		userClaims = &models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: fmt.Sprint(gofakeit.Int64())},
+			Email:            userEmail,
+		}
+		req = &models.ChangePasswordRequest{
+			CurrentPassword: "current password",
+			NewPassword:     "new password",
+		}
+	)
+
+	tests := []struct {
+		name         string
+		isAuthorized bool
+		statusCode   int
+
+		apiServiceMock apiServiceMockFunc
+	}{
+		{
+			name:         "success case",
+			isAuthorized: true,
+			statusCode:   http.StatusOK,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.ChangePasswordMock.Expect(minimock.AnyContext, userEmail, req).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "wrong password case",
+			isAuthorized: true,
+			statusCode:   http.StatusForbidden,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				mock := mocks.NewUsersServiceMock(mc)
+				mock.ChangePasswordMock.Expect(minimock.AnyContext, userEmail, req).Return(service.ErrWrongPassword)
+				return mock
+			},
+		},
+		{
+			name:         "unauthorized case",
+			isAuthorized: false,
+			statusCode:   http.StatusUnauthorized,
+			apiServiceMock: func(mc *minimock.Controller) service.UsersService {
+				return mocks.NewUsersServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiServiceMock := tt.apiServiceMock(mc)
+
+			api := httpServer.NewHTTPServer(
+				authCfg,
+				httpCfg,
+				nil,
+				nil,
+				apiServiceMock,
+				oauth,
+				staticStorage,
+			)
+
+			server := httptest.NewServer(api.Handler())
+			defer server.Close()
+
+			body, _ := json.Marshal(req)
+			r, _ := http.NewRequestWithContext(
+				ctx,
+				method,
+				server.URL+url,
+				bytes.NewBuffer(body),
+			)
+			if tt.isAuthorized {
+				token, _ := utils.GenerateToken(userClaims, authCfg.AccessTokenSecret(), authCfg.AccessTokenTTL())
+				r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			}
+
+			resp, _ := server.Client().Do(r)
+			require.Equal(t, tt.statusCode, resp.StatusCode)
+			if tt.statusCode == http.StatusOK {
+				require.Equal(t, "", resp.Cookies()[0].Value)
+			}
+		})
+	}
+}
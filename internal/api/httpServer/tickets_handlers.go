@@ -1,13 +1,20 @@
 package httpServer
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
 	"github.com/wDRxxx/eventflow-backend/internal/api"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
 )
 
 func (s *server) userTickets(w http.ResponseWriter, r *http.Request) {
@@ -46,7 +53,13 @@ func (s *server) buyTicket(w http.ResponseWriter, r *http.Request) {
 	err = utils.ReadJSON(r.Body, &req)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
 		return
 	}
 
@@ -54,6 +67,19 @@ func (s *server) buyTicket(w http.ResponseWriter, r *http.Request) {
 
 	url, err := s.ticketsService.BuyTicket(r.Context(), &req)
 	if err != nil {
+		if errors.Is(err, service.ErrEventNotPublished) {
+			utils.WriteJSONError(err, w, http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, service.ErrRequiredAnswer) || errors.Is(err, service.ErrWrongAnswer) {
+			utils.WriteJSONError(err, w, http.StatusUnprocessableEntity)
+			return
+		}
+
 		slog.Error("Error buying ticket", slog.Any("error", err))
 		utils.WriteJSONError(api.ErrInternal, w)
 		return
@@ -64,3 +90,271 @@ func (s *server) buyTicket(w http.ResponseWriter, r *http.Request) {
 		Message: url,
 	}, w)
 }
+
+func (s *server) checkIn(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	var req models.CheckInRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	ticket, err := s.ticketsService.CheckIn(r.Context(), int64(id), urlTitle, req.TicketID)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows), errors.Is(err, service.ErrTicketNotFound):
+			utils.WriteJSONError(service.ErrTicketNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		case errors.Is(err, service.ErrTicketUsed):
+			utils.WriteJSONError(err, w, http.StatusConflict)
+		default:
+			slog.Error("Error checking in ticket", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(ticket, w)
+}
+
+func (s *server) salesReport(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	report, err := s.ticketsService.SalesReport(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		default:
+			slog.Error("Error getting sales report", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(report, w)
+}
+
+func (s *server) attendees(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+	query := r.URL.Query()
+
+	filter := &models.AttendeesFilter{
+		Search: query.Get("search"),
+	}
+	if p := query.Get("page"); p != "" {
+		filter.Page, err = strconv.Atoi(p)
+		if err != nil || filter.Page < 1 {
+			utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+			return
+		}
+	}
+	if p := query.Get("per_page"); p != "" {
+		filter.PerPage, err = strconv.Atoi(p)
+		if err != nil || filter.PerPage < 1 {
+			utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	page, err := s.ticketsService.Attendees(r.Context(), int64(id), urlTitle, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		default:
+			slog.Error("Error getting attendees", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(page, w)
+}
+
+func (s *server) exportAttendees(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = models.ExportFormatCSV
+	}
+
+	file, err := s.ticketsService.ExportAttendees(r.Context(), int64(id), urlTitle, format, query.Get("search"))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		case errors.Is(err, service.ErrWrongExportFormat):
+			utils.WriteJSONError(err, w, http.StatusBadRequest)
+		default:
+			slog.Error("Error exporting attendees", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == models.ExportFormatXLSX {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-attendees.%s\"", urlTitle, format))
+	w.WriteHeader(http.StatusOK)
+	w.Write(file)
+}
+
+func (s *server) eventStats(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	stats, err := s.ticketsService.EventStats(r.Context(), int64(id), urlTitle, r.URL.Query().Get("interval"))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		case errors.Is(err, service.ErrWrongInterval):
+			utils.WriteJSONError(err, w, http.StatusBadRequest)
+		default:
+			slog.Error("Error getting event stats", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(stats, w)
+}
+
+func (s *server) userStats(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	stats, err := s.ticketsService.UserStats(r.Context(), int64(id))
+	if err != nil {
+		slog.Error("Error getting user stats", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(stats, w)
+}
+
+func (s *server) funnel(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		slog.Error("Error getting claims", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		slog.Error("Error converting claims.Subject to int", slog.Any("error", err), slog.String("subject", claims.Subject))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+	urlTitle := chi.URLParam(r, "url-title")
+
+	funnel, err := s.ticketsService.Funnel(r.Context(), int64(id), urlTitle)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			utils.WriteJSONError(api.ErrNotFound, w, http.StatusNotFound)
+		case errors.Is(err, service.ErrPermissionDenied):
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+		default:
+			slog.Error("Error getting funnel", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(funnel, w)
+}
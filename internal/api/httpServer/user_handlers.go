@@ -12,6 +12,7 @@ import (
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
 )
 
 func (s *server) register(w http.ResponseWriter, r *http.Request) {
@@ -19,14 +20,13 @@ func (s *server) register(w http.ResponseWriter, r *http.Request) {
 	err := utils.ReadReqJSON(w, r, &user)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
 		return
 	}
 
-	if !utils.IsEmail(user.Email) ||
-		user.Password == "" {
-
-		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusUnprocessableEntity)
+	err = validation.Struct(&user)
+	if err != nil {
+		s.writeValidationError(err, w)
 		return
 	}
 
@@ -53,14 +53,13 @@ func (s *server) login(w http.ResponseWriter, r *http.Request) {
 	err := utils.ReadReqJSON(w, r, &user)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
 		return
 	}
 
-	if !utils.IsEmail(user.Email) ||
-		user.Password == "" {
-
-		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusUnprocessableEntity)
+	err = validation.Struct(&user)
+	if err != nil {
+		s.writeValidationError(err, w)
 		return
 	}
 
@@ -76,15 +75,7 @@ func (s *server) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		Expires:  time.Now().Add(s.authConfig.RefreshTokenTTL()),
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true,
-		SameSite: http.SameSiteStrictMode,
-	})
+	s.setRefreshCookie(refreshToken, w)
 
 	utils.WriteJSON(&models.DefaultResponse{
 		Message: "logged in",
@@ -99,13 +90,21 @@ func (s *server) refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessToken, err := s.usersService.AccessToken(r.Context(), refreshToken.Value)
+	accessToken, nextRefreshToken, err := s.usersService.AccessToken(r.Context(), refreshToken.Value)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			s.clearRefreshCookie(w)
+			utils.WriteJSONError(err, w, http.StatusUnauthorized)
+			return
+		}
+
 		slog.Error("Error getting access token", slog.Any("error", err))
 		utils.WriteJSONError(api.ErrInternal, w)
 		return
 	}
 
+	s.setRefreshCookie(nextRefreshToken, w)
+
 	utils.WriteJSON(&models.DefaultResponse{
 		Error:   false,
 		Message: accessToken,
@@ -113,6 +112,225 @@ func (s *server) refresh(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *server) logout(w http.ResponseWriter, r *http.Request) {
+	refreshToken, err := r.Cookie("refresh_token")
+	if err == nil {
+		err = s.usersService.Logout(r.Context(), refreshToken.Value)
+		if err != nil {
+			slog.Error("Error revoking session", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+			return
+		}
+	}
+
+	s.clearRefreshCookie(w)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *server) logoutAll(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	err = s.usersService.LogoutAll(r.Context(), int64(userID))
+	if err != nil {
+		slog.Error("Error revoking sessions", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	s.clearRefreshCookie(w)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *server) verifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req models.VerifyEmailRequest
+	err := utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.usersService.VerifyEmail(r.Context(), req.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidVerifyToken) {
+			utils.WriteJSONError(err, w, http.StatusBadRequest)
+			return
+		}
+
+		slog.Error("Error verifying email", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "email verified",
+	}, w)
+}
+
+func (s *server) resendVerification(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	err = s.usersService.ResendVerification(r.Context(), claims.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEmailAlreadyVerified):
+			utils.WriteJSONError(err, w, http.StatusConflict)
+		case errors.Is(err, service.ErrVerificationRateLimit):
+			w.Header().Set("Retry-After", "60")
+			utils.WriteJSONError(err, w, http.StatusTooManyRequests)
+		default:
+			slog.Error("Error resending verification mail", slog.Any("error", err))
+			utils.WriteJSONError(api.ErrInternal, w)
+		}
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "verification mail sent",
+	}, w, http.StatusAccepted)
+}
+
+func (s *server) forgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req models.ForgotPasswordRequest
+	err := utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.usersService.ForgotPassword(r.Context(), req.Email)
+	if err != nil {
+		slog.Error("Error requesting password reset", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "if the account exists, password reset link was sent to the email",
+	}, w, http.StatusAccepted)
+}
+
+func (s *server) resetPassword(w http.ResponseWriter, r *http.Request) {
+	var req models.ResetPasswordRequest
+	err := utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.usersService.ResetPassword(r.Context(), req.Token, req.Password)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidResetToken) {
+			utils.WriteJSONError(err, w, http.StatusBadRequest)
+			return
+		}
+
+		slog.Error("Error resetting password", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	s.clearRefreshCookie(w)
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "password changed",
+	}, w)
+}
+
+func (s *server) changePassword(w http.ResponseWriter, r *http.Request) {
+	_, claims, err := s.getAndVerifyHeaderToken(r)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var req models.ChangePasswordRequest
+	err = utils.ReadReqJSON(w, r, &req)
+	if err != nil {
+		slog.Error("Error reading request body", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Struct(&req)
+	if err != nil {
+		s.writeValidationError(err, w)
+		return
+	}
+
+	err = s.usersService.ChangePassword(r.Context(), claims.Email, &req)
+	if err != nil {
+		if errors.Is(err, service.ErrWrongPassword) {
+			utils.WriteJSONError(err, w, http.StatusForbidden)
+			return
+		}
+
+		slog.Error("Error changing password", slog.Any("error", err))
+		utils.WriteJSONError(api.ErrInternal, w)
+		return
+	}
+
+	// every session is revoked, including the current one
+	s.clearRefreshCookie(w)
+
+	utils.WriteJSON(&models.DefaultResponse{
+		Error:   false,
+		Message: "password changed",
+	}, w)
+}
+
+func (s *server) setRefreshCookie(refreshToken string, w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken,
+		Expires:  time.Now().Add(s.authConfig.RefreshTokenTTL()),
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func (s *server) clearRefreshCookie(w http.ResponseWriter) {
 	http.SetCookie(w, &http.Cookie{
 		Name:     "refresh_token",
 		Value:    "",
@@ -122,8 +340,6 @@ func (s *server) logout(w http.ResponseWriter, r *http.Request) {
 		Secure:   true,
 		SameSite: http.SameSiteStrictMode,
 	})
-
-	w.WriteHeader(http.StatusAccepted)
 }
 
 func (s *server) profile(w http.ResponseWriter, r *http.Request) {
@@ -155,7 +371,13 @@ func (s *server) updateProfile(w http.ResponseWriter, r *http.Request) {
 	err = utils.ReadReqJSON(w, r, &user)
 	if err != nil {
 		slog.Error("Error reading request body", slog.Any("error", err))
-		utils.WriteJSONError(api.ErrInternal, w)
+		utils.WriteJSONError(api.ErrWrongInput, w, http.StatusBadRequest)
+		return
+	}
+
+	err = validation.Partial(&user)
+	if err != nil {
+		s.writeValidationError(err, w)
 		return
 	}
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/gc"
 	"github.com/wDRxxx/eventflow-backend/internal/mailer"
 	"github.com/wDRxxx/eventflow-backend/internal/metrics"
 )
@@ -23,7 +24,8 @@ type App struct {
 	httpServer       *http.Server
 	prometheusServer *http.Server
 
-	mailer mailer.Mailer
+	mailer    mailer.Mailer
+	collector *gc.Collector
 }
 
 func NewApp(ctx context.Context, wg *sync.WaitGroup, envPath string) (*App, error) {
@@ -49,6 +51,7 @@ func (a *App) initDeps(ctx context.Context) error {
 	a.initHTTPServer(ctx)
 	a.initPrometheusServer()
 	a.initMailer()
+	a.initCollector(ctx)
 
 	return nil
 }
@@ -76,6 +79,10 @@ func (a *App) initMailer() {
 	a.mailer = m
 }
 
+func (a *App) initCollector(ctx context.Context) {
+	a.collector = a.serviceProvider.Collector(ctx, a.wg)
+}
+
 func (a *App) Run() error {
 	defer func() {
 		closer.CloseAll()
@@ -118,6 +125,16 @@ func (a *App) Run() error {
 		a.mailer.ListenForMails()
 	}()
 
+	a.wg.Add(1)
+	go func() {
+		closer.Add(1, func() error {
+			a.wg.Done()
+			return nil
+		})
+
+		a.collector.Run()
+	}()
+
 	a.wg.Wait()
 
 	return nil
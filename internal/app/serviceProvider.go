@@ -11,8 +11,12 @@ import (
 
 	"github.com/wDRxxx/eventflow-backend/internal/api"
 	"github.com/wDRxxx/eventflow-backend/internal/api/httpServer"
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/gc"
+	"github.com/wDRxxx/eventflow-backend/internal/geocoder"
+	"github.com/wDRxxx/eventflow-backend/internal/geocoder/nominatim"
 	"github.com/wDRxxx/eventflow-backend/internal/mailer"
 	"github.com/wDRxxx/eventflow-backend/internal/mailer/smtp"
 	"github.com/wDRxxx/eventflow-backend/internal/oauth"
@@ -22,8 +26,13 @@ import (
 	"github.com/wDRxxx/eventflow-backend/internal/service/eventsService"
 	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
 	"github.com/wDRxxx/eventflow-backend/internal/service/usersService"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
+	"github.com/wDRxxx/eventflow-backend/internal/storage/fs"
+	"github.com/wDRxxx/eventflow-backend/internal/storage/s3"
 )
 
+const staticURLPrefix = "/api/static/"
+
 type serviceProvider struct {
 	httpConfig     *config.HttpConfig
 	postgresConfig *config.PostgresConfig
@@ -31,15 +40,22 @@ type serviceProvider struct {
 	mailerConfig   *config.MailerConfig
 	metricsConfig  *config.MetricsConfig
 	oauthConfig    *config.OAuthConfig
+	storageConfig  *config.StorageConfig
+	gcConfig       *config.GCConfig
+	geocoderConfig *config.GeocoderConfig
 
 	repository repository.Repository
+	storage    storage.Storage
+	geocoder   geocoder.Geocoder
+	authorizer authz.Authorizer
 	httpServer api.HTTPServer
 
 	eventsService  service.EventsService
 	ticketsService service.TicketsService
 	usersService   service.UsersService
 
-	mailer mailer.Mailer
+	mailer    mailer.Mailer
+	collector *gc.Collector
 
 	oauth *oauth.OAuth
 }
@@ -95,6 +111,30 @@ func (s *serviceProvider) OAuthConfig() *config.OAuthConfig {
 	return s.oauthConfig
 }
 
+func (s *serviceProvider) StorageConfig() *config.StorageConfig {
+	if s.storageConfig == nil {
+		s.storageConfig = config.NewStorageConfig()
+	}
+
+	return s.storageConfig
+}
+
+func (s *serviceProvider) GCConfig() *config.GCConfig {
+	if s.gcConfig == nil {
+		s.gcConfig = config.NewGCConfig()
+	}
+
+	return s.gcConfig
+}
+
+func (s *serviceProvider) GeocoderConfig() *config.GeocoderConfig {
+	if s.geocoderConfig == nil {
+		s.geocoderConfig = config.NewGeocoderConfig()
+	}
+
+	return s.geocoderConfig
+}
+
 func (s *serviceProvider) Repository(ctx context.Context) repository.Repository {
 	if s.repository == nil {
 		db, err := pgxpool.New(ctx, s.PostgresConfig().ConnectionString())
@@ -118,9 +158,50 @@ func (s *serviceProvider) Repository(ctx context.Context) repository.Repository
 	return s.repository
 }
 
-func (s *serviceProvider) EventsService(ctx context.Context) service.EventsService {
+func (s *serviceProvider) Storage(ctx context.Context) storage.Storage {
+	if s.storage == nil {
+		switch s.StorageConfig().Type() {
+		case config.StorageTypeS3:
+			st, err := s3.NewS3Storage(ctx, s.StorageConfig())
+			if err != nil {
+				log.Fatalf("error connecting to s3 storage: %v", err)
+			}
+
+			s.storage = st
+		default:
+			s.storage = fs.NewFSStorage(s.HttpConfig().StaticDir(), staticURLPrefix)
+		}
+	}
+
+	return s.storage
+}
+
+func (s *serviceProvider) Geocoder() geocoder.Geocoder {
+	if s.geocoder == nil {
+		s.geocoder = nominatim.NewNominatimGeocoder(s.GeocoderConfig())
+	}
+
+	return s.geocoder
+}
+
+func (s *serviceProvider) Authorizer(ctx context.Context) authz.Authorizer {
+	if s.authorizer == nil {
+		s.authorizer = authz.NewAuthorizer(s.Repository(ctx))
+	}
+
+	return s.authorizer
+}
+
+func (s *serviceProvider) EventsService(ctx context.Context, wg *sync.WaitGroup) service.EventsService {
 	if s.eventsService == nil {
-		s.eventsService = eventsService.NewEventsService(s.Repository(ctx))
+		s.eventsService = eventsService.NewEventsService(
+			s.Repository(ctx),
+			s.Storage(ctx),
+			s.Geocoder(),
+			s.Authorizer(ctx),
+			s.Mailer(wg),
+			s.AuthConfig(),
+		)
 	}
 
 	return s.eventsService
@@ -132,15 +213,17 @@ func (s *serviceProvider) TicketsService(ctx context.Context, wg *sync.WaitGroup
 			wg,
 			s.Repository(ctx),
 			s.Mailer(wg),
+			s.AuthConfig(),
+			s.Authorizer(ctx),
 		)
 	}
 
 	return s.ticketsService
 }
 
-func (s *serviceProvider) UsersService(ctx context.Context) service.UsersService {
+func (s *serviceProvider) UsersService(ctx context.Context, wg *sync.WaitGroup) service.UsersService {
 	if s.usersService == nil {
-		s.usersService = usersService.NewUsersService(s.Repository(ctx), s.AuthConfig())
+		s.usersService = usersService.NewUsersService(s.Repository(ctx), s.Mailer(wg), s.AuthConfig())
 	}
 
 	return s.usersService
@@ -151,10 +234,11 @@ func (s *serviceProvider) HTTPServer(ctx context.Context, wg *sync.WaitGroup) ap
 		s.httpServer = httpServer.NewHTTPServer(
 			s.AuthConfig(),
 			s.HttpConfig(),
-			s.EventsService(ctx),
+			s.EventsService(ctx, wg),
 			s.TicketsService(ctx, wg),
-			s.UsersService(ctx),
+			s.UsersService(ctx, wg),
 			s.OAuth(),
+			s.Storage(ctx),
 		)
 	}
 
@@ -174,6 +258,14 @@ func (s *serviceProvider) Mailer(wg *sync.WaitGroup) mailer.Mailer {
 	return s.mailer
 }
 
+func (s *serviceProvider) Collector(ctx context.Context, wg *sync.WaitGroup) *gc.Collector {
+	if s.collector == nil {
+		s.collector = gc.NewCollector(wg, s.Repository(ctx), s.Storage(ctx), s.GCConfig())
+	}
+
+	return s.collector
+}
+
 func (s *serviceProvider) OAuth() *oauth.OAuth {
 	if s.oauth == nil {
 		s.oauth = oauth.NewOAuth(s.OAuthConfig())
@@ -0,0 +1,103 @@
+package authz
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+type Action string
+
+const (
+	ActionViewEvent     Action = "view_event"
+	ActionUpdateEvent   Action = "update_event"
+	ActionDeleteEvent   Action = "delete_event"
+	ActionCancelEvent   Action = "cancel_event"
+	ActionManageMembers Action = "manage_members"
+	ActionCheckIn       Action = "check_in"
+	ActionViewSales     Action = "view_sales"
+	ActionViewAttendees Action = "view_attendees"
+	ActionReplyReviews  Action = "reply_reviews"
+	ActionViewHistory   Action = "view_history"
+)
+
+var roleActions = map[string][]Action{
+	models.EventRoleOwner: {
+		ActionViewEvent,
+		ActionUpdateEvent,
+		ActionDeleteEvent,
+		ActionCancelEvent,
+		ActionManageMembers,
+		ActionCheckIn,
+		ActionViewSales,
+		ActionViewAttendees,
+		ActionReplyReviews,
+		ActionViewHistory,
+	},
+	models.EventRoleEditor: {
+		ActionViewEvent,
+		ActionUpdateEvent,
+		ActionReplyReviews,
+	},
+	models.EventRoleCheckIn: {
+		ActionViewEvent,
+		ActionCheckIn,
+	},
+	models.EventRoleFinance: {
+		ActionViewEvent,
+		ActionViewSales,
+	},
+}
+
+// Authorizer decides whether user may perform action on the event
+type Authorizer interface {
+	Authorize(ctx context.Context, userID int64, event *models.Event, action Action) error
+}
+
+type authorizer struct {
+	repo repository.Repository
+}
+
+func NewAuthorizer(repo repository.Repository) Authorizer {
+	return &authorizer{repo: repo}
+}
+
+// Authorize returns service.ErrPermissionDenied if user has no role allowing the action.
+// Creator of the event is always its owner
+func (a *authorizer) Authorize(ctx context.Context, userID int64, event *models.Event, action Action) error {
+	if userID == 0 {
+		return service.ErrPermissionDenied
+	}
+
+	role := models.EventRoleOwner
+	if event.CreatorID != userID {
+		var err error
+
+		role, err = a.repo.EventMemberRole(ctx, event.ID, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return service.ErrPermissionDenied
+			}
+
+			return err
+		}
+	}
+
+	if !slices.Contains(roleActions[role], action) {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// IsValidRole reports whether role is one of known event roles
+func IsValidRole(role string) bool {
+	_, ok := roleActions[role]
+	return ok
+}
@@ -12,6 +12,8 @@ type AuthConfig struct {
 	accessTokenTTL     time.Duration
 	refreshTokenSecret string
 	refreshTokenTTL    time.Duration
+	verifyTokenSecret  string
+	verifyTokenTTL     time.Duration
 	domain             string
 }
 
@@ -35,6 +37,14 @@ func (c *AuthConfig) RefreshTokenTTL() time.Duration {
 	return c.refreshTokenTTL
 }
 
+func (c *AuthConfig) VerifyTokenSecret() string {
+	return c.verifyTokenSecret
+}
+
+func (c *AuthConfig) VerifyTokenTTL() time.Duration {
+	return c.verifyTokenTTL
+}
+
 func NewAuthConfig() *AuthConfig {
 	ats := os.Getenv("ACCESS_TOKEN_SECRET")
 	if ats == "" {
@@ -56,6 +66,16 @@ func NewAuthConfig() *AuthConfig {
 		panic("REFRESH_TOKEN_TTL environment variable is empty or has wrong format")
 	}
 
+	vts := os.Getenv("VERIFY_TOKEN_SECRET")
+	if vts == "" {
+		panic("VERIFY_TOKEN_SECRET environment variable is empty")
+	}
+
+	vttl, err := str2duration.ParseDuration(os.Getenv("VERIFY_TOKEN_TTL"))
+	if err != nil {
+		panic("VERIFY_TOKEN_TTL environment variable is empty or has wrong format")
+	}
+
 	domain := os.Getenv("DOMAIN")
 	if domain == "" {
 		panic("DOMAIN environment variable is empty")
@@ -66,6 +86,8 @@ func NewAuthConfig() *AuthConfig {
 		accessTokenTTL:     attl,
 		refreshTokenSecret: rts,
 		refreshTokenTTL:    rttl,
+		verifyTokenSecret:  vts,
+		verifyTokenTTL:     vttl,
 		domain:             domain,
 	}
 }
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/xhit/go-str2duration/v2"
+)
+
+type GCConfig struct {
+	interval    time.Duration
+	gracePeriod time.Duration
+	dryRun      bool
+}
+
+// Interval is a period between two runs of garbage collector
+func (c *GCConfig) Interval() time.Duration {
+	return c.interval
+}
+
+// GracePeriod is a minimal age of unreferenced file to be deleted,
+// so images of events which are being created right now are kept
+func (c *GCConfig) GracePeriod() time.Duration {
+	return c.gracePeriod
+}
+
+// DryRun reports whether garbage collector only counts orphaned files without deleting them
+func (c *GCConfig) DryRun() bool {
+	return c.dryRun
+}
+
+func NewGCConfig() *GCConfig {
+	interval := time.Hour
+	if v := os.Getenv("GC_INTERVAL"); v != "" {
+		d, err := str2duration.ParseDuration(v)
+		if err != nil {
+			panic("GC_INTERVAL environment variable has wrong format")
+		}
+		interval = d
+	}
+
+	gracePeriod := 24 * time.Hour
+	if v := os.Getenv("GC_GRACE_PERIOD"); v != "" {
+		d, err := str2duration.ParseDuration(v)
+		if err != nil {
+			panic("GC_GRACE_PERIOD environment variable has wrong format")
+		}
+		gracePeriod = d
+	}
+
+	return &GCConfig{
+		interval:    interval,
+		gracePeriod: gracePeriod,
+		dryRun:      os.Getenv("GC_DRY_RUN") == "true",
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/xhit/go-str2duration/v2"
+)
+
+type GeocoderConfig struct {
+	url       string
+	userAgent string
+	timeout   time.Duration
+}
+
+func (c *GeocoderConfig) URL() string {
+	return c.url
+}
+
+// UserAgent identifies application in requests to geocoding service as required by nominatim usage policy
+func (c *GeocoderConfig) UserAgent() string {
+	return c.userAgent
+}
+
+func (c *GeocoderConfig) Timeout() time.Duration {
+	return c.timeout
+}
+
+func NewGeocoderConfig() *GeocoderConfig {
+	url := os.Getenv("GEOCODER_URL")
+	if url == "" {
+		url = "https://nominatim.openstreetmap.org"
+	}
+
+	userAgent := os.Getenv("GEOCODER_USER_AGENT")
+	if userAgent == "" {
+		userAgent = "eventflow-backend"
+	}
+
+	timeout := 5 * time.Second
+	if v := os.Getenv("GEOCODER_TIMEOUT"); v != "" {
+		d, err := str2duration.ParseDuration(v)
+		if err != nil {
+			panic("GEOCODER_TIMEOUT environment variable has wrong format")
+		}
+		timeout = d
+	}
+
+	return &GeocoderConfig{
+		url:       url,
+		userAgent: userAgent,
+		timeout:   timeout,
+	}
+}
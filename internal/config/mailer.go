@@ -11,13 +11,18 @@ type MailerConfig struct {
 	host     string
 	port     string
 
-	orderTemplatePath string
+	orderTemplatePath        string
+	notificationTemplatePath string
 }
 
 func (c *MailerConfig) OrderTemplatePath() string {
 	return c.orderTemplatePath
 }
 
+func (c *MailerConfig) NotificationTemplatePath() string {
+	return c.notificationTemplatePath
+}
+
 func (c *MailerConfig) Login() string {
 	return c.login
 }
@@ -55,12 +60,17 @@ func NewMailerConfig() *MailerConfig {
 	if orderTemplatePath == "" {
 		log.Fatal("MAILER_ORDER_TEMPLATE_PATH environment variable is empty")
 	}
+	notificationTemplatePath := os.Getenv("MAILER_NOTIFICATION_TEMPLATE_PATH")
+	if notificationTemplatePath == "" {
+		log.Fatal("MAILER_NOTIFICATION_TEMPLATE_PATH environment variable is empty")
+	}
 
 	return &MailerConfig{
-		login:             login,
-		password:          password,
-		host:              host,
-		port:              port,
-		orderTemplatePath: orderTemplatePath,
+		login:                    login,
+		password:                 password,
+		host:                     host,
+		port:                     port,
+		orderTemplatePath:        orderTemplatePath,
+		notificationTemplatePath: notificationTemplatePath,
 	}
 }
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/xhit/go-str2duration/v2"
+)
+
+const (
+	StorageTypeFS = "fs"
+	StorageTypeS3 = "s3"
+)
+
+type StorageConfig struct {
+	storageType string
+
+	s3Endpoint  string
+	s3AccessKey string
+	s3SecretKey string
+	s3Bucket    string
+	s3Region    string
+	s3UseSSL    bool
+	s3URLTTL    time.Duration
+}
+
+func (c *StorageConfig) Type() string {
+	return c.storageType
+}
+
+func (c *StorageConfig) S3Endpoint() string {
+	return c.s3Endpoint
+}
+
+func (c *StorageConfig) S3AccessKey() string {
+	return c.s3AccessKey
+}
+
+func (c *StorageConfig) S3SecretKey() string {
+	return c.s3SecretKey
+}
+
+func (c *StorageConfig) S3Bucket() string {
+	return c.s3Bucket
+}
+
+func (c *StorageConfig) S3Region() string {
+	return c.s3Region
+}
+
+func (c *StorageConfig) S3UseSSL() bool {
+	return c.s3UseSSL
+}
+
+// S3URLTTL is a lifetime of presigned urls
+func (c *StorageConfig) S3URLTTL() time.Duration {
+	return c.s3URLTTL
+}
+
+func NewStorageConfig() *StorageConfig {
+	storageType := os.Getenv("STORAGE_TYPE")
+	if storageType == "" {
+		storageType = StorageTypeFS
+	}
+
+	switch storageType {
+	case StorageTypeFS:
+		return &StorageConfig{storageType: storageType}
+	case StorageTypeS3:
+	default:
+		panic("STORAGE_TYPE environment variable must be either fs or s3")
+	}
+
+	endpoint := os.Getenv("S3_ENDPOINT")
+	if endpoint == "" {
+		panic("S3_ENDPOINT environment variable is empty")
+	}
+
+	accessKey := os.Getenv("S3_ACCESS_KEY")
+	if accessKey == "" {
+		panic("S3_ACCESS_KEY environment variable is empty")
+	}
+
+	secretKey := os.Getenv("S3_SECRET_KEY")
+	if secretKey == "" {
+		panic("S3_SECRET_KEY environment variable is empty")
+	}
+
+	bucket := os.Getenv("S3_BUCKET")
+	if bucket == "" {
+		panic("S3_BUCKET environment variable is empty")
+	}
+
+	urlTTL, err := str2duration.ParseDuration(os.Getenv("S3_URL_TTL"))
+	if err != nil {
+		panic("S3_URL_TTL environment variable is empty or has wrong format")
+	}
+
+	return &StorageConfig{
+		storageType: storageType,
+		s3Endpoint:  endpoint,
+		s3AccessKey: accessKey,
+		s3SecretKey: secretKey,
+		s3Bucket:    bucket,
+		s3Region:    os.Getenv("S3_REGION"),
+		s3UseSSL:    os.Getenv("S3_USE_SSL") == "true",
+		s3URLTTL:    urlTTL,
+	}
+}
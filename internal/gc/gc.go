@@ -0,0 +1,137 @@
+package gc
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/metrics"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// Collector periodically deletes uploaded images which are not referenced by any event
+type Collector struct {
+	wg       *sync.WaitGroup
+	doneChan chan struct{}
+
+	repo    repository.Repository
+	storage storage.Storage
+	config  *config.GCConfig
+}
+
+// Result describes single garbage collector run
+type Result struct {
+	Files int
+	Bytes int64
+}
+
+func NewCollector(
+	wg *sync.WaitGroup,
+	repo repository.Repository,
+	storage storage.Storage,
+	config *config.GCConfig,
+) *Collector {
+	c := &Collector{
+		wg:       wg,
+		doneChan: make(chan struct{}),
+		repo:     repo,
+		storage:  storage,
+		config:   config,
+	}
+
+	closer.Add(1, func() error {
+		slog.Info("sending done signal to garbage collector...")
+		c.doneChan <- struct{}{}
+
+		return nil
+	})
+
+	closer.Add(2, func() error {
+		slog.Info("closing garbage collector channels...")
+		close(c.doneChan)
+
+		return nil
+	})
+
+	return c
+}
+
+// Run starts collecting orphaned images until done signal is received
+func (c *Collector) Run() {
+	ticker := time.NewTicker(c.config.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.wg.Add(1)
+			func() {
+				defer c.wg.Done()
+
+				res, err := c.Collect(context.Background(), time.Now())
+				if err != nil {
+					slog.Error("Error collecting orphaned images", slog.Any("error", err))
+					return
+				}
+
+				slog.Info(
+					"orphaned images collected",
+					slog.Int("files", res.Files),
+					slog.Int64("bytes", res.Bytes),
+					slog.Bool("dry_run", c.config.DryRun()),
+				)
+			}()
+		case <-c.doneChan:
+			return
+		}
+	}
+}
+
+// Collect deletes files which are not referenced by any event and are older than grace period.
+// In dry-run mode files are only counted
+func (c *Collector) Collect(ctx context.Context, now time.Time) (*Result, error) {
+	images, err := c.repo.ReferencedImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]struct{}, len(images))
+	for _, image := range images {
+		referenced[image] = struct{}{}
+	}
+
+	objects, err := c.storage.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{}
+	for _, object := range objects {
+		if _, ok := referenced[utils.ImageOriginalName(object.Name)]; ok {
+			continue
+		}
+		if now.Sub(object.UpdatedAt) < c.config.GracePeriod() {
+			continue
+		}
+
+		if !c.config.DryRun() {
+			err = c.storage.Delete(ctx, object.Name)
+			if err != nil {
+				slog.Error("Error deleting orphaned image", slog.String("name", object.Name), slog.Any("error", err))
+				continue
+			}
+		}
+
+		res.Files++
+		res.Bytes += object.Size
+	}
+
+	metrics.AddGCReclaimedBytes(res.Bytes, c.config.DryRun())
+
+	return res, nil
+}
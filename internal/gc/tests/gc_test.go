@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/gc"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/storage/fs"
+)
+
+func TestCollect(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		now = time.Now()
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		dryRun         bool
+		want           *gc.Result
+		remaining      []string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: &gc.Result{Files: 2, Bytes: 6},
+			remaining: []string{
+				"used.jpg",
+				"used_card.jpg",
+				"fresh.jpg",
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.ReferencedImagesMock.Expect(ctx).Return([]string{"used.jpg"}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "dry run case",
+			dryRun: true,
+			want:   &gc.Result{Files: 2, Bytes: 6},
+			remaining: []string{
+				"used.jpg",
+				"used_card.jpg",
+				"fresh.jpg",
+				"orphan.jpg",
+				"orphan_thumbnail.jpg",
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.ReferencedImagesMock.Expect(ctx).Return([]string{"used.jpg"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "failure case",
+			want: nil,
+			err:  repoErr,
+			remaining: []string{
+				"used.jpg",
+				"used_card.jpg",
+				"fresh.jpg",
+				"orphan.jpg",
+				"orphan_thumbnail.jpg",
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.ReferencedImagesMock.Expect(ctx).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			storage := fs.NewFSStorage(dir, "/api/static/")
+
+			old := now.Add(-48 * time.Hour)
+			for _, name := range []string{"used.jpg", "used_card.jpg", "orphan.jpg", "orphan_thumbnail.jpg", "fresh.jpg"} {
+				err := storage.Put(ctx, name, bytes.NewReader([]byte("img")), 3, "image/jpeg")
+				require.NoError(t, err)
+
+				if name != "fresh.jpg" {
+					err = os.Chtimes(dir+"/"+name, old, old)
+					require.NoError(t, err)
+				}
+			}
+
+			t.Setenv("GC_GRACE_PERIOD", "24h")
+			if tt.dryRun {
+				t.Setenv("GC_DRY_RUN", "true")
+			}
+
+			collector := gc.NewCollector(wg, tt.repositoryMock(mc), storage, config.NewGCConfig())
+			res, err := collector.Collect(ctx, now)
+
+			require.Equal(t, tt.want, res)
+			require.Equal(t, tt.err, err)
+
+			objects, err := storage.List(ctx)
+			require.NoError(t, err)
+
+			var names []string
+			for _, object := range objects {
+				names = append(names, object.Name)
+			}
+			require.ElementsMatch(t, tt.remaining, names)
+		})
+	}
+}
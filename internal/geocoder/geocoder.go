@@ -0,0 +1,14 @@
+package geocoder
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("address not found")
+
+// Geocoder turns address into coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (lat float64, lng float64, err error)
+}
@@ -0,0 +1,77 @@
+package nominatim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/geocoder"
+)
+
+type nominatimGeocoder struct {
+	url       string
+	userAgent string
+	client    *http.Client
+}
+
+type place struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+func NewNominatimGeocoder(config *config.GeocoderConfig) geocoder.Geocoder {
+	return &nominatimGeocoder{
+		url:       config.URL(),
+		userAgent: config.UserAgent(),
+		client:    &http.Client{Timeout: config.Timeout()},
+	}
+}
+
+func (g *nominatimGeocoder) Geocode(ctx context.Context, address string) (float64, float64, error) {
+	query := url.Values{}
+	query.Set("q", address)
+	query.Set("format", "json")
+	query.Set("limit", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.url+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("nominatim responded with status %d", resp.StatusCode)
+	}
+
+	var places []place
+	err = json.NewDecoder(resp.Body).Decode(&places)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(places) == 0 {
+		return 0, 0, geocoder.ErrNotFound
+	}
+
+	lat, err := strconv.ParseFloat(places[0].Lat, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lng, err := strconv.ParseFloat(places[0].Lon, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lat, lng, nil
+}
@@ -8,4 +8,5 @@ type Mailer interface {
 	ListenForMails()
 	SendHTMLMessage(body []byte, to []string) error
 	SendOrderMail(msg *models.OrderMessage)
+	SendNotificationMail(msg *models.NotificationMessage)
 }
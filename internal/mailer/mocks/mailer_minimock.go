@@ -0,0 +1,1196 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.0). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/wDRxxx/eventflow-backend/internal/mailer.Mailer -o mailer_minimock.go -n MailerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// MailerMock implements mm_mailer.Mailer
+type MailerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListenForMails          func()
+	funcListenForMailsOrigin    string
+	inspectFuncListenForMails   func()
+	afterListenForMailsCounter  uint64
+	beforeListenForMailsCounter uint64
+	ListenForMailsMock          mMailerMockListenForMails
+
+	funcSendHTMLMessage          func(body []byte, to []string) (err error)
+	funcSendHTMLMessageOrigin    string
+	inspectFuncSendHTMLMessage   func(body []byte, to []string)
+	afterSendHTMLMessageCounter  uint64
+	beforeSendHTMLMessageCounter uint64
+	SendHTMLMessageMock          mMailerMockSendHTMLMessage
+
+	funcSendNotificationMail          func(msg *models.NotificationMessage)
+	funcSendNotificationMailOrigin    string
+	inspectFuncSendNotificationMail   func(msg *models.NotificationMessage)
+	afterSendNotificationMailCounter  uint64
+	beforeSendNotificationMailCounter uint64
+	SendNotificationMailMock          mMailerMockSendNotificationMail
+
+	funcSendOrderMail          func(msg *models.OrderMessage)
+	funcSendOrderMailOrigin    string
+	inspectFuncSendOrderMail   func(msg *models.OrderMessage)
+	afterSendOrderMailCounter  uint64
+	beforeSendOrderMailCounter uint64
+	SendOrderMailMock          mMailerMockSendOrderMail
+}
+
+// NewMailerMock returns a mock for mm_mailer.Mailer
+func NewMailerMock(t minimock.Tester) *MailerMock {
+	m := &MailerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListenForMailsMock = mMailerMockListenForMails{mock: m}
+
+	m.SendHTMLMessageMock = mMailerMockSendHTMLMessage{mock: m}
+	m.SendHTMLMessageMock.callArgs = []*MailerMockSendHTMLMessageParams{}
+
+	m.SendNotificationMailMock = mMailerMockSendNotificationMail{mock: m}
+	m.SendNotificationMailMock.callArgs = []*MailerMockSendNotificationMailParams{}
+
+	m.SendOrderMailMock = mMailerMockSendOrderMail{mock: m}
+	m.SendOrderMailMock.callArgs = []*MailerMockSendOrderMailParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMailerMockListenForMails struct {
+	optional           bool
+	mock               *MailerMock
+	defaultExpectation *MailerMockListenForMailsExpectation
+	expectations       []*MailerMockListenForMailsExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MailerMockListenForMailsExpectation specifies expectation struct of the Mailer.ListenForMails
+type MailerMockListenForMailsExpectation struct {
+	mock *MailerMock
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListenForMails *mMailerMockListenForMails) Optional() *mMailerMockListenForMails {
+	mmListenForMails.optional = true
+	return mmListenForMails
+}
+
+// Expect sets up expected params for Mailer.ListenForMails
+func (mmListenForMails *mMailerMockListenForMails) Expect() *mMailerMockListenForMails {
+	if mmListenForMails.mock.funcListenForMails != nil {
+		mmListenForMails.mock.t.Fatalf("MailerMock.ListenForMails mock is already set by Set")
+	}
+
+	if mmListenForMails.defaultExpectation == nil {
+		mmListenForMails.defaultExpectation = &MailerMockListenForMailsExpectation{}
+	}
+
+	return mmListenForMails
+}
+
+// Inspect accepts an inspector function that has same arguments as the Mailer.ListenForMails
+func (mmListenForMails *mMailerMockListenForMails) Inspect(f func()) *mMailerMockListenForMails {
+	if mmListenForMails.mock.inspectFuncListenForMails != nil {
+		mmListenForMails.mock.t.Fatalf("Inspect function is already set for MailerMock.ListenForMails")
+	}
+
+	mmListenForMails.mock.inspectFuncListenForMails = f
+
+	return mmListenForMails
+}
+
+// Return sets up results that will be returned by Mailer.ListenForMails
+func (mmListenForMails *mMailerMockListenForMails) Return() *MailerMock {
+	if mmListenForMails.mock.funcListenForMails != nil {
+		mmListenForMails.mock.t.Fatalf("MailerMock.ListenForMails mock is already set by Set")
+	}
+
+	if mmListenForMails.defaultExpectation == nil {
+		mmListenForMails.defaultExpectation = &MailerMockListenForMailsExpectation{mock: mmListenForMails.mock}
+	}
+
+	mmListenForMails.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListenForMails.mock
+}
+
+// Set uses given function f to mock the Mailer.ListenForMails method
+func (mmListenForMails *mMailerMockListenForMails) Set(f func()) *MailerMock {
+	if mmListenForMails.defaultExpectation != nil {
+		mmListenForMails.mock.t.Fatalf("Default expectation is already set for the Mailer.ListenForMails method")
+	}
+
+	if len(mmListenForMails.expectations) > 0 {
+		mmListenForMails.mock.t.Fatalf("Some expectations are already set for the Mailer.ListenForMails method")
+	}
+
+	mmListenForMails.mock.funcListenForMails = f
+	mmListenForMails.mock.funcListenForMailsOrigin = minimock.CallerInfo(1)
+	return mmListenForMails.mock
+}
+
+// Times sets number of times Mailer.ListenForMails should be invoked
+func (mmListenForMails *mMailerMockListenForMails) Times(n uint64) *mMailerMockListenForMails {
+	if n == 0 {
+		mmListenForMails.mock.t.Fatalf("Times of MailerMock.ListenForMails mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListenForMails.expectedInvocations, n)
+	mmListenForMails.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListenForMails
+}
+
+func (mmListenForMails *mMailerMockListenForMails) invocationsDone() bool {
+	if len(mmListenForMails.expectations) == 0 && mmListenForMails.defaultExpectation == nil && mmListenForMails.mock.funcListenForMails == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListenForMails.mock.afterListenForMailsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListenForMails.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListenForMails implements mm_mailer.Mailer
+func (mmListenForMails *MailerMock) ListenForMails() {
+	mm_atomic.AddUint64(&mmListenForMails.beforeListenForMailsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListenForMails.afterListenForMailsCounter, 1)
+
+	mmListenForMails.t.Helper()
+
+	if mmListenForMails.inspectFuncListenForMails != nil {
+		mmListenForMails.inspectFuncListenForMails()
+	}
+
+	if mmListenForMails.ListenForMailsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListenForMails.ListenForMailsMock.defaultExpectation.Counter, 1)
+
+		return
+
+	}
+	if mmListenForMails.funcListenForMails != nil {
+		mmListenForMails.funcListenForMails()
+		return
+	}
+	mmListenForMails.t.Fatalf("Unexpected call to MailerMock.ListenForMails.")
+
+}
+
+// ListenForMailsAfterCounter returns a count of finished MailerMock.ListenForMails invocations
+func (mmListenForMails *MailerMock) ListenForMailsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListenForMails.afterListenForMailsCounter)
+}
+
+// ListenForMailsBeforeCounter returns a count of MailerMock.ListenForMails invocations
+func (mmListenForMails *MailerMock) ListenForMailsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListenForMails.beforeListenForMailsCounter)
+}
+
+// MinimockListenForMailsDone returns true if the count of the ListenForMails invocations corresponds
+// the number of defined expectations
+func (m *MailerMock) MinimockListenForMailsDone() bool {
+	if m.ListenForMailsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListenForMailsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListenForMailsMock.invocationsDone()
+}
+
+// MinimockListenForMailsInspect logs each unmet expectation
+func (m *MailerMock) MinimockListenForMailsInspect() {
+	for _, e := range m.ListenForMailsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MailerMock.ListenForMails")
+		}
+	}
+
+	afterListenForMailsCounter := mm_atomic.LoadUint64(&m.afterListenForMailsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListenForMailsMock.defaultExpectation != nil && afterListenForMailsCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.ListenForMails at\n%s", m.ListenForMailsMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListenForMails != nil && afterListenForMailsCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.ListenForMails at\n%s", m.funcListenForMailsOrigin)
+	}
+
+	if !m.ListenForMailsMock.invocationsDone() && afterListenForMailsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MailerMock.ListenForMails at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListenForMailsMock.expectedInvocations), m.ListenForMailsMock.expectedInvocationsOrigin, afterListenForMailsCounter)
+	}
+}
+
+type mMailerMockSendHTMLMessage struct {
+	optional           bool
+	mock               *MailerMock
+	defaultExpectation *MailerMockSendHTMLMessageExpectation
+	expectations       []*MailerMockSendHTMLMessageExpectation
+
+	callArgs []*MailerMockSendHTMLMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MailerMockSendHTMLMessageExpectation specifies expectation struct of the Mailer.SendHTMLMessage
+type MailerMockSendHTMLMessageExpectation struct {
+	mock               *MailerMock
+	params             *MailerMockSendHTMLMessageParams
+	paramPtrs          *MailerMockSendHTMLMessageParamPtrs
+	expectationOrigins MailerMockSendHTMLMessageExpectationOrigins
+	results            *MailerMockSendHTMLMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MailerMockSendHTMLMessageParams contains parameters of the Mailer.SendHTMLMessage
+type MailerMockSendHTMLMessageParams struct {
+	body []byte
+	to   []string
+}
+
+// MailerMockSendHTMLMessageParamPtrs contains pointers to parameters of the Mailer.SendHTMLMessage
+type MailerMockSendHTMLMessageParamPtrs struct {
+	body *[]byte
+	to   *[]string
+}
+
+// MailerMockSendHTMLMessageResults contains results of the Mailer.SendHTMLMessage
+type MailerMockSendHTMLMessageResults struct {
+	err error
+}
+
+// MailerMockSendHTMLMessageOrigins contains origins of expectations of the Mailer.SendHTMLMessage
+type MailerMockSendHTMLMessageExpectationOrigins struct {
+	origin     string
+	originBody string
+	originTo   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Optional() *mMailerMockSendHTMLMessage {
+	mmSendHTMLMessage.optional = true
+	return mmSendHTMLMessage
+}
+
+// Expect sets up expected params for Mailer.SendHTMLMessage
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Expect(body []byte, to []string) *mMailerMockSendHTMLMessage {
+	if mmSendHTMLMessage.mock.funcSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Set")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation == nil {
+		mmSendHTMLMessage.defaultExpectation = &MailerMockSendHTMLMessageExpectation{}
+	}
+
+	if mmSendHTMLMessage.defaultExpectation.paramPtrs != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendHTMLMessage.defaultExpectation.params = &MailerMockSendHTMLMessageParams{body, to}
+	mmSendHTMLMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendHTMLMessage.expectations {
+		if minimock.Equal(e.params, mmSendHTMLMessage.defaultExpectation.params) {
+			mmSendHTMLMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendHTMLMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendHTMLMessage
+}
+
+// ExpectBodyParam1 sets up expected param body for Mailer.SendHTMLMessage
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) ExpectBodyParam1(body []byte) *mMailerMockSendHTMLMessage {
+	if mmSendHTMLMessage.mock.funcSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Set")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation == nil {
+		mmSendHTMLMessage.defaultExpectation = &MailerMockSendHTMLMessageExpectation{}
+	}
+
+	if mmSendHTMLMessage.defaultExpectation.params != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Expect")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation.paramPtrs == nil {
+		mmSendHTMLMessage.defaultExpectation.paramPtrs = &MailerMockSendHTMLMessageParamPtrs{}
+	}
+	mmSendHTMLMessage.defaultExpectation.paramPtrs.body = &body
+	mmSendHTMLMessage.defaultExpectation.expectationOrigins.originBody = minimock.CallerInfo(1)
+
+	return mmSendHTMLMessage
+}
+
+// ExpectToParam2 sets up expected param to for Mailer.SendHTMLMessage
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) ExpectToParam2(to []string) *mMailerMockSendHTMLMessage {
+	if mmSendHTMLMessage.mock.funcSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Set")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation == nil {
+		mmSendHTMLMessage.defaultExpectation = &MailerMockSendHTMLMessageExpectation{}
+	}
+
+	if mmSendHTMLMessage.defaultExpectation.params != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Expect")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation.paramPtrs == nil {
+		mmSendHTMLMessage.defaultExpectation.paramPtrs = &MailerMockSendHTMLMessageParamPtrs{}
+	}
+	mmSendHTMLMessage.defaultExpectation.paramPtrs.to = &to
+	mmSendHTMLMessage.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmSendHTMLMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the Mailer.SendHTMLMessage
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Inspect(f func(body []byte, to []string)) *mMailerMockSendHTMLMessage {
+	if mmSendHTMLMessage.mock.inspectFuncSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("Inspect function is already set for MailerMock.SendHTMLMessage")
+	}
+
+	mmSendHTMLMessage.mock.inspectFuncSendHTMLMessage = f
+
+	return mmSendHTMLMessage
+}
+
+// Return sets up results that will be returned by Mailer.SendHTMLMessage
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Return(err error) *MailerMock {
+	if mmSendHTMLMessage.mock.funcSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Set")
+	}
+
+	if mmSendHTMLMessage.defaultExpectation == nil {
+		mmSendHTMLMessage.defaultExpectation = &MailerMockSendHTMLMessageExpectation{mock: mmSendHTMLMessage.mock}
+	}
+	mmSendHTMLMessage.defaultExpectation.results = &MailerMockSendHTMLMessageResults{err}
+	mmSendHTMLMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendHTMLMessage.mock
+}
+
+// Set uses given function f to mock the Mailer.SendHTMLMessage method
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Set(f func(body []byte, to []string) (err error)) *MailerMock {
+	if mmSendHTMLMessage.defaultExpectation != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("Default expectation is already set for the Mailer.SendHTMLMessage method")
+	}
+
+	if len(mmSendHTMLMessage.expectations) > 0 {
+		mmSendHTMLMessage.mock.t.Fatalf("Some expectations are already set for the Mailer.SendHTMLMessage method")
+	}
+
+	mmSendHTMLMessage.mock.funcSendHTMLMessage = f
+	mmSendHTMLMessage.mock.funcSendHTMLMessageOrigin = minimock.CallerInfo(1)
+	return mmSendHTMLMessage.mock
+}
+
+// When sets expectation for the Mailer.SendHTMLMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) When(body []byte, to []string) *MailerMockSendHTMLMessageExpectation {
+	if mmSendHTMLMessage.mock.funcSendHTMLMessage != nil {
+		mmSendHTMLMessage.mock.t.Fatalf("MailerMock.SendHTMLMessage mock is already set by Set")
+	}
+
+	expectation := &MailerMockSendHTMLMessageExpectation{
+		mock:               mmSendHTMLMessage.mock,
+		params:             &MailerMockSendHTMLMessageParams{body, to},
+		expectationOrigins: MailerMockSendHTMLMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendHTMLMessage.expectations = append(mmSendHTMLMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Mailer.SendHTMLMessage return parameters for the expectation previously defined by the When method
+func (e *MailerMockSendHTMLMessageExpectation) Then(err error) *MailerMock {
+	e.results = &MailerMockSendHTMLMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times Mailer.SendHTMLMessage should be invoked
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Times(n uint64) *mMailerMockSendHTMLMessage {
+	if n == 0 {
+		mmSendHTMLMessage.mock.t.Fatalf("Times of MailerMock.SendHTMLMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendHTMLMessage.expectedInvocations, n)
+	mmSendHTMLMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendHTMLMessage
+}
+
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) invocationsDone() bool {
+	if len(mmSendHTMLMessage.expectations) == 0 && mmSendHTMLMessage.defaultExpectation == nil && mmSendHTMLMessage.mock.funcSendHTMLMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendHTMLMessage.mock.afterSendHTMLMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendHTMLMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendHTMLMessage implements mm_mailer.Mailer
+func (mmSendHTMLMessage *MailerMock) SendHTMLMessage(body []byte, to []string) (err error) {
+	mm_atomic.AddUint64(&mmSendHTMLMessage.beforeSendHTMLMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendHTMLMessage.afterSendHTMLMessageCounter, 1)
+
+	mmSendHTMLMessage.t.Helper()
+
+	if mmSendHTMLMessage.inspectFuncSendHTMLMessage != nil {
+		mmSendHTMLMessage.inspectFuncSendHTMLMessage(body, to)
+	}
+
+	mm_params := MailerMockSendHTMLMessageParams{body, to}
+
+	// Record call args
+	mmSendHTMLMessage.SendHTMLMessageMock.mutex.Lock()
+	mmSendHTMLMessage.SendHTMLMessageMock.callArgs = append(mmSendHTMLMessage.SendHTMLMessageMock.callArgs, &mm_params)
+	mmSendHTMLMessage.SendHTMLMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendHTMLMessage.SendHTMLMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MailerMockSendHTMLMessageParams{body, to}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.body != nil && !minimock.Equal(*mm_want_ptrs.body, mm_got.body) {
+				mmSendHTMLMessage.t.Errorf("MailerMock.SendHTMLMessage got unexpected parameter body, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.expectationOrigins.originBody, *mm_want_ptrs.body, mm_got.body, minimock.Diff(*mm_want_ptrs.body, mm_got.body))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmSendHTMLMessage.t.Errorf("MailerMock.SendHTMLMessage got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendHTMLMessage.t.Errorf("MailerMock.SendHTMLMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendHTMLMessage.SendHTMLMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendHTMLMessage.t.Fatal("No results are set for the MailerMock.SendHTMLMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmSendHTMLMessage.funcSendHTMLMessage != nil {
+		return mmSendHTMLMessage.funcSendHTMLMessage(body, to)
+	}
+	mmSendHTMLMessage.t.Fatalf("Unexpected call to MailerMock.SendHTMLMessage. %v %v", body, to)
+	return
+}
+
+// SendHTMLMessageAfterCounter returns a count of finished MailerMock.SendHTMLMessage invocations
+func (mmSendHTMLMessage *MailerMock) SendHTMLMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendHTMLMessage.afterSendHTMLMessageCounter)
+}
+
+// SendHTMLMessageBeforeCounter returns a count of MailerMock.SendHTMLMessage invocations
+func (mmSendHTMLMessage *MailerMock) SendHTMLMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendHTMLMessage.beforeSendHTMLMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MailerMock.SendHTMLMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendHTMLMessage *mMailerMockSendHTMLMessage) Calls() []*MailerMockSendHTMLMessageParams {
+	mmSendHTMLMessage.mutex.RLock()
+
+	argCopy := make([]*MailerMockSendHTMLMessageParams, len(mmSendHTMLMessage.callArgs))
+	copy(argCopy, mmSendHTMLMessage.callArgs)
+
+	mmSendHTMLMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendHTMLMessageDone returns true if the count of the SendHTMLMessage invocations corresponds
+// the number of defined expectations
+func (m *MailerMock) MinimockSendHTMLMessageDone() bool {
+	if m.SendHTMLMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendHTMLMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendHTMLMessageMock.invocationsDone()
+}
+
+// MinimockSendHTMLMessageInspect logs each unmet expectation
+func (m *MailerMock) MinimockSendHTMLMessageInspect() {
+	for _, e := range m.SendHTMLMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MailerMock.SendHTMLMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendHTMLMessageCounter := mm_atomic.LoadUint64(&m.afterSendHTMLMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendHTMLMessageMock.defaultExpectation != nil && afterSendHTMLMessageCounter < 1 {
+		if m.SendHTMLMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MailerMock.SendHTMLMessage at\n%s", m.SendHTMLMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MailerMock.SendHTMLMessage at\n%s with params: %#v", m.SendHTMLMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendHTMLMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendHTMLMessage != nil && afterSendHTMLMessageCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.SendHTMLMessage at\n%s", m.funcSendHTMLMessageOrigin)
+	}
+
+	if !m.SendHTMLMessageMock.invocationsDone() && afterSendHTMLMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MailerMock.SendHTMLMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendHTMLMessageMock.expectedInvocations), m.SendHTMLMessageMock.expectedInvocationsOrigin, afterSendHTMLMessageCounter)
+	}
+}
+
+type mMailerMockSendNotificationMail struct {
+	optional           bool
+	mock               *MailerMock
+	defaultExpectation *MailerMockSendNotificationMailExpectation
+	expectations       []*MailerMockSendNotificationMailExpectation
+
+	callArgs []*MailerMockSendNotificationMailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MailerMockSendNotificationMailExpectation specifies expectation struct of the Mailer.SendNotificationMail
+type MailerMockSendNotificationMailExpectation struct {
+	mock               *MailerMock
+	params             *MailerMockSendNotificationMailParams
+	paramPtrs          *MailerMockSendNotificationMailParamPtrs
+	expectationOrigins MailerMockSendNotificationMailExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// MailerMockSendNotificationMailParams contains parameters of the Mailer.SendNotificationMail
+type MailerMockSendNotificationMailParams struct {
+	msg *models.NotificationMessage
+}
+
+// MailerMockSendNotificationMailParamPtrs contains pointers to parameters of the Mailer.SendNotificationMail
+type MailerMockSendNotificationMailParamPtrs struct {
+	msg **models.NotificationMessage
+}
+
+// MailerMockSendNotificationMailOrigins contains origins of expectations of the Mailer.SendNotificationMail
+type MailerMockSendNotificationMailExpectationOrigins struct {
+	origin    string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Optional() *mMailerMockSendNotificationMail {
+	mmSendNotificationMail.optional = true
+	return mmSendNotificationMail
+}
+
+// Expect sets up expected params for Mailer.SendNotificationMail
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Expect(msg *models.NotificationMessage) *mMailerMockSendNotificationMail {
+	if mmSendNotificationMail.mock.funcSendNotificationMail != nil {
+		mmSendNotificationMail.mock.t.Fatalf("MailerMock.SendNotificationMail mock is already set by Set")
+	}
+
+	if mmSendNotificationMail.defaultExpectation == nil {
+		mmSendNotificationMail.defaultExpectation = &MailerMockSendNotificationMailExpectation{}
+	}
+
+	if mmSendNotificationMail.defaultExpectation.paramPtrs != nil {
+		mmSendNotificationMail.mock.t.Fatalf("MailerMock.SendNotificationMail mock is already set by ExpectParams functions")
+	}
+
+	mmSendNotificationMail.defaultExpectation.params = &MailerMockSendNotificationMailParams{msg}
+	mmSendNotificationMail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendNotificationMail.expectations {
+		if minimock.Equal(e.params, mmSendNotificationMail.defaultExpectation.params) {
+			mmSendNotificationMail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendNotificationMail.defaultExpectation.params)
+		}
+	}
+
+	return mmSendNotificationMail
+}
+
+// ExpectMsgParam1 sets up expected param msg for Mailer.SendNotificationMail
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) ExpectMsgParam1(msg *models.NotificationMessage) *mMailerMockSendNotificationMail {
+	if mmSendNotificationMail.mock.funcSendNotificationMail != nil {
+		mmSendNotificationMail.mock.t.Fatalf("MailerMock.SendNotificationMail mock is already set by Set")
+	}
+
+	if mmSendNotificationMail.defaultExpectation == nil {
+		mmSendNotificationMail.defaultExpectation = &MailerMockSendNotificationMailExpectation{}
+	}
+
+	if mmSendNotificationMail.defaultExpectation.params != nil {
+		mmSendNotificationMail.mock.t.Fatalf("MailerMock.SendNotificationMail mock is already set by Expect")
+	}
+
+	if mmSendNotificationMail.defaultExpectation.paramPtrs == nil {
+		mmSendNotificationMail.defaultExpectation.paramPtrs = &MailerMockSendNotificationMailParamPtrs{}
+	}
+	mmSendNotificationMail.defaultExpectation.paramPtrs.msg = &msg
+	mmSendNotificationMail.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmSendNotificationMail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Mailer.SendNotificationMail
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Inspect(f func(msg *models.NotificationMessage)) *mMailerMockSendNotificationMail {
+	if mmSendNotificationMail.mock.inspectFuncSendNotificationMail != nil {
+		mmSendNotificationMail.mock.t.Fatalf("Inspect function is already set for MailerMock.SendNotificationMail")
+	}
+
+	mmSendNotificationMail.mock.inspectFuncSendNotificationMail = f
+
+	return mmSendNotificationMail
+}
+
+// Return sets up results that will be returned by Mailer.SendNotificationMail
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Return() *MailerMock {
+	if mmSendNotificationMail.mock.funcSendNotificationMail != nil {
+		mmSendNotificationMail.mock.t.Fatalf("MailerMock.SendNotificationMail mock is already set by Set")
+	}
+
+	if mmSendNotificationMail.defaultExpectation == nil {
+		mmSendNotificationMail.defaultExpectation = &MailerMockSendNotificationMailExpectation{mock: mmSendNotificationMail.mock}
+	}
+
+	mmSendNotificationMail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendNotificationMail.mock
+}
+
+// Set uses given function f to mock the Mailer.SendNotificationMail method
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Set(f func(msg *models.NotificationMessage)) *MailerMock {
+	if mmSendNotificationMail.defaultExpectation != nil {
+		mmSendNotificationMail.mock.t.Fatalf("Default expectation is already set for the Mailer.SendNotificationMail method")
+	}
+
+	if len(mmSendNotificationMail.expectations) > 0 {
+		mmSendNotificationMail.mock.t.Fatalf("Some expectations are already set for the Mailer.SendNotificationMail method")
+	}
+
+	mmSendNotificationMail.mock.funcSendNotificationMail = f
+	mmSendNotificationMail.mock.funcSendNotificationMailOrigin = minimock.CallerInfo(1)
+	return mmSendNotificationMail.mock
+}
+
+// Times sets number of times Mailer.SendNotificationMail should be invoked
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Times(n uint64) *mMailerMockSendNotificationMail {
+	if n == 0 {
+		mmSendNotificationMail.mock.t.Fatalf("Times of MailerMock.SendNotificationMail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendNotificationMail.expectedInvocations, n)
+	mmSendNotificationMail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendNotificationMail
+}
+
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) invocationsDone() bool {
+	if len(mmSendNotificationMail.expectations) == 0 && mmSendNotificationMail.defaultExpectation == nil && mmSendNotificationMail.mock.funcSendNotificationMail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendNotificationMail.mock.afterSendNotificationMailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendNotificationMail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendNotificationMail implements mm_mailer.Mailer
+func (mmSendNotificationMail *MailerMock) SendNotificationMail(msg *models.NotificationMessage) {
+	mm_atomic.AddUint64(&mmSendNotificationMail.beforeSendNotificationMailCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendNotificationMail.afterSendNotificationMailCounter, 1)
+
+	mmSendNotificationMail.t.Helper()
+
+	if mmSendNotificationMail.inspectFuncSendNotificationMail != nil {
+		mmSendNotificationMail.inspectFuncSendNotificationMail(msg)
+	}
+
+	mm_params := MailerMockSendNotificationMailParams{msg}
+
+	// Record call args
+	mmSendNotificationMail.SendNotificationMailMock.mutex.Lock()
+	mmSendNotificationMail.SendNotificationMailMock.callArgs = append(mmSendNotificationMail.SendNotificationMailMock.callArgs, &mm_params)
+	mmSendNotificationMail.SendNotificationMailMock.mutex.Unlock()
+
+	for _, e := range mmSendNotificationMail.SendNotificationMailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSendNotificationMail.SendNotificationMailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendNotificationMail.SendNotificationMailMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendNotificationMail.SendNotificationMailMock.defaultExpectation.params
+		mm_want_ptrs := mmSendNotificationMail.SendNotificationMailMock.defaultExpectation.paramPtrs
+
+		mm_got := MailerMockSendNotificationMailParams{msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmSendNotificationMail.t.Errorf("MailerMock.SendNotificationMail got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendNotificationMail.SendNotificationMailMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendNotificationMail.t.Errorf("MailerMock.SendNotificationMail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendNotificationMail.SendNotificationMailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSendNotificationMail.funcSendNotificationMail != nil {
+		mmSendNotificationMail.funcSendNotificationMail(msg)
+		return
+	}
+	mmSendNotificationMail.t.Fatalf("Unexpected call to MailerMock.SendNotificationMail. %v", msg)
+
+}
+
+// SendNotificationMailAfterCounter returns a count of finished MailerMock.SendNotificationMail invocations
+func (mmSendNotificationMail *MailerMock) SendNotificationMailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendNotificationMail.afterSendNotificationMailCounter)
+}
+
+// SendNotificationMailBeforeCounter returns a count of MailerMock.SendNotificationMail invocations
+func (mmSendNotificationMail *MailerMock) SendNotificationMailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendNotificationMail.beforeSendNotificationMailCounter)
+}
+
+// Calls returns a list of arguments used in each call to MailerMock.SendNotificationMail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendNotificationMail *mMailerMockSendNotificationMail) Calls() []*MailerMockSendNotificationMailParams {
+	mmSendNotificationMail.mutex.RLock()
+
+	argCopy := make([]*MailerMockSendNotificationMailParams, len(mmSendNotificationMail.callArgs))
+	copy(argCopy, mmSendNotificationMail.callArgs)
+
+	mmSendNotificationMail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendNotificationMailDone returns true if the count of the SendNotificationMail invocations corresponds
+// the number of defined expectations
+func (m *MailerMock) MinimockSendNotificationMailDone() bool {
+	if m.SendNotificationMailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendNotificationMailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendNotificationMailMock.invocationsDone()
+}
+
+// MinimockSendNotificationMailInspect logs each unmet expectation
+func (m *MailerMock) MinimockSendNotificationMailInspect() {
+	for _, e := range m.SendNotificationMailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MailerMock.SendNotificationMail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendNotificationMailCounter := mm_atomic.LoadUint64(&m.afterSendNotificationMailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendNotificationMailMock.defaultExpectation != nil && afterSendNotificationMailCounter < 1 {
+		if m.SendNotificationMailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MailerMock.SendNotificationMail at\n%s", m.SendNotificationMailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MailerMock.SendNotificationMail at\n%s with params: %#v", m.SendNotificationMailMock.defaultExpectation.expectationOrigins.origin, *m.SendNotificationMailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendNotificationMail != nil && afterSendNotificationMailCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.SendNotificationMail at\n%s", m.funcSendNotificationMailOrigin)
+	}
+
+	if !m.SendNotificationMailMock.invocationsDone() && afterSendNotificationMailCounter > 0 {
+		m.t.Errorf("Expected %d calls to MailerMock.SendNotificationMail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendNotificationMailMock.expectedInvocations), m.SendNotificationMailMock.expectedInvocationsOrigin, afterSendNotificationMailCounter)
+	}
+}
+
+type mMailerMockSendOrderMail struct {
+	optional           bool
+	mock               *MailerMock
+	defaultExpectation *MailerMockSendOrderMailExpectation
+	expectations       []*MailerMockSendOrderMailExpectation
+
+	callArgs []*MailerMockSendOrderMailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MailerMockSendOrderMailExpectation specifies expectation struct of the Mailer.SendOrderMail
+type MailerMockSendOrderMailExpectation struct {
+	mock               *MailerMock
+	params             *MailerMockSendOrderMailParams
+	paramPtrs          *MailerMockSendOrderMailParamPtrs
+	expectationOrigins MailerMockSendOrderMailExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// MailerMockSendOrderMailParams contains parameters of the Mailer.SendOrderMail
+type MailerMockSendOrderMailParams struct {
+	msg *models.OrderMessage
+}
+
+// MailerMockSendOrderMailParamPtrs contains pointers to parameters of the Mailer.SendOrderMail
+type MailerMockSendOrderMailParamPtrs struct {
+	msg **models.OrderMessage
+}
+
+// MailerMockSendOrderMailOrigins contains origins of expectations of the Mailer.SendOrderMail
+type MailerMockSendOrderMailExpectationOrigins struct {
+	origin    string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendOrderMail *mMailerMockSendOrderMail) Optional() *mMailerMockSendOrderMail {
+	mmSendOrderMail.optional = true
+	return mmSendOrderMail
+}
+
+// Expect sets up expected params for Mailer.SendOrderMail
+func (mmSendOrderMail *mMailerMockSendOrderMail) Expect(msg *models.OrderMessage) *mMailerMockSendOrderMail {
+	if mmSendOrderMail.mock.funcSendOrderMail != nil {
+		mmSendOrderMail.mock.t.Fatalf("MailerMock.SendOrderMail mock is already set by Set")
+	}
+
+	if mmSendOrderMail.defaultExpectation == nil {
+		mmSendOrderMail.defaultExpectation = &MailerMockSendOrderMailExpectation{}
+	}
+
+	if mmSendOrderMail.defaultExpectation.paramPtrs != nil {
+		mmSendOrderMail.mock.t.Fatalf("MailerMock.SendOrderMail mock is already set by ExpectParams functions")
+	}
+
+	mmSendOrderMail.defaultExpectation.params = &MailerMockSendOrderMailParams{msg}
+	mmSendOrderMail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendOrderMail.expectations {
+		if minimock.Equal(e.params, mmSendOrderMail.defaultExpectation.params) {
+			mmSendOrderMail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendOrderMail.defaultExpectation.params)
+		}
+	}
+
+	return mmSendOrderMail
+}
+
+// ExpectMsgParam1 sets up expected param msg for Mailer.SendOrderMail
+func (mmSendOrderMail *mMailerMockSendOrderMail) ExpectMsgParam1(msg *models.OrderMessage) *mMailerMockSendOrderMail {
+	if mmSendOrderMail.mock.funcSendOrderMail != nil {
+		mmSendOrderMail.mock.t.Fatalf("MailerMock.SendOrderMail mock is already set by Set")
+	}
+
+	if mmSendOrderMail.defaultExpectation == nil {
+		mmSendOrderMail.defaultExpectation = &MailerMockSendOrderMailExpectation{}
+	}
+
+	if mmSendOrderMail.defaultExpectation.params != nil {
+		mmSendOrderMail.mock.t.Fatalf("MailerMock.SendOrderMail mock is already set by Expect")
+	}
+
+	if mmSendOrderMail.defaultExpectation.paramPtrs == nil {
+		mmSendOrderMail.defaultExpectation.paramPtrs = &MailerMockSendOrderMailParamPtrs{}
+	}
+	mmSendOrderMail.defaultExpectation.paramPtrs.msg = &msg
+	mmSendOrderMail.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmSendOrderMail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Mailer.SendOrderMail
+func (mmSendOrderMail *mMailerMockSendOrderMail) Inspect(f func(msg *models.OrderMessage)) *mMailerMockSendOrderMail {
+	if mmSendOrderMail.mock.inspectFuncSendOrderMail != nil {
+		mmSendOrderMail.mock.t.Fatalf("Inspect function is already set for MailerMock.SendOrderMail")
+	}
+
+	mmSendOrderMail.mock.inspectFuncSendOrderMail = f
+
+	return mmSendOrderMail
+}
+
+// Return sets up results that will be returned by Mailer.SendOrderMail
+func (mmSendOrderMail *mMailerMockSendOrderMail) Return() *MailerMock {
+	if mmSendOrderMail.mock.funcSendOrderMail != nil {
+		mmSendOrderMail.mock.t.Fatalf("MailerMock.SendOrderMail mock is already set by Set")
+	}
+
+	if mmSendOrderMail.defaultExpectation == nil {
+		mmSendOrderMail.defaultExpectation = &MailerMockSendOrderMailExpectation{mock: mmSendOrderMail.mock}
+	}
+
+	mmSendOrderMail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendOrderMail.mock
+}
+
+// Set uses given function f to mock the Mailer.SendOrderMail method
+func (mmSendOrderMail *mMailerMockSendOrderMail) Set(f func(msg *models.OrderMessage)) *MailerMock {
+	if mmSendOrderMail.defaultExpectation != nil {
+		mmSendOrderMail.mock.t.Fatalf("Default expectation is already set for the Mailer.SendOrderMail method")
+	}
+
+	if len(mmSendOrderMail.expectations) > 0 {
+		mmSendOrderMail.mock.t.Fatalf("Some expectations are already set for the Mailer.SendOrderMail method")
+	}
+
+	mmSendOrderMail.mock.funcSendOrderMail = f
+	mmSendOrderMail.mock.funcSendOrderMailOrigin = minimock.CallerInfo(1)
+	return mmSendOrderMail.mock
+}
+
+// Times sets number of times Mailer.SendOrderMail should be invoked
+func (mmSendOrderMail *mMailerMockSendOrderMail) Times(n uint64) *mMailerMockSendOrderMail {
+	if n == 0 {
+		mmSendOrderMail.mock.t.Fatalf("Times of MailerMock.SendOrderMail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendOrderMail.expectedInvocations, n)
+	mmSendOrderMail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendOrderMail
+}
+
+func (mmSendOrderMail *mMailerMockSendOrderMail) invocationsDone() bool {
+	if len(mmSendOrderMail.expectations) == 0 && mmSendOrderMail.defaultExpectation == nil && mmSendOrderMail.mock.funcSendOrderMail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendOrderMail.mock.afterSendOrderMailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendOrderMail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendOrderMail implements mm_mailer.Mailer
+func (mmSendOrderMail *MailerMock) SendOrderMail(msg *models.OrderMessage) {
+	mm_atomic.AddUint64(&mmSendOrderMail.beforeSendOrderMailCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendOrderMail.afterSendOrderMailCounter, 1)
+
+	mmSendOrderMail.t.Helper()
+
+	if mmSendOrderMail.inspectFuncSendOrderMail != nil {
+		mmSendOrderMail.inspectFuncSendOrderMail(msg)
+	}
+
+	mm_params := MailerMockSendOrderMailParams{msg}
+
+	// Record call args
+	mmSendOrderMail.SendOrderMailMock.mutex.Lock()
+	mmSendOrderMail.SendOrderMailMock.callArgs = append(mmSendOrderMail.SendOrderMailMock.callArgs, &mm_params)
+	mmSendOrderMail.SendOrderMailMock.mutex.Unlock()
+
+	for _, e := range mmSendOrderMail.SendOrderMailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSendOrderMail.SendOrderMailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendOrderMail.SendOrderMailMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendOrderMail.SendOrderMailMock.defaultExpectation.params
+		mm_want_ptrs := mmSendOrderMail.SendOrderMailMock.defaultExpectation.paramPtrs
+
+		mm_got := MailerMockSendOrderMailParams{msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmSendOrderMail.t.Errorf("MailerMock.SendOrderMail got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendOrderMail.SendOrderMailMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendOrderMail.t.Errorf("MailerMock.SendOrderMail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendOrderMail.SendOrderMailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSendOrderMail.funcSendOrderMail != nil {
+		mmSendOrderMail.funcSendOrderMail(msg)
+		return
+	}
+	mmSendOrderMail.t.Fatalf("Unexpected call to MailerMock.SendOrderMail. %v", msg)
+
+}
+
+// SendOrderMailAfterCounter returns a count of finished MailerMock.SendOrderMail invocations
+func (mmSendOrderMail *MailerMock) SendOrderMailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendOrderMail.afterSendOrderMailCounter)
+}
+
+// SendOrderMailBeforeCounter returns a count of MailerMock.SendOrderMail invocations
+func (mmSendOrderMail *MailerMock) SendOrderMailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendOrderMail.beforeSendOrderMailCounter)
+}
+
+// Calls returns a list of arguments used in each call to MailerMock.SendOrderMail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendOrderMail *mMailerMockSendOrderMail) Calls() []*MailerMockSendOrderMailParams {
+	mmSendOrderMail.mutex.RLock()
+
+	argCopy := make([]*MailerMockSendOrderMailParams, len(mmSendOrderMail.callArgs))
+	copy(argCopy, mmSendOrderMail.callArgs)
+
+	mmSendOrderMail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendOrderMailDone returns true if the count of the SendOrderMail invocations corresponds
+// the number of defined expectations
+func (m *MailerMock) MinimockSendOrderMailDone() bool {
+	if m.SendOrderMailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendOrderMailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendOrderMailMock.invocationsDone()
+}
+
+// MinimockSendOrderMailInspect logs each unmet expectation
+func (m *MailerMock) MinimockSendOrderMailInspect() {
+	for _, e := range m.SendOrderMailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MailerMock.SendOrderMail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendOrderMailCounter := mm_atomic.LoadUint64(&m.afterSendOrderMailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendOrderMailMock.defaultExpectation != nil && afterSendOrderMailCounter < 1 {
+		if m.SendOrderMailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MailerMock.SendOrderMail at\n%s", m.SendOrderMailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MailerMock.SendOrderMail at\n%s with params: %#v", m.SendOrderMailMock.defaultExpectation.expectationOrigins.origin, *m.SendOrderMailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendOrderMail != nil && afterSendOrderMailCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.SendOrderMail at\n%s", m.funcSendOrderMailOrigin)
+	}
+
+	if !m.SendOrderMailMock.invocationsDone() && afterSendOrderMailCounter > 0 {
+		m.t.Errorf("Expected %d calls to MailerMock.SendOrderMail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendOrderMailMock.expectedInvocations), m.SendOrderMailMock.expectedInvocationsOrigin, afterSendOrderMailCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MailerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListenForMailsInspect()
+
+			m.MinimockSendHTMLMessageInspect()
+
+			m.MinimockSendNotificationMailInspect()
+
+			m.MinimockSendOrderMailInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MailerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MailerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListenForMailsDone() &&
+		m.MinimockSendHTMLMessageDone() &&
+		m.MinimockSendNotificationMailDone() &&
+		m.MinimockSendOrderMailDone()
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"html/template"
 	"log/slog"
+	"mime"
 	"net"
 	"net/smtp"
 	"sync"
@@ -15,16 +16,18 @@ import (
 )
 
 type mail struct {
-	wg             *sync.WaitGroup
-	orderMailsChan chan *models.OrderMessage
-	doneChan       chan struct{}
+	wg                    *sync.WaitGroup
+	orderMailsChan        chan *models.OrderMessage
+	notificationMailsChan chan *models.NotificationMessage
+	doneChan              chan struct{}
 
 	login string
 	host  string
 	port  string
 
-	auth      *smtp.Auth
-	orderTmpl *template.Template
+	auth             *smtp.Auth
+	orderTmpl        *template.Template
+	notificationTmpl *template.Template
 }
 
 func NewSMTPMailer(config *config.MailerConfig, wg *sync.WaitGroup) (mailer.Mailer, error) {
@@ -35,15 +38,22 @@ func NewSMTPMailer(config *config.MailerConfig, wg *sync.WaitGroup) (mailer.Mail
 		return nil, err
 	}
 
+	notificationTmpl, err := template.ParseFiles(config.NotificationTemplatePath())
+	if err != nil {
+		return nil, err
+	}
+
 	m := &mail{
-		wg:             wg,
-		orderMailsChan: make(chan *models.OrderMessage, 100),
-		doneChan:       make(chan struct{}),
-		login:          config.Login(),
-		host:           config.Host(),
-		port:           config.Port(),
-		auth:           &auth,
-		orderTmpl:      tmpl,
+		wg:                    wg,
+		orderMailsChan:        make(chan *models.OrderMessage, 100),
+		notificationMailsChan: make(chan *models.NotificationMessage, 100),
+		doneChan:              make(chan struct{}),
+		login:                 config.Login(),
+		host:                  config.Host(),
+		port:                  config.Port(),
+		auth:                  &auth,
+		orderTmpl:             tmpl,
+		notificationTmpl:      notificationTmpl,
 	}
 
 	closer.Add(1, func() error {
@@ -56,6 +66,7 @@ func NewSMTPMailer(config *config.MailerConfig, wg *sync.WaitGroup) (mailer.Mail
 	closer.Add(2, func() error {
 		slog.Info("closing api service channels...")
 		close(m.orderMailsChan)
+		close(m.notificationMailsChan)
 		close(m.doneChan)
 
 		return nil
@@ -81,6 +92,16 @@ func (m *mail) ListenForMails() {
 					slog.Error("error sending order message: ", slog.Any("error", err), slog.Any("message", msg))
 				}
 			}()
+		case msg := <-m.notificationMailsChan:
+			m.wg.Add(1)
+			go func() {
+				defer m.wg.Done()
+
+				err := m.sendNotificationMail(msg)
+				if err != nil {
+					slog.Error("error sending notification message: ", slog.Any("error", err), slog.Any("message", msg))
+				}
+			}()
 		case <-m.doneChan:
 			return
 		}
@@ -114,6 +135,37 @@ func (m *mail) sendOrderMail(msg *models.OrderMessage) error {
 	return nil
 }
 
+func (m *mail) SendNotificationMail(msg *models.NotificationMessage) {
+	m.notificationMailsChan <- msg
+}
+
+// sendNotificationMail sends message to every recipient separately, so they don't see each other.
+// Failed recipient is logged and doesn't stop sending to the rest
+func (m *mail) sendNotificationMail(msg *models.NotificationMessage) error {
+	var body bytes.Buffer
+
+	err := m.notificationTmpl.Execute(&body, msg)
+	if err != nil {
+		return err
+	}
+
+	for _, to := range msg.To {
+		data := []byte("From:  EventFlow\n" +
+			"To: " + to + "\n" +
+			"Subject: " + mime.QEncoding.Encode("UTF-8", msg.Subject) + "\n" +
+			"MIME-version: 1.0;\n" +
+			"Content-Type: text/html; charset=\"UTF-8\"\n\n" +
+			body.String())
+
+		err = m.SendHTMLMessage(data, []string{to})
+		if err != nil {
+			slog.Error("error sending notification message", slog.Any("error", err), slog.String("to", to), slog.String("subject", msg.Subject))
+		}
+	}
+
+	return nil
+}
+
 func (m *mail) SendHTMLMessage(body []byte, to []string) error {
 	err := smtp.SendMail(m.Address(), *m.auth, m.login, to, body)
 	if err != nil {
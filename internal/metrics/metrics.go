@@ -11,6 +11,7 @@ type Metrics struct {
 	requestCounter        prometheus.Counter
 	responseCounter       *prometheus.CounterVec
 	histogramResponseTime *prometheus.HistogramVec
+	gcReclaimedBytes      *prometheus.CounterVec
 }
 
 var metrics *Metrics
@@ -30,6 +31,10 @@ func Init(appName string) {
 			Help:    "histogram of response time from service",
 			Buckets: prometheus.ExponentialBuckets(0.0001, 2, 16),
 		}, []string{"status", "path"}),
+		gcReclaimedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: appName + "_gc_reclaimed_bytes_total",
+			Help: "size of orphaned images deleted by garbage collector",
+		}, []string{"dry_run"}),
 	}
 }
 
@@ -44,3 +49,11 @@ func IncResponseCounter(status int, path string) {
 func HistogramsResponseTimeObserve(status int, path string, time float64) {
 	metrics.histogramResponseTime.WithLabelValues(fmt.Sprint(status), path).Observe(time)
 }
+
+func AddGCReclaimedBytes(bytes int64, dryRun bool) {
+	if metrics == nil {
+		return
+	}
+
+	metrics.gcReclaimedBytes.WithLabelValues(fmt.Sprint(dryRun)).Add(float64(bytes))
+}
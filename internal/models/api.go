@@ -1,19 +1,183 @@
 package models
 
+import "time"
+
 type DefaultResponse struct {
 	Error   bool   `json:"error"`
 	Message string `json:"message"`
 }
 
+// FieldError describes why single field of the request is invalid
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type ValidationErrorResponse struct {
+	Error   bool          `json:"error"`
+	Message string        `json:"message"`
+	Errors  []*FieldError `json:"errors"`
+}
+
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
 
 type BuyTicketRequest struct {
-	EventUrlTitle string `json:"event_url_title"`
-	FirstName     string `json:"first_name"`
-	LastName      string `json:"last_name"`
-	PriceID       int64  `json:"price_id,omitempty"`
+	EventUrlTitle string `json:"event_url_title" validate:"required"`
+	FirstName     string `json:"first_name" validate:"required,max=100"`
+	LastName      string `json:"last_name" validate:"required,max=100"`
+	PriceID       int64  `json:"price_id,omitempty" validate:"min=0"`
 	UserEmail     string `json:"-"`
+
+	Answers []*TicketAnswer `json:"answers,omitempty" validate:"max=100"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,maxbytes=72"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,maxbytes=72"`
+}
+
+type UpdateSlugRequest struct {
+	Slug string `json:"slug" validate:"required"`
+}
+
+type CloneEventRequest struct {
+	Title         string    `json:"title,omitempty" validate:"max=200"`
+	BeginningTime time.Time `json:"beginning_time" validate:"required"`
+	EndTime       time.Time `json:"end_time" validate:"required,gtfield=BeginningTime"`
+}
+
+// UpdateEventImageRequest is a partial update of the image, caption is changed only if it's provided
+type UpdateEventImageRequest struct {
+	Caption *string `json:"caption" validate:"max=500"`
+	IsCover bool    `json:"is_cover"`
+}
+
+type ReorderImagesRequest struct {
+	IDs []int64 `json:"ids" validate:"required"`
+}
+
+type CheckInRequest struct {
+	TicketID string `json:"ticket_id" validate:"required"`
+}
+
+type SalesReport struct {
+	TicketsSold  int64 `json:"tickets_sold"`
+	CheckedIn    int64 `json:"checked_in"`
+	CapacityLeft int64 `json:"capacity_left"`
+}
+
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+const (
+	StatsIntervalHour = "hour"
+	StatsIntervalDay  = "day"
+)
+
+type TierStats struct {
+	PriceID     int64  `json:"price_id"`
+	Price       int64  `json:"price"`
+	Currency    string `json:"currency"`
+	TicketsSold int64  `json:"tickets_sold"`
+}
+
+type Revenue struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+// SalesBucket is a number of tickets sold within an hour or a day beginning at Time
+type SalesBucket struct {
+	Time        time.Time `json:"time"`
+	TicketsSold int64     `json:"tickets_sold"`
+}
+
+// EventStats is sales analytics of the event. Revenue doesn't include refunded tickets
+type EventStats struct {
+	TicketsSold  int64          `json:"tickets_sold"`
+	CapacityLeft int64          `json:"capacity_left"`
+	CheckedIn    int64          `json:"checked_in"`
+	CheckInRate  float64        `json:"check_in_rate"`
+	Refunds      int64          `json:"refunds"`
+	Tiers        []*TierStats   `json:"tiers"`
+	Revenue      []*Revenue     `json:"revenue"`
+	Interval     string         `json:"interval"`
+	Sales        []*SalesBucket `json:"sales"`
+}
+
+// UserStats is sales summary across all events created by the user
+type UserStats struct {
+	Events      int64      `json:"events"`
+	TicketsSold int64      `json:"tickets_sold"`
+	CheckedIn   int64      `json:"checked_in"`
+	CheckInRate float64    `json:"check_in_rate"`
+	Refunds     int64      `json:"refunds"`
+	Revenue     []*Revenue `json:"revenue"`
+}
+
+// Funnel shows how views of the event turn into purchases. Purchases are counted by unique buyers
+type Funnel struct {
+	Views              int64   `json:"views"`
+	PurchasesStarted   int64   `json:"purchases_started"`
+	PurchasesCompleted int64   `json:"purchases_completed"`
+	StartRate          float64 `json:"start_rate"`
+	CompletionRate     float64 `json:"completion_rate"`
+	ConversionRate     float64 `json:"conversion_rate"`
+}
+
+// AttendeesFilter selects attendees of the event. Search matches names and email,
+// zero PerPage means no pagination
+type AttendeesFilter struct {
+	Search  string
+	Page    int
+	PerPage int
+}
+
+type AttendeesPage struct {
+	Attendees []*Attendee `json:"attendees"`
+	Total     int64       `json:"total"`
+	Page      int         `json:"page"`
+	PerPage   int         `json:"per_page"`
+}
+
+type ReplyReviewRequest struct {
+	Reply string `json:"reply" validate:"required,max=2000"`
+}
+
+type CancelEventRequest struct {
+	Reason string `json:"reason" validate:"max=1000"`
+}
+
+type RefundsProgress struct {
+	Total      int64 `json:"total"`
+	Pending    int64 `json:"pending"`
+	Processing int64 `json:"processing"`
+	Succeeded  int64 `json:"succeeded"`
+	Failed     int64 `json:"failed"`
+}
+
+type CancellationProgress struct {
+	Status       string          `json:"status"`
+	CancelReason string          `json:"cancel_reason"`
+	CancelledAt  *time.Time      `json:"cancelled_at"`
+	Refunds      RefundsProgress `json:"refunds"`
 }
@@ -5,6 +5,17 @@ type OrderMessage struct {
 
 	TicketID    string
 	EventTitle  string
+	EventTime   string
 	ImageURL    string
 	RedirectURL string
 }
+
+type NotificationMessage struct {
+	To []string
+
+	Subject    string
+	Title      string
+	Lines      []string
+	ButtonText string
+	ButtonURL  string
+}
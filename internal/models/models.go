@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,16 +12,51 @@ import (
 
 type User struct {
 	ID         int64  `json:"-" db:"id"`
-	Email      string `json:"email" db:"email"`
-	Password   string `json:"password,omitempty" db:"password"`
-	TGUsername string `json:"tg_username,omitempty" db:"tg_username"`
+	Email      string `json:"email" db:"email" validate:"required,email"`
+	Password   string `json:"password,omitempty" db:"password" validate:"required,maxbytes=72"`
+	TGUsername string `json:"tg_username,omitempty" db:"tg_username" validate:"max=32"`
+
+	// NotifyFollowed enables emails about new events of followed organizers
+	NotifyFollowed *bool `json:"notify_followed,omitempty" db:"-"`
 
 	YookassaSettings YookassaSettings `json:"yookassa_settings" db:"-"`
 
+	// EmailVerified is true when user has confirmed the email via link from verification mail
+	EmailVerified      bool       `json:"email_verified" db:"-"`
+	EmailVerifiedAt    *time.Time `json:"-" db:"email_verified_at"`
+	VerificationSentAt *time.Time `json:"-" db:"verification_sent_at"`
+
 	CreatedAt time.Time `json:"-" db:"created_at"`
 	UpdatedAt time.Time `json:"-" db:"updated_at"`
 
 	IsOAuth bool `json:"-" db:"-"`
+
+	OrganizerRating *Rating `json:"organizer_rating,omitempty" db:"-"`
+}
+
+// PasswordReset is a single-use token for setting new password of the user,
+// only hash of the token is stored
+type PasswordReset struct {
+	ID        int64      `db:"id"`
+	UserID    int64      `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// UserSession is a refresh token issued to the user. Tokens of one login form a family:
+// every refresh rotates the token, replacing it with the next one of the same family
+type UserSession struct {
+	ID        int64      `db:"id"`
+	FamilyID  string     `db:"family_id"`
+	UserID    int64      `db:"user_id"`
+	Email     string     `db:"-"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RotatedAt *time.Time `db:"rotated_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
 }
 
 type UserClaims struct {
@@ -28,22 +64,48 @@ type UserClaims struct {
 	Email string `json:"email"`
 }
 
+const (
+	EventStatusDraft     = "draft"
+	EventStatusScheduled = "scheduled"
+	EventStatusPublished = "published"
+	EventStatusEnded     = "ended"
+	EventStatusCancelled = "cancelled"
+)
+
 type Event struct {
-	ID            int64     `json:"-" db:"id"`
-	Title         string    `json:"title" db:"title"`
-	URLTitle      string    `json:"url_title,omitempty" db:"url_title"`
-	Description   string    `json:"description" db:"description"`
-	BeginningTime time.Time `json:"beginning_time" db:"beginning_time"`
-	EndTime       time.Time `json:"end_time" db:"end_time"`
-	CreatorID     int64     `json:"creator_id,omitempty" db:"creator_id"`
-	IsPublic      bool      `json:"is_public" db:"is_public"`
-	Location      string    `json:"location" db:"location"`
-	IsFree        bool      `json:"is_free" db:"is_free"`
-	PreviewImage  string    `json:"preview_image" db:"preview_image"`
-	UTCOffset     int64     `json:"utc_offset" db:"utc_offset"`
-	Capacity      int64     `json:"capacity" db:"capacity"`
-	MinimalAge    int64     `json:"minimal_age" db:"minimal_age"`
-	Prices        []*Price  `json:"prices" db:"-"`
+	ID               int64             `json:"-" db:"id"`
+	Title            string            `json:"title" db:"title" validate:"required,max=200"`
+	URLTitle         string            `json:"url_title,omitempty" db:"url_title"`
+	Description      string            `json:"description" db:"description" validate:"required"`
+	BeginningTime    time.Time         `json:"beginning_time" db:"beginning_time" validate:"required"`
+	EndTime          time.Time         `json:"end_time" db:"end_time" validate:"required,gtfield=BeginningTime"`
+	CreatorID        int64             `json:"creator_id,omitempty" db:"creator_id"`
+	IsPublic         bool              `json:"is_public" db:"is_public"`
+	Location         string            `json:"location" db:"location" validate:"required,max=500"`
+	Latitude         *float64          `json:"latitude,omitempty" db:"latitude" validate:"min=-90,max=90"`
+	Longitude        *float64          `json:"longitude,omitempty" db:"longitude" validate:"min=-180,max=180"`
+	Distance         *float64          `json:"distance_km,omitempty" db:"-"`
+	IsFree           bool              `json:"is_free" db:"is_free"`
+	PreviewImage     string            `json:"preview_image" db:"preview_image"`
+	PreviewImageURLs map[string]string `json:"preview_image_urls,omitempty" db:"-"`
+	TimeZone         string            `json:"time_zone" db:"time_zone" validate:"timezone"`
+	Capacity         int64             `json:"capacity" db:"capacity" validate:"min=0"`
+	MinimalAge       int64             `json:"minimal_age" db:"minimal_age" validate:"min=0,max=150"`
+	Status           string            `json:"status" db:"status"`
+	PublishAt        *time.Time        `json:"publish_at,omitempty" db:"publish_at"`
+	CancelReason     string            `json:"cancel_reason,omitempty" db:"-"`
+	CancelledAt      *time.Time        `json:"cancelled_at,omitempty" db:"-"`
+	Prices           []*Price          `json:"prices" db:"-"`
+	Images           []*EventImage     `json:"images" db:"-"`
+	Agenda           []*Session        `json:"agenda,omitempty" db:"-"`
+	Questions        []*EventQuestion  `json:"questions,omitempty" db:"-"`
+	Rating           *Rating           `json:"rating,omitempty" db:"-"`
+	Organizer        *OrganizerProfile `json:"organizer,omitempty" db:"-"`
+
+	// SilentUpdate suppresses notification of ticket holders about the update
+	SilentUpdate bool `json:"silent_update,omitempty" db:"-"`
+	// ClearCoordinates makes update reset coordinates, which don't match the new location anymore
+	ClearCoordinates bool `json:"-" db:"-"`
 
 	CreatedAt time.Time `json:"-" db:"created_at"`
 	UpdatedAt time.Time `json:"-" db:"updated_at"`
@@ -54,8 +116,163 @@ type Event struct {
 
 type Price struct {
 	ID       int64  `json:"id,omitempty" db:"id"`
-	Price    int64  `json:"price" db:"price"`
-	Currency string `json:"currency" db:"currency"`
+	Price    int64  `json:"price" db:"price" validate:"min=0"`
+	Currency string `json:"currency" db:"currency" validate:"required,currency"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+type EventImage struct {
+	ID       int64             `json:"id" db:"id"`
+	EventID  int64             `json:"-" db:"event_id"`
+	Image    string            `json:"image" db:"image"`
+	Caption  string            `json:"caption" db:"caption"`
+	Position int64             `json:"position" db:"position"`
+	IsCover  bool              `json:"is_cover" db:"is_cover"`
+	URLs     map[string]string `json:"urls,omitempty" db:"-"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+type Speaker struct {
+	ID        int64             `json:"id" db:"id"`
+	EventID   int64             `json:"-" db:"event_id"`
+	Name      string            `json:"name" db:"name" validate:"required,max=200"`
+	Bio       string            `json:"bio" db:"bio"`
+	Photo     string            `json:"photo,omitempty" db:"photo"`
+	PhotoURLs map[string]string `json:"photo_urls,omitempty" db:"-"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+type Session struct {
+	ID            int64      `json:"id" db:"id"`
+	EventID       int64      `json:"-" db:"event_id"`
+	Title         string     `json:"title" db:"title" validate:"required,max=200"`
+	Description   string     `json:"description" db:"description"`
+	Room          string     `json:"room" db:"room"`
+	BeginningTime time.Time  `json:"beginning_time" db:"beginning_time" validate:"required"`
+	EndTime       time.Time  `json:"end_time" db:"end_time" validate:"required,gtfield=BeginningTime"`
+	SpeakerIDs    []int64    `json:"speaker_ids" db:"-"`
+	Speakers      []*Speaker `json:"speakers,omitempty" db:"-"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+const (
+	QuestionTypeText         = "text"
+	QuestionTypeSingleChoice = "single_choice"
+	QuestionTypeMultiChoice  = "multi_choice"
+	QuestionTypeCheckbox     = "checkbox"
+)
+
+// EventQuestion is an extra question, which buyer answers at checkout
+type EventQuestion struct {
+	ID       int64    `json:"id" db:"id"`
+	EventID  int64    `json:"-" db:"event_id"`
+	Label    string   `json:"label" db:"label" validate:"required,max=200"`
+	Type     string   `json:"type" db:"type" validate:"required,oneof=text single_choice multi_choice checkbox"`
+	Options  []string `json:"options,omitempty" db:"options" validate:"max=50"`
+	Required bool     `json:"required" db:"required"`
+	Position int64    `json:"position" db:"position"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// TicketAnswer is an answer to the event question. Checkbox is answered with "true" or "false",
+// multi choice question can have several values, other questions have exactly one
+type TicketAnswer struct {
+	TicketID   string   `json:"-" db:"ticket_id"`
+	QuestionID int64    `json:"question_id" db:"question_id" validate:"required"`
+	Values     []string `json:"values" db:"answer" validate:"max=50"`
+}
+
+// OrganizerProfile is a public page of the user, who creates events
+type OrganizerProfile struct {
+	UserID      int64             `json:"id" db:"user_id"`
+	Handle      string            `json:"handle" db:"handle"`
+	DisplayName string            `json:"display_name" db:"display_name"`
+	Avatar      string            `json:"avatar,omitempty" db:"avatar"`
+	AvatarURLs  map[string]string `json:"avatar_urls,omitempty" db:"-"`
+	Bio         string            `json:"bio,omitempty" db:"bio"`
+	Website     string            `json:"website,omitempty" db:"website"`
+	SocialLinks map[string]string `json:"social_links,omitempty" db:"social_links"`
+	URL         string            `json:"url,omitempty" db:"-"`
+
+	Rating         *Rating  `json:"rating,omitempty" db:"-"`
+	UpcomingEvents []*Event `json:"upcoming_events,omitempty" db:"-"`
+	PastEvents     []*Event `json:"past_events,omitempty" db:"-"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+type Review struct {
+	ID         int64      `json:"id" db:"id"`
+	EventID    int64      `json:"-" db:"event_id"`
+	UserID     int64      `json:"-" db:"user_id"`
+	AuthorName string     `json:"author_name" db:"-"`
+	Rating     int64      `json:"rating" db:"rating" validate:"required,min=1,max=5"`
+	Comment    string     `json:"comment" db:"comment" validate:"max=2000"`
+	Reply      string     `json:"reply,omitempty" db:"reply"`
+	RepliedAt  *time.Time `json:"replied_at,omitempty" db:"replied_at"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// Rating is an aggregate of reviews ratings
+type Rating struct {
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+const (
+	EventRevisionCreate      = "create"
+	EventRevisionUpdate      = "update"
+	EventRevisionPriceChange = "price_change"
+	EventRevisionDelete      = "delete"
+	EventRevisionSlugChange  = "slug_change"
+)
+
+// EventRevision is a snapshot of the event as it was stored after the change
+type EventRevision struct {
+	ID         int64           `json:"id" db:"id"`
+	EventID    int64           `json:"event_id" db:"event_id"`
+	Version    int64           `json:"version" db:"version"`
+	Action     string          `json:"action" db:"action"`
+	ActorID    *int64          `json:"actor_id" db:"actor_id"`
+	ActorEmail string          `json:"actor_email,omitempty" db:"-"`
+	Snapshot   json.RawMessage `json:"snapshot" db:"snapshot"`
+	Diff       []*FieldChange  `json:"diff" db:"diff"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// FieldChange is a change of single field of the event between two revisions
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+const (
+	EventRoleOwner   = "owner"
+	EventRoleEditor  = "editor"
+	EventRoleCheckIn = "checkin"
+	EventRoleFinance = "finance"
+)
+
+type EventMember struct {
+	ID      int64  `json:"id" db:"id"`
+	EventID int64  `json:"-" db:"event_id"`
+	Email   string `json:"email" db:"email" validate:"required,email"`
+	Role    string `json:"role" db:"role" validate:"required,oneof=owner editor checkin finance"`
 
 	CreatedAt time.Time `json:"-" db:"created_at"`
 	UpdatedAt time.Time `json:"-" db:"updated_at"`
@@ -81,10 +298,69 @@ type Ticket struct {
 	FirstName string `json:"first_name" db:"first_name"`
 	LastName  string `json:"last_name" db:"last_name"`
 	PaymentID string `json:"-" db:"payment_id"`
+	PriceID   int64  `json:"price_id,omitempty" db:"price_id"`
+	Price     int64  `json:"price,omitempty" db:"price"`
+	Currency  string `json:"currency,omitempty" db:"currency"`
+
+	Answers []*TicketAnswer `json:"answers,omitempty" db:"-"`
+	// RefundPending is set when the event was cancelled while the ticket was being paid,
+	// so the ticket is refunded instead of being issued
+	RefundPending bool `json:"-" db:"-"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+}
+
+// Attendee is a ticket of the event as organizer sees it
+type Attendee struct {
+	TicketID    string          `json:"ticket_id" db:"id"`
+	FirstName   string          `json:"first_name" db:"first_name"`
+	LastName    string          `json:"last_name" db:"last_name"`
+	Email       string          `json:"email" db:"email"`
+	PriceID     int64           `json:"price_id,omitempty" db:"price_id"`
+	Price       int64           `json:"price" db:"price"`
+	Currency    string          `json:"currency,omitempty" db:"currency"`
+	IsUsed      bool            `json:"is_used" db:"is_used"`
+	PurchasedAt time.Time       `json:"purchased_at" db:"created_at"`
+	Answers     []*TicketAnswer `json:"answers,omitempty" db:"-"`
+}
+
+// EventView is a view of the event page by anonymised visitor, views are unique per visitor and day
+type EventView struct {
+	EventID int64     `db:"event_id"`
+	Visitor string    `db:"visitor"`
+	Day     time.Time `db:"day"`
+}
+
+const (
+	RefundStatusPending    = "pending"
+	RefundStatusProcessing = "processing"
+	RefundStatusSucceeded  = "succeeded"
+	RefundStatusFailed     = "failed"
+)
+
+type Refund struct {
+	ID        int64  `json:"id" db:"id"`
+	TicketID  string `json:"ticket_id" db:"ticket_id"`
+	EventID   int64  `json:"-" db:"event_id"`
+	PaymentID string `json:"-" db:"payment_id"`
+	RefundID  string `json:"-" db:"refund_id"`
+	Status    string `json:"status" db:"status"`
+	Error     string `json:"error,omitempty" db:"error"`
+
+	// Attempts counts transient failures, the refund isn't retried until NextAttemptAt
+	Attempts      int        `json:"-" db:"attempts"`
+	NextAttemptAt *time.Time `json:"-" db:"next_attempt_at"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+
+	ShopID  string `json:"-" db:"-"`
+	ShopKey string `json:"-" db:"-"`
 }
 
 type TicketPayment struct {
 	BuyTicketRequest *BuyTicketRequest
+	Price            *Price
 	Payment          *yoopayment.Payment
 	User             *User
 	Event            *Event
@@ -0,0 +1,367 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) EventSpeakers(ctx context.Context, eventID int64) ([]*models.Speaker, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"id",
+		"event_id",
+		"name",
+		"bio",
+		"photo",
+		"created_at",
+		"updated_at",
+	).
+		From(speakersTable).
+		Where(sq.Eq{"event_id": eventID}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var speakers []*models.Speaker
+	for rows.Next() {
+		var speaker models.Speaker
+
+		err = rows.Scan(
+			&speaker.ID,
+			&speaker.EventID,
+			&speaker.Name,
+			&speaker.Bio,
+			&speaker.Photo,
+			&speaker.CreatedAt,
+			&speaker.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		speakers = append(speakers, &speaker)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return speakers, nil
+}
+
+func (r *repo) InsertSpeaker(ctx context.Context, speaker *models.Speaker) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(speakersTable).
+		Columns("event_id", "name", "bio", "photo").
+		Values(speaker.EventID, speaker.Name, speaker.Bio, speaker.Photo).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateSpeaker replaces speaker's name and bio. Photo is changed only if the new one is provided
+func (r *repo) UpdateSpeaker(ctx context.Context, speaker *models.Speaker) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(speakersTable).
+		Set("name", speaker.Name).
+		Set("bio", speaker.Bio).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": speaker.ID, "event_id": speaker.EventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	if speaker.Photo != "" {
+		builder = builder.Set("photo", speaker.Photo)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteSpeaker(ctx context.Context, eventID int64, speakerID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(speakersTable).
+		Where(sq.Eq{"id": speakerID, "event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// EventSessions returns sessions of the event ordered by time together with ids of their speakers
+func (r *repo) EventSessions(ctx context.Context, eventID int64) ([]*models.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"s.id",
+		"s.event_id",
+		"s.title",
+		"s.description",
+		"s.room",
+		"s.beginning_time",
+		"s.end_time",
+		"s.created_at",
+		"s.updated_at",
+		"coalesce(array_agg(ss.speaker_id ORDER BY ss.speaker_id) FILTER (WHERE ss.speaker_id IS NOT NULL), '{}')",
+	).
+		From(sessionsTable+" s").
+		LeftJoin(sessionSpeakersTable+" ss ON ss.session_id = s.id").
+		Where(sq.Eq{"s.event_id": eventID}).
+		GroupBy("s.id").
+		OrderBy("s.beginning_time", "s.id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*models.Session
+	for rows.Next() {
+		var session models.Session
+
+		err = rows.Scan(
+			&session.ID,
+			&session.EventID,
+			&session.Title,
+			&session.Description,
+			&session.Room,
+			&session.BeginningTime,
+			&session.EndTime,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+			&session.SpeakerIDs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, &session)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *repo) InsertSession(ctx context.Context, session *models.Session) (id int64, err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Insert(sessionsTable).
+		Columns("event_id", "title", "description", "room", "beginning_time", "end_time").
+		Values(
+			session.EventID,
+			session.Title,
+			session.Description,
+			session.Room,
+			session.BeginningTime,
+			session.EndTime,
+		).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertSessionSpeakers(ctx, tx, id, session.SpeakerIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateSession replaces session and its speakers
+func (r *repo) UpdateSession(ctx context.Context, session *models.Session) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Update(sessionsTable).
+		Set("title", session.Title).
+		Set("description", session.Description).
+		Set("room", session.Room).
+		Set("beginning_time", session.BeginningTime).
+		Set("end_time", session.EndTime).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": session.ID, "event_id": session.EventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM session_speakers WHERE session_id = $1", session.ID)
+	if err != nil {
+		return err
+	}
+
+	err = insertSessionSpeakers(ctx, tx, session.ID, session.SpeakerIDs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteSession(ctx context.Context, eventID int64, sessionID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(sessionsTable).
+		Where(sq.Eq{"id": sessionID, "event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func insertSessionSpeakers(ctx context.Context, tx pgx.Tx, sessionID int64, speakerIDs []int64) error {
+	if len(speakerIDs) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(sessionSpeakersTable).
+		Columns("session_id", "speaker_id").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, speakerID := range speakerIDs {
+		builder = builder.Values(sessionID, speakerID)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// EventAttendees returns tickets of the event with buyers' emails and answers to event questions
+// along with total number of tickets matching the filter
+func (r *repo) EventAttendees(ctx context.Context, eventID int64, filter *models.AttendeesFilter) ([]*models.Attendee, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	where := sq.And{sq.Eq{"t.event_id": eventID}}
+	if filter.Search != "" {
+		pattern := "%" + escapeLike(filter.Search) + "%"
+		where = append(where, sq.Or{
+			sq.ILike{"t.first_name": pattern},
+			sq.ILike{"t.last_name": pattern},
+			sq.ILike{"u.email": pattern},
+			sq.Expr("t.first_name || ' ' || t.last_name ILIKE ?", pattern),
+		})
+	}
+
+	countBuilder := sq.Select("count(t.id)").
+		From(ticketsTable + " t").
+		Join(usersTable + " u ON u.id = t.user_id").
+		Where(where).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	builder := sq.Select(
+		"t.id",
+		"t.first_name",
+		"t.last_name",
+		"u.email",
+		"coalesce(t.price_id, 0)",
+		"t.price",
+		"t.currency",
+		"t.is_used",
+		"t.created_at",
+	).
+		From(ticketsTable+" t").
+		Join(usersTable+" u ON u.id = t.user_id").
+		Where(where).
+		OrderBy("t.created_at", "t.id").
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.PerPage > 0 {
+		builder = builder.
+			Limit(uint64(filter.PerPage)).
+			Offset(uint64((filter.Page - 1) * filter.PerPage))
+	}
+
+	sql, args, err = builder.ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var attendees []*models.Attendee
+	byTicket := make(map[string]*models.Attendee)
+	for rows.Next() {
+		var attendee models.Attendee
+
+		err = rows.Scan(
+			&attendee.TicketID,
+			&attendee.FirstName,
+			&attendee.LastName,
+			&attendee.Email,
+			&attendee.PriceID,
+			&attendee.Price,
+			&attendee.Currency,
+			&attendee.IsUsed,
+			&attendee.PurchasedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		attendees = append(attendees, &attendee)
+		byTicket[attendee.TicketID] = &attendee
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(attendees) == 0 {
+		return attendees, total, nil
+	}
+
+	ticketIDs := make([]string, 0, len(attendees))
+	for _, attendee := range attendees {
+		ticketIDs = append(ticketIDs, attendee.TicketID)
+	}
+
+	answersBuilder := sq.Select("ticket_id", "question_id", "answer").
+		From(ticketAnswersTable).
+		Where(sq.Eq{"ticket_id": ticketIDs}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err = answersBuilder.ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	answerRows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer answerRows.Close()
+
+	for answerRows.Next() {
+		var answer models.TicketAnswer
+
+		err = answerRows.Scan(&answer.TicketID, &answer.QuestionID, &answer.Values)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		attendee := byTicket[answer.TicketID]
+		attendee.Answers = append(attendee.Answers, &answer)
+	}
+	if err = answerRows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return attendees, total, nil
+}
+
+// escapeLike escapes wildcards of LIKE pattern, so the value is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -20,7 +21,7 @@ func (r *repo) Events(ctx context.Context, page int) ([]*models.Event, error) {
 		"location",
 	).
 		From(eventsTable).
-		Where(sq.Eq{"is_public": true}).
+		Where(sq.Eq{"is_public": true, "status": models.EventStatusPublished}).
 		OrderBy("created_at DESC").
 		Limit(10).
 		Offset(uint64((page - 1) * 10)).
@@ -59,6 +60,81 @@ func (r *repo) Events(ctx context.Context, page int) ([]*models.Event, error) {
 	return events, nil
 }
 
+// EventsNear returns public published events within radius from given point ordered by distance
+func (r *repo) EventsNear(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) ([]*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	distance := sq.Select(
+		"title",
+		"coalesce(preview_image, '') as preview_image",
+		"url_title",
+		"location",
+		"latitude",
+		"longitude",
+	).
+		// rounding may push the argument of asin slightly above 1 for antipodal points
+		Column(`6371 * 2 * asin(least(1, sqrt(
+			power(sin(radians(latitude - ?) / 2), 2) +
+			cos(radians(?)) * cos(radians(latitude)) * power(sin(radians(longitude - ?) / 2), 2)
+		))) as distance`, lat, lat, lng).
+		From(eventsTable).
+		Where(sq.Eq{"is_public": true, "status": models.EventStatusPublished}).
+		Where(sq.NotEq{"latitude": nil, "longitude": nil})
+
+	builder := sq.Select(
+		"title",
+		"preview_image",
+		"url_title",
+		"location",
+		"latitude",
+		"longitude",
+		"distance",
+	).
+		FromSelect(distance, "e").
+		Where(sq.LtOrEq{"distance": radiusKm}).
+		OrderBy("distance").
+		Limit(10).
+		Offset(uint64((page - 1) * 10)).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		var event models.Event
+
+		err = rows.Scan(
+			&event.Title,
+			&event.PreviewImage,
+			&event.URLTitle,
+			&event.Location,
+			&event.Latitude,
+			&event.Longitude,
+			&event.Distance,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
 func (r *repo) EventByURLTitle(ctx context.Context, urlTitle string) (*models.Event, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -73,10 +149,16 @@ func (r *repo) EventByURLTitle(ctx context.Context, urlTitle string) (*models.Ev
 		"e.creator_id",
 		"e.is_public",
 		"e.location",
+		"e.latitude",
+		"e.longitude",
 		"e.is_free",
 		"coalesce(e.preview_image, '') as preview_image",
-		"e.utc_offset",
+		"e.time_zone",
 		"e.minimal_age",
+		"e.status",
+		"e.publish_at",
+		"e.cancel_reason",
+		"e.cancelled_at",
 		"e.created_at",
 		"e.updated_at",
 		"coalesce(s.shop_id, '') as shop_id",
@@ -104,10 +186,16 @@ func (r *repo) EventByURLTitle(ctx context.Context, urlTitle string) (*models.Ev
 		&event.CreatorID,
 		&event.IsPublic,
 		&event.Location,
+		&event.Latitude,
+		&event.Longitude,
 		&event.IsFree,
 		&event.PreviewImage,
-		&event.UTCOffset,
+		&event.TimeZone,
 		&event.MinimalAge,
+		&event.Status,
+		&event.PublishAt,
+		&event.CancelReason,
+		&event.CancelledAt,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 		&event.ShopID,
@@ -159,6 +247,11 @@ func (r *repo) EventByURLTitle(ctx context.Context, urlTitle string) (*models.Ev
 		}
 	}
 
+	event.Images, err = r.EventImages(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &event, nil
 }
 
@@ -220,10 +313,30 @@ func (r *repo) InsertEvent(ctx context.Context, event *models.Event) (id int64,
 		}
 	}
 
+	err = insertEventImages(ctx, tx, id, event.Images)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertEventQuestions(ctx, tx, id, event.Questions)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertEventOwner(ctx, tx, id, event.CreatorID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertEventRevision(ctx, tx, id, event.CreatorID, models.EventRevisionCreate)
+	if err != nil {
+		return 0, err
+	}
+
 	return id, nil
 }
 
-func (r *repo) UpdateEvent(ctx context.Context, event *models.Event) error {
+func (r *repo) UpdateEvent(ctx context.Context, actorID int64, event *models.Event) (err error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
@@ -259,6 +372,9 @@ func (r *repo) UpdateEvent(ctx context.Context, event *models.Event) error {
 		SetMap(m).
 		Where(sq.Eq{"url_title": event.URLTitle}).
 		PlaceholderFormat(sq.Dollar)
+	if event.ClearCoordinates {
+		builder = builder.Set("latitude", nil).Set("longitude", nil)
+	}
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
@@ -315,15 +431,46 @@ func (r *repo) UpdateEvent(ctx context.Context, event *models.Event) error {
 		}
 	}
 
+	err = insertEventRevision(ctx, tx, event.ID, actorID, models.EventRevisionUpdate)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (r *repo) DeleteEvent(ctx context.Context, urlTitle string) error {
+// DeleteEvent deletes the event, keeping its last state in the history
+func (r *repo) DeleteEvent(ctx context.Context, actorID int64, urlTitle string) (err error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	var eventID int64
+	err = tx.QueryRow(ctx, "select id from events where url_title = $1", urlTitle).Scan(&eventID)
+	if err != nil {
+		return err
+	}
+
+	err = insertEventRevision(ctx, tx, eventID, actorID, models.EventRevisionDelete)
+	if err != nil {
+		return err
+	}
+
 	builder := sq.Delete(eventsTable).
-		Where(sq.Eq{"url_title": urlTitle}).
+		Where(sq.Eq{"id": eventID}).
 		PlaceholderFormat(sq.Dollar)
 
 	sql, args, err := builder.ToSql()
@@ -331,7 +478,7 @@ func (r *repo) DeleteEvent(ctx context.Context, urlTitle string) error {
 		return err
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
@@ -348,9 +495,15 @@ func (r *repo) UserEvents(ctx context.Context, userID int64) ([]*models.Event, e
 		"coalesce(preview_image, '')",
 		"url_title",
 		"location",
+		"status",
 	).
 		From(eventsTable).
-		Where(sq.Eq{"creator_id": userID}).
+		Where(sq.Or{
+			sq.Eq{"creator_id": userID},
+			sq.Expr(`id IN (SELECT m.event_id FROM event_members m
+			JOIN users u ON lower(u.email) = m.email AND u.email_verified_at IS NOT NULL
+			WHERE u.id = ?)`, userID),
+		}).
 		OrderBy("created_at DESC").
 		PlaceholderFormat(sq.Dollar)
 
@@ -374,6 +527,7 @@ func (r *repo) UserEvents(ctx context.Context, userID int64) ([]*models.Event, e
 			&event.PreviewImage,
 			&event.URLTitle,
 			&event.Location,
+			&event.Status,
 		)
 		if err != nil {
 			return nil, err
@@ -386,3 +540,74 @@ func (r *repo) UserEvents(ctx context.Context, userID int64) ([]*models.Event, e
 	}
 	return events, nil
 }
+
+func (r *repo) PublishScheduledEvents(ctx context.Context, now time.Time) ([]*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(eventsTable).
+		Set("status", models.EventStatusPublished).
+		Set("updated_at", now).
+		Where(sq.Eq{"status": models.EventStatusScheduled}).
+		Where(sq.LtOrEq{"publish_at": now}).
+		Suffix("RETURNING id, title, url_title, creator_id, is_public").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		event := models.Event{Status: models.EventStatusPublished}
+
+		err = rows.Scan(
+			&event.ID,
+			&event.Title,
+			&event.URLTitle,
+			&event.CreatorID,
+			&event.IsPublic,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *repo) EndPastEvents(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(eventsTable).
+		Set("status", models.EventStatusEnded).
+		Set("updated_at", now).
+		Where(sq.Eq{"status": models.EventStatusPublished}).
+		Where(sq.Lt{"end_time": now}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
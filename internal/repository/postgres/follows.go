@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+const feedPageSize = 10
+
+// eventCardColumns are columns of events table scanned by eventCards
+var eventCardColumns = []string{
+	"e.title",
+	"coalesce(e.preview_image, '')",
+	"e.url_title",
+	"e.location",
+	"e.beginning_time",
+	"e.end_time",
+	"e.time_zone",
+	"e.status",
+	"e.creator_id",
+}
+
+func (r *repo) InsertBookmark(ctx context.Context, userID int64, eventID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(bookmarksTable).
+		Columns("user_id", "event_id").
+		Values(userID, eventID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteBookmark(ctx context.Context, userID int64, eventID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(bookmarksTable).
+		Where(sq.Eq{"user_id": userID, "event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UserBookmarks returns bookmarked events of the user, which are still visible to the public
+func (r *repo) UserBookmarks(ctx context.Context, userID int64) ([]*models.Event, error) {
+	builder := sq.Select(eventCardColumns...).
+		From(bookmarksTable + " b").
+		Join(eventsTable + " e ON e.id = b.event_id").
+		Where(sq.Eq{
+			"b.user_id": userID,
+			"e.status":  []string{models.EventStatusPublished, models.EventStatusEnded, models.EventStatusCancelled},
+		}).
+		OrderBy("b.created_at DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	return r.eventCards(ctx, builder)
+}
+
+func (r *repo) InsertFollow(ctx context.Context, followerID int64, organizerID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(followsTable).
+		Columns("follower_id", "organizer_id").
+		Values(followerID, organizerID).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteFollow(ctx context.Context, followerID int64, organizerID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(followsTable).
+		Where(sq.Eq{"follower_id": followerID, "organizer_id": organizerID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// IsOrganizer reports whether the user has created any public event
+func (r *repo) IsOrganizer(ctx context.Context, userID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT EXISTS(SELECT 1 FROM events WHERE creator_id = $1 AND is_public AND status != $2)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, sql, userID, models.EventStatusDraft).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// FollowerEmails returns emails of the organizer's followers, who opted in for notifications
+func (r *repo) FollowerEmails(ctx context.Context, organizerID int64) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select("u.email").
+		From(followsTable + " f").
+		Join(usersTable + " u ON u.id = f.follower_id").
+		Where(sq.Eq{"f.organizer_id": organizerID, "u.notify_followed": true}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+
+		err = rows.Scan(&email)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// FeedEvents returns upcoming public events of organizers followed by the user, the soonest first
+func (r *repo) FeedEvents(ctx context.Context, userID int64, now time.Time, page int) ([]*models.Event, error) {
+	builder := sq.Select(eventCardColumns...).
+		From(eventsTable+" e").
+		Join(followsTable+" f ON f.organizer_id = e.creator_id").
+		Where(sq.Eq{
+			"f.follower_id": userID,
+			"e.is_public":   true,
+			"e.status":      models.EventStatusPublished,
+		}).
+		Where(sq.Gt{"e.beginning_time": now}).
+		OrderBy("e.beginning_time", "e.id").
+		Limit(feedPageSize).
+		Offset(uint64((page - 1) * feedPageSize)).
+		PlaceholderFormat(sq.Dollar)
+
+	return r.eventCards(ctx, builder)
+}
+
+// eventCards runs query selecting short info about events
+func (r *repo) eventCards(ctx context.Context, builder sq.SelectBuilder) ([]*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		var event models.Event
+
+		err = rows.Scan(
+			&event.Title,
+			&event.PreviewImage,
+			&event.URLTitle,
+			&event.Location,
+			&event.BeginningTime,
+			&event.EndTime,
+			&event.TimeZone,
+			&event.Status,
+			&event.CreatorID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// InsertEventViews stores batch of views, views already recorded for the visitor and day are skipped
+func (r *repo) InsertEventViews(ctx context.Context, views []*models.EventView) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(eventViewsTable).
+		Columns("event_id", "visitor", "day").
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, view := range views {
+		builder = builder.Values(view.EventID, view.Visitor, view.Day)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) InsertCheckout(ctx context.Context, eventID int64, userID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(eventCheckoutsTable).
+		Columns("event_id", "user_id").
+		Values(eventID, userID).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EventFunnel counts views, started and completed purchases of the event. Only buyers with a tracked checkout
+// are counted as completed, since purchases made before checkouts tracking can't be a part of the funnel
+func (r *repo) EventFunnel(ctx context.Context, eventID int64) (*models.Funnel, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT
+	(SELECT count(*) FROM event_views WHERE event_id = $1),
+	(SELECT count(DISTINCT user_id) FROM event_checkouts WHERE event_id = $1),
+	(SELECT count(DISTINCT t.user_id) FROM tickets t WHERE t.event_id = $1 AND EXISTS (
+		SELECT 1 FROM event_checkouts c WHERE c.event_id = t.event_id AND c.user_id = t.user_id
+	))`
+
+	var funnel models.Funnel
+	err := r.db.QueryRow(ctx, sql, eventID).Scan(
+		&funnel.Views,
+		&funnel.PurchasesStarted,
+		&funnel.PurchasesCompleted,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &funnel, nil
+}
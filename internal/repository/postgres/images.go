@@ -0,0 +1,310 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) EventImages(ctx context.Context, eventID int64) ([]*models.EventImage, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"id",
+		"event_id",
+		"image",
+		"caption",
+		"position",
+		"is_cover",
+		"created_at",
+		"updated_at",
+	).
+		From(eventImagesTable).
+		Where(sq.Eq{"event_id": eventID}).
+		OrderBy("position", "id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*models.EventImage
+	for rows.Next() {
+		var image models.EventImage
+
+		err = rows.Scan(
+			&image.ID,
+			&image.EventID,
+			&image.Image,
+			&image.Caption,
+			&image.Position,
+			&image.IsCover,
+			&image.CreatedAt,
+			&image.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, &image)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (r *repo) InsertEventImages(ctx context.Context, eventID int64, images []*models.EventImage) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	return insertEventImages(ctx, tx, eventID, images)
+}
+
+// UpdateEventImage changes only provided fields of the image
+func (r *repo) UpdateEventImage(ctx context.Context, eventID int64, imageID int64, req *models.UpdateEventImageRequest) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Update(eventImagesTable).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": imageID, "event_id": eventID}).
+		Suffix("RETURNING image").
+		PlaceholderFormat(sq.Dollar)
+	if req.Caption != nil {
+		builder = builder.Set("caption", *req.Caption)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	var filename string
+	err = tx.QueryRow(ctx, sql, args...).Scan(&filename)
+	if err != nil {
+		return err
+	}
+
+	if req.IsCover {
+		err = setCoverImage(ctx, tx, eventID, imageID, filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repo) ReorderEventImages(ctx context.Context, eventID int64, ids []int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `UPDATE event_images i SET position = o.position - 1, updated_at = now()
+	FROM unnest($2::bigint[]) WITH ORDINALITY AS o(id, position)
+	WHERE i.id = o.id AND i.event_id = $1`
+
+	_, err := r.db.Exec(ctx, sql, eventID, ids)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteEventImage(ctx context.Context, eventID int64, imageID int64) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	var isCover bool
+	err = tx.QueryRow(
+		ctx,
+		`DELETE FROM event_images WHERE id = $1 AND event_id = $2 RETURNING is_cover`,
+		imageID,
+		eventID,
+	).Scan(&isCover)
+	if err != nil {
+		return err
+	}
+
+	if !isCover {
+		return nil
+	}
+
+	var (
+		nextID    int64
+		nextImage string
+	)
+	err = tx.QueryRow(
+		ctx,
+		`SELECT id, image FROM event_images WHERE event_id = $1 ORDER BY position, id LIMIT 1`,
+		eventID,
+	).Scan(&nextID, &nextImage)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			_, err = tx.Exec(ctx, `UPDATE events SET preview_image = '' WHERE id = $1`, eventID)
+			return err
+		}
+
+		return err
+	}
+
+	return setCoverImage(ctx, tx, eventID, nextID, nextImage)
+}
+
+// ReferencedImages returns filenames of all images which are still used by events
+func (r *repo) ReferencedImages(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT preview_image FROM events WHERE coalesce(preview_image, '') != ''
+	UNION
+	SELECT image FROM event_images
+	UNION
+	SELECT photo FROM speakers WHERE photo != ''
+	UNION
+	SELECT avatar FROM organizer_profiles WHERE avatar != ''`
+
+	rows, err := r.db.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []string
+	for rows.Next() {
+		var image string
+
+		err = rows.Scan(&image)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, image)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// insertEventImages appends images to the end of event's gallery
+func insertEventImages(ctx context.Context, tx pgx.Tx, eventID int64, images []*models.EventImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	var position int64
+	err := tx.QueryRow(
+		ctx,
+		`SELECT coalesce(max(position) + 1, 0) FROM event_images WHERE event_id = $1`,
+		eventID,
+	).Scan(&position)
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		image.EventID = eventID
+		image.Position = position
+		position++
+
+		err = tx.QueryRow(
+			ctx,
+			`INSERT INTO event_images (event_id, image, caption, position) VALUES ($1, $2, $3, $4) RETURNING id`,
+			eventID,
+			image.Image,
+			image.Caption,
+			image.Position,
+		).Scan(&image.ID)
+		if err != nil {
+			return err
+		}
+
+		if image.IsCover {
+			err = setCoverImage(ctx, tx, eventID, image.ID, image.Image)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setCoverImage marks image as the only cover of event gallery
+// and uses it as event's preview image
+func setCoverImage(ctx context.Context, tx pgx.Tx, eventID int64, imageID int64, filename string) error {
+	_, err := tx.Exec(
+		ctx,
+		`UPDATE event_images SET is_cover = (id = $2) WHERE event_id = $1`,
+		eventID,
+		imageID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE events SET preview_image = $2 WHERE id = $1`, eventID, filename)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) EventMembers(ctx context.Context, eventID int64) ([]*models.EventMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"id",
+		"event_id",
+		"email",
+		"role",
+		"created_at",
+		"updated_at",
+	).
+		From(eventMembersTable).
+		Where(sq.Eq{"event_id": eventID}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*models.EventMember
+	for rows.Next() {
+		var member models.EventMember
+
+		err = rows.Scan(
+			&member.ID,
+			&member.EventID,
+			&member.Email,
+			&member.Role,
+			&member.CreatedAt,
+			&member.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, &member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// EventMemberRole returns role of the user in the event, pgx.ErrNoRows if user isn't a member.
+// Role is granted only after the user has verified the invited email
+func (r *repo) EventMemberRole(ctx context.Context, eventID int64, userID int64) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select("m.role").
+		From(eventMembersTable + " m").
+		Join(usersTable + " u ON lower(u.email) = m.email AND u.email_verified_at IS NOT NULL").
+		Where(sq.Eq{"m.event_id": eventID, "u.id": userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var role string
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&role)
+	if err != nil {
+		return "", err
+	}
+
+	return role, nil
+}
+
+// UpsertEventMember adds member to the event or changes role of the existing one
+func (r *repo) UpsertEventMember(ctx context.Context, member *models.EventMember) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(eventMembersTable).
+		Columns("event_id", "email", "role").
+		Values(member.EventID, member.Email, member.Role).
+		Suffix(`ON CONFLICT (event_id, email)
+		DO UPDATE SET role = EXCLUDED.role, updated_at = now()
+		RETURNING id`).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repo) DeleteEventMember(ctx context.Context, eventID int64, memberID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(eventMembersTable).
+		Where(sq.Eq{"id": memberID, "event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// insertEventOwner makes creator of the event its owner
+func insertEventOwner(ctx context.Context, tx pgx.Tx, eventID int64, creatorID int64) error {
+	sql := `INSERT INTO event_members (event_id, email, role)
+	SELECT $1, lower(email), $2 FROM users WHERE id = $3
+	ON CONFLICT (event_id, email) DO NOTHING`
+
+	_, err := tx.Exec(ctx, sql, eventID, models.EventRoleOwner, creatorID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) OrganizerProfile(ctx context.Context, userID int64) (*models.OrganizerProfile, error) {
+	return r.organizerProfile(ctx, sq.Eq{"user_id": userID})
+}
+
+func (r *repo) OrganizerProfileByHandle(ctx context.Context, handle string) (*models.OrganizerProfile, error) {
+	return r.organizerProfile(ctx, sq.Eq{"handle": handle})
+}
+
+func (r *repo) organizerProfile(ctx context.Context, where sq.Eq) (*models.OrganizerProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"user_id",
+		"handle",
+		"display_name",
+		"avatar",
+		"bio",
+		"website",
+		"social_links",
+		"created_at",
+		"updated_at",
+	).
+		From(organizerProfilesTable).
+		Where(where).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var profile models.OrganizerProfile
+	err = r.db.QueryRow(ctx, sql, args...).Scan(
+		&profile.UserID,
+		&profile.Handle,
+		&profile.DisplayName,
+		&profile.Avatar,
+		&profile.Bio,
+		&profile.Website,
+		&profile.SocialLinks,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// UpsertOrganizerProfile creates profile of the user or replaces existing one. Avatar is kept if new one isn't provided
+func (r *repo) UpsertOrganizerProfile(ctx context.Context, profile *models.OrganizerProfile) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	socialLinks := profile.SocialLinks
+	if socialLinks == nil {
+		socialLinks = map[string]string{}
+	}
+
+	builder := sq.Insert(organizerProfilesTable).
+		Columns("user_id", "handle", "display_name", "avatar", "bio", "website", "social_links").
+		Values(
+			profile.UserID,
+			profile.Handle,
+			profile.DisplayName,
+			profile.Avatar,
+			profile.Bio,
+			profile.Website,
+			socialLinks,
+		).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+		handle = excluded.handle,
+		display_name = excluded.display_name,
+		avatar = CASE WHEN excluded.avatar != '' THEN excluded.avatar ELSE organizer_profiles.avatar END,
+		bio = excluded.bio,
+		website = excluded.website,
+		social_links = excluded.social_links,
+		updated_at = now()`).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OrganizerEvents returns public events of the organizer, which were published
+func (r *repo) OrganizerEvents(ctx context.Context, userID int64) ([]*models.Event, error) {
+	builder := sq.Select(eventCardColumns...).
+		From(eventsTable+" e").
+		Where(sq.Eq{
+			"e.creator_id": userID,
+			"e.is_public":  true,
+			"e.status":     []string{models.EventStatusPublished, models.EventStatusEnded},
+		}).
+		OrderBy("e.beginning_time DESC", "e.id DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	return r.eventCards(ctx, builder)
+}
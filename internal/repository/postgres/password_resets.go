@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) InsertPasswordReset(ctx context.Context, reset *models.PasswordReset) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(passwordResetsTable).
+		Columns("user_id", "token_hash", "expires_at").
+		Values(reset.UserID, reset.TokenHash, reset.ExpiresAt).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkPasswordResetSent records sending of password reset mail unless previous one was sent after notBefore,
+// in which case pgx.ErrNoRows is returned
+func (r *repo) MarkPasswordResetSent(ctx context.Context, userID int64, now time.Time, notBefore time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(usersTable).
+		Set("password_reset_sent_at", now).
+		Where(sq.Eq{"id": userID}).
+		Where(sq.Or{
+			sq.Eq{"password_reset_sent_at": nil},
+			sq.Lt{"password_reset_sent_at": notBefore},
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// ResetPassword consumes unused and unexpired reset token, sets new password of its user and revokes
+// all sessions of the user. Other unused tokens of the user are invalidated too.
+// Returns pgx.ErrNoRows if token can't be used
+func (r *repo) ResetPassword(ctx context.Context, tokenHash string, password string, now time.Time) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	var userID int64
+	err = tx.QueryRow(
+		ctx,
+		`UPDATE password_resets SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING user_id`,
+		tokenHash,
+		now,
+	).Scan(&userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE password_resets SET used_at = $2 WHERE user_id = $1 AND used_at IS NULL`,
+		userID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET password = $2, updated_at = $3 WHERE id = $1`, userID, password, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE user_sessions SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`,
+		userID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) EventQuestions(ctx context.Context, eventID int64) ([]*models.EventQuestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"id",
+		"event_id",
+		"label",
+		"type",
+		"options",
+		"required",
+		"position",
+		"created_at",
+		"updated_at",
+	).
+		From(eventQuestionsTable).
+		Where(sq.Eq{"event_id": eventID, "deleted_at": nil}).
+		OrderBy("position", "id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []*models.EventQuestion
+	for rows.Next() {
+		var question models.EventQuestion
+
+		err = rows.Scan(
+			&question.ID,
+			&question.EventID,
+			&question.Label,
+			&question.Type,
+			&question.Options,
+			&question.Required,
+			&question.Position,
+			&question.CreatedAt,
+			&question.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		questions = append(questions, &question)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+func (r *repo) InsertQuestion(ctx context.Context, question *models.EventQuestion) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(eventQuestionsTable).
+		Columns("event_id", "label", "type", "options", "required", "position").
+		Values(
+			question.EventID,
+			question.Label,
+			question.Type,
+			question.Options,
+			question.Required,
+			question.Position,
+		).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repo) UpdateQuestion(ctx context.Context, question *models.EventQuestion) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(eventQuestionsTable).
+		Set("label", question.Label).
+		Set("type", question.Type).
+		Set("options", question.Options).
+		Set("required", question.Required).
+		Set("position", question.Position).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": question.ID, "event_id": question.EventID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteQuestion hides the question from the event. Question is only marked as deleted,
+// so buying ticket with answer to it can't fail and answers already given are kept
+func (r *repo) DeleteQuestion(ctx context.Context, eventID int64, questionID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(eventQuestionsTable).
+		Set("deleted_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": questionID, "event_id": eventID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func insertEventQuestions(ctx context.Context, tx pgx.Tx, eventID int64, questions []*models.EventQuestion) error {
+	for _, question := range questions {
+		question.EventID = eventID
+
+		err := tx.QueryRow(
+			ctx,
+			`INSERT INTO event_questions (event_id, label, type, options, required, position)
+			VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+			eventID,
+			question.Label,
+			question.Type,
+			question.Options,
+			question.Required,
+			question.Position,
+		).Scan(&question.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertTicketAnswers(ctx context.Context, tx pgx.Tx, ticketID string, answers []*models.TicketAnswer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(ticketAnswersTable).
+		Columns("ticket_id", "question_id", "answer").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, answer := range answers {
+		builder = builder.Values(ticketID, answer.QuestionID, answer.Values)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// CancelEvent marks event as cancelled and creates pending refunds for all paid tickets of the event
+func (r *repo) CancelEvent(ctx context.Context, eventID int64, reason string, now time.Time) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Update(eventsTable).
+		Set("status", models.EventStatusCancelled).
+		Set("cancel_reason", reason).
+		Set("cancelled_at", now).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	sql = `INSERT INTO refunds (ticket_id, event_id, payment_id)
+	SELECT id, event_id, payment_id FROM tickets
+	WHERE event_id = $1 AND coalesce(payment_id, '') != ''
+	ON CONFLICT (ticket_id) DO NOTHING`
+
+	_, err = tx.Exec(ctx, sql, eventID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TicketHolderEmails returns emails of all users who have tickets to the event
+func (r *repo) TicketHolderEmails(ctx context.Context, eventID int64) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select("DISTINCT u.email").
+		From(ticketsTable + " t").
+		Join(usersTable + " u ON u.id = t.user_id").
+		Where(sq.Eq{"t.event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+
+		err = rows.Scan(&email)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+func (r *repo) RefundsProgress(ctx context.Context, eventID int64) (*models.RefundsProgress, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT count(*),
+	count(*) FILTER (WHERE status = $2),
+	count(*) FILTER (WHERE status = $3),
+	count(*) FILTER (WHERE status = $4),
+	count(*) FILTER (WHERE status = $5)
+	FROM refunds WHERE event_id = $1`
+
+	var progress models.RefundsProgress
+	err := r.db.QueryRow(
+		ctx,
+		sql,
+		eventID,
+		models.RefundStatusPending,
+		models.RefundStatusProcessing,
+		models.RefundStatusSucceeded,
+		models.RefundStatusFailed,
+	).Scan(
+		&progress.Total,
+		&progress.Pending,
+		&progress.Processing,
+		&progress.Succeeded,
+		&progress.Failed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &progress, nil
+}
+
+// ActiveRefunds returns refunds which are not finished yet and are due for the next attempt
+// together with shop credentials of the event creator
+func (r *repo) ActiveRefunds(ctx context.Context, now time.Time, limit int) ([]*models.Refund, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"rf.id",
+		"rf.ticket_id",
+		"rf.event_id",
+		"rf.payment_id",
+		"rf.refund_id",
+		"rf.status",
+		"rf.attempts",
+		"coalesce(s.shop_id, '')",
+		"coalesce(s.shop_key, '')",
+	).
+		From(refundsTable + " rf").
+		Join(eventsTable + " e ON e.id = rf.event_id").
+		LeftJoin(yookassaSettingsTable + " s ON s.user_id = e.creator_id").
+		Where(sq.Eq{"rf.status": []string{models.RefundStatusPending, models.RefundStatusProcessing}}).
+		Where(sq.Or{
+			sq.Eq{"rf.next_attempt_at": nil},
+			sq.LtOrEq{"rf.next_attempt_at": now},
+		}).
+		OrderBy("rf.id").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refunds []*models.Refund
+	for rows.Next() {
+		var refund models.Refund
+
+		err = rows.Scan(
+			&refund.ID,
+			&refund.TicketID,
+			&refund.EventID,
+			&refund.PaymentID,
+			&refund.RefundID,
+			&refund.Status,
+			&refund.Attempts,
+			&refund.ShopID,
+			&refund.ShopKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		refunds = append(refunds, &refund)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+func (r *repo) UpdateRefund(ctx context.Context, refund *models.Refund) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(refundsTable).
+		Set("refund_id", refund.RefundID).
+		Set("status", refund.Status).
+		Set("error", refund.Error).
+		Set("attempts", refund.Attempts).
+		Set("next_attempt_at", refund.NextAttemptAt).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": refund.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -14,11 +14,28 @@ type repo struct {
 }
 
 const (
-	usersTable            = "users"
-	eventsTable           = "events"
-	pricesTable           = "prices"
-	ticketsTable          = "tickets"
-	yookassaSettingsTable = "users_yookassa_settings"
+	usersTable             = "users"
+	eventsTable            = "events"
+	pricesTable            = "prices"
+	eventImagesTable       = "event_images"
+	eventMembersTable      = "event_members"
+	speakersTable          = "speakers"
+	sessionsTable          = "sessions"
+	sessionSpeakersTable   = "session_speakers"
+	eventQuestionsTable    = "event_questions"
+	ticketAnswersTable     = "ticket_answers"
+	ticketsTable           = "tickets"
+	refundsTable           = "refunds"
+	eventViewsTable        = "event_views"
+	eventCheckoutsTable    = "event_checkouts"
+	reviewsTable           = "reviews"
+	bookmarksTable         = "bookmarks"
+	followsTable           = "follows"
+	organizerProfilesTable = "organizer_profiles"
+	eventRevisionsTable    = "event_revisions"
+	userSessionsTable      = "user_sessions"
+	passwordResetsTable    = "password_resets"
+	yookassaSettingsTable  = "users_yookassa_settings"
 
 	structTag = "db"
 )
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) EventReviews(ctx context.Context, eventID int64) ([]*models.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT rv.id, rv.event_id, rv.user_id, rv.rating, rv.comment, rv.reply, rv.replied_at, rv.created_at, rv.updated_at,
+	coalesce((SELECT t.first_name FROM tickets t WHERE t.event_id = rv.event_id AND t.user_id = rv.user_id LIMIT 1), '')
+	FROM reviews rv
+	WHERE rv.event_id = $1
+	ORDER BY rv.created_at DESC, rv.id DESC`
+
+	rows, err := r.db.Query(ctx, sql, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []*models.Review
+	for rows.Next() {
+		var review models.Review
+
+		err = rows.Scan(
+			&review.ID,
+			&review.EventID,
+			&review.UserID,
+			&review.Rating,
+			&review.Comment,
+			&review.Reply,
+			&review.RepliedAt,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+			&review.AuthorName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		reviews = append(reviews, &review)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (r *repo) EventRating(ctx context.Context, eventID int64) (*models.Rating, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT coalesce(avg(rating), 0), count(id) FROM reviews WHERE event_id = $1`
+
+	var rating models.Rating
+	err := r.db.QueryRow(ctx, sql, eventID).Scan(&rating.Average, &rating.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rating, nil
+}
+
+// OrganizerRating aggregates reviews left since the given time for all events created by the user
+func (r *repo) OrganizerRating(ctx context.Context, userID int64, since time.Time) (*models.Rating, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT coalesce(avg(rv.rating), 0), count(rv.id)
+	FROM reviews rv
+	JOIN events e ON e.id = rv.event_id
+	WHERE e.creator_id = $1 AND rv.created_at >= $2`
+
+	var rating models.Rating
+	err := r.db.QueryRow(ctx, sql, userID, since).Scan(&rating.Average, &rating.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rating, nil
+}
+
+// HasUsedTicket reports whether the user has checked in ticket to the event
+func (r *repo) HasUsedTicket(ctx context.Context, eventID int64, userID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT EXISTS(SELECT 1 FROM tickets WHERE event_id = $1 AND user_id = $2 AND is_used)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, sql, eventID, userID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// InsertReview inserts review, pgx.ErrNoRows is returned if the user has already reviewed the event
+func (r *repo) InsertReview(ctx context.Context, review *models.Review) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(reviewsTable).
+		Columns("event_id", "user_id", "rating", "comment").
+		Values(review.EventID, review.UserID, review.Rating, review.Comment).
+		Suffix("ON CONFLICT (event_id, user_id) DO NOTHING RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repo) UpdateReviewReply(ctx context.Context, eventID int64, reviewID int64, reply string, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(reviewsTable).
+		Set("reply", reply).
+		Set("replied_at", now).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": reviewID, "event_id": eventID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
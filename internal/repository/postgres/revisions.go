@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// eventSnapshotSQL selects event row together with its prices as a single json object.
+// Columns changed by ticket sales, scheduler, cancellation and gallery aren't edits of the event,
+// so they are left out of the snapshot and don't show up in the diff of the next update
+const eventSnapshotSQL = `SELECT to_jsonb(e) - 'created_at' - 'updated_at'
+	- 'capacity' - 'status' - 'cancel_reason' - 'cancelled_at' - 'preview_image' || jsonb_build_object(
+	'prices', coalesce((
+		SELECT jsonb_agg(jsonb_build_object('currency', p.currency, 'price', p.price) ORDER BY p.currency)
+		FROM prices p WHERE p.event_id = e.id
+	), '[]'::jsonb)
+)
+FROM events e WHERE e.id = $1`
+
+// EventRevisions returns history of the event starting from the latest revision
+func (r *repo) EventRevisions(ctx context.Context, eventID int64) ([]*models.EventRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"rv.id",
+		"rv.event_id",
+		"rv.version",
+		"rv.action",
+		"rv.actor_id",
+		"coalesce(u.email, '')",
+		"rv.snapshot",
+		"rv.diff",
+		"rv.created_at",
+	).
+		From(eventRevisionsTable + " rv").
+		LeftJoin(usersTable + " u ON u.id = rv.actor_id").
+		Where(sq.Eq{"rv.event_id": eventID}).
+		OrderBy("rv.version DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*models.EventRevision
+	for rows.Next() {
+		var revision models.EventRevision
+
+		err = rows.Scan(
+			&revision.ID,
+			&revision.EventID,
+			&revision.Version,
+			&revision.Action,
+			&revision.ActorID,
+			&revision.ActorEmail,
+			&revision.Snapshot,
+			&revision.Diff,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &revision)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// DeletedEvent returns id and creator of the latest deleted event, which had the url title,
+// so its history stays reachable after deletion
+func (r *repo) DeletedEvent(ctx context.Context, urlTitle string) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"event_id",
+		"(snapshot->>'creator_id')::bigint",
+		"snapshot->>'url_title'",
+	).
+		From(eventRevisionsTable).
+		Where(sq.Eq{"action": models.EventRevisionDelete}).
+		Where("snapshot->>'url_title' = ?", urlTitle).
+		OrderBy("created_at DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var event models.Event
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&event.ID, &event.CreatorID, &event.URLTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// insertEventRevision stores the event as it's stored in tx now together with the changes since previous revision.
+// Update which changes only prices is stored as price change
+func insertEventRevision(ctx context.Context, tx pgx.Tx, eventID int64, actorID int64, action string) error {
+	// concurrent revisions of the event must not read the same latest version
+	_, err := tx.Exec(ctx, `SELECT id FROM events WHERE id = $1 FOR UPDATE`, eventID)
+	if err != nil {
+		return err
+	}
+
+	var snapshot []byte
+	err = tx.QueryRow(ctx, eventSnapshotSQL, eventID).Scan(&snapshot)
+	if err != nil {
+		return err
+	}
+
+	var (
+		version  int64
+		previous []byte
+	)
+	err = tx.QueryRow(
+		ctx,
+		`SELECT version, snapshot FROM event_revisions WHERE event_id = $1 ORDER BY version DESC LIMIT 1`,
+		eventID,
+	).Scan(&version, &previous)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	diff, err := utils.DiffJSON(previous, snapshot)
+	if err != nil {
+		return err
+	}
+
+	if action == models.EventRevisionUpdate && len(diff) == 1 && diff[0].Field == "prices" {
+		action = models.EventRevisionPriceChange
+	}
+
+	diffJSON, err := json.Marshal(diff)
+	if err != nil {
+		return err
+	}
+
+	var actor *int64
+	if actorID != 0 {
+		actor = &actorID
+	}
+
+	builder := sq.Insert(eventRevisionsTable).
+		Columns("event_id", "version", "action", "actor_id", "snapshot", "diff").
+		Values(eventID, version+1, action, actor, snapshot, diffJSON).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// SlugOwner returns id of the event, which uses slug now or used it before,
+// pgx.ErrNoRows if slug is free
+func (r *repo) SlugOwner(ctx context.Context, slug string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT id FROM events WHERE url_title = $1
+	UNION
+	SELECT event_id FROM event_slugs WHERE slug = $1
+	LIMIT 1`
+
+	var id int64
+	err := r.db.QueryRow(ctx, sql, slug).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// SlugRedirect returns current url title of the event, which used the slug before
+func (r *repo) SlugRedirect(ctx context.Context, slug string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT e.url_title FROM event_slugs s
+	JOIN events e ON e.id = s.event_id
+	WHERE s.slug = $1`
+
+	var urlTitle string
+	err := r.db.QueryRow(ctx, sql, slug).Scan(&urlTitle)
+	if err != nil {
+		return "", err
+	}
+
+	return urlTitle, nil
+}
+
+// UpdateEventSlug changes url title of the event and keeps the old one in the history for redirects
+func (r *repo) UpdateEventSlug(ctx context.Context, actorID int64, eventID int64, oldSlug string, newSlug string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO event_slugs (slug, event_id) VALUES ($1, $2) ON CONFLICT (slug) DO NOTHING`,
+		oldSlug,
+		eventID,
+	)
+	if err != nil {
+		return err
+	}
+
+	// event can take back one of its previous slugs
+	_, err = tx.Exec(ctx, `DELETE FROM event_slugs WHERE slug = $1 AND event_id = $2`, newSlug, eventID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE events SET url_title = $1, updated_at = now() WHERE id = $2`,
+		newSlug,
+		eventID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = insertEventRevision(ctx, tx, eventID, actorID, models.EventRevisionSlugChange)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
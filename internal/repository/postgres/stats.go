@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+// EventStats aggregates sales of the event. Sales are grouped into hourly or daily buckets
+// by wall clock of the given time zone
+func (r *repo) EventStats(ctx context.Context, eventID int64, interval string, timeZone string) (*models.EventStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT count(t.id), count(t.id) FILTER (WHERE t.is_used), e.capacity,
+	(SELECT count(*) FROM refunds WHERE event_id = e.id AND status = $2)
+	FROM events e
+	LEFT JOIN tickets t ON t.event_id = e.id
+	WHERE e.id = $1
+	GROUP BY e.id`
+
+	var stats models.EventStats
+	err := r.db.QueryRow(ctx, sql, eventID, models.RefundStatusSucceeded).Scan(
+		&stats.TicketsSold,
+		&stats.CheckedIn,
+		&stats.CapacityLeft,
+		&stats.Refunds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sql = `SELECT coalesce(price_id, 0), price, currency, count(id)
+	FROM tickets
+	WHERE event_id = $1
+	GROUP BY 1, 2, 3
+	ORDER BY 2, 3`
+
+	rows, err := r.db.Query(ctx, sql, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tier models.TierStats
+
+		err = rows.Scan(&tier.PriceID, &tier.Price, &tier.Currency, &tier.TicketsSold)
+		if err != nil {
+			return nil, err
+		}
+
+		stats.Tiers = append(stats.Tiers, &tier)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sql = `SELECT t.currency, sum(t.price)
+	FROM tickets t
+	LEFT JOIN refunds rf ON rf.ticket_id = t.id AND rf.status = $2
+	WHERE t.event_id = $1 AND t.price > 0 AND rf.id IS NULL
+	GROUP BY t.currency
+	ORDER BY t.currency`
+
+	stats.Revenue, err = r.revenue(ctx, sql, eventID, models.RefundStatusSucceeded)
+	if err != nil {
+		return nil, err
+	}
+
+	sql = `SELECT date_trunc($2, timezone($3, created_at AT TIME ZONE 'UTC')) AS bucket, count(id)
+	FROM tickets
+	WHERE event_id = $1
+	GROUP BY bucket
+	ORDER BY bucket`
+
+	rows, err = r.db.Query(ctx, sql, eventID, interval, timeZone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bucket models.SalesBucket
+
+		err = rows.Scan(&bucket.Time, &bucket.TicketsSold)
+		if err != nil {
+			return nil, err
+		}
+
+		stats.Sales = append(stats.Sales, &bucket)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// UserStats aggregates sales of all events created by the user
+func (r *repo) UserStats(ctx context.Context, userID int64) (*models.UserStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT count(DISTINCT e.id), count(t.id), count(t.id) FILTER (WHERE t.is_used),
+	(SELECT count(*) FROM refunds rf JOIN events re ON re.id = rf.event_id WHERE re.creator_id = $1 AND rf.status = $2)
+	FROM events e
+	LEFT JOIN tickets t ON t.event_id = e.id
+	WHERE e.creator_id = $1`
+
+	var stats models.UserStats
+	err := r.db.QueryRow(ctx, sql, userID, models.RefundStatusSucceeded).Scan(
+		&stats.Events,
+		&stats.TicketsSold,
+		&stats.CheckedIn,
+		&stats.Refunds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sql = `SELECT t.currency, sum(t.price)
+	FROM tickets t
+	JOIN events e ON e.id = t.event_id
+	LEFT JOIN refunds rf ON rf.ticket_id = t.id AND rf.status = $2
+	WHERE e.creator_id = $1 AND t.price > 0 AND rf.id IS NULL
+	GROUP BY t.currency
+	ORDER BY t.currency`
+
+	stats.Revenue, err = r.revenue(ctx, sql, userID, models.RefundStatusSucceeded)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// revenue runs query returning currency and amount pairs
+func (r *repo) revenue(ctx context.Context, sql string, args ...any) ([]*models.Revenue, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revenue []*models.Revenue
+	for rows.Next() {
+		var rev models.Revenue
+
+		err = rows.Scan(&rev.Currency, &rev.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		revenue = append(revenue, &rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revenue, nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
-func (r *repo) InsertTicket(ctx context.Context, ticket *models.Ticket) (string, error) {
+// InsertTicket issues the ticket. If the event is already cancelled, paid ticket gets pending refund
+// and RefundPending set, while free one isn't issued at all and pgx.ErrNoRows is returned
+func (r *repo) InsertTicket(ctx context.Context, ticket *models.Ticket) (id string, err error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
@@ -27,7 +30,22 @@ func (r *repo) InsertTicket(ctx context.Context, ticket *models.Ticket) (string,
 		err = tx.Commit(ctx)
 	}()
 
+	// event row is locked, so it can't be cancelled until the ticket is inserted
+	var status string
+	err = tx.QueryRow(ctx, "SELECT status FROM events WHERE id = $1 FOR UPDATE", ticket.EventID).Scan(&status)
+	if err != nil {
+		return "", err
+	}
+
+	cancelled := status == models.EventStatusCancelled
+	if cancelled && ticket.PaymentID == "" {
+		return "", pgx.ErrNoRows
+	}
+
 	m, err := utils.MapByStructTag(structTag, *ticket)
+	if err != nil {
+		return "", err
+	}
 
 	builder := sq.Insert(ticketsTable).
 		SetMap(m).
@@ -43,12 +61,29 @@ func (r *repo) InsertTicket(ctx context.Context, ticket *models.Ticket) (string,
 		return "", err
 	}
 
+	if cancelled {
+		sql = `INSERT INTO refunds (ticket_id, event_id, payment_id) VALUES ($1, $2, $3)
+		ON CONFLICT (ticket_id) DO NOTHING`
+		_, err = tx.Exec(ctx, sql, ticket.ID, ticket.EventID, ticket.PaymentID)
+		if err != nil {
+			return "", err
+		}
+
+		ticket.RefundPending = true
+		return ticket.ID, nil
+	}
+
 	sql = `update events set capacity = capacity - 1 where id = $1`
 	_, err = tx.Exec(ctx, sql, ticket.EventID)
 	if err != nil {
 		return "", err
 	}
 
+	err = insertTicketAnswers(ctx, tx, ticket.ID, ticket.Answers)
+	if err != nil {
+		return "", err
+	}
+
 	return ticket.ID, nil
 }
 
@@ -94,7 +129,7 @@ func (r *repo) UserTickets(ctx context.Context, userID int64) ([]*models.Ticket,
 	defer cancel()
 
 	sql := `SELECT t.id, t.event_id, t.is_used, t.first_name, t.last_name,
-	e.title, e.beginning_time, e.preview_image FROM tickets t
+	e.title, e.beginning_time, e.time_zone, e.preview_image FROM tickets t
 	LEFT JOIN events e ON t.event_id = e.id
 	WHERE user_id = $1`
 
@@ -117,6 +152,7 @@ func (r *repo) UserTickets(ctx context.Context, userID int64) ([]*models.Ticket,
 			&ticket.LastName,
 			&ticket.Event.Title,
 			&ticket.Event.BeginningTime,
+			&ticket.Event.TimeZone,
 			&ticket.Event.PreviewImage,
 		)
 		if err != nil {
@@ -131,3 +167,53 @@ func (r *repo) UserTickets(ctx context.Context, userID int64) ([]*models.Ticket,
 
 	return tickets, nil
 }
+
+// UseTicket marks ticket as used, pgx.ErrNoRows is returned if ticket is already used
+func (r *repo) UseTicket(ctx context.Context, ticketID string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(ticketsTable).
+		Set("is_used", true).
+		Where(sq.Eq{"id": ticketID, "is_used": false}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *repo) SalesReport(ctx context.Context, eventID int64) (*models.SalesReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	sql := `SELECT count(t.id), count(t.id) FILTER (WHERE t.is_used), e.capacity
+	FROM events e
+	LEFT JOIN tickets t ON t.event_id = e.id
+	WHERE e.id = $1
+	GROUP BY e.id`
+
+	var report models.SalesReport
+	err := r.db.QueryRow(ctx, sql, eventID).Scan(
+		&report.TicketsSold,
+		&report.CheckedIn,
+		&report.CapacityLeft,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+func (r *repo) InsertUserSession(ctx context.Context, session *models.UserSession) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Insert(userSessionsTable).
+		Columns("family_id", "user_id", "token_hash", "expires_at").
+		Values(session.FamilyID, session.UserID, session.TokenHash, session.ExpiresAt).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UserSessionByTokenHash returns session of the refresh token together with email of its user
+func (r *repo) UserSessionByTokenHash(ctx context.Context, tokenHash string) (*models.UserSession, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Select(
+		"s.id",
+		"s.family_id",
+		"s.user_id",
+		"u.email",
+		"s.token_hash",
+		"s.expires_at",
+		"s.rotated_at",
+		"s.revoked_at",
+		"s.created_at",
+	).
+		From(userSessionsTable + " s").
+		Join(usersTable + " u ON u.id = s.user_id").
+		Where(sq.Eq{"s.token_hash": tokenHash}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var session models.UserSession
+	err = r.db.QueryRow(ctx, sql, args...).Scan(
+		&session.ID,
+		&session.FamilyID,
+		&session.UserID,
+		&session.Email,
+		&session.TokenHash,
+		&session.ExpiresAt,
+		&session.RotatedAt,
+		&session.RevokedAt,
+		&session.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// RotateUserSession marks session as rotated and stores the next one of its family.
+// Returns pgx.ErrNoRows if session was already rotated or revoked
+func (r *repo) RotateUserSession(ctx context.Context, sessionID int64, next *models.UserSession, now time.Time) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	res, err := tx.Exec(
+		ctx,
+		`UPDATE user_sessions SET rotated_at = $2 WHERE id = $1 AND rotated_at IS NULL AND revoked_at IS NULL`,
+		sessionID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO user_sessions (family_id, user_id, token_hash, expires_at) VALUES ($1, $2, $3, $4)`,
+		next.FamilyID,
+		next.UserID,
+		next.TokenHash,
+		next.ExpiresAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReissueUserSession stores the next session of the family once more, if no session of the family
+// was rotated after rotatedAt or revoked. Returns pgx.ErrNoRows otherwise
+func (r *repo) ReissueUserSession(ctx context.Context, next *models.UserSession, rotatedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	res, err := r.db.Exec(
+		ctx,
+		`INSERT INTO user_sessions (family_id, user_id, token_hash, expires_at)
+		SELECT $1, $2, $3, $4
+		WHERE NOT EXISTS (
+			SELECT 1 FROM user_sessions
+			WHERE family_id = $1 AND (rotated_at > $5 OR revoked_at IS NOT NULL)
+		)`,
+		next.FamilyID,
+		next.UserID,
+		next.TokenHash,
+		next.ExpiresAt,
+		rotatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteStaleUserSessions deletes expired and revoked sessions and returns their number
+func (r *repo) DeleteStaleUserSessions(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Delete(userSessionsTable).
+		Where(sq.Or{
+			sq.LtOrEq{"expires_at": now},
+			sq.NotEq{"revoked_at": nil},
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+func (r *repo) RevokeSessionFamily(ctx context.Context, familyID string, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(userSessionsTable).
+		Set("revoked_at", now).
+		Where(sq.Eq{"family_id": familyID, "revoked_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) RevokeUserSessions(ctx context.Context, userID int64, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(userSessionsTable).
+		Set("revoked_at", now).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
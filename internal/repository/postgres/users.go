@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/utils"
@@ -42,25 +44,33 @@ func (r *repo) User(ctx context.Context, userEmail string) (*models.User, error)
 	defer cancel()
 
 	sql := `
-	SELECT u.id, u.email, u.password, coalesce(tg_username, ''), coalesce(s.shop_id, ''), coalesce(s.shop_key, '')
+	SELECT u.id, u.email, u.password, coalesce(tg_username, ''), u.notify_followed,
+	u.email_verified_at, u.verification_sent_at,
+	coalesce(s.shop_id, ''), coalesce(s.shop_key, '')
 	FROM users u
 	LEFT JOIN users_yookassa_settings s ON u.id = s.user_id
 	WHERE U.email = $1
 `
 
 	var user models.User
+	var notifyFollowed bool
 	err := r.db.QueryRow(ctx, sql, userEmail).
 		Scan(
 			&user.ID,
 			&user.Email,
 			&user.Password,
 			&user.TGUsername,
+			&notifyFollowed,
+			&user.EmailVerifiedAt,
+			&user.VerificationSentAt,
 			&user.YookassaSettings.ShopID,
 			&user.YookassaSettings.ShopKey,
 		)
 	if err != nil {
 		return nil, err
 	}
+	user.NotifyFollowed = &notifyFollowed
+	user.EmailVerified = user.EmailVerifiedAt != nil
 
 	return &user, nil
 }
@@ -87,6 +97,227 @@ func (r *repo) UpdateUserTGUsername(ctx context.Context, userID int64, username
 	return nil
 }
 
+func (r *repo) UpdateUserNotifyFollowed(ctx context.Context, userID int64, notify bool) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(usersTable).
+		Set("notify_followed", notify).
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var isAdmin bool
+	err := r.db.QueryRow(ctx, `SELECT is_admin FROM users WHERE id = $1`, userID).Scan(&isAdmin)
+	if err != nil {
+		return false, err
+	}
+
+	return isAdmin, nil
+}
+
+// UpdateUserPassword sets new password of the user and revokes all user's sessions in the same transaction
+func (r *repo) UpdateUserPassword(ctx context.Context, userID int64, password string, now time.Time) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Update(usersTable).
+		Set("password", password).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE user_sessions SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`,
+		userID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repo) EmailVerified(ctx context.Context, userID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var verified bool
+	err := r.db.QueryRow(ctx, `SELECT email_verified_at IS NOT NULL FROM users WHERE id = $1`, userID).Scan(&verified)
+	if err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
+
+// VerifyUserEmail marks email as verified if it is still the email of the user.
+// Verifying already verified email keeps the original time
+func (r *repo) VerifyUserEmail(ctx context.Context, userID int64, email string, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(usersTable).
+		Set("email_verified_at", sq.Expr("coalesce(email_verified_at, ?)", now)).
+		Where(sq.Eq{"id": userID, "email": email}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// MarkVerificationSent records sending of verification mail unless previous one was sent after notBefore,
+// in which case pgx.ErrNoRows is returned
+func (r *repo) MarkVerificationSent(ctx context.Context, userID int64, now time.Time, notBefore time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	builder := sq.Update(usersTable).
+		Set("verification_sent_at", now).
+		Where(sq.Eq{"id": userID}).
+		Where(sq.Or{
+			sq.Eq{"verification_sent_at": nil},
+			sq.Lt{"verification_sent_at": notBefore},
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// ClaimUnverifiedUser marks email of the user as verified, replaces the password and revokes all sessions.
+// It's used when email owner signs in via oauth, so whoever registered the email before can't sign in.
+// Returns pgx.ErrNoRows if email is already verified
+func (r *repo) ClaimUnverifiedUser(ctx context.Context, userID int64, password string, now time.Time) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+
+		err = tx.Commit(ctx)
+	}()
+
+	builder := sq.Update(usersTable).
+		Set("email_verified_at", now).
+		Set("password", password).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": userID, "email_verified_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE user_sessions SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`,
+		userID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE password_resets SET used_at = $2 WHERE user_id = $1 AND used_at IS NULL`,
+		userID,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *repo) UpdateYookassaSettings(ctx context.Context, settings *models.YookassaSettings) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -2,24 +2,103 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 )
 
 type Repository interface {
 	Events(ctx context.Context, page int) ([]*models.Event, error)
+	EventsNear(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) ([]*models.Event, error)
 	UserEvents(ctx context.Context, userID int64) ([]*models.Event, error)
 	EventByURLTitle(ctx context.Context, urlTitle string) (*models.Event, error)
 	InsertEvent(ctx context.Context, event *models.Event) (int64, error)
-	UpdateEvent(ctx context.Context, event *models.Event) error
-	DeleteEvent(ctx context.Context, urlTitle string) error
+	UpdateEvent(ctx context.Context, actorID int64, event *models.Event) error
+	DeleteEvent(ctx context.Context, actorID int64, urlTitle string) error
+	EventRevisions(ctx context.Context, eventID int64) ([]*models.EventRevision, error)
+	DeletedEvent(ctx context.Context, urlTitle string) (*models.Event, error)
+	SlugOwner(ctx context.Context, slug string) (int64, error)
+	SlugRedirect(ctx context.Context, slug string) (string, error)
+	UpdateEventSlug(ctx context.Context, actorID int64, eventID int64, oldSlug string, newSlug string) error
+	EventImages(ctx context.Context, eventID int64) ([]*models.EventImage, error)
+	InsertEventImages(ctx context.Context, eventID int64, images []*models.EventImage) error
+	UpdateEventImage(ctx context.Context, eventID int64, imageID int64, req *models.UpdateEventImageRequest) error
+	ReorderEventImages(ctx context.Context, eventID int64, ids []int64) error
+	DeleteEventImage(ctx context.Context, eventID int64, imageID int64) error
+	ReferencedImages(ctx context.Context) ([]string, error)
+	EventMembers(ctx context.Context, eventID int64) ([]*models.EventMember, error)
+	EventMemberRole(ctx context.Context, eventID int64, userID int64) (string, error)
+	UpsertEventMember(ctx context.Context, member *models.EventMember) (int64, error)
+	DeleteEventMember(ctx context.Context, eventID int64, memberID int64) error
+	EventSpeakers(ctx context.Context, eventID int64) ([]*models.Speaker, error)
+	InsertSpeaker(ctx context.Context, speaker *models.Speaker) (int64, error)
+	UpdateSpeaker(ctx context.Context, speaker *models.Speaker) error
+	DeleteSpeaker(ctx context.Context, eventID int64, speakerID int64) error
+	EventSessions(ctx context.Context, eventID int64) ([]*models.Session, error)
+	InsertSession(ctx context.Context, session *models.Session) (int64, error)
+	UpdateSession(ctx context.Context, session *models.Session) error
+	DeleteSession(ctx context.Context, eventID int64, sessionID int64) error
+	EventQuestions(ctx context.Context, eventID int64) ([]*models.EventQuestion, error)
+	InsertQuestion(ctx context.Context, question *models.EventQuestion) (int64, error)
+	UpdateQuestion(ctx context.Context, question *models.EventQuestion) error
+	DeleteQuestion(ctx context.Context, eventID int64, questionID int64) error
+	EventReviews(ctx context.Context, eventID int64) ([]*models.Review, error)
+	EventRating(ctx context.Context, eventID int64) (*models.Rating, error)
+	OrganizerRating(ctx context.Context, userID int64, since time.Time) (*models.Rating, error)
+	HasUsedTicket(ctx context.Context, eventID int64, userID int64) (bool, error)
+	InsertReview(ctx context.Context, review *models.Review) (int64, error)
+	UpdateReviewReply(ctx context.Context, eventID int64, reviewID int64, reply string, now time.Time) error
+	InsertBookmark(ctx context.Context, userID int64, eventID int64) error
+	DeleteBookmark(ctx context.Context, userID int64, eventID int64) error
+	UserBookmarks(ctx context.Context, userID int64) ([]*models.Event, error)
+	InsertFollow(ctx context.Context, followerID int64, organizerID int64) error
+	DeleteFollow(ctx context.Context, followerID int64, organizerID int64) error
+	IsOrganizer(ctx context.Context, userID int64) (bool, error)
+	IsAdmin(ctx context.Context, userID int64) (bool, error)
+	FollowerEmails(ctx context.Context, organizerID int64) ([]string, error)
+	FeedEvents(ctx context.Context, userID int64, now time.Time, page int) ([]*models.Event, error)
+	OrganizerProfile(ctx context.Context, userID int64) (*models.OrganizerProfile, error)
+	OrganizerProfileByHandle(ctx context.Context, handle string) (*models.OrganizerProfile, error)
+	UpsertOrganizerProfile(ctx context.Context, profile *models.OrganizerProfile) error
+	OrganizerEvents(ctx context.Context, userID int64) ([]*models.Event, error)
+	PublishScheduledEvents(ctx context.Context, now time.Time) ([]*models.Event, error)
+	EndPastEvents(ctx context.Context, now time.Time) (int64, error)
+	CancelEvent(ctx context.Context, eventID int64, reason string, now time.Time) error
+	TicketHolderEmails(ctx context.Context, eventID int64) ([]string, error)
+	RefundsProgress(ctx context.Context, eventID int64) (*models.RefundsProgress, error)
+	ActiveRefunds(ctx context.Context, now time.Time, limit int) ([]*models.Refund, error)
+	UpdateRefund(ctx context.Context, refund *models.Refund) error
 
 	InsertTicket(ctx context.Context, ticket *models.Ticket) (string, error)
 	Ticket(ctx context.Context, ticketID string) (*models.Ticket, error)
 	UserTickets(ctx context.Context, userID int64) ([]*models.Ticket, error)
+	UseTicket(ctx context.Context, ticketID string) error
+	SalesReport(ctx context.Context, eventID int64) (*models.SalesReport, error)
+	EventStats(ctx context.Context, eventID int64, interval string, timeZone string) (*models.EventStats, error)
+	UserStats(ctx context.Context, userID int64) (*models.UserStats, error)
+	InsertEventViews(ctx context.Context, views []*models.EventView) error
+	InsertCheckout(ctx context.Context, eventID int64, userID int64) error
+	EventFunnel(ctx context.Context, eventID int64) (*models.Funnel, error)
+	EventAttendees(ctx context.Context, eventID int64, filter *models.AttendeesFilter) ([]*models.Attendee, int64, error)
 
 	InsertUser(ctx context.Context, user *models.User) (int64, error)
 	User(ctx context.Context, userEmail string) (*models.User, error)
 	UpdateYookassaSettings(ctx context.Context, settings *models.YookassaSettings) error
 	UpdateUserTGUsername(ctx context.Context, userID int64, username string) error
+	UpdateUserNotifyFollowed(ctx context.Context, userID int64, notify bool) error
+	UpdateUserPassword(ctx context.Context, userID int64, password string, now time.Time) error
+	EmailVerified(ctx context.Context, userID int64) (bool, error)
+	VerifyUserEmail(ctx context.Context, userID int64, email string, now time.Time) error
+	MarkVerificationSent(ctx context.Context, userID int64, now time.Time, notBefore time.Time) error
+	ClaimUnverifiedUser(ctx context.Context, userID int64, password string, now time.Time) error
+	InsertUserSession(ctx context.Context, session *models.UserSession) error
+	UserSessionByTokenHash(ctx context.Context, tokenHash string) (*models.UserSession, error)
+	RotateUserSession(ctx context.Context, sessionID int64, next *models.UserSession, now time.Time) error
+	ReissueUserSession(ctx context.Context, next *models.UserSession, rotatedAt time.Time) error
+	DeleteStaleUserSessions(ctx context.Context, now time.Time) (int64, error)
+	RevokeSessionFamily(ctx context.Context, familyID string, now time.Time) error
+	RevokeUserSessions(ctx context.Context, userID int64, now time.Time) error
+	InsertPasswordReset(ctx context.Context, reset *models.PasswordReset) error
+	MarkPasswordResetSent(ctx context.Context, userID int64, now time.Time, notBefore time.Time) error
+	ResetPassword(ctx context.Context, tokenHash string, password string, now time.Time) error
 }
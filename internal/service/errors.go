@@ -5,10 +5,50 @@ import (
 )
 
 var (
-	ErrPricesForFree     = errors.New("prices are provided for free event")
-	ErrNoPrices          = errors.New("prices aren't provided for non-free event")
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrWrongCredentials  = errors.New("wrong credentials")
-	ErrPermissionDenied  = errors.New("permission denied")
-	ErrPaymentTimeout    = errors.New("payment timeout")
+	ErrPricesForFree         = errors.New("prices are provided for free event")
+	ErrNoPrices              = errors.New("prices aren't provided for non-free event")
+	ErrUserAlreadyExists     = errors.New("user already exists")
+	ErrWrongCredentials      = errors.New("wrong credentials")
+	ErrPermissionDenied      = errors.New("permission denied")
+	ErrPaymentTimeout        = errors.New("payment timeout")
+	ErrEventNotPublished     = errors.New("event is not published")
+	ErrWrongEventStatus      = errors.New("wrong event status")
+	ErrStatusTransition      = errors.New("event status can't be changed this way")
+	ErrPublishTime           = errors.New("publish time must be in the future")
+	ErrWrongImagesOrder      = errors.New("images order must contain every image of the event exactly once")
+	ErrWrongCoordinates      = errors.New("latitude must be in [-90, 90] and longitude in [-180, 180]")
+	ErrWrongRadius           = errors.New("radius must be positive and not greater than 500 km")
+	ErrWrongRole             = errors.New("role must be one of owner, editor, checkin, finance")
+	ErrWrongEmail            = errors.New("wrong email")
+	ErrTicketNotFound        = errors.New("ticket not found")
+	ErrTicketUsed            = errors.New("ticket is already used")
+	ErrEventHasTickets       = errors.New("event already has sold tickets, cancel it instead")
+	ErrEventNotActive        = errors.New("only draft, scheduled or published event can be cancelled")
+	ErrSessionTime           = errors.New("session must end after it begins")
+	ErrSessionOutsideEvent   = errors.New("session must take place between beginning and end of the event")
+	ErrWrongSpeakers         = errors.New("speakers must belong to the event")
+	ErrWrongQuestion         = errors.New("question must have label and known type, only choice questions have options")
+	ErrRequiredAnswer        = errors.New("answer to required question is missing")
+	ErrWrongAnswer           = errors.New("answer doesn't match question of the event")
+	ErrWrongSlug             = errors.New("slug must contain latin or cyrillic letters or digits")
+	ErrSlugTaken             = errors.New("slug is already taken")
+	ErrWrongExportFormat     = errors.New("export format must be csv or xlsx")
+	ErrWrongInterval         = errors.New("interval must be hour or day")
+	ErrWrongReview           = errors.New("rating must be from 1 to 5, comment must be at most 2000 characters")
+	ErrWrongReply            = errors.New("reply must be from 1 to 2000 characters")
+	ErrEventNotEnded         = errors.New("event can be reviewed only after it ends")
+	ErrReviewNotAllowed      = errors.New("only checked in attendees can review the event")
+	ErrAlreadyReviewed       = errors.New("event is already reviewed")
+	ErrFollowSelf            = errors.New("users can't follow themselves")
+	ErrOrganizerNotFound     = errors.New("organizer not found")
+	ErrWrongHandle           = errors.New("handle must be 3-32 latin letters, digits, '-' or '_'")
+	ErrHandleTaken           = errors.New("handle is already taken")
+	ErrWrongProfile          = errors.New("display name is required, website and social links must be http(s) urls")
+	ErrInvalidRefreshToken   = errors.New("refresh token is invalid, expired or revoked")
+	ErrEmailNotVerified      = errors.New("email must be verified first")
+	ErrInvalidVerifyToken    = errors.New("verification link is invalid or expired")
+	ErrEmailAlreadyVerified  = errors.New("email is already verified")
+	ErrVerificationRateLimit = errors.New("verification mail was sent recently, try again later")
+	ErrInvalidResetToken     = errors.New("password reset link is invalid, expired or already used")
+	ErrWrongPassword         = errors.New("current password is wrong")
 )
@@ -0,0 +1,224 @@
+package eventsService
+
+import (
+	"context"
+	"slices"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func (s *eventsServ) AddSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (int64, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	speaker.EventID = event.ID
+	id, err := s.repo.InsertSpeaker(ctx, speaker)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *eventsServ) UpdateSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	speaker.EventID = event.ID
+	err = s.repo.UpdateSpeaker(ctx, speaker)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) DeleteSpeaker(ctx context.Context, userID int64, urlTitle string, speakerID int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteSpeaker(ctx, event.ID, speakerID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) AddSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) (int64, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.prepareSession(ctx, event, session)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := s.repo.InsertSession(ctx, session)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *eventsServ) UpdateSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.prepareSession(ctx, event, session)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateSession(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) DeleteSession(ctx context.Context, userID int64, urlTitle string, sessionID int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteSession(ctx, event.ID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// prepareSession validates session of the event and converts its wall clock times
+// in event's time zone to UTC for storing. Session must be within the event, repeated speakers are dropped
+func (s *eventsServ) prepareSession(ctx context.Context, event *models.Event, session *models.Session) error {
+	if !session.EndTime.After(session.BeginningTime) {
+		return service.ErrSessionTime
+	}
+
+	if len(session.SpeakerIDs) > 0 {
+		slices.Sort(session.SpeakerIDs)
+		session.SpeakerIDs = slices.Compact(session.SpeakerIDs)
+
+		speakers, err := s.repo.EventSpeakers(ctx, event.ID)
+		if err != nil {
+			return err
+		}
+
+		known := make(map[int64]bool, len(speakers))
+		for _, speaker := range speakers {
+			known[speaker.ID] = true
+		}
+
+		for _, id := range session.SpeakerIDs {
+			if !known[id] {
+				return service.ErrWrongSpeakers
+			}
+		}
+	}
+
+	loc := eventLocation(event.TimeZone)
+
+	session.EventID = event.ID
+	session.BeginningTime = utils.WallClockIn(session.BeginningTime, loc).UTC()
+	session.EndTime = utils.WallClockIn(session.EndTime, loc).UTC()
+
+	if (!event.BeginningTime.IsZero() && session.BeginningTime.Before(event.BeginningTime)) ||
+		(!event.EndTime.IsZero() && session.EndTime.After(event.EndTime)) {
+		return service.ErrSessionOutsideEvent
+	}
+
+	return nil
+}
+
+// setAgenda attaches sessions of the event with their speakers ordered by time
+func (s *eventsServ) setAgenda(ctx context.Context, event *models.Event) error {
+	sessions, err := s.repo.EventSessions(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	speakers, err := s.repo.EventSpeakers(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[int64]*models.Speaker, len(speakers))
+	for _, speaker := range speakers {
+		if speaker.Photo != "" {
+			speaker.PhotoURLs, err = s.imageURLs(ctx, speaker.Photo)
+			if err != nil {
+				return err
+			}
+		}
+
+		byID[speaker.ID] = speaker
+	}
+
+	loc := eventLocation(event.TimeZone)
+	for _, session := range sessions {
+		session.BeginningTime = session.BeginningTime.In(loc)
+		session.EndTime = session.EndTime.In(loc)
+
+		for _, id := range session.SpeakerIDs {
+			if speaker, ok := byID[id]; ok {
+				session.Speakers = append(session.Speakers, speaker)
+			}
+		}
+	}
+
+	event.Agenda = sessions
+
+	return nil
+}
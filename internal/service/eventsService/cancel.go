@@ -0,0 +1,99 @@
+package eventsService
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+// CancelEvent marks event as cancelled, starts refunds of paid tickets
+// and notifies every ticket holder
+func (s *eventsServ) CancelEvent(ctx context.Context, userID int64, urlTitle string, reason string) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionCancelEvent)
+	if err != nil {
+		return err
+	}
+
+	if event.Status == models.EventStatusEnded || event.Status == models.EventStatusCancelled {
+		return service.ErrEventNotActive
+	}
+
+	reason = strings.TrimSpace(reason)
+	err = s.repo.CancelEvent(ctx, event.ID, reason, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	emails, err := s.repo.TicketHolderEmails(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(emails) == 0 {
+		return nil
+	}
+
+	lines := []string{"Unfortunately, the event you have a ticket to was cancelled by the organizer."}
+	if reason != "" {
+		lines = append(lines, "Reason: "+reason)
+	}
+	if !event.IsFree {
+		lines = append(lines, "The money for your ticket will be refunded automatically.")
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:         emails,
+		Subject:    fmt.Sprintf("\"%s\" is cancelled", event.Title),
+		Title:      fmt.Sprintf("\"%s\" is cancelled", event.Title),
+		Lines:      lines,
+		ButtonText: "Open my tickets",
+		ButtonURL:  s.authConfig.Domain() + "/user/profile",
+	})
+
+	return nil
+}
+
+// ignoreCancellation drops cancellation details from the event input,
+// they are set only when the event is cancelled
+func ignoreCancellation(event *models.Event) {
+	event.CancelReason = ""
+	event.CancelledAt = nil
+}
+
+func (s *eventsServ) CancellationProgress(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+) (*models.CancellationProgress, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewSales)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.repo.RefundsProgress(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CancellationProgress{
+		Status:       event.Status,
+		CancelReason: event.CancelReason,
+		CancelledAt:  event.CancelledAt,
+		Refunds:      *refunds,
+	}, nil
+}
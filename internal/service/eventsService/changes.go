@@ -0,0 +1,69 @@
+package eventsService
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// eventChanges describes material changes of the event, which ticket holders should know about.
+// Fields of update, that are left empty, are not changed
+func eventChanges(old *models.Event, update *models.Event) []string {
+	var changes []string
+
+	oldLoc := eventLocation(old.TimeZone)
+	newLoc := oldLoc
+	if update.TimeZone != "" {
+		newLoc = eventLocation(update.TimeZone)
+	}
+
+	formatTimeChange := func(name string, oldTime time.Time, newTime time.Time) string {
+		return fmt.Sprintf(
+			"%s: %s → %s",
+			name,
+			utils.FormatEventTime(oldTime, oldLoc),
+			utils.FormatEventTime(newTime, newLoc),
+		)
+	}
+
+	if !update.BeginningTime.IsZero() && !update.BeginningTime.Equal(old.BeginningTime) {
+		changes = append(changes, formatTimeChange("Beginning time", old.BeginningTime, update.BeginningTime))
+	}
+	if !update.EndTime.IsZero() && !update.EndTime.Equal(old.EndTime) {
+		changes = append(changes, formatTimeChange("End time", old.EndTime, update.EndTime))
+	}
+	if update.Location != "" && update.Location != old.Location {
+		changes = append(changes, fmt.Sprintf("Location: %s → %s", old.Location, update.Location))
+	}
+	if update.Description != "" && update.Description != old.Description {
+		changes = append(changes, fmt.Sprintf("Description: %s → %s", old.Description, update.Description))
+	}
+
+	return changes
+}
+
+// notifyAboutChanges queues email with the changes to all ticket holders of the event
+func (s *eventsServ) notifyAboutChanges(ctx context.Context, event *models.Event, changes []string) {
+	emails, err := s.repo.TicketHolderEmails(ctx, event.ID)
+	if err != nil {
+		slog.Error("error getting ticket holders", slog.Any("error", err), slog.String("url_title", event.URLTitle))
+		return
+	}
+
+	if len(emails) == 0 {
+		return
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:         emails,
+		Subject:    fmt.Sprintf("\"%s\" has changed", event.Title),
+		Title:      fmt.Sprintf("\"%s\" has changed", event.Title),
+		Lines:      append([]string{"The organizer has changed details of the event you have a ticket to."}, changes...),
+		ButtonText: "Open my tickets",
+		ButtonURL:  s.authConfig.Domain() + "/user/profile",
+	})
+}
@@ -0,0 +1,109 @@
+package eventsService
+
+import (
+	"context"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// CloneEvent creates draft copy of the event with new dates. Copy belongs to the user,
+// tickets and everything related to them aren't copied
+func (s *eventsServ) CloneEvent(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	req *models.CloneEventRequest,
+) (*models.Event, error) {
+	source, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, source, authz.ActionUpdateEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.requireVerifiedEmail(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// capacity of the event is decreased on every sold ticket
+	report, err := s.repo.SalesReport(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.repo.EventQuestions(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &models.Event{
+		Title:         source.Title,
+		Description:   source.Description,
+		BeginningTime: req.BeginningTime,
+		EndTime:       req.EndTime,
+		CreatorID:     userID,
+		IsPublic:      source.IsPublic,
+		Location:      source.Location,
+		Latitude:      source.Latitude,
+		Longitude:     source.Longitude,
+		IsFree:        source.IsFree,
+		PreviewImage:  source.PreviewImage,
+		TimeZone:      source.TimeZone,
+		Capacity:      source.Capacity + report.TicketsSold,
+		MinimalAge:    source.MinimalAge,
+		Status:        models.EventStatusDraft,
+	}
+	if req.Title != "" {
+		event.Title = req.Title
+	}
+
+	for _, price := range source.Prices {
+		event.Prices = append(event.Prices, &models.Price{
+			Price:    price.Price,
+			Currency: price.Currency,
+		})
+	}
+
+	for _, image := range source.Images {
+		event.Images = append(event.Images, &models.EventImage{
+			Image:   image.Image,
+			Caption: image.Caption,
+			IsCover: image.IsCover,
+		})
+	}
+
+	for _, question := range questions {
+		event.Questions = append(event.Questions, &models.EventQuestion{
+			Label:    question.Label,
+			Type:     question.Type,
+			Options:  question.Options,
+			Required: question.Required,
+			Position: question.Position,
+		})
+	}
+
+	err = setEventTimes(event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.ID, err = s.insertEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.LocalizeEventTimes(event)
+
+	err = s.setImageURLs(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -2,35 +2,129 @@ package eventsService
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/geocoder"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
 type eventsServ struct {
-	repo repository.Repository
+	repo       repository.Repository
+	storage    storage.Storage
+	geocoder   geocoder.Geocoder
+	authorizer authz.Authorizer
+	mailer     mailer.Mailer
+	authConfig *config.AuthConfig
+
+	doneChan      chan struct{}
+	viewsChan     chan *models.EventView
+	viewsDoneChan chan struct{}
+	// viewsFlushed is closed by views recorder after the last batch is stored
+	viewsFlushed chan struct{}
 }
 
 func NewEventsService(
 	repo repository.Repository,
+	storage storage.Storage,
+	geocoder geocoder.Geocoder,
+	authorizer authz.Authorizer,
+	mailer mailer.Mailer,
+	authConfig *config.AuthConfig,
 ) service.EventsService {
 	s := &eventsServ{
-		repo: repo,
+		repo:       repo,
+		storage:    storage,
+		geocoder:   geocoder,
+		authorizer: authorizer,
+		mailer:     mailer,
+		authConfig: authConfig,
+		doneChan:   make(chan struct{}),
+
+		viewsChan:     make(chan *models.EventView, viewsQueueSize),
+		viewsDoneChan: make(chan struct{}),
+		viewsFlushed:  make(chan struct{}),
 	}
 
+	closer.Add(1, func() error {
+		slog.Info("sending done signal to events scheduler...")
+		s.doneChan <- struct{}{}
+		s.viewsDoneChan <- struct{}{}
+		<-s.viewsFlushed
+
+		return nil
+	})
+
+	closer.Add(2, func() error {
+		slog.Info("closing events service channels...")
+		close(s.doneChan)
+		close(s.viewsDoneChan)
+
+		return nil
+	})
+
+	go s.runScheduler()
+	go s.runViewsRecorder()
+
 	return s
 }
 
-func (s *eventsServ) Event(ctx context.Context, urlTitle string) (*models.Event, error) {
+func (s *eventsServ) Event(ctx context.Context, userID int64, urlTitle string) (*models.Event, error) {
 	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
 	if err != nil {
 		return nil, err
 	}
 
+	if event.Status != models.EventStatusPublished &&
+		event.Status != models.EventStatusEnded &&
+		event.Status != models.EventStatusCancelled {
+		err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewEvent)
+		if err != nil {
+			if errors.Is(err, service.ErrPermissionDenied) {
+				return nil, service.ErrEventNotPublished
+			}
+
+			return nil, err
+		}
+	}
+
+	utils.LocalizeEventTimes(event)
+
+	err = s.setImageURLs(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setAgenda(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Questions, err = s.repo.EventQuestions(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setRating(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setOrganizer(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
 	return event, nil
 }
 
@@ -40,11 +134,21 @@ func (s *eventsServ) Events(ctx context.Context, page int) ([]*models.Event, err
 		return nil, err
 	}
 
+	for _, event := range events {
+		err = s.setImageURLs(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return events, nil
 }
 
 func (s *eventsServ) CreateEvent(ctx context.Context, event *models.Event) (int64, error) {
-	event.URLTitle = uuid.NewString()
+	err := s.requireVerifiedEmail(ctx, event.CreatorID)
+	if err != nil {
+		return 0, err
+	}
 
 	if !event.IsFree && len(event.Prices) == 0 {
 		return 0, service.ErrNoPrices
@@ -58,11 +162,49 @@ func (s *eventsServ) CreateEvent(ctx context.Context, event *models.Event) (int6
 		event.Capacity = 1000000000
 	}
 
-	id, err := s.repo.InsertEvent(ctx, event)
+	ignoreCancellation(event)
+
+	if event.TimeZone == "" {
+		event.TimeZone = defaultTimeZone
+	}
+
+	err = setEventTimes(event)
+	if err != nil {
+		return 0, err
+	}
+
+	switch event.Status {
+	case "":
+		event.Status = models.EventStatusDraft
+		if event.PublishAt != nil {
+			event.Status = models.EventStatusScheduled
+		}
+	case models.EventStatusDraft, models.EventStatusScheduled, models.EventStatusPublished:
+	default:
+		return 0, service.ErrWrongEventStatus
+	}
+
+	if event.Status == models.EventStatusScheduled {
+		err = validatePublishTime(event.PublishAt)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = s.setCoordinates(ctx, event)
 	if err != nil {
 		return 0, err
 	}
 
+	id, err := s.insertEvent(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	if event.Status == models.EventStatusPublished {
+		s.notifyFollowers(ctx, event)
+	}
+
 	return id, nil
 }
 
@@ -72,19 +214,80 @@ func (s *eventsServ) UpdateEvent(ctx context.Context, userID int64, event *model
 		return err
 	}
 
-	if e.CreatorID != userID {
-		return service.ErrPermissionDenied
+	err = s.authorizer.Authorize(ctx, userID, e, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	// creator is the owner of the event, so it can't be changed by update
+	event.CreatorID = 0
+	ignoreCancellation(event)
+
+	if event.Status != "" && event.Status != e.Status {
+		publishAt := event.PublishAt
+		if publishAt == nil {
+			publishAt = e.PublishAt
+		}
+
+		err = validateStatusTransition(e.Status, event.Status, publishAt)
+		if err != nil {
+			return err
+		}
+	} else if event.PublishAt != nil {
+		err = validatePublishTime(event.PublishAt)
+		if err != nil {
+			return err
+		}
 	}
+
+	err = updateEventTimes(e, event)
+	if err != nil {
+		return err
+	}
+
+	if event.Latitude != nil || event.Longitude != nil ||
+		(event.Location != "" && event.Location != e.Location) {
+		err = s.setCoordinates(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(event.Prices) == 0 {
 		event.IsFree = true
 	}
 
+	changes := eventChanges(e, event)
+
 	event.UpdatedAt = time.Now()
-	err = s.repo.UpdateEvent(ctx, event)
+	err = s.repo.UpdateEvent(ctx, userID, event)
 	if err != nil {
 		return err
 	}
 
+	if len(event.Images) > 0 {
+		err = s.repo.InsertEventImages(ctx, e.ID, event.Images)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changes) > 0 && !event.SilentUpdate {
+		if event.Title != "" {
+			e.Title = event.Title
+		}
+
+		s.notifyAboutChanges(ctx, e, changes)
+	}
+
+	if event.Status == models.EventStatusPublished && e.Status != models.EventStatusPublished {
+		if event.Title != "" {
+			e.Title = event.Title
+		}
+
+		s.notifyFollowers(ctx, e)
+	}
+
 	return nil
 }
 
@@ -94,11 +297,20 @@ func (s *eventsServ) DeleteEvent(ctx context.Context, userID int64, urlTitle str
 		return err
 	}
 
-	if event.CreatorID != userID {
-		return service.ErrPermissionDenied
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionDeleteEvent)
+	if err != nil {
+		return err
+	}
+
+	report, err := s.repo.SalesReport(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if report.TicketsSold > 0 {
+		return service.ErrEventHasTickets
 	}
 
-	err = s.repo.DeleteEvent(ctx, urlTitle)
+	err = s.repo.DeleteEvent(ctx, userID, urlTitle)
 	if err != nil {
 		return err
 	}
@@ -106,11 +318,32 @@ func (s *eventsServ) DeleteEvent(ctx context.Context, userID int64, urlTitle str
 	return nil
 }
 
+// requireVerifiedEmail returns service.ErrEmailNotVerified if the user hasn't verified the email yet
+func (s *eventsServ) requireVerifiedEmail(ctx context.Context, userID int64) error {
+	verified, err := s.repo.EmailVerified(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !verified {
+		return service.ErrEmailNotVerified
+	}
+
+	return nil
+}
+
 func (s *eventsServ) UserEvents(ctx context.Context, userID int64) ([]*models.Event, error) {
 	events, err := s.repo.UserEvents(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	for _, event := range events {
+		err = s.setImageURLs(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return events, nil
 }
@@ -0,0 +1,140 @@
+package eventsService
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// BookmarkEvent saves event for later, only events visible to the public can be bookmarked
+func (s *eventsServ) BookmarkEvent(ctx context.Context, userID int64, urlTitle string) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	if event.Status != models.EventStatusPublished &&
+		event.Status != models.EventStatusEnded &&
+		event.Status != models.EventStatusCancelled {
+		return service.ErrEventNotPublished
+	}
+
+	err = s.repo.InsertBookmark(ctx, userID, event.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) DeleteBookmark(ctx context.Context, userID int64, urlTitle string) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteBookmark(ctx, userID, event.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) Bookmarks(ctx context.Context, userID int64) ([]*models.Event, error) {
+	events, err := s.repo.UserBookmarks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.prepareEventCards(ctx, events)
+}
+
+func (s *eventsServ) FollowOrganizer(ctx context.Context, userID int64, organizerID int64) error {
+	if userID == organizerID {
+		return service.ErrFollowSelf
+	}
+
+	isOrganizer, err := s.repo.IsOrganizer(ctx, organizerID)
+	if err != nil {
+		return err
+	}
+	if !isOrganizer {
+		return service.ErrOrganizerNotFound
+	}
+
+	err = s.repo.InsertFollow(ctx, userID, organizerID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) UnfollowOrganizer(ctx context.Context, userID int64, organizerID int64) error {
+	err := s.repo.DeleteFollow(ctx, userID, organizerID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Feed returns upcoming public events of organizers followed by the user
+func (s *eventsServ) Feed(ctx context.Context, userID int64, page int) ([]*models.Event, error) {
+	events, err := s.repo.FeedEvents(ctx, userID, time.Now().UTC(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.prepareEventCards(ctx, events)
+}
+
+func (s *eventsServ) prepareEventCards(ctx context.Context, events []*models.Event) ([]*models.Event, error) {
+	for _, event := range events {
+		utils.LocalizeEventTimes(event)
+
+		err := s.setImageURLs(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if events == nil {
+		events = []*models.Event{}
+	}
+
+	return events, nil
+}
+
+// notifyFollowers emails followers of the event's organizer about newly published public event.
+// Errors are only logged, since publishing must not fail because of notifications
+func (s *eventsServ) notifyFollowers(ctx context.Context, event *models.Event) {
+	if !event.IsPublic {
+		return
+	}
+
+	emails, err := s.repo.FollowerEmails(ctx, event.CreatorID)
+	if err != nil {
+		slog.Error("error getting followers", slog.Any("error", err), slog.String("url_title", event.URLTitle))
+		return
+	}
+
+	if len(emails) == 0 {
+		return
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:         emails,
+		Subject:    fmt.Sprintf("New event: \"%s\"", event.Title),
+		Title:      fmt.Sprintf("New event: \"%s\"", event.Title),
+		Lines:      []string{"An organizer you follow has published a new event."},
+		ButtonText: "Open event",
+		ButtonURL:  s.authConfig.Domain() + "/events/" + event.URLTitle,
+	})
+}
@@ -0,0 +1,49 @@
+package eventsService
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+// EventHistory returns revisions of the event, which are visible to its owner and to admins.
+// History of the deleted event is found by its last url title
+func (s *eventsServ) EventHistory(ctx context.Context, userID int64, urlTitle string) ([]*models.EventRevision, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if errors.Is(err, pgx.ErrNoRows) {
+		event, err = s.repo.DeletedEvent(ctx, urlTitle)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewHistory)
+	if errors.Is(err, service.ErrPermissionDenied) {
+		isAdmin, adminErr := s.repo.IsAdmin(ctx, userID)
+		if adminErr != nil {
+			return nil, adminErr
+		}
+		if isAdmin {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.EventRevisions(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if revisions == nil {
+		revisions = []*models.EventRevision{}
+	}
+
+	return revisions, nil
+}
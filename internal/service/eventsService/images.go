@@ -0,0 +1,151 @@
+package eventsService
+
+import (
+	"context"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func (s *eventsServ) AddEventImages(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	images []*models.EventImage,
+) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	if len(event.Images) == 0 && len(images) > 0 {
+		images[0].IsCover = true
+	}
+
+	err = s.repo.InsertEventImages(ctx, event.ID, images)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) UpdateEventImage(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	imageID int64,
+	req *models.UpdateEventImageRequest,
+) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateEventImage(ctx, event.ID, imageID, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) ReorderEventImages(ctx context.Context, userID int64, urlTitle string, ids []int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) != len(event.Images) {
+		return service.ErrWrongImagesOrder
+	}
+
+	known := make(map[int64]bool, len(event.Images))
+	for _, image := range event.Images {
+		known[image.ID] = true
+	}
+	for _, id := range ids {
+		if !known[id] {
+			return service.ErrWrongImagesOrder
+		}
+		delete(known, id)
+	}
+
+	err = s.repo.ReorderEventImages(ctx, event.ID, ids)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) DeleteEventImage(ctx context.Context, userID int64, urlTitle string, imageID int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteEventImage(ctx, event.ID, imageID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setImageURLs fills urls of every variant of event's images
+func (s *eventsServ) setImageURLs(ctx context.Context, event *models.Event) error {
+	var err error
+
+	if event.PreviewImage != "" {
+		event.PreviewImageURLs, err = s.imageURLs(ctx, event.PreviewImage)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, image := range event.Images {
+		image.URLs, err = s.imageURLs(ctx, image.Image)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *eventsServ) imageURLs(ctx context.Context, filename string) (map[string]string, error) {
+	urls := utils.ImageVariantNames(filename)
+	for variant, name := range urls {
+		url, err := s.storage.URL(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		urls[variant] = url
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,80 @@
+package eventsService
+
+import (
+	"context"
+	"log/slog"
+	"math"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+const (
+	defaultRadiusKm = 10
+	maxRadiusKm     = 500
+)
+
+func (s *eventsServ) EventsNear(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) ([]*models.Event, error) {
+	err := validateCoordinates(lat, lng)
+	if err != nil {
+		return nil, err
+	}
+
+	if radiusKm == 0 {
+		radiusKm = defaultRadiusKm
+	}
+	if math.IsNaN(radiusKm) || radiusKm < 0 || radiusKm > maxRadiusKm {
+		return nil, service.ErrWrongRadius
+	}
+
+	events, err := s.repo.EventsNear(ctx, lat, lng, radiusKm, page)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		err = s.setImageURLs(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// setCoordinates validates coordinates of the event or, if they aren't provided,
+// looks them up by event's location. Geocoding failures aren't fatal, event just stays without coordinates,
+// so coordinates of the previous location are cleared on update
+func (s *eventsServ) setCoordinates(ctx context.Context, event *models.Event) error {
+	if event.Latitude != nil || event.Longitude != nil {
+		if event.Latitude == nil || event.Longitude == nil {
+			return service.ErrWrongCoordinates
+		}
+
+		return validateCoordinates(*event.Latitude, *event.Longitude)
+	}
+
+	if event.Location == "" {
+		return nil
+	}
+
+	lat, lng, err := s.geocoder.Geocode(ctx, event.Location)
+	if err != nil {
+		slog.Warn("Error geocoding event location", slog.String("location", event.Location), slog.Any("error", err))
+		event.ClearCoordinates = true
+		return nil
+	}
+
+	event.Latitude = &lat
+	event.Longitude = &lng
+
+	return nil
+}
+
+func validateCoordinates(lat float64, lng float64) error {
+	if math.IsNaN(lat) || math.IsNaN(lng) || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return service.ErrWrongCoordinates
+	}
+
+	return nil
+}
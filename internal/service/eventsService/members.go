@@ -0,0 +1,98 @@
+package eventsService
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func (s *eventsServ) EventMembers(ctx context.Context, userID int64, urlTitle string) ([]*models.EventMember, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.EventMembers(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// InviteEventMember adds member to the event by email, or changes role of the existing member,
+// and notifies invited person
+func (s *eventsServ) InviteEventMember(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	member *models.EventMember,
+) (int64, error) {
+	member.Email = strings.ToLower(strings.TrimSpace(member.Email))
+	if !utils.IsEmail(member.Email) {
+		return 0, service.ErrWrongEmail
+	}
+
+	if !authz.IsValidRole(member.Role) {
+		return 0, service.ErrWrongRole
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionManageMembers)
+	if err != nil {
+		return 0, err
+	}
+
+	member.EventID = event.ID
+	id, err := s.repo.UpsertEventMember(ctx, member)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:      []string{member.Email},
+		Subject: fmt.Sprintf("You are invited to \"%s\"", event.Title),
+		Title:   fmt.Sprintf("You are invited to \"%s\"", event.Title),
+		Lines: []string{
+			fmt.Sprintf("You were added to the event team with the %s role.", member.Role),
+			"Sign in with this email to get access to the event.",
+		},
+		ButtonText: "Open my events",
+		ButtonURL:  s.authConfig.Domain() + "/user/events",
+	})
+
+	return id, nil
+}
+
+func (s *eventsServ) DeleteEventMember(ctx context.Context, userID int64, urlTitle string, memberID int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionManageMembers)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteEventMember(ctx, event.ID, memberID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
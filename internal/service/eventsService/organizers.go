@@ -0,0 +1,186 @@
+package eventsService
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+const (
+	maxDisplayNameLength = 100
+	maxBioLength         = 2000
+	maxSocialLinks       = 10
+)
+
+var handleRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{2,31}$`)
+
+// OrganizerProfile returns public profile of the organizer with upcoming and past public events
+func (s *eventsServ) OrganizerProfile(ctx context.Context, handle string) (*models.OrganizerProfile, error) {
+	profile, err := s.repo.OrganizerProfileByHandle(ctx, strings.ToLower(handle))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrOrganizerNotFound
+		}
+
+		return nil, err
+	}
+
+	err = s.prepareOrganizerProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Rating, err = service.OrganizerRating(ctx, s.repo, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.OrganizerEvents(ctx, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err = s.prepareEventCards(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	profile.UpcomingEvents = []*models.Event{}
+	profile.PastEvents = []*models.Event{}
+	for _, event := range events {
+		if event.Status == models.EventStatusPublished && event.EndTime.After(now) {
+			// upcoming events are shown from the nearest one
+			profile.UpcomingEvents = append([]*models.Event{event}, profile.UpcomingEvents...)
+			continue
+		}
+
+		profile.PastEvents = append(profile.PastEvents, event)
+	}
+
+	return profile, nil
+}
+
+// UpdateOrganizerProfile creates or updates organizer profile of the user
+func (s *eventsServ) UpdateOrganizerProfile(ctx context.Context, userID int64, profile *models.OrganizerProfile) (*models.OrganizerProfile, error) {
+	profile.UserID = userID
+	profile.Handle = strings.ToLower(strings.TrimSpace(profile.Handle))
+	profile.DisplayName = strings.TrimSpace(profile.DisplayName)
+	profile.Bio = strings.TrimSpace(profile.Bio)
+	profile.Website = strings.TrimSpace(profile.Website)
+
+	if !handleRegexp.MatchString(profile.Handle) {
+		return nil, service.ErrWrongHandle
+	}
+
+	err := validateOrganizerProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.repo.OrganizerProfileByHandle(ctx, profile.Handle)
+	if err == nil && owner.UserID != userID {
+		return nil, service.ErrHandleTaken
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	err = s.repo.UpsertOrganizerProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err = s.repo.OrganizerProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.prepareOrganizerProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// setOrganizer links event to the profile of its creator, if the creator has one
+func (s *eventsServ) setOrganizer(ctx context.Context, event *models.Event) error {
+	profile, err := s.repo.OrganizerProfile(ctx, event.CreatorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	err = s.prepareOrganizerProfile(ctx, profile)
+	if err != nil {
+		return err
+	}
+
+	event.Organizer = &models.OrganizerProfile{
+		UserID:      profile.UserID,
+		Handle:      profile.Handle,
+		DisplayName: profile.DisplayName,
+		AvatarURLs:  profile.AvatarURLs,
+		URL:         profile.URL,
+	}
+
+	return nil
+}
+
+func (s *eventsServ) prepareOrganizerProfile(ctx context.Context, profile *models.OrganizerProfile) error {
+	profile.URL = "/organizers/" + profile.Handle
+
+	if profile.Avatar != "" {
+		var err error
+		profile.AvatarURLs, err = s.imageURLs(ctx, profile.Avatar)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateOrganizerProfile(profile *models.OrganizerProfile) error {
+	if profile.DisplayName == "" ||
+		utf8.RuneCountInString(profile.DisplayName) > maxDisplayNameLength ||
+		utf8.RuneCountInString(profile.Bio) > maxBioLength ||
+		len(profile.SocialLinks) > maxSocialLinks {
+		return service.ErrWrongProfile
+	}
+
+	if profile.Website != "" && !isWebURL(profile.Website) {
+		return service.ErrWrongProfile
+	}
+
+	for network, link := range profile.SocialLinks {
+		if strings.TrimSpace(network) == "" || !isWebURL(link) {
+			return service.ErrWrongProfile
+		}
+	}
+
+	return nil
+}
+
+// isWebURL reports whether link is absolute http or https url
+func isWebURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
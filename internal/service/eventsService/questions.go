@@ -0,0 +1,128 @@
+package eventsService
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func (s *eventsServ) AddQuestion(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	question *models.EventQuestion,
+) (int64, error) {
+	err := validateQuestion(question)
+	if err != nil {
+		return 0, err
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	question.EventID = event.ID
+	id, err := s.repo.InsertQuestion(ctx, question)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *eventsServ) UpdateQuestion(
+	ctx context.Context,
+	userID int64,
+	urlTitle string,
+	question *models.EventQuestion,
+) error {
+	err := validateQuestion(question)
+	if err != nil {
+		return err
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	question.EventID = event.ID
+	err = s.repo.UpdateQuestion(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventsServ) DeleteQuestion(ctx context.Context, userID int64, urlTitle string, questionID int64) error {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteQuestion(ctx, event.ID, questionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQuestion checks question's label and type and normalizes its options.
+// Only choice questions have options
+func validateQuestion(question *models.EventQuestion) error {
+	question.Label = strings.TrimSpace(question.Label)
+	if question.Label == "" {
+		return service.ErrWrongQuestion
+	}
+
+	switch question.Type {
+	case models.QuestionTypeText, models.QuestionTypeCheckbox:
+		if len(question.Options) > 0 {
+			return service.ErrWrongQuestion
+		}
+
+		question.Options = []string{}
+	case models.QuestionTypeSingleChoice, models.QuestionTypeMultiChoice:
+		options := make([]string, 0, len(question.Options))
+		for _, option := range question.Options {
+			option = strings.TrimSpace(option)
+			if option == "" || slices.Contains(options, option) {
+				return service.ErrWrongQuestion
+			}
+
+			options = append(options, option)
+		}
+
+		if len(options) == 0 {
+			return service.ErrWrongQuestion
+		}
+
+		question.Options = options
+	default:
+		return service.ErrWrongQuestion
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package eventsService
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+const maxReviewLength = 2000
+
+func (s *eventsServ) Reviews(ctx context.Context, urlTitle string) ([]*models.Review, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Status != models.EventStatusPublished && event.Status != models.EventStatusEnded {
+		return nil, service.ErrEventNotPublished
+	}
+
+	reviews, err := s.repo.EventReviews(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reviews == nil {
+		reviews = []*models.Review{}
+	}
+
+	return reviews, nil
+}
+
+// AddReview adds review of the ended event. Only users whose ticket was checked in
+// can review the event, and only once
+func (s *eventsServ) AddReview(ctx context.Context, userID int64, urlTitle string, review *models.Review) (int64, error) {
+	review.Comment = strings.TrimSpace(review.Comment)
+	if review.Rating < 1 || review.Rating > 5 || utf8.RuneCountInString(review.Comment) > maxReviewLength {
+		return 0, service.ErrWrongReview
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return 0, err
+	}
+
+	if event.Status == models.EventStatusCancelled || event.EndTime.After(time.Now()) {
+		return 0, service.ErrEventNotEnded
+	}
+
+	attended, err := s.repo.HasUsedTicket(ctx, event.ID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if !attended {
+		return 0, service.ErrReviewNotAllowed
+	}
+
+	review.EventID = event.ID
+	review.UserID = userID
+	id, err := s.repo.InsertReview(ctx, review)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, service.ErrAlreadyReviewed
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *eventsServ) ReplyToReview(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) error {
+	reply = strings.TrimSpace(reply)
+	if reply == "" || utf8.RuneCountInString(reply) > maxReviewLength {
+		return service.ErrWrongReply
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionReplyReviews)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateReviewReply(ctx, event.ID, reviewID, reply, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setRating sets aggregate rating of the event if it has any reviews
+func (s *eventsServ) setRating(ctx context.Context, event *models.Event) error {
+	rating, err := s.repo.EventRating(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	if rating.Count > 0 {
+		event.Rating = rating
+	}
+
+	return nil
+}
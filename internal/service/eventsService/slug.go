@@ -0,0 +1,124 @@
+package eventsService
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+const (
+	defaultSlug     = "event"
+	maxSlugAttempts = 20
+	// insert attempts of the event, whose slug was taken by concurrent insert
+	maxInsertAttempts = 3
+
+	uniqueViolationCode = "23505"
+)
+
+// insertEvent stores event under unique slug made from its title. Slug can be taken by concurrent insert
+// of the event with the same title after it's checked, then insert is retried with random suffix
+func (s *eventsServ) insertEvent(ctx context.Context, event *models.Event) (int64, error) {
+	base, err := s.uniqueSlug(ctx, event.Title)
+	if err != nil {
+		return 0, err
+	}
+
+	slug := base
+	for attempt := 1; ; attempt++ {
+		event.URLTitle = slug
+
+		id, err := s.repo.InsertEvent(ctx, event)
+		var pgErr *pgconn.PgError
+		if err == nil || !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode || attempt == maxInsertAttempts {
+			return id, err
+		}
+
+		slug = randomSlug(base)
+	}
+}
+
+// uniqueSlug makes slug from the title of event, adding numeric suffix if slug is already taken
+func (s *eventsServ) uniqueSlug(ctx context.Context, title string) (string, error) {
+	base := utils.Slugify(title)
+	if base == "" {
+		base = defaultSlug
+	}
+
+	for i := 1; i <= maxSlugAttempts; i++ {
+		slug := base
+		if i > 1 {
+			slug = fmt.Sprintf("%s-%d", base, i)
+		}
+
+		_, err := s.repo.SlugOwner(ctx, slug)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return slug, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return randomSlug(base), nil
+}
+
+// randomSlug adds random suffix to the slug
+func randomSlug(base string) string {
+	return base + "-" + uuid.NewString()[:8]
+}
+
+// UpdateEventSlug changes url title of the event. Previous url title keeps redirecting to the event
+func (s *eventsServ) UpdateEventSlug(ctx context.Context, userID int64, urlTitle string, slug string) (string, error) {
+	slug = utils.Slugify(slug)
+	if slug == "" {
+		return "", service.ErrWrongSlug
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionUpdateEvent)
+	if err != nil {
+		return "", err
+	}
+
+	if slug == event.URLTitle {
+		return slug, nil
+	}
+
+	owner, err := s.repo.SlugOwner(ctx, slug)
+	if err == nil && owner != event.ID {
+		return "", service.ErrSlugTaken
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", err
+	}
+
+	err = s.repo.UpdateEventSlug(ctx, userID, event.ID, event.URLTitle, slug)
+	if err != nil {
+		return "", err
+	}
+
+	return slug, nil
+}
+
+// EventRedirect returns current url title of the event, which had given url title before
+func (s *eventsServ) EventRedirect(ctx context.Context, urlTitle string) (string, error) {
+	current, err := s.repo.SlugRedirect(ctx, urlTitle)
+	if err != nil {
+		return "", err
+	}
+
+	return current, nil
+}
@@ -0,0 +1,83 @@
+package eventsService
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+const schedulerInterval = time.Minute
+
+// statusTransitions describes which statuses event can be moved to from the given one
+var statusTransitions = map[string][]string{
+	models.EventStatusDraft:     {models.EventStatusScheduled, models.EventStatusPublished},
+	models.EventStatusScheduled: {models.EventStatusDraft, models.EventStatusPublished},
+	models.EventStatusPublished: {models.EventStatusEnded},
+	models.EventStatusEnded:     {},
+	// events are cancelled only through CancelEvent, since it also starts refunds
+	models.EventStatusCancelled: {},
+}
+
+func validateStatusTransition(from string, to string, publishAt *time.Time) error {
+	if _, ok := statusTransitions[to]; !ok {
+		return service.ErrWrongEventStatus
+	}
+
+	if !slices.Contains(statusTransitions[from], to) {
+		return service.ErrStatusTransition
+	}
+
+	if to == models.EventStatusScheduled {
+		return validatePublishTime(publishAt)
+	}
+
+	return nil
+}
+
+func validatePublishTime(publishAt *time.Time) error {
+	if publishAt == nil || !publishAt.After(time.Now()) {
+		return service.ErrPublishTime
+	}
+
+	// publish_at column has no time zone, so it is always stored in UTC
+	*publishAt = publishAt.UTC()
+
+	return nil
+}
+
+// runScheduler periodically publishes scheduled events and ends past ones
+func (s *eventsServ) runScheduler() {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.updateStatuses(context.Background())
+		case <-s.doneChan:
+			return
+		}
+	}
+}
+
+func (s *eventsServ) updateStatuses(ctx context.Context) {
+	now := time.Now().UTC()
+
+	published, err := s.repo.PublishScheduledEvents(ctx, now)
+	if err != nil {
+		slog.Error("error publishing scheduled events", slog.Any("error", err))
+	}
+	for _, event := range published {
+		slog.Info("scheduled event was published", slog.String("url_title", event.URLTitle))
+		s.notifyFollowers(ctx, event)
+	}
+
+	_, err = s.repo.EndPastEvents(ctx, now)
+	if err != nil {
+		slog.Error("error ending past events", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestAddSession(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		creatorID = gofakeit.Int64()
+		sessionID = gofakeit.Int64()
+		speakerID = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+			TimeZone:  "Europe/Moscow",
+		}
+		speakers = []*models.Speaker{{ID: speakerID, EventID: event.ID, Name: gofakeit.Name()}}
+		// the event is in the afternoon of Moscow time, 11:00-21:00
+		scheduled = &models.Event{
+			ID:            event.ID,
+			URLTitle:      urlTitle,
+			CreatorID:     creatorID,
+			TimeZone:      "Europe/Moscow",
+			BeginningTime: time.Date(2030, 5, 1, 8, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 5, 1, 18, 0, 0, 0, time.UTC),
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	newSession := func(speakerIDs ...int64) *models.Session {
+		return &models.Session{
+			Title:         gofakeit.BeerName(),
+			Room:          "A",
+			BeginningTime: time.Date(2030, 5, 1, 10, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 5, 1, 11, 0, 0, 0, time.UTC),
+			SpeakerIDs:    speakerIDs,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		session        *models.Session
+		want           int64
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:    "success case",
+			session: newSession(speakerID),
+			want:    sessionID,
+			err:     nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventSpeakersMock.Expect(ctx, event.ID).Return(speakers, nil)
+				mock.InsertSessionMock.Set(func(_ context.Context, session *models.Session) (int64, error) {
+					require.Equal(t, event.ID, session.EventID)
+					// 10:00 in Moscow is 07:00 in UTC
+					require.Equal(t, time.Date(2030, 5, 1, 7, 0, 0, 0, time.UTC), session.BeginningTime)
+					return sessionID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "wrong time case",
+			session: &models.Session{
+				Title:         gofakeit.BeerName(),
+				BeginningTime: time.Date(2030, 5, 1, 11, 0, 0, 0, time.UTC),
+				EndTime:       time.Date(2030, 5, 1, 10, 0, 0, 0, time.UTC),
+			},
+			want: 0,
+			err:  service.ErrSessionTime,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				return mock
+			},
+		},
+		{
+			name:    "repeated speaker case",
+			session: newSession(speakerID, speakerID),
+			want:    sessionID,
+			err:     nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventSpeakersMock.Expect(ctx, event.ID).Return(speakers, nil)
+				mock.InsertSessionMock.Set(func(_ context.Context, session *models.Session) (int64, error) {
+					require.Equal(t, []int64{speakerID}, session.SpeakerIDs)
+					return sessionID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name:    "outside event case",
+			session: newSession(),
+			want:    0,
+			err:     service.ErrSessionOutsideEvent,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(scheduled, nil)
+				return mock
+			},
+		},
+		{
+			name:    "foreign speaker case",
+			session: newSession(speakerID + 1),
+			want:    0,
+			err:     service.ErrWrongSpeakers,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventSpeakersMock.Expect(ctx, event.ID).Return(speakers, nil)
+				return mock
+			},
+		},
+		{
+			name:    "failure case",
+			session: newSession(),
+			want:    0,
+			err:     repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.InsertSessionMock.Return(0, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			id, err := service.AddSession(ctx, creatorID, urlTitle, tt.session)
+
+			require.Equal(t, tt.want, id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestEventAgenda(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		urlTitle = gofakeit.UUID()
+		event    = &models.Event{
+			ID:       gofakeit.Int64(),
+			URLTitle: urlTitle,
+			TimeZone: "Europe/Moscow",
+			Status:   models.EventStatusPublished,
+		}
+		speaker  = &models.Speaker{ID: gofakeit.Int64(), EventID: event.ID, Name: gofakeit.Name()}
+		sessions = []*models.Session{{
+			ID:            gofakeit.Int64(),
+			EventID:       event.ID,
+			Title:         gofakeit.BeerName(),
+			BeginningTime: time.Date(2030, 5, 1, 7, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 5, 1, 8, 0, 0, 0, time.UTC),
+			SpeakerIDs:    []int64{speaker.ID},
+		}}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	mock := mocks.NewRepositoryMock(mc)
+	mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+	mock.EventSessionsMock.Expect(ctx, event.ID).Return(sessions, nil)
+	mock.EventSpeakersMock.Expect(ctx, event.ID).Return([]*models.Speaker{speaker}, nil)
+	mock.EventQuestionsMock.Expect(ctx, event.ID).Return(nil, nil)
+	mock.EventRatingMock.Expect(ctx, event.ID).Return(&models.Rating{}, nil)
+	mock.OrganizerProfileMock.Expect(ctx, event.CreatorID).Return(nil, pgx.ErrNoRows)
+
+	service := newEventsService(mock, nil)
+	resp, err := service.Event(ctx, 0, urlTitle)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Agenda, 1)
+	require.Equal(t, []*models.Speaker{speaker}, resp.Agenda[0].Speakers)
+	require.Equal(t, "10:00", resp.Agenda[0].BeginningTime.Format("15:04"))
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestCancelEvent(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		creatorID = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		reason    = gofakeit.Sentence(5)
+		emails    = []string{gofakeit.Email(), gofakeit.Email()}
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			Title:     gofakeit.BeerName(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+			Status:    models.EventStatusPublished,
+		}
+		endedEvent = &models.Event{
+			ID:        event.ID,
+			Title:     event.Title,
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+			Status:    models.EventStatusEnded,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		userID         int64
+		err            error
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: creatorID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.CancelEventMock.Set(func(_ context.Context, eventID int64, r string, _ time.Time) error {
+					require.Equal(t, event.ID, eventID)
+					require.Equal(t, reason, r)
+					return nil
+				})
+				mock.TicketHolderEmailsMock.Expect(ctx, event.ID).Return(emails, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, emails, msg.To)
+					require.Contains(t, msg.Lines, "Reason: "+reason)
+				})
+				return mock
+			},
+		},
+		{
+			name:   "no ticket holders case",
+			userID: creatorID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.CancelEventMock.Return(nil)
+				mock.TicketHolderEmailsMock.Expect(ctx, event.ID).Return(nil, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "editor member case",
+			userID: userID,
+			err:    service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, userID).Return(models.EventRoleEditor, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "ended event case",
+			userID: creatorID,
+			err:    service.ErrEventNotActive,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(endedEvent, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "not found case",
+			userID: creatorID,
+			err:    pgx.ErrNoRows,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(nil, pgx.ErrNoRows)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "failure case",
+			userID: creatorID,
+			err:    repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.CancelEventMock.Return(repoErr)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
+
+			service := newEventsService(repositoryMock, mailerMock)
+			err := service.CancelEvent(ctx, tt.userID, urlTitle, reason)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+)
+
+func TestUpdateEventNotification(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID   = gofakeit.Int64()
+		urlTitle = gofakeit.UUID()
+		emails   = []string{gofakeit.Email()}
+		event    = &models.Event{
+			ID:            gofakeit.Int64(),
+			Title:         gofakeit.BeerName(),
+			URLTitle:      urlTitle,
+			Description:   gofakeit.ProductDescription(),
+			BeginningTime: time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 5, 1, 18, 0, 0, 0, time.UTC),
+			CreatorID:     userID,
+			Location:      "Moscow",
+			TimeZone:      "Europe/Moscow",
+			Status:        models.EventStatusPublished,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		update         *models.Event
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name: "material change case",
+			update: &models.Event{
+				URLTitle:      urlTitle,
+				Location:      "Saint Petersburg",
+				BeginningTime: time.Date(2030, 5, 2, 19, 0, 0, 0, time.UTC),
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.UpdateEventMock.Return(nil)
+				mock.TicketHolderEmailsMock.Expect(ctx, event.ID).Return(emails, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, emails, msg.To)
+					require.Contains(t, msg.Lines, "Beginning time: 01.05.2030 19:00 MSK → 02.05.2030 19:00 MSK")
+					require.Contains(t, msg.Lines, "Location: Moscow → Saint Petersburg")
+				})
+				return mock
+			},
+		},
+		{
+			name: "silent update case",
+			update: &models.Event{
+				URLTitle:     urlTitle,
+				Location:     "Saint Petersburg",
+				SilentUpdate: true,
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.UpdateEventMock.Return(nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name: "cosmetic change case",
+			update: &models.Event{
+				URLTitle: urlTitle,
+				Title:    gofakeit.BeerName(),
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.UpdateEventMock.Return(nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
+
+			service := newEventsService(repositoryMock, mailerMock)
+			err := service.UpdateEvent(ctx, userID, tt.update)
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestCloneEvent(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		creatorID = gofakeit.Int64()
+		editorID  = gofakeit.Int64()
+		cloneID   = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		source    = &models.Event{
+			ID:           gofakeit.Int64(),
+			Title:        "Summer Fest",
+			URLTitle:     urlTitle,
+			Description:  gofakeit.ProductDescription(),
+			CreatorID:    creatorID,
+			Location:     gofakeit.City(),
+			PreviewImage: "cover.jpg",
+			TimeZone:     "Europe/Moscow",
+			Capacity:     90,
+			Status:       models.EventStatusPublished,
+			Prices:       []*models.Price{{ID: gofakeit.Int64(), Price: 1000, Currency: "RUB"}},
+			Images:       []*models.EventImage{{ID: gofakeit.Int64(), Image: "cover.jpg", IsCover: true}},
+		}
+		questions = []*models.EventQuestion{{ID: gofakeit.Int64(), Label: "Company", Type: models.QuestionTypeText}}
+		req       = &models.CloneEventRequest{
+			BeginningTime: time.Date(2030, 6, 1, 18, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 6, 1, 21, 0, 0, 0, time.UTC),
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		userID         int64
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: editorID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(source, nil)
+				mock.EventMemberRoleMock.Expect(ctx, source.ID, editorID).Return(models.EventRoleEditor, nil)
+				mock.EmailVerifiedMock.Expect(ctx, editorID).Return(true, nil)
+				mock.SalesReportMock.Expect(ctx, source.ID).Return(&models.SalesReport{TicketsSold: 10}, nil)
+				mock.EventQuestionsMock.Expect(ctx, source.ID).Return(questions, nil)
+				mock.SlugOwnerMock.Set(func(_ context.Context, slug string) (int64, error) {
+					if slug == "summer-fest" {
+						return source.ID, nil
+					}
+					return 0, pgx.ErrNoRows
+				})
+				mock.InsertEventMock.Set(func(_ context.Context, event *models.Event) (int64, error) {
+					require.Equal(t, "summer-fest-2", event.URLTitle)
+					require.Equal(t, editorID, event.CreatorID)
+					require.Equal(t, models.EventStatusDraft, event.Status)
+					require.Equal(t, int64(100), event.Capacity)
+					// 18:00 in Moscow is 15:00 in UTC
+					require.Equal(t, time.Date(2030, 6, 1, 15, 0, 0, 0, time.UTC), event.BeginningTime)
+					require.Len(t, event.Prices, 1)
+					require.Zero(t, event.Prices[0].ID)
+					require.Len(t, event.Images, 1)
+					require.Zero(t, event.Images[0].ID)
+					require.Len(t, event.Questions, 1)
+					require.Zero(t, event.Questions[0].ID)
+					return cloneID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name:   "wrong user case",
+			userID: gofakeit.Int64(),
+			err:    service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(source, nil)
+				mock.EventMemberRoleMock.Return("", pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:   "unverified email case",
+			userID: creatorID,
+			err:    service.ErrEmailNotVerified,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(source, nil)
+				mock.EmailVerifiedMock.Expect(ctx, creatorID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:   "failure case",
+			userID: creatorID,
+			err:    repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(source, nil)
+				mock.EmailVerifiedMock.Expect(ctx, creatorID).Return(true, nil)
+				mock.SalesReportMock.Expect(ctx, source.ID).Return(&models.SalesReport{}, nil)
+				mock.EventQuestionsMock.Expect(ctx, source.ID).Return(nil, nil)
+				mock.SlugOwnerMock.Return(0, pgx.ErrNoRows)
+				mock.InsertEventMock.Return(0, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			event, err := service.CloneEvent(ctx, tt.userID, urlTitle, req)
+
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, cloneID, event.ID)
+			}
+		})
+	}
+}
@@ -8,16 +8,16 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
-	"github.com/wDRxxx/eventflow-backend/internal/service/eventsService"
-
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
 func TestEvent(t *testing.T) {
@@ -32,7 +32,14 @@ func TestEvent(t *testing.T) {
 
 		repoErr  = errors.New("repo err")
 		urlTitle = gofakeit.UUID()
-		event    = &models.Event{
+		draft    = &models.Event{
+			ID:        gofakeit.Int64(),
+			Title:     gofakeit.BeerName(),
+			URLTitle:  urlTitle,
+			CreatorID: gofakeit.Int64(),
+			Status:    models.EventStatusDraft,
+		}
+		event = &models.Event{
 			ID:            gofakeit.Int64(),
 			Title:         gofakeit.BeerName(),
 			URLTitle:      urlTitle,
@@ -44,9 +51,10 @@ func TestEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        gofakeit.Bool(),
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
+			Status:        models.EventStatusPublished,
 			Prices:        nil,
 			CreatedAt:     time.Now(),
 			UpdatedAt:     time.Now(),
@@ -67,6 +75,20 @@ func TestEvent(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventSessionsMock.Expect(ctx, event.ID).Return(nil, nil)
+				mock.EventQuestionsMock.Expect(ctx, event.ID).Return(nil, nil)
+				mock.EventRatingMock.Expect(ctx, event.ID).Return(&models.Rating{}, nil)
+				mock.OrganizerProfileMock.Expect(ctx, event.CreatorID).Return(nil, pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name: "draft case",
+			want: nil,
+			err:  service.ErrEventNotPublished,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(draft, nil)
 				return mock
 			},
 		},
@@ -86,8 +108,8 @@ func TestEvent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
-			event, err := service.Event(ctx, urlTitle)
+			service := newEventsService(repositoryMock, nil)
+			event, err := service.Event(ctx, 0, urlTitle)
 
 			require.Equal(t, tt.want, event)
 			require.Equal(t, tt.err, err)
@@ -119,7 +141,7 @@ func TestEvents(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        gofakeit.Bool(),
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -161,7 +183,7 @@ func TestEvents(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
+			service := newEventsService(repositoryMock, nil)
 			event, err := service.Events(ctx, page)
 
 			require.Equal(t, tt.want, event)
@@ -195,7 +217,7 @@ func TestCreateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -214,7 +236,7 @@ func TestCreateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        false,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -233,7 +255,7 @@ func TestCreateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices: []*models.Price{{
@@ -244,6 +266,35 @@ func TestCreateEvent(t *testing.T) {
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
+		wrongLatitude = 91.0
+		event4        = &models.Event{
+			Title:         gofakeit.BeerName(),
+			Description:   gofakeit.ProductDescription(),
+			BeginningTime: time.Now(),
+			EndTime:       time.Now(),
+			Location:      gofakeit.City(),
+			IsFree:        true,
+			Latitude:      &wrongLatitude,
+			Longitude:     &wrongLatitude,
+		}
+		event5 = &models.Event{
+			Title:         gofakeit.BeerName(),
+			Description:   gofakeit.ProductDescription(),
+			BeginningTime: time.Now(),
+			EndTime:       time.Now(),
+			Location:      gofakeit.City(),
+			IsFree:        true,
+			TimeZone:      "Mars/Olympus",
+		}
+		event6 = &models.Event{
+			Title:         gofakeit.BeerName(),
+			Description:   gofakeit.ProductDescription(),
+			BeginningTime: time.Date(2030, 1, 1, 18, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2030, 1, 1, 21, 0, 0, 0, time.UTC),
+			Location:      gofakeit.City(),
+			IsFree:        true,
+			TimeZone:      "Asia/Yekaterinburg",
+		}
 	)
 	closer.SetGlobalCloser(closer.New(wg))
 
@@ -251,6 +302,7 @@ func TestCreateEvent(t *testing.T) {
 		name           string
 		want           int64
 		event          *models.Event
+		beginningTime  time.Time
 		err            error
 		repositoryMock repositoryMockFunc
 	}{
@@ -261,10 +313,23 @@ func TestCreateEvent(t *testing.T) {
 			err:   nil,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				mock.SlugOwnerMock.Return(0, pgx.ErrNoRows)
 				mock.InsertEventMock.Expect(ctx, event1).Return(id, nil)
 				return mock
 			},
 		},
+		{
+			name:  "unverified email case",
+			want:  0,
+			event: event1,
+			err:   service.ErrEmailNotVerified,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Expect(ctx, event1.CreatorID).Return(false, nil)
+				return mock
+			},
+		},
 		{
 			name:  "wrong prices case 1",
 			want:  0,
@@ -272,6 +337,7 @@ func TestCreateEvent(t *testing.T) {
 			err:   service.ErrNoPrices,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
 				return mock
 			},
 		},
@@ -282,6 +348,43 @@ func TestCreateEvent(t *testing.T) {
 			err:   service.ErrPricesForFree,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:  "wrong coordinates case",
+			want:  0,
+			event: event4,
+			err:   service.ErrWrongCoordinates,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				return mock
+			},
+		},
+		{
+			name:          "time zone case",
+			want:          id,
+			event:         event6,
+			beginningTime: time.Date(2030, 1, 1, 13, 0, 0, 0, time.UTC),
+			err:           nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				mock.SlugOwnerMock.Return(0, pgx.ErrNoRows)
+				mock.InsertEventMock.Expect(ctx, event6).Return(id, nil)
+				return mock
+			},
+		},
+		{
+			name:  "wrong time zone case",
+			want:  0,
+			event: event5,
+			err:   utils.ErrWrongTimeZone,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
 				return mock
 			},
 		},
@@ -292,6 +395,8 @@ func TestCreateEvent(t *testing.T) {
 			event: event1,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				mock.SlugOwnerMock.Return(0, pgx.ErrNoRows)
 				mock.InsertEventMock.Expect(ctx, event1).Return(id, repoErr)
 				return mock
 			},
@@ -302,11 +407,18 @@ func TestCreateEvent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
+			service := newEventsService(repositoryMock, nil)
 			eventID, err := service.CreateEvent(ctx, tt.event)
 
 			require.Equal(t, tt.want, eventID)
 			require.Equal(t, tt.err, err)
+			if err == nil {
+				require.Equal(t, geocoder.lat, *tt.event.Latitude)
+				require.Equal(t, geocoder.lng, *tt.event.Longitude)
+			}
+			if !tt.beginningTime.IsZero() {
+				require.Equal(t, tt.beginningTime, tt.event.BeginningTime)
+			}
 		})
 	}
 }
@@ -337,7 +449,7 @@ func TestUpdateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -356,7 +468,7 @@ func TestUpdateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        false,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices: []*models.Price{{
@@ -379,7 +491,7 @@ func TestUpdateEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        false,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices: []*models.Price{{
@@ -390,6 +502,33 @@ func TestUpdateEvent(t *testing.T) {
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
+		published = &models.Event{
+			ID:        id,
+			URLTitle:  gofakeit.UUID(),
+			CreatorID: userID,
+			Status:    models.EventStatusPublished,
+		}
+		toDraft = &models.Event{
+			URLTitle: published.URLTitle,
+			Status:   models.EventStatusDraft,
+		}
+		// events as they are stored, because update input is changed by the service
+		stored1 = *event1
+		stored2 = *event2
+
+		takeover = &models.Event{
+			URLTitle:     event3.URLTitle,
+			Title:        gofakeit.BeerName(),
+			CreatorID:    userID,
+			SilentUpdate: true,
+		}
+		cancelledAt    = time.Now()
+		fakeCancelling = &models.Event{
+			URLTitle:     stored1.URLTitle,
+			CancelReason: gofakeit.Sentence(5),
+			CancelledAt:  &cancelledAt,
+			SilentUpdate: true,
+		}
 	)
 	closer.SetGlobalCloser(closer.New(wg))
 
@@ -405,8 +544,8 @@ func TestUpdateEvent(t *testing.T) {
 			event: event1,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
-				mock.EventByURLTitleMock.Expect(ctx, event1.URLTitle).Return(event1, nil)
-				mock.UpdateEventMock.Expect(ctx, event1).Return(nil)
+				mock.EventByURLTitleMock.Expect(ctx, event1.URLTitle).Return(&stored1, nil)
+				mock.UpdateEventMock.Expect(ctx, userID, event1).Return(nil)
 				return mock
 			},
 		},
@@ -416,8 +555,8 @@ func TestUpdateEvent(t *testing.T) {
 			event: event2,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
-				mock.EventByURLTitleMock.Expect(ctx, event2.URLTitle).Return(event2, nil)
-				mock.UpdateEventMock.Expect(ctx, event2).Return(nil)
+				mock.EventByURLTitleMock.Expect(ctx, event2.URLTitle).Return(&stored2, nil)
+				mock.UpdateEventMock.Expect(ctx, userID, event2).Return(nil)
 
 				return mock
 			},
@@ -429,6 +568,52 @@ func TestUpdateEvent(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.EventByURLTitleMock.Expect(ctx, event3.URLTitle).Return(event3, nil)
+				mock.EventMemberRoleMock.Return("", pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:  "editor changes creator case",
+			err:   nil,
+			event: takeover,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, takeover.URLTitle).Return(event3, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event3.ID, userID).Return(models.EventRoleEditor, nil)
+				mock.UpdateEventMock.Set(func(_ context.Context, _ int64, event *models.Event) error {
+					if event.CreatorID != 0 {
+						return errors.New("creator is changed")
+					}
+
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:  "cancellation details case",
+			err:   nil,
+			event: fakeCancelling,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, fakeCancelling.URLTitle).Return(&stored1, nil)
+				mock.UpdateEventMock.Set(func(_ context.Context, _ int64, event *models.Event) error {
+					if event.CancelReason != "" || event.CancelledAt != nil {
+						return errors.New("cancellation details are changed")
+					}
+
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:  "wrong status transition case",
+			err:   service.ErrStatusTransition,
+			event: toDraft,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, toDraft.URLTitle).Return(published, nil)
 				return mock
 			},
 		},
@@ -438,8 +623,8 @@ func TestUpdateEvent(t *testing.T) {
 			event: event2,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
-				mock.EventByURLTitleMock.Expect(ctx, event2.URLTitle).Return(event2, nil)
-				mock.UpdateEventMock.Expect(ctx, event2).Return(repoErr)
+				mock.EventByURLTitleMock.Expect(ctx, event2.URLTitle).Return(&stored2, nil)
+				mock.UpdateEventMock.Expect(ctx, userID, event2).Return(repoErr)
 				return mock
 			},
 		},
@@ -449,7 +634,7 @@ func TestUpdateEvent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
+			service := newEventsService(repositoryMock, nil)
 			err := service.UpdateEvent(ctx, userID, tt.event)
 
 			require.Equal(t, tt.err, err)
@@ -483,7 +668,7 @@ func TestDeleteEvent(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -507,7 +692,19 @@ func TestDeleteEvent(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
-				mock.DeleteEventMock.Expect(ctx, urlTitle).Return(nil)
+				mock.SalesReportMock.Expect(ctx, event.ID).Return(&models.SalesReport{}, nil)
+				mock.DeleteEventMock.Expect(ctx, userID, urlTitle).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "sold tickets case",
+			err:    service.ErrEventHasTickets,
+			userID: userID,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.SalesReportMock.Expect(ctx, event.ID).Return(&models.SalesReport{TicketsSold: 1}, nil)
 				return mock
 			},
 		},
@@ -518,6 +715,7 @@ func TestDeleteEvent(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Return("", pgx.ErrNoRows)
 				return mock
 			},
 		},
@@ -528,7 +726,8 @@ func TestDeleteEvent(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
-				mock.DeleteEventMock.Expect(ctx, urlTitle).Return(repoErr)
+				mock.SalesReportMock.Expect(ctx, event.ID).Return(&models.SalesReport{}, nil)
+				mock.DeleteEventMock.Expect(ctx, userID, urlTitle).Return(repoErr)
 				return mock
 			},
 		},
@@ -538,7 +737,7 @@ func TestDeleteEvent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
+			service := newEventsService(repositoryMock, nil)
 			err := service.DeleteEvent(ctx, tt.userID, urlTitle)
 
 			require.Equal(t, tt.err, err)
@@ -570,7 +769,7 @@ func TestUserEvents(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        gofakeit.Bool(),
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -612,7 +811,7 @@ func TestUserEvents(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := eventsService.NewEventsService(repositoryMock)
+			service := newEventsService(repositoryMock, nil)
 			event, err := service.UserEvents(ctx, userID)
 
 			require.Equal(t, tt.want, event)
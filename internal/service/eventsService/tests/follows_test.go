@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestFollowOrganizer(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID      = gofakeit.Int64()
+		organizerID = gofakeit.Int64()
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		organizerID    int64
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:        "success case",
+			organizerID: organizerID,
+			err:         nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.IsOrganizerMock.Expect(ctx, organizerID).Return(true, nil)
+				mock.InsertFollowMock.Expect(ctx, userID, organizerID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:        "not organizer case",
+			organizerID: organizerID,
+			err:         service.ErrOrganizerNotFound,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.IsOrganizerMock.Expect(ctx, organizerID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:        "self case",
+			organizerID: userID,
+			err:         service.ErrFollowSelf,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			err := service.FollowOrganizer(ctx, userID, tt.organizerID)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestPublishNotifiesFollowers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		creatorID = gofakeit.Int64()
+		emails    = []string{gofakeit.Email(), gofakeit.Email()}
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			Title:     gofakeit.BeerName(),
+			URLTitle:  gofakeit.UUID(),
+			CreatorID: creatorID,
+			IsPublic:  true,
+			TimeZone:  "Europe/Moscow",
+			Status:    models.EventStatusDraft,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	repositoryMock := mocks.NewRepositoryMock(mc)
+	repositoryMock.EventByURLTitleMock.Expect(ctx, event.URLTitle).Return(event, nil)
+	repositoryMock.UpdateEventMock.Return(nil)
+	repositoryMock.FollowerEmailsMock.Expect(ctx, creatorID).Return(emails, nil)
+
+	mailerMock := mailerMocks.NewMailerMock(mc)
+	mailerMock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+		require.Equal(t, emails, msg.To)
+		require.Contains(t, msg.ButtonURL, "/events/"+event.URLTitle)
+	})
+
+	var repo repository.Repository = repositoryMock
+	service := newEventsService(repo, mailerMock)
+	err := service.UpdateEvent(ctx, creatorID, &models.Event{
+		URLTitle: event.URLTitle,
+		Status:   models.EventStatusPublished,
+	})
+
+	require.NoError(t, err)
+}
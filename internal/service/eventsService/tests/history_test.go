@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestEventHistory(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		ownerID  = gofakeit.Int64()
+		otherID  = ownerID + 1
+		urlTitle = gofakeit.UUID()
+		event    = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: ownerID,
+		}
+		revisions = []*models.EventRevision{
+			{
+				Version: 2,
+				Action:  models.EventRevisionPriceChange,
+				ActorID: &ownerID,
+				Diff: []*models.FieldChange{
+					{Field: "prices", Old: []any{}, New: []any{map[string]any{"currency": "RUB", "price": 1000.0}}},
+				},
+			},
+			{Version: 1, Action: models.EventRevisionCreate, ActorID: &ownerID},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		userID         int64
+		want           []*models.EventRevision
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "owner case",
+			userID: ownerID,
+			want:   revisions,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventRevisionsMock.Expect(ctx, event.ID).Return(revisions, nil)
+				return mock
+			},
+		},
+		{
+			name:   "admin case",
+			userID: otherID,
+			want:   []*models.EventRevision{},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, otherID).Return("", pgx.ErrNoRows)
+				mock.IsAdminMock.Expect(ctx, otherID).Return(true, nil)
+				mock.EventRevisionsMock.Expect(ctx, event.ID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name:   "deleted event case",
+			userID: ownerID,
+			want:   revisions,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(nil, pgx.ErrNoRows)
+				mock.DeletedEventMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventRevisionsMock.Expect(ctx, event.ID).Return(revisions, nil)
+				return mock
+			},
+		},
+		{
+			name:   "unknown event case",
+			userID: ownerID,
+			err:    pgx.ErrNoRows,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(nil, pgx.ErrNoRows)
+				mock.DeletedEventMock.Expect(ctx, urlTitle).Return(nil, pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:   "editor case",
+			userID: otherID,
+			err:    service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, otherID).Return(models.EventRoleEditor, nil)
+				mock.IsAdminMock.Expect(ctx, otherID).Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			resp, err := service.EventHistory(ctx, tt.userID, urlTitle)
+
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestAddEventImages(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		urlTitle = gofakeit.UUID()
+		userID   = gofakeit.Int64()
+		event    = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: userID,
+		}
+		images = []*models.EventImage{
+			{Image: gofakeit.UUID()},
+			{Image: gofakeit.UUID()},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		userID         int64
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			err:    nil,
+			userID: userID,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.InsertEventImagesMock.Expect(ctx, event.ID, images).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "wrong user case",
+			err:    service.ErrPermissionDenied,
+			userID: gofakeit.Int64(),
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Return("", pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:   "failure case",
+			err:    repoErr,
+			userID: userID,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.InsertEventImagesMock.Expect(ctx, event.ID, images).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			err := service.AddEventImages(ctx, tt.userID, urlTitle, images)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+
+	require.True(t, images[0].IsCover)
+	require.False(t, images[1].IsCover)
+}
+
+func TestReorderEventImages(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		urlTitle = gofakeit.UUID()
+		userID   = gofakeit.Int64()
+		event    = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: userID,
+			Images: []*models.EventImage{
+				{ID: 1, Image: gofakeit.UUID(), IsCover: true},
+				{ID: 2, Image: gofakeit.UUID()},
+			},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		ids            []int64
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			ids:  []int64{2, 1},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.ReorderEventImagesMock.Expect(ctx, event.ID, []int64{2, 1}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "duplicated image case",
+			err:  service.ErrWrongImagesOrder,
+			ids:  []int64{1, 1},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				return mock
+			},
+		},
+		{
+			name: "missing image case",
+			err:  service.ErrWrongImagesOrder,
+			ids:  []int64{2},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				return mock
+			},
+		},
+		{
+			name: "failure case",
+			err:  repoErr,
+			ids:  []int64{1, 2},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.ReorderEventImagesMock.Expect(ctx, event.ID, []int64{1, 2}).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			err := service.ReorderEventImages(ctx, userID, urlTitle, tt.ids)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/eventsService"
+)
+
+func TestEventsNear(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		repoErr = errors.New("repo err")
+
+		lat      = 55.7558
+		lng      = 37.6173
+		distance = 1.5
+		events   = []*models.Event{
+			{
+				Title:     gofakeit.BeerName(),
+				URLTitle:  gofakeit.UUID(),
+				Location:  gofakeit.City(),
+				Latitude:  &lat,
+				Longitude: &lng,
+				Distance:  &distance,
+			},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		lat            float64
+		lng            float64
+		radius         float64
+		want           []*models.Event
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			lat:    lat,
+			lng:    lng,
+			radius: 5,
+			want:   events,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventsNearMock.Expect(ctx, lat, lng, 5, 1).Return(events, nil)
+				return mock
+			},
+		},
+		{
+			name:   "default radius case",
+			lat:    lat,
+			lng:    lng,
+			radius: 0,
+			want:   events,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventsNearMock.Expect(ctx, lat, lng, 10, 1).Return(events, nil)
+				return mock
+			},
+		},
+		{
+			name:   "wrong coordinates case",
+			lat:    100,
+			lng:    lng,
+			radius: 5,
+			want:   nil,
+			err:    service.ErrWrongCoordinates,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				return mock
+			},
+		},
+		{
+			name:   "nan coordinates case",
+			lat:    math.NaN(),
+			lng:    lng,
+			radius: 5,
+			want:   nil,
+			err:    service.ErrWrongCoordinates,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				return mock
+			},
+		},
+		{
+			name:   "nan radius case",
+			lat:    lat,
+			lng:    lng,
+			radius: math.NaN(),
+			want:   nil,
+			err:    service.ErrWrongRadius,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				return mock
+			},
+		},
+		{
+			name:   "wrong radius case",
+			lat:    lat,
+			lng:    lng,
+			radius: 1000,
+			want:   nil,
+			err:    service.ErrWrongRadius,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				return mock
+			},
+		},
+		{
+			name:   "failure case",
+			lat:    lat,
+			lng:    lng,
+			radius: 5,
+			want:   nil,
+			err:    repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventsNearMock.Expect(ctx, lat, lng, 5, 1).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			res, err := service.EventsNear(ctx, tt.lat, tt.lng, tt.radius, 1)
+
+			require.Equal(t, tt.want, res)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestUpdateEventLocation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		lat    = 55.7558
+		lng    = 37.6173
+		stored = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  gofakeit.UUID(),
+			CreatorID: userID,
+			Location:  "Moscow",
+			Latitude:  &lat,
+			Longitude: &lng,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name     string
+		geocoder *stubGeocoder
+		check    func(t *testing.T, event *models.Event)
+	}{
+		{
+			name:     "geocoded location case",
+			geocoder: &stubGeocoder{lat: 59.9386, lng: 30.3141},
+			check: func(t *testing.T, event *models.Event) {
+				require.Equal(t, 59.9386, *event.Latitude)
+				require.Equal(t, 30.3141, *event.Longitude)
+				require.False(t, event.ClearCoordinates)
+			},
+		},
+		{
+			name:     "geocoding failure case",
+			geocoder: &stubGeocoder{err: errors.New("geocoder error")},
+			check: func(t *testing.T, event *models.Event) {
+				require.Nil(t, event.Latitude)
+				require.Nil(t, event.Longitude)
+				require.True(t, event.ClearCoordinates)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := mocks.NewRepositoryMock(mc)
+			repositoryMock.EventByURLTitleMock.Expect(ctx, stored.URLTitle).Return(stored, nil)
+			repositoryMock.UpdateEventMock.Set(func(_ context.Context, _ int64, event *models.Event) error {
+				tt.check(t, event)
+				return nil
+			})
+
+			service := eventsService.NewEventsService(
+				repositoryMock,
+				staticStorage,
+				tt.geocoder,
+				authz.NewAuthorizer(repositoryMock),
+				nil,
+				config.NewAuthConfig(),
+			)
+			err := service.UpdateEvent(ctx, userID, &models.Event{
+				URLTitle:     stored.URLTitle,
+				Location:     "Saint Petersburg",
+				Prices:       []*models.Price{{Price: 1000, Currency: "RUB"}},
+				SilentUpdate: true,
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestInviteEventMember(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		creatorID = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		memberID  = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		email     = gofakeit.Email()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			Title:     gofakeit.BeerName(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		userID         int64
+		member         *models.EventMember
+		want           int64
+		err            error
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: creatorID,
+			member: &models.EventMember{Email: email, Role: models.EventRoleEditor},
+			want:   memberID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.UpsertEventMemberMock.Return(memberID, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, []string{email}, msg.To)
+				})
+				return mock
+			},
+		},
+		{
+			name:   "owner member case",
+			userID: userID,
+			member: &models.EventMember{Email: email, Role: models.EventRoleCheckIn},
+			want:   memberID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, userID).Return(models.EventRoleOwner, nil)
+				mock.UpsertEventMemberMock.Return(memberID, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Return()
+				return mock
+			},
+		},
+		{
+			name:   "editor member case",
+			userID: userID,
+			member: &models.EventMember{Email: email, Role: models.EventRoleEditor},
+			want:   0,
+			err:    service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, userID).Return(models.EventRoleEditor, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "wrong role case",
+			userID: creatorID,
+			member: &models.EventMember{Email: email, Role: "admin"},
+			want:   0,
+			err:    service.ErrWrongRole,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name:   "wrong email case",
+			userID: creatorID,
+			member: &models.EventMember{Email: "email", Role: models.EventRoleEditor},
+			want:   0,
+			err:    service.ErrWrongEmail,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
+
+			service := newEventsService(repositoryMock, mailerMock)
+			id, err := service.InviteEventMember(ctx, tt.userID, urlTitle, tt.member)
+
+			require.Equal(t, tt.want, id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestUpdateOrganizerProfile(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		handle = "tech-meetups"
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	newProfile := func() *models.OrganizerProfile {
+		return &models.OrganizerProfile{
+			Handle:      " Tech-Meetups ",
+			DisplayName: gofakeit.Company(),
+			Website:     "https://example.com",
+			SocialLinks: map[string]string{"telegram": "https://t.me/techmeetups"},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		profile        func() *models.OrganizerProfile
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:    "success case",
+			profile: newProfile,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.OrganizerProfileByHandleMock.Expect(ctx, handle).Return(nil, pgx.ErrNoRows)
+				mock.UpsertOrganizerProfileMock.Set(func(_ context.Context, profile *models.OrganizerProfile) error {
+					require.Equal(t, userID, profile.UserID)
+					require.Equal(t, handle, profile.Handle)
+					return nil
+				})
+				mock.OrganizerProfileMock.Expect(ctx, userID).Return(&models.OrganizerProfile{
+					UserID: userID,
+					Handle: handle,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "wrong handle case",
+			profile: func() *models.OrganizerProfile {
+				profile := newProfile()
+				profile.Handle = "a b"
+				return profile
+			},
+			err: service.ErrWrongHandle,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+		{
+			name: "wrong website case",
+			profile: func() *models.OrganizerProfile {
+				profile := newProfile()
+				profile.Website = "javascript:alert(1)"
+				return profile
+			},
+			err: service.ErrWrongProfile,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+		{
+			name:    "handle taken case",
+			profile: newProfile,
+			err:     service.ErrHandleTaken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.OrganizerProfileByHandleMock.Expect(ctx, handle).Return(&models.OrganizerProfile{
+					UserID: userID + 1,
+					Handle: handle,
+				}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			profile, err := service.UpdateOrganizerProfile(ctx, userID, tt.profile())
+
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "/organizers/"+handle, profile.URL)
+		})
+	}
+}
+
+func TestOrganizerProfile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		handle = "tech-meetups"
+		now    = time.Now().UTC()
+
+		later = &models.Event{
+			URLTitle:      "later",
+			BeginningTime: now.Add(48 * time.Hour),
+			EndTime:       now.Add(50 * time.Hour),
+			TimeZone:      "UTC",
+			Status:        models.EventStatusPublished,
+		}
+		sooner = &models.Event{
+			URLTitle:      "sooner",
+			BeginningTime: now.Add(24 * time.Hour),
+			EndTime:       now.Add(26 * time.Hour),
+			TimeZone:      "UTC",
+			Status:        models.EventStatusPublished,
+		}
+		ended = &models.Event{
+			URLTitle:      "ended",
+			BeginningTime: now.Add(-26 * time.Hour),
+			EndTime:       now.Add(-24 * time.Hour),
+			TimeZone:      "UTC",
+			Status:        models.EventStatusEnded,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	mock := mocks.NewRepositoryMock(mc)
+	mock.OrganizerProfileByHandleMock.Expect(ctx, handle).Return(&models.OrganizerProfile{
+		UserID: userID,
+		Handle: handle,
+	}, nil)
+	mock.OrganizerRatingMock.Return(&models.Rating{}, nil)
+	mock.OrganizerEventsMock.Expect(ctx, userID).Return([]*models.Event{later, sooner, ended}, nil)
+
+	service := newEventsService(mock, nil)
+	profile, err := service.OrganizerProfile(ctx, "Tech-Meetups")
+
+	require.NoError(t, err)
+	require.Nil(t, profile.Rating)
+	require.Equal(t, []*models.Event{sooner, later}, profile.UpcomingEvents)
+	require.Equal(t, []*models.Event{ended}, profile.PastEvents)
+}
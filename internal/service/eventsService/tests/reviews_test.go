@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestAddReview(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID   = gofakeit.Int64()
+		reviewID = gofakeit.Int64()
+		urlTitle = gofakeit.UUID()
+		ended    = &models.Event{
+			ID:       gofakeit.Int64(),
+			URLTitle: urlTitle,
+			EndTime:  time.Now().Add(-time.Hour),
+			Status:   models.EventStatusEnded,
+		}
+		upcoming = &models.Event{
+			ID:       gofakeit.Int64(),
+			URLTitle: urlTitle,
+			EndTime:  time.Now().Add(time.Hour),
+			Status:   models.EventStatusPublished,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		review         *models.Review
+		want           int64
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			review: &models.Review{Rating: 5, Comment: " Great! "},
+			want:   reviewID,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(ended, nil)
+				mock.HasUsedTicketMock.Expect(ctx, ended.ID, userID).Return(true, nil)
+				mock.InsertReviewMock.Expect(ctx, &models.Review{
+					EventID: ended.ID,
+					UserID:  userID,
+					Rating:  5,
+					Comment: "Great!",
+				}).Return(reviewID, nil)
+				return mock
+			},
+		},
+		{
+			name:   "already reviewed case",
+			review: &models.Review{Rating: 4},
+			want:   0,
+			err:    service.ErrAlreadyReviewed,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(ended, nil)
+				mock.HasUsedTicketMock.Expect(ctx, ended.ID, userID).Return(true, nil)
+				mock.InsertReviewMock.Return(0, pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:   "not checked in case",
+			review: &models.Review{Rating: 1},
+			want:   0,
+			err:    service.ErrReviewNotAllowed,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(ended, nil)
+				mock.HasUsedTicketMock.Expect(ctx, ended.ID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:   "event not ended case",
+			review: &models.Review{Rating: 3},
+			want:   0,
+			err:    service.ErrEventNotEnded,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(upcoming, nil)
+				return mock
+			},
+		},
+		{
+			name:   "wrong rating case",
+			review: &models.Review{Rating: 6},
+			want:   0,
+			err:    service.ErrWrongReview,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			id, err := service.AddReview(ctx, userID, urlTitle, tt.review)
+
+			require.Equal(t, tt.want, id)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -1,9 +1,44 @@
 package tests
 
 import (
+	"context"
+	"os"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/eventsService"
+	"github.com/wDRxxx/eventflow-backend/internal/storage/fs"
 )
 
+var staticStorage = fs.NewFSStorage(os.TempDir(), "/api/static/")
+
+var geocoder = &stubGeocoder{lat: 55.7558, lng: 37.6173}
+
+// stubGeocoder resolves any address to the same point without network requests
+type stubGeocoder struct {
+	lat float64
+	lng float64
+	err error
+}
+
+func (g *stubGeocoder) Geocode(_ context.Context, _ string) (float64, float64, error) {
+	return g.lat, g.lng, g.err
+}
+
+func newEventsService(repo repository.Repository, mailer mailer.Mailer) service.EventsService {
+	return eventsService.NewEventsService(
+		repo,
+		staticStorage,
+		geocoder,
+		authz.NewAuthorizer(repo),
+		mailer,
+		config.NewAuthConfig(),
+	)
+}
+
 func init() {
 	config.Load(".env")
 }
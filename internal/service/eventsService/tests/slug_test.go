@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+func TestUpdateEventSlug(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		creatorID = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		slug           string
+		want           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			slug: "Летний фест 2030",
+			want: "letniy-fest-2030",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.SlugOwnerMock.Expect(ctx, "letniy-fest-2030").Return(0, pgx.ErrNoRows)
+				mock.UpdateEventSlugMock.Expect(ctx, creatorID, event.ID, urlTitle, "letniy-fest-2030").Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "own previous slug case",
+			slug: "summer-fest",
+			want: "summer-fest",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.SlugOwnerMock.Expect(ctx, "summer-fest").Return(event.ID, nil)
+				mock.UpdateEventSlugMock.Expect(ctx, creatorID, event.ID, urlTitle, "summer-fest").Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "taken slug case",
+			slug: "summer-fest",
+			want: "",
+			err:  service.ErrSlugTaken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.SlugOwnerMock.Expect(ctx, "summer-fest").Return(event.ID+1, nil)
+				return mock
+			},
+		},
+		{
+			name: "wrong slug case",
+			slug: "!!!",
+			want: "",
+			err:  service.ErrWrongSlug,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := newEventsService(repositoryMock, nil)
+			slug, err := service.UpdateEventSlug(ctx, creatorID, urlTitle, tt.slug)
+
+			require.Equal(t, tt.want, slug)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestCreateEventSlugConflict(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       = gofakeit.Int64()
+		conflict = &pgconn.PgError{Code: "23505"}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		want           int64
+		err            error
+		check          func(t *testing.T, urlTitle string)
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "taken by concurrent insert case",
+			want: id,
+			err:  nil,
+			check: func(t *testing.T, urlTitle string) {
+				require.Regexp(t, `^summer-fest-[0-9a-f]{8}$`, urlTitle)
+			},
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				mock.SlugOwnerMock.Expect(ctx, "summer-fest").Return(0, pgx.ErrNoRows)
+				mock.InsertEventMock.Set(func(_ context.Context, event *models.Event) (int64, error) {
+					if event.URLTitle == "summer-fest" {
+						return 0, conflict
+					}
+
+					return id, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "always taken case",
+			want: 0,
+			err:  conflict,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EmailVerifiedMock.Return(true, nil)
+				mock.SlugOwnerMock.Expect(ctx, "summer-fest").Return(0, pgx.ErrNoRows)
+				mock.InsertEventMock.Times(3).Return(0, conflict)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			event := &models.Event{
+				Title:         "Summer Fest",
+				BeginningTime: time.Date(2030, 1, 1, 18, 0, 0, 0, time.UTC),
+				EndTime:       time.Date(2030, 1, 1, 21, 0, 0, 0, time.UTC),
+				Location:      gofakeit.City(),
+				IsFree:        true,
+				CreatorID:     gofakeit.Int64(),
+			}
+
+			service := newEventsService(repositoryMock, nil)
+			id, err := service.CreateEvent(ctx, event)
+
+			require.Equal(t, tt.want, id)
+			require.Equal(t, tt.err, err)
+			if tt.check != nil {
+				tt.check(t, event.URLTitle)
+			}
+		})
+	}
+}
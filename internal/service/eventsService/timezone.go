@@ -0,0 +1,63 @@
+package eventsService
+
+import (
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+const defaultTimeZone = "UTC"
+
+// setEventTimes interprets wall clock of event's beginning and end time in its time zone
+// and converts them to UTC for storing
+func setEventTimes(event *models.Event) error {
+	loc, err := utils.LoadTimeZone(event.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	if !event.BeginningTime.IsZero() {
+		event.BeginningTime = utils.WallClockIn(event.BeginningTime, loc).UTC()
+	}
+	if !event.EndTime.IsZero() {
+		event.EndTime = utils.WallClockIn(event.EndTime, loc).UTC()
+	}
+
+	return nil
+}
+
+// updateEventTimes prepares times of updated event for storing. If only time zone is changed,
+// event keeps its local beginning and end time in the new zone
+func updateEventTimes(old *models.Event, event *models.Event) error {
+	if event.TimeZone == "" {
+		if event.BeginningTime.IsZero() && event.EndTime.IsZero() {
+			return nil
+		}
+
+		event.TimeZone = old.TimeZone
+	}
+
+	if event.TimeZone != old.TimeZone {
+		utils.LocalizeEventTimes(old)
+
+		if event.BeginningTime.IsZero() {
+			event.BeginningTime = old.BeginningTime
+		}
+		if event.EndTime.IsZero() {
+			event.EndTime = old.EndTime
+		}
+	}
+
+	return setEventTimes(event)
+}
+
+// eventLocation returns location of the event's time zone, or UTC if the zone is unknown
+func eventLocation(timeZone string) *time.Location {
+	loc, err := utils.LoadTimeZone(timeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
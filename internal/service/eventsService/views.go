@@ -0,0 +1,89 @@
+package eventsService
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+const (
+	viewsQueueSize     = 1024
+	viewsBatchSize     = 500
+	viewsFlushInterval = 10 * time.Second
+)
+
+// RecordView queues view of the event page without waiting for it to be stored.
+// Visitor is hashed together with the day, so raw identifiers never reach the database
+// and visitors can't be tracked across days. Views are dropped if the queue is full
+func (s *eventsServ) RecordView(event *models.Event, visitor string) {
+	if event.Status != models.EventStatusPublished {
+		return
+	}
+
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	hash := sha256.Sum256([]byte(s.authConfig.AccessTokenSecret() + "|" + day.Format(time.DateOnly) + "|" + visitor))
+
+	view := &models.EventView{
+		EventID: event.ID,
+		Visitor: hex.EncodeToString(hash[:]),
+		Day:     day,
+	}
+
+	select {
+	case s.viewsChan <- view:
+	default:
+		slog.Warn("views queue is full, view is dropped", slog.Int64("event_id", event.ID))
+	}
+}
+
+// runViewsRecorder stores queued views in batches, either when batch is full or periodically.
+// On shutdown views left in the queue are stored before viewsFlushed is closed
+func (s *eventsServ) runViewsRecorder() {
+	defer close(s.viewsFlushed)
+
+	ticker := time.NewTicker(viewsFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*models.EventView, 0, viewsBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		err := s.repo.InsertEventViews(context.Background(), batch)
+		if err != nil {
+			slog.Error("error inserting event views", slog.Any("error", err), slog.Int("count", len(batch)))
+		}
+
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case view := <-s.viewsChan:
+			batch = append(batch, view)
+			if len(batch) >= viewsBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.viewsDoneChan:
+			for {
+				select {
+				case view := <-s.viewsChan:
+					batch = append(batch, view)
+					if len(batch) >= viewsBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
@@ -19,6 +19,76 @@ type EventsServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddEventImages          func(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) (err error)
+	funcAddEventImagesOrigin    string
+	inspectFuncAddEventImages   func(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage)
+	afterAddEventImagesCounter  uint64
+	beforeAddEventImagesCounter uint64
+	AddEventImagesMock          mEventsServiceMockAddEventImages
+
+	funcAddQuestion          func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (i1 int64, err error)
+	funcAddQuestionOrigin    string
+	inspectFuncAddQuestion   func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion)
+	afterAddQuestionCounter  uint64
+	beforeAddQuestionCounter uint64
+	AddQuestionMock          mEventsServiceMockAddQuestion
+
+	funcAddReview          func(ctx context.Context, userID int64, urlTitle string, review *models.Review) (i1 int64, err error)
+	funcAddReviewOrigin    string
+	inspectFuncAddReview   func(ctx context.Context, userID int64, urlTitle string, review *models.Review)
+	afterAddReviewCounter  uint64
+	beforeAddReviewCounter uint64
+	AddReviewMock          mEventsServiceMockAddReview
+
+	funcAddSession          func(ctx context.Context, userID int64, urlTitle string, session *models.Session) (i1 int64, err error)
+	funcAddSessionOrigin    string
+	inspectFuncAddSession   func(ctx context.Context, userID int64, urlTitle string, session *models.Session)
+	afterAddSessionCounter  uint64
+	beforeAddSessionCounter uint64
+	AddSessionMock          mEventsServiceMockAddSession
+
+	funcAddSpeaker          func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (i1 int64, err error)
+	funcAddSpeakerOrigin    string
+	inspectFuncAddSpeaker   func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker)
+	afterAddSpeakerCounter  uint64
+	beforeAddSpeakerCounter uint64
+	AddSpeakerMock          mEventsServiceMockAddSpeaker
+
+	funcBookmarkEvent          func(ctx context.Context, userID int64, urlTitle string) (err error)
+	funcBookmarkEventOrigin    string
+	inspectFuncBookmarkEvent   func(ctx context.Context, userID int64, urlTitle string)
+	afterBookmarkEventCounter  uint64
+	beforeBookmarkEventCounter uint64
+	BookmarkEventMock          mEventsServiceMockBookmarkEvent
+
+	funcBookmarks          func(ctx context.Context, userID int64) (epa1 []*models.Event, err error)
+	funcBookmarksOrigin    string
+	inspectFuncBookmarks   func(ctx context.Context, userID int64)
+	afterBookmarksCounter  uint64
+	beforeBookmarksCounter uint64
+	BookmarksMock          mEventsServiceMockBookmarks
+
+	funcCancelEvent          func(ctx context.Context, userID int64, urlTitle string, reason string) (err error)
+	funcCancelEventOrigin    string
+	inspectFuncCancelEvent   func(ctx context.Context, userID int64, urlTitle string, reason string)
+	afterCancelEventCounter  uint64
+	beforeCancelEventCounter uint64
+	CancelEventMock          mEventsServiceMockCancelEvent
+
+	funcCancellationProgress          func(ctx context.Context, userID int64, urlTitle string) (cp1 *models.CancellationProgress, err error)
+	funcCancellationProgressOrigin    string
+	inspectFuncCancellationProgress   func(ctx context.Context, userID int64, urlTitle string)
+	afterCancellationProgressCounter  uint64
+	beforeCancellationProgressCounter uint64
+	CancellationProgressMock          mEventsServiceMockCancellationProgress
+
+	funcCloneEvent          func(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) (ep1 *models.Event, err error)
+	funcCloneEventOrigin    string
+	inspectFuncCloneEvent   func(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest)
+	afterCloneEventCounter  uint64
+	beforeCloneEventCounter uint64
+	CloneEventMock          mEventsServiceMockCloneEvent
+
 	funcCreateEvent          func(ctx context.Context, event *models.Event) (i1 int64, err error)
 	funcCreateEventOrigin    string
 	inspectFuncCreateEvent   func(ctx context.Context, event *models.Event)
@@ -26,6 +96,13 @@ type EventsServiceMock struct {
 	beforeCreateEventCounter uint64
 	CreateEventMock          mEventsServiceMockCreateEvent
 
+	funcDeleteBookmark          func(ctx context.Context, userID int64, urlTitle string) (err error)
+	funcDeleteBookmarkOrigin    string
+	inspectFuncDeleteBookmark   func(ctx context.Context, userID int64, urlTitle string)
+	afterDeleteBookmarkCounter  uint64
+	beforeDeleteBookmarkCounter uint64
+	DeleteBookmarkMock          mEventsServiceMockDeleteBookmark
+
 	funcDeleteEvent          func(ctx context.Context, userID int64, urlTitle string) (err error)
 	funcDeleteEventOrigin    string
 	inspectFuncDeleteEvent   func(ctx context.Context, userID int64, urlTitle string)
@@ -33,13 +110,69 @@ type EventsServiceMock struct {
 	beforeDeleteEventCounter uint64
 	DeleteEventMock          mEventsServiceMockDeleteEvent
 
-	funcEvent          func(ctx context.Context, urlTitle string) (ep1 *models.Event, err error)
+	funcDeleteEventImage          func(ctx context.Context, userID int64, urlTitle string, imageID int64) (err error)
+	funcDeleteEventImageOrigin    string
+	inspectFuncDeleteEventImage   func(ctx context.Context, userID int64, urlTitle string, imageID int64)
+	afterDeleteEventImageCounter  uint64
+	beforeDeleteEventImageCounter uint64
+	DeleteEventImageMock          mEventsServiceMockDeleteEventImage
+
+	funcDeleteEventMember          func(ctx context.Context, userID int64, urlTitle string, memberID int64) (err error)
+	funcDeleteEventMemberOrigin    string
+	inspectFuncDeleteEventMember   func(ctx context.Context, userID int64, urlTitle string, memberID int64)
+	afterDeleteEventMemberCounter  uint64
+	beforeDeleteEventMemberCounter uint64
+	DeleteEventMemberMock          mEventsServiceMockDeleteEventMember
+
+	funcDeleteQuestion          func(ctx context.Context, userID int64, urlTitle string, questionID int64) (err error)
+	funcDeleteQuestionOrigin    string
+	inspectFuncDeleteQuestion   func(ctx context.Context, userID int64, urlTitle string, questionID int64)
+	afterDeleteQuestionCounter  uint64
+	beforeDeleteQuestionCounter uint64
+	DeleteQuestionMock          mEventsServiceMockDeleteQuestion
+
+	funcDeleteSession          func(ctx context.Context, userID int64, urlTitle string, sessionID int64) (err error)
+	funcDeleteSessionOrigin    string
+	inspectFuncDeleteSession   func(ctx context.Context, userID int64, urlTitle string, sessionID int64)
+	afterDeleteSessionCounter  uint64
+	beforeDeleteSessionCounter uint64
+	DeleteSessionMock          mEventsServiceMockDeleteSession
+
+	funcDeleteSpeaker          func(ctx context.Context, userID int64, urlTitle string, speakerID int64) (err error)
+	funcDeleteSpeakerOrigin    string
+	inspectFuncDeleteSpeaker   func(ctx context.Context, userID int64, urlTitle string, speakerID int64)
+	afterDeleteSpeakerCounter  uint64
+	beforeDeleteSpeakerCounter uint64
+	DeleteSpeakerMock          mEventsServiceMockDeleteSpeaker
+
+	funcEvent          func(ctx context.Context, userID int64, urlTitle string) (ep1 *models.Event, err error)
 	funcEventOrigin    string
-	inspectFuncEvent   func(ctx context.Context, urlTitle string)
+	inspectFuncEvent   func(ctx context.Context, userID int64, urlTitle string)
 	afterEventCounter  uint64
 	beforeEventCounter uint64
 	EventMock          mEventsServiceMockEvent
 
+	funcEventHistory          func(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventRevision, err error)
+	funcEventHistoryOrigin    string
+	inspectFuncEventHistory   func(ctx context.Context, userID int64, urlTitle string)
+	afterEventHistoryCounter  uint64
+	beforeEventHistoryCounter uint64
+	EventHistoryMock          mEventsServiceMockEventHistory
+
+	funcEventMembers          func(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventMember, err error)
+	funcEventMembersOrigin    string
+	inspectFuncEventMembers   func(ctx context.Context, userID int64, urlTitle string)
+	afterEventMembersCounter  uint64
+	beforeEventMembersCounter uint64
+	EventMembersMock          mEventsServiceMockEventMembers
+
+	funcEventRedirect          func(ctx context.Context, urlTitle string) (s1 string, err error)
+	funcEventRedirectOrigin    string
+	inspectFuncEventRedirect   func(ctx context.Context, urlTitle string)
+	afterEventRedirectCounter  uint64
+	beforeEventRedirectCounter uint64
+	EventRedirectMock          mEventsServiceMockEventRedirect
+
 	funcEvents          func(ctx context.Context, page int) (epa1 []*models.Event, err error)
 	funcEventsOrigin    string
 	inspectFuncEvents   func(ctx context.Context, page int)
@@ -47,6 +180,76 @@ type EventsServiceMock struct {
 	beforeEventsCounter uint64
 	EventsMock          mEventsServiceMockEvents
 
+	funcEventsNear          func(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) (epa1 []*models.Event, err error)
+	funcEventsNearOrigin    string
+	inspectFuncEventsNear   func(ctx context.Context, lat float64, lng float64, radiusKm float64, page int)
+	afterEventsNearCounter  uint64
+	beforeEventsNearCounter uint64
+	EventsNearMock          mEventsServiceMockEventsNear
+
+	funcFeed          func(ctx context.Context, userID int64, page int) (epa1 []*models.Event, err error)
+	funcFeedOrigin    string
+	inspectFuncFeed   func(ctx context.Context, userID int64, page int)
+	afterFeedCounter  uint64
+	beforeFeedCounter uint64
+	FeedMock          mEventsServiceMockFeed
+
+	funcFollowOrganizer          func(ctx context.Context, userID int64, organizerID int64) (err error)
+	funcFollowOrganizerOrigin    string
+	inspectFuncFollowOrganizer   func(ctx context.Context, userID int64, organizerID int64)
+	afterFollowOrganizerCounter  uint64
+	beforeFollowOrganizerCounter uint64
+	FollowOrganizerMock          mEventsServiceMockFollowOrganizer
+
+	funcInviteEventMember          func(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) (i1 int64, err error)
+	funcInviteEventMemberOrigin    string
+	inspectFuncInviteEventMember   func(ctx context.Context, userID int64, urlTitle string, member *models.EventMember)
+	afterInviteEventMemberCounter  uint64
+	beforeInviteEventMemberCounter uint64
+	InviteEventMemberMock          mEventsServiceMockInviteEventMember
+
+	funcOrganizerProfile          func(ctx context.Context, handle string) (op1 *models.OrganizerProfile, err error)
+	funcOrganizerProfileOrigin    string
+	inspectFuncOrganizerProfile   func(ctx context.Context, handle string)
+	afterOrganizerProfileCounter  uint64
+	beforeOrganizerProfileCounter uint64
+	OrganizerProfileMock          mEventsServiceMockOrganizerProfile
+
+	funcRecordView          func(event *models.Event, visitor string)
+	funcRecordViewOrigin    string
+	inspectFuncRecordView   func(event *models.Event, visitor string)
+	afterRecordViewCounter  uint64
+	beforeRecordViewCounter uint64
+	RecordViewMock          mEventsServiceMockRecordView
+
+	funcReorderEventImages          func(ctx context.Context, userID int64, urlTitle string, ids []int64) (err error)
+	funcReorderEventImagesOrigin    string
+	inspectFuncReorderEventImages   func(ctx context.Context, userID int64, urlTitle string, ids []int64)
+	afterReorderEventImagesCounter  uint64
+	beforeReorderEventImagesCounter uint64
+	ReorderEventImagesMock          mEventsServiceMockReorderEventImages
+
+	funcReplyToReview          func(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) (err error)
+	funcReplyToReviewOrigin    string
+	inspectFuncReplyToReview   func(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string)
+	afterReplyToReviewCounter  uint64
+	beforeReplyToReviewCounter uint64
+	ReplyToReviewMock          mEventsServiceMockReplyToReview
+
+	funcReviews          func(ctx context.Context, urlTitle string) (rpa1 []*models.Review, err error)
+	funcReviewsOrigin    string
+	inspectFuncReviews   func(ctx context.Context, urlTitle string)
+	afterReviewsCounter  uint64
+	beforeReviewsCounter uint64
+	ReviewsMock          mEventsServiceMockReviews
+
+	funcUnfollowOrganizer          func(ctx context.Context, userID int64, organizerID int64) (err error)
+	funcUnfollowOrganizerOrigin    string
+	inspectFuncUnfollowOrganizer   func(ctx context.Context, userID int64, organizerID int64)
+	afterUnfollowOrganizerCounter  uint64
+	beforeUnfollowOrganizerCounter uint64
+	UnfollowOrganizerMock          mEventsServiceMockUnfollowOrganizer
+
 	funcUpdateEvent          func(ctx context.Context, userID int64, event *models.Event) (err error)
 	funcUpdateEventOrigin    string
 	inspectFuncUpdateEvent   func(ctx context.Context, userID int64, event *models.Event)
@@ -54,6 +257,48 @@ type EventsServiceMock struct {
 	beforeUpdateEventCounter uint64
 	UpdateEventMock          mEventsServiceMockUpdateEvent
 
+	funcUpdateEventImage          func(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) (err error)
+	funcUpdateEventImageOrigin    string
+	inspectFuncUpdateEventImage   func(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest)
+	afterUpdateEventImageCounter  uint64
+	beforeUpdateEventImageCounter uint64
+	UpdateEventImageMock          mEventsServiceMockUpdateEventImage
+
+	funcUpdateEventSlug          func(ctx context.Context, userID int64, urlTitle string, slug string) (s1 string, err error)
+	funcUpdateEventSlugOrigin    string
+	inspectFuncUpdateEventSlug   func(ctx context.Context, userID int64, urlTitle string, slug string)
+	afterUpdateEventSlugCounter  uint64
+	beforeUpdateEventSlugCounter uint64
+	UpdateEventSlugMock          mEventsServiceMockUpdateEventSlug
+
+	funcUpdateOrganizerProfile          func(ctx context.Context, userID int64, profile *models.OrganizerProfile) (op1 *models.OrganizerProfile, err error)
+	funcUpdateOrganizerProfileOrigin    string
+	inspectFuncUpdateOrganizerProfile   func(ctx context.Context, userID int64, profile *models.OrganizerProfile)
+	afterUpdateOrganizerProfileCounter  uint64
+	beforeUpdateOrganizerProfileCounter uint64
+	UpdateOrganizerProfileMock          mEventsServiceMockUpdateOrganizerProfile
+
+	funcUpdateQuestion          func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (err error)
+	funcUpdateQuestionOrigin    string
+	inspectFuncUpdateQuestion   func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion)
+	afterUpdateQuestionCounter  uint64
+	beforeUpdateQuestionCounter uint64
+	UpdateQuestionMock          mEventsServiceMockUpdateQuestion
+
+	funcUpdateSession          func(ctx context.Context, userID int64, urlTitle string, session *models.Session) (err error)
+	funcUpdateSessionOrigin    string
+	inspectFuncUpdateSession   func(ctx context.Context, userID int64, urlTitle string, session *models.Session)
+	afterUpdateSessionCounter  uint64
+	beforeUpdateSessionCounter uint64
+	UpdateSessionMock          mEventsServiceMockUpdateSession
+
+	funcUpdateSpeaker          func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (err error)
+	funcUpdateSpeakerOrigin    string
+	inspectFuncUpdateSpeaker   func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker)
+	afterUpdateSpeakerCounter  uint64
+	beforeUpdateSpeakerCounter uint64
+	UpdateSpeakerMock          mEventsServiceMockUpdateSpeaker
+
 	funcUserEvents          func(ctx context.Context, userID int64) (epa1 []*models.Event, err error)
 	funcUserEventsOrigin    string
 	inspectFuncUserEvents   func(ctx context.Context, userID int64)
@@ -70,21 +315,126 @@ func NewEventsServiceMock(t minimock.Tester) *EventsServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddEventImagesMock = mEventsServiceMockAddEventImages{mock: m}
+	m.AddEventImagesMock.callArgs = []*EventsServiceMockAddEventImagesParams{}
+
+	m.AddQuestionMock = mEventsServiceMockAddQuestion{mock: m}
+	m.AddQuestionMock.callArgs = []*EventsServiceMockAddQuestionParams{}
+
+	m.AddReviewMock = mEventsServiceMockAddReview{mock: m}
+	m.AddReviewMock.callArgs = []*EventsServiceMockAddReviewParams{}
+
+	m.AddSessionMock = mEventsServiceMockAddSession{mock: m}
+	m.AddSessionMock.callArgs = []*EventsServiceMockAddSessionParams{}
+
+	m.AddSpeakerMock = mEventsServiceMockAddSpeaker{mock: m}
+	m.AddSpeakerMock.callArgs = []*EventsServiceMockAddSpeakerParams{}
+
+	m.BookmarkEventMock = mEventsServiceMockBookmarkEvent{mock: m}
+	m.BookmarkEventMock.callArgs = []*EventsServiceMockBookmarkEventParams{}
+
+	m.BookmarksMock = mEventsServiceMockBookmarks{mock: m}
+	m.BookmarksMock.callArgs = []*EventsServiceMockBookmarksParams{}
+
+	m.CancelEventMock = mEventsServiceMockCancelEvent{mock: m}
+	m.CancelEventMock.callArgs = []*EventsServiceMockCancelEventParams{}
+
+	m.CancellationProgressMock = mEventsServiceMockCancellationProgress{mock: m}
+	m.CancellationProgressMock.callArgs = []*EventsServiceMockCancellationProgressParams{}
+
+	m.CloneEventMock = mEventsServiceMockCloneEvent{mock: m}
+	m.CloneEventMock.callArgs = []*EventsServiceMockCloneEventParams{}
+
 	m.CreateEventMock = mEventsServiceMockCreateEvent{mock: m}
 	m.CreateEventMock.callArgs = []*EventsServiceMockCreateEventParams{}
 
+	m.DeleteBookmarkMock = mEventsServiceMockDeleteBookmark{mock: m}
+	m.DeleteBookmarkMock.callArgs = []*EventsServiceMockDeleteBookmarkParams{}
+
 	m.DeleteEventMock = mEventsServiceMockDeleteEvent{mock: m}
 	m.DeleteEventMock.callArgs = []*EventsServiceMockDeleteEventParams{}
 
+	m.DeleteEventImageMock = mEventsServiceMockDeleteEventImage{mock: m}
+	m.DeleteEventImageMock.callArgs = []*EventsServiceMockDeleteEventImageParams{}
+
+	m.DeleteEventMemberMock = mEventsServiceMockDeleteEventMember{mock: m}
+	m.DeleteEventMemberMock.callArgs = []*EventsServiceMockDeleteEventMemberParams{}
+
+	m.DeleteQuestionMock = mEventsServiceMockDeleteQuestion{mock: m}
+	m.DeleteQuestionMock.callArgs = []*EventsServiceMockDeleteQuestionParams{}
+
+	m.DeleteSessionMock = mEventsServiceMockDeleteSession{mock: m}
+	m.DeleteSessionMock.callArgs = []*EventsServiceMockDeleteSessionParams{}
+
+	m.DeleteSpeakerMock = mEventsServiceMockDeleteSpeaker{mock: m}
+	m.DeleteSpeakerMock.callArgs = []*EventsServiceMockDeleteSpeakerParams{}
+
 	m.EventMock = mEventsServiceMockEvent{mock: m}
 	m.EventMock.callArgs = []*EventsServiceMockEventParams{}
 
+	m.EventHistoryMock = mEventsServiceMockEventHistory{mock: m}
+	m.EventHistoryMock.callArgs = []*EventsServiceMockEventHistoryParams{}
+
+	m.EventMembersMock = mEventsServiceMockEventMembers{mock: m}
+	m.EventMembersMock.callArgs = []*EventsServiceMockEventMembersParams{}
+
+	m.EventRedirectMock = mEventsServiceMockEventRedirect{mock: m}
+	m.EventRedirectMock.callArgs = []*EventsServiceMockEventRedirectParams{}
+
 	m.EventsMock = mEventsServiceMockEvents{mock: m}
 	m.EventsMock.callArgs = []*EventsServiceMockEventsParams{}
 
+	m.EventsNearMock = mEventsServiceMockEventsNear{mock: m}
+	m.EventsNearMock.callArgs = []*EventsServiceMockEventsNearParams{}
+
+	m.FeedMock = mEventsServiceMockFeed{mock: m}
+	m.FeedMock.callArgs = []*EventsServiceMockFeedParams{}
+
+	m.FollowOrganizerMock = mEventsServiceMockFollowOrganizer{mock: m}
+	m.FollowOrganizerMock.callArgs = []*EventsServiceMockFollowOrganizerParams{}
+
+	m.InviteEventMemberMock = mEventsServiceMockInviteEventMember{mock: m}
+	m.InviteEventMemberMock.callArgs = []*EventsServiceMockInviteEventMemberParams{}
+
+	m.OrganizerProfileMock = mEventsServiceMockOrganizerProfile{mock: m}
+	m.OrganizerProfileMock.callArgs = []*EventsServiceMockOrganizerProfileParams{}
+
+	m.RecordViewMock = mEventsServiceMockRecordView{mock: m}
+	m.RecordViewMock.callArgs = []*EventsServiceMockRecordViewParams{}
+
+	m.ReorderEventImagesMock = mEventsServiceMockReorderEventImages{mock: m}
+	m.ReorderEventImagesMock.callArgs = []*EventsServiceMockReorderEventImagesParams{}
+
+	m.ReplyToReviewMock = mEventsServiceMockReplyToReview{mock: m}
+	m.ReplyToReviewMock.callArgs = []*EventsServiceMockReplyToReviewParams{}
+
+	m.ReviewsMock = mEventsServiceMockReviews{mock: m}
+	m.ReviewsMock.callArgs = []*EventsServiceMockReviewsParams{}
+
+	m.UnfollowOrganizerMock = mEventsServiceMockUnfollowOrganizer{mock: m}
+	m.UnfollowOrganizerMock.callArgs = []*EventsServiceMockUnfollowOrganizerParams{}
+
 	m.UpdateEventMock = mEventsServiceMockUpdateEvent{mock: m}
 	m.UpdateEventMock.callArgs = []*EventsServiceMockUpdateEventParams{}
 
+	m.UpdateEventImageMock = mEventsServiceMockUpdateEventImage{mock: m}
+	m.UpdateEventImageMock.callArgs = []*EventsServiceMockUpdateEventImageParams{}
+
+	m.UpdateEventSlugMock = mEventsServiceMockUpdateEventSlug{mock: m}
+	m.UpdateEventSlugMock.callArgs = []*EventsServiceMockUpdateEventSlugParams{}
+
+	m.UpdateOrganizerProfileMock = mEventsServiceMockUpdateOrganizerProfile{mock: m}
+	m.UpdateOrganizerProfileMock.callArgs = []*EventsServiceMockUpdateOrganizerProfileParams{}
+
+	m.UpdateQuestionMock = mEventsServiceMockUpdateQuestion{mock: m}
+	m.UpdateQuestionMock.callArgs = []*EventsServiceMockUpdateQuestionParams{}
+
+	m.UpdateSessionMock = mEventsServiceMockUpdateSession{mock: m}
+	m.UpdateSessionMock.callArgs = []*EventsServiceMockUpdateSessionParams{}
+
+	m.UpdateSpeakerMock = mEventsServiceMockUpdateSpeaker{mock: m}
+	m.UpdateSpeakerMock.callArgs = []*EventsServiceMockUpdateSpeakerParams{}
+
 	m.UserEventsMock = mEventsServiceMockUserEvents{mock: m}
 	m.UserEventsMock.callArgs = []*EventsServiceMockUserEventsParams{}
 
@@ -93,53 +443,58 @@ func NewEventsServiceMock(t minimock.Tester) *EventsServiceMock {
 	return m
 }
 
-type mEventsServiceMockCreateEvent struct {
+type mEventsServiceMockAddEventImages struct {
 	optional           bool
 	mock               *EventsServiceMock
-	defaultExpectation *EventsServiceMockCreateEventExpectation
-	expectations       []*EventsServiceMockCreateEventExpectation
+	defaultExpectation *EventsServiceMockAddEventImagesExpectation
+	expectations       []*EventsServiceMockAddEventImagesExpectation
 
-	callArgs []*EventsServiceMockCreateEventParams
+	callArgs []*EventsServiceMockAddEventImagesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// EventsServiceMockCreateEventExpectation specifies expectation struct of the EventsService.CreateEvent
-type EventsServiceMockCreateEventExpectation struct {
+// EventsServiceMockAddEventImagesExpectation specifies expectation struct of the EventsService.AddEventImages
+type EventsServiceMockAddEventImagesExpectation struct {
 	mock               *EventsServiceMock
-	params             *EventsServiceMockCreateEventParams
-	paramPtrs          *EventsServiceMockCreateEventParamPtrs
-	expectationOrigins EventsServiceMockCreateEventExpectationOrigins
-	results            *EventsServiceMockCreateEventResults
+	params             *EventsServiceMockAddEventImagesParams
+	paramPtrs          *EventsServiceMockAddEventImagesParamPtrs
+	expectationOrigins EventsServiceMockAddEventImagesExpectationOrigins
+	results            *EventsServiceMockAddEventImagesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// EventsServiceMockCreateEventParams contains parameters of the EventsService.CreateEvent
-type EventsServiceMockCreateEventParams struct {
-	ctx   context.Context
-	event *models.Event
+// EventsServiceMockAddEventImagesParams contains parameters of the EventsService.AddEventImages
+type EventsServiceMockAddEventImagesParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	images   []*models.EventImage
 }
 
-// EventsServiceMockCreateEventParamPtrs contains pointers to parameters of the EventsService.CreateEvent
-type EventsServiceMockCreateEventParamPtrs struct {
-	ctx   *context.Context
-	event **models.Event
+// EventsServiceMockAddEventImagesParamPtrs contains pointers to parameters of the EventsService.AddEventImages
+type EventsServiceMockAddEventImagesParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	images   *[]*models.EventImage
 }
 
-// EventsServiceMockCreateEventResults contains results of the EventsService.CreateEvent
-type EventsServiceMockCreateEventResults struct {
-	i1  int64
+// EventsServiceMockAddEventImagesResults contains results of the EventsService.AddEventImages
+type EventsServiceMockAddEventImagesResults struct {
 	err error
 }
 
-// EventsServiceMockCreateEventOrigins contains origins of expectations of the EventsService.CreateEvent
-type EventsServiceMockCreateEventExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originEvent string
+// EventsServiceMockAddEventImagesOrigins contains origins of expectations of the EventsService.AddEventImages
+type EventsServiceMockAddEventImagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originImages   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -147,344 +502,404 @@ type EventsServiceMockCreateEventExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Optional() *mEventsServiceMockCreateEvent {
-	mmCreateEvent.optional = true
-	return mmCreateEvent
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Optional() *mEventsServiceMockAddEventImages {
+	mmAddEventImages.optional = true
+	return mmAddEventImages
 }
 
-// Expect sets up expected params for EventsService.CreateEvent
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Expect(ctx context.Context, event *models.Event) *mEventsServiceMockCreateEvent {
-	if mmCreateEvent.mock.funcCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+// Expect sets up expected params for EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Expect(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	if mmCreateEvent.defaultExpectation == nil {
-		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{}
 	}
 
-	if mmCreateEvent.defaultExpectation.paramPtrs != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by ExpectParams functions")
+	if mmAddEventImages.defaultExpectation.paramPtrs != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by ExpectParams functions")
 	}
 
-	mmCreateEvent.defaultExpectation.params = &EventsServiceMockCreateEventParams{ctx, event}
-	mmCreateEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateEvent.expectations {
-		if minimock.Equal(e.params, mmCreateEvent.defaultExpectation.params) {
-			mmCreateEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateEvent.defaultExpectation.params)
+	mmAddEventImages.defaultExpectation.params = &EventsServiceMockAddEventImagesParams{ctx, userID, urlTitle, images}
+	mmAddEventImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddEventImages.expectations {
+		if minimock.Equal(e.params, mmAddEventImages.defaultExpectation.params) {
+			mmAddEventImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddEventImages.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateEvent
+	return mmAddEventImages
 }
 
-// ExpectCtxParam1 sets up expected param ctx for EventsService.CreateEvent
-func (mmCreateEvent *mEventsServiceMockCreateEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockCreateEvent {
-	if mmCreateEvent.mock.funcCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	if mmCreateEvent.defaultExpectation == nil {
-		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{}
 	}
 
-	if mmCreateEvent.defaultExpectation.params != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Expect")
+	if mmAddEventImages.defaultExpectation.params != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Expect")
 	}
 
-	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
-		mmCreateEvent.defaultExpectation.paramPtrs = &EventsServiceMockCreateEventParamPtrs{}
+	if mmAddEventImages.defaultExpectation.paramPtrs == nil {
+		mmAddEventImages.defaultExpectation.paramPtrs = &EventsServiceMockAddEventImagesParamPtrs{}
 	}
-	mmCreateEvent.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddEventImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddEventImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateEvent
+	return mmAddEventImages
 }
 
-// ExpectEventParam2 sets up expected param event for EventsService.CreateEvent
-func (mmCreateEvent *mEventsServiceMockCreateEvent) ExpectEventParam2(event *models.Event) *mEventsServiceMockCreateEvent {
-	if mmCreateEvent.mock.funcCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) ExpectUserIDParam2(userID int64) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	if mmCreateEvent.defaultExpectation == nil {
-		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{}
 	}
 
-	if mmCreateEvent.defaultExpectation.params != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Expect")
+	if mmAddEventImages.defaultExpectation.params != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Expect")
 	}
 
-	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
-		mmCreateEvent.defaultExpectation.paramPtrs = &EventsServiceMockCreateEventParamPtrs{}
+	if mmAddEventImages.defaultExpectation.paramPtrs == nil {
+		mmAddEventImages.defaultExpectation.paramPtrs = &EventsServiceMockAddEventImagesParamPtrs{}
 	}
-	mmCreateEvent.defaultExpectation.paramPtrs.event = &event
-	mmCreateEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+	mmAddEventImages.defaultExpectation.paramPtrs.userID = &userID
+	mmAddEventImages.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmCreateEvent
+	return mmAddEventImages
 }
 
-// Inspect accepts an inspector function that has same arguments as the EventsService.CreateEvent
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Inspect(f func(ctx context.Context, event *models.Event)) *mEventsServiceMockCreateEvent {
-	if mmCreateEvent.mock.inspectFuncCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.CreateEvent")
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	mmCreateEvent.mock.inspectFuncCreateEvent = f
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{}
+	}
 
-	return mmCreateEvent
+	if mmAddEventImages.defaultExpectation.params != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Expect")
+	}
+
+	if mmAddEventImages.defaultExpectation.paramPtrs == nil {
+		mmAddEventImages.defaultExpectation.paramPtrs = &EventsServiceMockAddEventImagesParamPtrs{}
+	}
+	mmAddEventImages.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAddEventImages.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmAddEventImages
 }
 
-// Return sets up results that will be returned by EventsService.CreateEvent
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Return(i1 int64, err error) *EventsServiceMock {
-	if mmCreateEvent.mock.funcCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+// ExpectImagesParam4 sets up expected param images for EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) ExpectImagesParam4(images []*models.EventImage) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	if mmCreateEvent.defaultExpectation == nil {
-		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{mock: mmCreateEvent.mock}
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{}
 	}
-	mmCreateEvent.defaultExpectation.results = &EventsServiceMockCreateEventResults{i1, err}
-	mmCreateEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateEvent.mock
+
+	if mmAddEventImages.defaultExpectation.params != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Expect")
+	}
+
+	if mmAddEventImages.defaultExpectation.paramPtrs == nil {
+		mmAddEventImages.defaultExpectation.paramPtrs = &EventsServiceMockAddEventImagesParamPtrs{}
+	}
+	mmAddEventImages.defaultExpectation.paramPtrs.images = &images
+	mmAddEventImages.defaultExpectation.expectationOrigins.originImages = minimock.CallerInfo(1)
+
+	return mmAddEventImages
 }
 
-// Set uses given function f to mock the EventsService.CreateEvent method
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Set(f func(ctx context.Context, event *models.Event) (i1 int64, err error)) *EventsServiceMock {
-	if mmCreateEvent.defaultExpectation != nil {
-		mmCreateEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.CreateEvent method")
+// Inspect accepts an inspector function that has same arguments as the EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Inspect(f func(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage)) *mEventsServiceMockAddEventImages {
+	if mmAddEventImages.mock.inspectFuncAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.AddEventImages")
 	}
 
-	if len(mmCreateEvent.expectations) > 0 {
-		mmCreateEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.CreateEvent method")
+	mmAddEventImages.mock.inspectFuncAddEventImages = f
+
+	return mmAddEventImages
+}
+
+// Return sets up results that will be returned by EventsService.AddEventImages
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Return(err error) *EventsServiceMock {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	mmCreateEvent.mock.funcCreateEvent = f
-	mmCreateEvent.mock.funcCreateEventOrigin = minimock.CallerInfo(1)
-	return mmCreateEvent.mock
+	if mmAddEventImages.defaultExpectation == nil {
+		mmAddEventImages.defaultExpectation = &EventsServiceMockAddEventImagesExpectation{mock: mmAddEventImages.mock}
+	}
+	mmAddEventImages.defaultExpectation.results = &EventsServiceMockAddEventImagesResults{err}
+	mmAddEventImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddEventImages.mock
 }
 
-// When sets expectation for the EventsService.CreateEvent which will trigger the result defined by the following
+// Set uses given function f to mock the EventsService.AddEventImages method
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Set(f func(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) (err error)) *EventsServiceMock {
+	if mmAddEventImages.defaultExpectation != nil {
+		mmAddEventImages.mock.t.Fatalf("Default expectation is already set for the EventsService.AddEventImages method")
+	}
+
+	if len(mmAddEventImages.expectations) > 0 {
+		mmAddEventImages.mock.t.Fatalf("Some expectations are already set for the EventsService.AddEventImages method")
+	}
+
+	mmAddEventImages.mock.funcAddEventImages = f
+	mmAddEventImages.mock.funcAddEventImagesOrigin = minimock.CallerInfo(1)
+	return mmAddEventImages.mock
+}
+
+// When sets expectation for the EventsService.AddEventImages which will trigger the result defined by the following
 // Then helper
-func (mmCreateEvent *mEventsServiceMockCreateEvent) When(ctx context.Context, event *models.Event) *EventsServiceMockCreateEventExpectation {
-	if mmCreateEvent.mock.funcCreateEvent != nil {
-		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+func (mmAddEventImages *mEventsServiceMockAddEventImages) When(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) *EventsServiceMockAddEventImagesExpectation {
+	if mmAddEventImages.mock.funcAddEventImages != nil {
+		mmAddEventImages.mock.t.Fatalf("EventsServiceMock.AddEventImages mock is already set by Set")
 	}
 
-	expectation := &EventsServiceMockCreateEventExpectation{
-		mock:               mmCreateEvent.mock,
-		params:             &EventsServiceMockCreateEventParams{ctx, event},
-		expectationOrigins: EventsServiceMockCreateEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &EventsServiceMockAddEventImagesExpectation{
+		mock:               mmAddEventImages.mock,
+		params:             &EventsServiceMockAddEventImagesParams{ctx, userID, urlTitle, images},
+		expectationOrigins: EventsServiceMockAddEventImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateEvent.expectations = append(mmCreateEvent.expectations, expectation)
+	mmAddEventImages.expectations = append(mmAddEventImages.expectations, expectation)
 	return expectation
 }
 
-// Then sets up EventsService.CreateEvent return parameters for the expectation previously defined by the When method
-func (e *EventsServiceMockCreateEventExpectation) Then(i1 int64, err error) *EventsServiceMock {
-	e.results = &EventsServiceMockCreateEventResults{i1, err}
+// Then sets up EventsService.AddEventImages return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockAddEventImagesExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockAddEventImagesResults{err}
 	return e.mock
 }
 
-// Times sets number of times EventsService.CreateEvent should be invoked
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Times(n uint64) *mEventsServiceMockCreateEvent {
+// Times sets number of times EventsService.AddEventImages should be invoked
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Times(n uint64) *mEventsServiceMockAddEventImages {
 	if n == 0 {
-		mmCreateEvent.mock.t.Fatalf("Times of EventsServiceMock.CreateEvent mock can not be zero")
+		mmAddEventImages.mock.t.Fatalf("Times of EventsServiceMock.AddEventImages mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateEvent.expectedInvocations, n)
-	mmCreateEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateEvent
+	mm_atomic.StoreUint64(&mmAddEventImages.expectedInvocations, n)
+	mmAddEventImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddEventImages
 }
 
-func (mmCreateEvent *mEventsServiceMockCreateEvent) invocationsDone() bool {
-	if len(mmCreateEvent.expectations) == 0 && mmCreateEvent.defaultExpectation == nil && mmCreateEvent.mock.funcCreateEvent == nil {
+func (mmAddEventImages *mEventsServiceMockAddEventImages) invocationsDone() bool {
+	if len(mmAddEventImages.expectations) == 0 && mmAddEventImages.defaultExpectation == nil && mmAddEventImages.mock.funcAddEventImages == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateEvent.mock.afterCreateEventCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateEvent.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddEventImages.mock.afterAddEventImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddEventImages.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateEvent implements mm_service.EventsService
-func (mmCreateEvent *EventsServiceMock) CreateEvent(ctx context.Context, event *models.Event) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateEvent.beforeCreateEventCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateEvent.afterCreateEventCounter, 1)
+// AddEventImages implements mm_service.EventsService
+func (mmAddEventImages *EventsServiceMock) AddEventImages(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) (err error) {
+	mm_atomic.AddUint64(&mmAddEventImages.beforeAddEventImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddEventImages.afterAddEventImagesCounter, 1)
 
-	mmCreateEvent.t.Helper()
+	mmAddEventImages.t.Helper()
 
-	if mmCreateEvent.inspectFuncCreateEvent != nil {
-		mmCreateEvent.inspectFuncCreateEvent(ctx, event)
+	if mmAddEventImages.inspectFuncAddEventImages != nil {
+		mmAddEventImages.inspectFuncAddEventImages(ctx, userID, urlTitle, images)
 	}
 
-	mm_params := EventsServiceMockCreateEventParams{ctx, event}
+	mm_params := EventsServiceMockAddEventImagesParams{ctx, userID, urlTitle, images}
 
 	// Record call args
-	mmCreateEvent.CreateEventMock.mutex.Lock()
-	mmCreateEvent.CreateEventMock.callArgs = append(mmCreateEvent.CreateEventMock.callArgs, &mm_params)
-	mmCreateEvent.CreateEventMock.mutex.Unlock()
+	mmAddEventImages.AddEventImagesMock.mutex.Lock()
+	mmAddEventImages.AddEventImagesMock.callArgs = append(mmAddEventImages.AddEventImagesMock.callArgs, &mm_params)
+	mmAddEventImages.AddEventImagesMock.mutex.Unlock()
 
-	for _, e := range mmCreateEvent.CreateEventMock.expectations {
+	for _, e := range mmAddEventImages.AddEventImagesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateEvent.CreateEventMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateEvent.CreateEventMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateEvent.CreateEventMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateEvent.CreateEventMock.defaultExpectation.paramPtrs
+	if mmAddEventImages.AddEventImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddEventImages.AddEventImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddEventImages.AddEventImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddEventImages.AddEventImagesMock.defaultExpectation.paramPtrs
 
-		mm_got := EventsServiceMockCreateEventParams{ctx, event}
+		mm_got := EventsServiceMockAddEventImagesParams{ctx, userID, urlTitle, images}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddEventImages.t.Errorf("EventsServiceMock.AddEventImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEventImages.AddEventImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
-				mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddEventImages.t.Errorf("EventsServiceMock.AddEventImages got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEventImages.AddEventImagesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmAddEventImages.t.Errorf("EventsServiceMock.AddEventImages got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEventImages.AddEventImagesMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.images != nil && !minimock.Equal(*mm_want_ptrs.images, mm_got.images) {
+				mmAddEventImages.t.Errorf("EventsServiceMock.AddEventImages got unexpected parameter images, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddEventImages.AddEventImagesMock.defaultExpectation.expectationOrigins.originImages, *mm_want_ptrs.images, mm_got.images, minimock.Diff(*mm_want_ptrs.images, mm_got.images))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddEventImages.t.Errorf("EventsServiceMock.AddEventImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddEventImages.AddEventImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateEvent.CreateEventMock.defaultExpectation.results
+		mm_results := mmAddEventImages.AddEventImagesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateEvent.t.Fatal("No results are set for the EventsServiceMock.CreateEvent")
+			mmAddEventImages.t.Fatal("No results are set for the EventsServiceMock.AddEventImages")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateEvent.funcCreateEvent != nil {
-		return mmCreateEvent.funcCreateEvent(ctx, event)
+	if mmAddEventImages.funcAddEventImages != nil {
+		return mmAddEventImages.funcAddEventImages(ctx, userID, urlTitle, images)
 	}
-	mmCreateEvent.t.Fatalf("Unexpected call to EventsServiceMock.CreateEvent. %v %v", ctx, event)
+	mmAddEventImages.t.Fatalf("Unexpected call to EventsServiceMock.AddEventImages. %v %v %v %v", ctx, userID, urlTitle, images)
 	return
 }
 
-// CreateEventAfterCounter returns a count of finished EventsServiceMock.CreateEvent invocations
-func (mmCreateEvent *EventsServiceMock) CreateEventAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateEvent.afterCreateEventCounter)
+// AddEventImagesAfterCounter returns a count of finished EventsServiceMock.AddEventImages invocations
+func (mmAddEventImages *EventsServiceMock) AddEventImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEventImages.afterAddEventImagesCounter)
 }
 
-// CreateEventBeforeCounter returns a count of EventsServiceMock.CreateEvent invocations
-func (mmCreateEvent *EventsServiceMock) CreateEventBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateEvent.beforeCreateEventCounter)
+// AddEventImagesBeforeCounter returns a count of EventsServiceMock.AddEventImages invocations
+func (mmAddEventImages *EventsServiceMock) AddEventImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddEventImages.beforeAddEventImagesCounter)
 }
 
-// Calls returns a list of arguments used in each call to EventsServiceMock.CreateEvent.
+// Calls returns a list of arguments used in each call to EventsServiceMock.AddEventImages.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateEvent *mEventsServiceMockCreateEvent) Calls() []*EventsServiceMockCreateEventParams {
-	mmCreateEvent.mutex.RLock()
+func (mmAddEventImages *mEventsServiceMockAddEventImages) Calls() []*EventsServiceMockAddEventImagesParams {
+	mmAddEventImages.mutex.RLock()
 
-	argCopy := make([]*EventsServiceMockCreateEventParams, len(mmCreateEvent.callArgs))
-	copy(argCopy, mmCreateEvent.callArgs)
+	argCopy := make([]*EventsServiceMockAddEventImagesParams, len(mmAddEventImages.callArgs))
+	copy(argCopy, mmAddEventImages.callArgs)
 
-	mmCreateEvent.mutex.RUnlock()
+	mmAddEventImages.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateEventDone returns true if the count of the CreateEvent invocations corresponds
+// MinimockAddEventImagesDone returns true if the count of the AddEventImages invocations corresponds
 // the number of defined expectations
-func (m *EventsServiceMock) MinimockCreateEventDone() bool {
-	if m.CreateEventMock.optional {
+func (m *EventsServiceMock) MinimockAddEventImagesDone() bool {
+	if m.AddEventImagesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateEventMock.expectations {
+	for _, e := range m.AddEventImagesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateEventMock.invocationsDone()
+	return m.AddEventImagesMock.invocationsDone()
 }
 
-// MinimockCreateEventInspect logs each unmet expectation
-func (m *EventsServiceMock) MinimockCreateEventInspect() {
-	for _, e := range m.CreateEventMock.expectations {
+// MinimockAddEventImagesInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockAddEventImagesInspect() {
+	for _, e := range m.AddEventImagesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to EventsServiceMock.AddEventImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateEventCounter := mm_atomic.LoadUint64(&m.afterCreateEventCounter)
+	afterAddEventImagesCounter := mm_atomic.LoadUint64(&m.afterAddEventImagesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateEventMock.defaultExpectation != nil && afterCreateEventCounter < 1 {
-		if m.CreateEventMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s", m.CreateEventMock.defaultExpectation.returnOrigin)
+	if m.AddEventImagesMock.defaultExpectation != nil && afterAddEventImagesCounter < 1 {
+		if m.AddEventImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.AddEventImages at\n%s", m.AddEventImagesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s with params: %#v", m.CreateEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateEventMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to EventsServiceMock.AddEventImages at\n%s with params: %#v", m.AddEventImagesMock.defaultExpectation.expectationOrigins.origin, *m.AddEventImagesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateEvent != nil && afterCreateEventCounter < 1 {
-		m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s", m.funcCreateEventOrigin)
+	if m.funcAddEventImages != nil && afterAddEventImagesCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.AddEventImages at\n%s", m.funcAddEventImagesOrigin)
 	}
 
-	if !m.CreateEventMock.invocationsDone() && afterCreateEventCounter > 0 {
-		m.t.Errorf("Expected %d calls to EventsServiceMock.CreateEvent at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateEventMock.expectedInvocations), m.CreateEventMock.expectedInvocationsOrigin, afterCreateEventCounter)
+	if !m.AddEventImagesMock.invocationsDone() && afterAddEventImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.AddEventImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddEventImagesMock.expectedInvocations), m.AddEventImagesMock.expectedInvocationsOrigin, afterAddEventImagesCounter)
 	}
 }
 
-type mEventsServiceMockDeleteEvent struct {
+type mEventsServiceMockAddQuestion struct {
 	optional           bool
 	mock               *EventsServiceMock
-	defaultExpectation *EventsServiceMockDeleteEventExpectation
-	expectations       []*EventsServiceMockDeleteEventExpectation
+	defaultExpectation *EventsServiceMockAddQuestionExpectation
+	expectations       []*EventsServiceMockAddQuestionExpectation
 
-	callArgs []*EventsServiceMockDeleteEventParams
+	callArgs []*EventsServiceMockAddQuestionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// EventsServiceMockDeleteEventExpectation specifies expectation struct of the EventsService.DeleteEvent
-type EventsServiceMockDeleteEventExpectation struct {
+// EventsServiceMockAddQuestionExpectation specifies expectation struct of the EventsService.AddQuestion
+type EventsServiceMockAddQuestionExpectation struct {
 	mock               *EventsServiceMock
-	params             *EventsServiceMockDeleteEventParams
-	paramPtrs          *EventsServiceMockDeleteEventParamPtrs
-	expectationOrigins EventsServiceMockDeleteEventExpectationOrigins
-	results            *EventsServiceMockDeleteEventResults
+	params             *EventsServiceMockAddQuestionParams
+	paramPtrs          *EventsServiceMockAddQuestionParamPtrs
+	expectationOrigins EventsServiceMockAddQuestionExpectationOrigins
+	results            *EventsServiceMockAddQuestionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// EventsServiceMockDeleteEventParams contains parameters of the EventsService.DeleteEvent
-type EventsServiceMockDeleteEventParams struct {
+// EventsServiceMockAddQuestionParams contains parameters of the EventsService.AddQuestion
+type EventsServiceMockAddQuestionParams struct {
 	ctx      context.Context
 	userID   int64
 	urlTitle string
+	question *models.EventQuestion
 }
 
-// EventsServiceMockDeleteEventParamPtrs contains pointers to parameters of the EventsService.DeleteEvent
-type EventsServiceMockDeleteEventParamPtrs struct {
+// EventsServiceMockAddQuestionParamPtrs contains pointers to parameters of the EventsService.AddQuestion
+type EventsServiceMockAddQuestionParamPtrs struct {
 	ctx      *context.Context
 	userID   *int64
 	urlTitle *string
+	question **models.EventQuestion
 }
 
-// EventsServiceMockDeleteEventResults contains results of the EventsService.DeleteEvent
-type EventsServiceMockDeleteEventResults struct {
+// EventsServiceMockAddQuestionResults contains results of the EventsService.AddQuestion
+type EventsServiceMockAddQuestionResults struct {
+	i1  int64
 	err error
 }
 
-// EventsServiceMockDeleteEventOrigins contains origins of expectations of the EventsService.DeleteEvent
-type EventsServiceMockDeleteEventExpectationOrigins struct {
+// EventsServiceMockAddQuestionOrigins contains origins of expectations of the EventsService.AddQuestion
+type EventsServiceMockAddQuestionExpectationOrigins struct {
 	origin         string
 	originCtx      string
 	originUserID   string
 	originUrlTitle string
+	originQuestion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -492,370 +907,404 @@ type EventsServiceMockDeleteEventExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Optional() *mEventsServiceMockDeleteEvent {
-	mmDeleteEvent.optional = true
-	return mmDeleteEvent
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Optional() *mEventsServiceMockAddQuestion {
+	mmAddQuestion.optional = true
+	return mmAddQuestion
 }
 
-// Expect sets up expected params for EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockDeleteEvent {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+// Expect sets up expected params for EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Expect(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	if mmDeleteEvent.defaultExpectation == nil {
-		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{}
 	}
 
-	if mmDeleteEvent.defaultExpectation.paramPtrs != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by ExpectParams functions")
+	if mmAddQuestion.defaultExpectation.paramPtrs != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteEvent.defaultExpectation.params = &EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
-	mmDeleteEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteEvent.expectations {
-		if minimock.Equal(e.params, mmDeleteEvent.defaultExpectation.params) {
-			mmDeleteEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEvent.defaultExpectation.params)
+	mmAddQuestion.defaultExpectation.params = &EventsServiceMockAddQuestionParams{ctx, userID, urlTitle, question}
+	mmAddQuestion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddQuestion.expectations {
+		if minimock.Equal(e.params, mmAddQuestion.defaultExpectation.params) {
+			mmAddQuestion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddQuestion.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteEvent
+	return mmAddQuestion
 }
 
-// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteEvent {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	if mmDeleteEvent.defaultExpectation == nil {
-		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{}
 	}
 
-	if mmDeleteEvent.defaultExpectation.params != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	if mmAddQuestion.defaultExpectation.params != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Expect")
 	}
 
-	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
-		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	if mmAddQuestion.defaultExpectation.paramPtrs == nil {
+		mmAddQuestion.defaultExpectation.paramPtrs = &EventsServiceMockAddQuestionParamPtrs{}
 	}
-	mmDeleteEvent.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddQuestion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddQuestion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteEvent
+	return mmAddQuestion
 }
 
-// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteEvent {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) ExpectUserIDParam2(userID int64) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	if mmDeleteEvent.defaultExpectation == nil {
-		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{}
 	}
 
-	if mmDeleteEvent.defaultExpectation.params != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	if mmAddQuestion.defaultExpectation.params != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Expect")
 	}
 
-	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
-		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	if mmAddQuestion.defaultExpectation.paramPtrs == nil {
+		mmAddQuestion.defaultExpectation.paramPtrs = &EventsServiceMockAddQuestionParamPtrs{}
 	}
-	mmDeleteEvent.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAddQuestion.defaultExpectation.paramPtrs.userID = &userID
+	mmAddQuestion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteEvent
+	return mmAddQuestion
 }
 
-// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteEvent {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	if mmDeleteEvent.defaultExpectation == nil {
-		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{}
 	}
 
-	if mmDeleteEvent.defaultExpectation.params != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	if mmAddQuestion.defaultExpectation.params != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Expect")
 	}
 
-	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
-		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	if mmAddQuestion.defaultExpectation.paramPtrs == nil {
+		mmAddQuestion.defaultExpectation.paramPtrs = &EventsServiceMockAddQuestionParamPtrs{}
 	}
-	mmDeleteEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
-	mmDeleteEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+	mmAddQuestion.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAddQuestion.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
 
-	return mmDeleteEvent
+	return mmAddQuestion
 }
 
-// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockDeleteEvent {
-	if mmDeleteEvent.mock.inspectFuncDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteEvent")
+// ExpectQuestionParam4 sets up expected param question for EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) ExpectQuestionParam4(question *models.EventQuestion) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	mmDeleteEvent.mock.inspectFuncDeleteEvent = f
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{}
+	}
 
-	return mmDeleteEvent
+	if mmAddQuestion.defaultExpectation.params != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Expect")
+	}
+
+	if mmAddQuestion.defaultExpectation.paramPtrs == nil {
+		mmAddQuestion.defaultExpectation.paramPtrs = &EventsServiceMockAddQuestionParamPtrs{}
+	}
+	mmAddQuestion.defaultExpectation.paramPtrs.question = &question
+	mmAddQuestion.defaultExpectation.expectationOrigins.originQuestion = minimock.CallerInfo(1)
+
+	return mmAddQuestion
 }
 
-// Return sets up results that will be returned by EventsService.DeleteEvent
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Return(err error) *EventsServiceMock {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Inspect(f func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion)) *mEventsServiceMockAddQuestion {
+	if mmAddQuestion.mock.inspectFuncAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.AddQuestion")
 	}
 
-	if mmDeleteEvent.defaultExpectation == nil {
-		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{mock: mmDeleteEvent.mock}
+	mmAddQuestion.mock.inspectFuncAddQuestion = f
+
+	return mmAddQuestion
+}
+
+// Return sets up results that will be returned by EventsService.AddQuestion
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Return(i1 int64, err error) *EventsServiceMock {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
-	mmDeleteEvent.defaultExpectation.results = &EventsServiceMockDeleteEventResults{err}
-	mmDeleteEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteEvent.mock
+
+	if mmAddQuestion.defaultExpectation == nil {
+		mmAddQuestion.defaultExpectation = &EventsServiceMockAddQuestionExpectation{mock: mmAddQuestion.mock}
+	}
+	mmAddQuestion.defaultExpectation.results = &EventsServiceMockAddQuestionResults{i1, err}
+	mmAddQuestion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddQuestion.mock
 }
 
-// Set uses given function f to mock the EventsService.DeleteEvent method
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Set(f func(ctx context.Context, userID int64, urlTitle string) (err error)) *EventsServiceMock {
-	if mmDeleteEvent.defaultExpectation != nil {
-		mmDeleteEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteEvent method")
+// Set uses given function f to mock the EventsService.AddQuestion method
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Set(f func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (i1 int64, err error)) *EventsServiceMock {
+	if mmAddQuestion.defaultExpectation != nil {
+		mmAddQuestion.mock.t.Fatalf("Default expectation is already set for the EventsService.AddQuestion method")
 	}
 
-	if len(mmDeleteEvent.expectations) > 0 {
-		mmDeleteEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteEvent method")
+	if len(mmAddQuestion.expectations) > 0 {
+		mmAddQuestion.mock.t.Fatalf("Some expectations are already set for the EventsService.AddQuestion method")
 	}
 
-	mmDeleteEvent.mock.funcDeleteEvent = f
-	mmDeleteEvent.mock.funcDeleteEventOrigin = minimock.CallerInfo(1)
-	return mmDeleteEvent.mock
+	mmAddQuestion.mock.funcAddQuestion = f
+	mmAddQuestion.mock.funcAddQuestionOrigin = minimock.CallerInfo(1)
+	return mmAddQuestion.mock
 }
 
-// When sets expectation for the EventsService.DeleteEvent which will trigger the result defined by the following
+// When sets expectation for the EventsService.AddQuestion which will trigger the result defined by the following
 // Then helper
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockDeleteEventExpectation {
-	if mmDeleteEvent.mock.funcDeleteEvent != nil {
-		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+func (mmAddQuestion *mEventsServiceMockAddQuestion) When(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) *EventsServiceMockAddQuestionExpectation {
+	if mmAddQuestion.mock.funcAddQuestion != nil {
+		mmAddQuestion.mock.t.Fatalf("EventsServiceMock.AddQuestion mock is already set by Set")
 	}
 
-	expectation := &EventsServiceMockDeleteEventExpectation{
-		mock:               mmDeleteEvent.mock,
-		params:             &EventsServiceMockDeleteEventParams{ctx, userID, urlTitle},
-		expectationOrigins: EventsServiceMockDeleteEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &EventsServiceMockAddQuestionExpectation{
+		mock:               mmAddQuestion.mock,
+		params:             &EventsServiceMockAddQuestionParams{ctx, userID, urlTitle, question},
+		expectationOrigins: EventsServiceMockAddQuestionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteEvent.expectations = append(mmDeleteEvent.expectations, expectation)
+	mmAddQuestion.expectations = append(mmAddQuestion.expectations, expectation)
 	return expectation
 }
 
-// Then sets up EventsService.DeleteEvent return parameters for the expectation previously defined by the When method
-func (e *EventsServiceMockDeleteEventExpectation) Then(err error) *EventsServiceMock {
-	e.results = &EventsServiceMockDeleteEventResults{err}
+// Then sets up EventsService.AddQuestion return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockAddQuestionExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockAddQuestionResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times EventsService.DeleteEvent should be invoked
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Times(n uint64) *mEventsServiceMockDeleteEvent {
+// Times sets number of times EventsService.AddQuestion should be invoked
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Times(n uint64) *mEventsServiceMockAddQuestion {
 	if n == 0 {
-		mmDeleteEvent.mock.t.Fatalf("Times of EventsServiceMock.DeleteEvent mock can not be zero")
+		mmAddQuestion.mock.t.Fatalf("Times of EventsServiceMock.AddQuestion mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteEvent.expectedInvocations, n)
-	mmDeleteEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteEvent
+	mm_atomic.StoreUint64(&mmAddQuestion.expectedInvocations, n)
+	mmAddQuestion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddQuestion
 }
 
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) invocationsDone() bool {
-	if len(mmDeleteEvent.expectations) == 0 && mmDeleteEvent.defaultExpectation == nil && mmDeleteEvent.mock.funcDeleteEvent == nil {
+func (mmAddQuestion *mEventsServiceMockAddQuestion) invocationsDone() bool {
+	if len(mmAddQuestion.expectations) == 0 && mmAddQuestion.defaultExpectation == nil && mmAddQuestion.mock.funcAddQuestion == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEvent.mock.afterDeleteEventCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEvent.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddQuestion.mock.afterAddQuestionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddQuestion.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteEvent implements mm_service.EventsService
-func (mmDeleteEvent *EventsServiceMock) DeleteEvent(ctx context.Context, userID int64, urlTitle string) (err error) {
-	mm_atomic.AddUint64(&mmDeleteEvent.beforeDeleteEventCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteEvent.afterDeleteEventCounter, 1)
+// AddQuestion implements mm_service.EventsService
+func (mmAddQuestion *EventsServiceMock) AddQuestion(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmAddQuestion.beforeAddQuestionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddQuestion.afterAddQuestionCounter, 1)
 
-	mmDeleteEvent.t.Helper()
+	mmAddQuestion.t.Helper()
 
-	if mmDeleteEvent.inspectFuncDeleteEvent != nil {
-		mmDeleteEvent.inspectFuncDeleteEvent(ctx, userID, urlTitle)
+	if mmAddQuestion.inspectFuncAddQuestion != nil {
+		mmAddQuestion.inspectFuncAddQuestion(ctx, userID, urlTitle, question)
 	}
 
-	mm_params := EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
+	mm_params := EventsServiceMockAddQuestionParams{ctx, userID, urlTitle, question}
 
 	// Record call args
-	mmDeleteEvent.DeleteEventMock.mutex.Lock()
-	mmDeleteEvent.DeleteEventMock.callArgs = append(mmDeleteEvent.DeleteEventMock.callArgs, &mm_params)
-	mmDeleteEvent.DeleteEventMock.mutex.Unlock()
+	mmAddQuestion.AddQuestionMock.mutex.Lock()
+	mmAddQuestion.AddQuestionMock.callArgs = append(mmAddQuestion.AddQuestionMock.callArgs, &mm_params)
+	mmAddQuestion.AddQuestionMock.mutex.Unlock()
 
-	for _, e := range mmDeleteEvent.DeleteEventMock.expectations {
+	for _, e := range mmAddQuestion.AddQuestionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmDeleteEvent.DeleteEventMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteEvent.DeleteEventMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteEvent.DeleteEventMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteEvent.DeleteEventMock.defaultExpectation.paramPtrs
+	if mmAddQuestion.AddQuestionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddQuestion.AddQuestionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddQuestion.AddQuestionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddQuestion.AddQuestionMock.defaultExpectation.paramPtrs
 
-		mm_got := EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
+		mm_got := EventsServiceMockAddQuestionParams{ctx, userID, urlTitle, question}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddQuestion.t.Errorf("EventsServiceMock.AddQuestion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddQuestion.AddQuestionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmAddQuestion.t.Errorf("EventsServiceMock.AddQuestion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddQuestion.AddQuestionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
-				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+				mmAddQuestion.t.Errorf("EventsServiceMock.AddQuestion got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddQuestion.AddQuestionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.question != nil && !minimock.Equal(*mm_want_ptrs.question, mm_got.question) {
+				mmAddQuestion.t.Errorf("EventsServiceMock.AddQuestion got unexpected parameter question, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddQuestion.AddQuestionMock.defaultExpectation.expectationOrigins.originQuestion, *mm_want_ptrs.question, mm_got.question, minimock.Diff(*mm_want_ptrs.question, mm_got.question))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddQuestion.t.Errorf("EventsServiceMock.AddQuestion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddQuestion.AddQuestionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteEvent.DeleteEventMock.defaultExpectation.results
+		mm_results := mmAddQuestion.AddQuestionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteEvent.t.Fatal("No results are set for the EventsServiceMock.DeleteEvent")
+			mmAddQuestion.t.Fatal("No results are set for the EventsServiceMock.AddQuestion")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmDeleteEvent.funcDeleteEvent != nil {
-		return mmDeleteEvent.funcDeleteEvent(ctx, userID, urlTitle)
+	if mmAddQuestion.funcAddQuestion != nil {
+		return mmAddQuestion.funcAddQuestion(ctx, userID, urlTitle, question)
 	}
-	mmDeleteEvent.t.Fatalf("Unexpected call to EventsServiceMock.DeleteEvent. %v %v %v", ctx, userID, urlTitle)
+	mmAddQuestion.t.Fatalf("Unexpected call to EventsServiceMock.AddQuestion. %v %v %v %v", ctx, userID, urlTitle, question)
 	return
 }
 
-// DeleteEventAfterCounter returns a count of finished EventsServiceMock.DeleteEvent invocations
-func (mmDeleteEvent *EventsServiceMock) DeleteEventAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteEvent.afterDeleteEventCounter)
+// AddQuestionAfterCounter returns a count of finished EventsServiceMock.AddQuestion invocations
+func (mmAddQuestion *EventsServiceMock) AddQuestionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddQuestion.afterAddQuestionCounter)
 }
 
-// DeleteEventBeforeCounter returns a count of EventsServiceMock.DeleteEvent invocations
-func (mmDeleteEvent *EventsServiceMock) DeleteEventBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteEvent.beforeDeleteEventCounter)
+// AddQuestionBeforeCounter returns a count of EventsServiceMock.AddQuestion invocations
+func (mmAddQuestion *EventsServiceMock) AddQuestionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddQuestion.beforeAddQuestionCounter)
 }
 
-// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteEvent.
+// Calls returns a list of arguments used in each call to EventsServiceMock.AddQuestion.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Calls() []*EventsServiceMockDeleteEventParams {
-	mmDeleteEvent.mutex.RLock()
+func (mmAddQuestion *mEventsServiceMockAddQuestion) Calls() []*EventsServiceMockAddQuestionParams {
+	mmAddQuestion.mutex.RLock()
 
-	argCopy := make([]*EventsServiceMockDeleteEventParams, len(mmDeleteEvent.callArgs))
-	copy(argCopy, mmDeleteEvent.callArgs)
+	argCopy := make([]*EventsServiceMockAddQuestionParams, len(mmAddQuestion.callArgs))
+	copy(argCopy, mmAddQuestion.callArgs)
 
-	mmDeleteEvent.mutex.RUnlock()
+	mmAddQuestion.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteEventDone returns true if the count of the DeleteEvent invocations corresponds
+// MinimockAddQuestionDone returns true if the count of the AddQuestion invocations corresponds
 // the number of defined expectations
-func (m *EventsServiceMock) MinimockDeleteEventDone() bool {
-	if m.DeleteEventMock.optional {
+func (m *EventsServiceMock) MinimockAddQuestionDone() bool {
+	if m.AddQuestionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteEventMock.expectations {
+	for _, e := range m.AddQuestionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteEventMock.invocationsDone()
+	return m.AddQuestionMock.invocationsDone()
 }
 
-// MinimockDeleteEventInspect logs each unmet expectation
-func (m *EventsServiceMock) MinimockDeleteEventInspect() {
-	for _, e := range m.DeleteEventMock.expectations {
+// MinimockAddQuestionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockAddQuestionInspect() {
+	for _, e := range m.AddQuestionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to EventsServiceMock.AddQuestion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteEventCounter := mm_atomic.LoadUint64(&m.afterDeleteEventCounter)
+	afterAddQuestionCounter := mm_atomic.LoadUint64(&m.afterAddQuestionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteEventMock.defaultExpectation != nil && afterDeleteEventCounter < 1 {
-		if m.DeleteEventMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s", m.DeleteEventMock.defaultExpectation.returnOrigin)
+	if m.AddQuestionMock.defaultExpectation != nil && afterAddQuestionCounter < 1 {
+		if m.AddQuestionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.AddQuestion at\n%s", m.AddQuestionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s with params: %#v", m.DeleteEventMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEventMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to EventsServiceMock.AddQuestion at\n%s with params: %#v", m.AddQuestionMock.defaultExpectation.expectationOrigins.origin, *m.AddQuestionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteEvent != nil && afterDeleteEventCounter < 1 {
-		m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s", m.funcDeleteEventOrigin)
+	if m.funcAddQuestion != nil && afterAddQuestionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.AddQuestion at\n%s", m.funcAddQuestionOrigin)
 	}
 
-	if !m.DeleteEventMock.invocationsDone() && afterDeleteEventCounter > 0 {
-		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteEvent at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteEventMock.expectedInvocations), m.DeleteEventMock.expectedInvocationsOrigin, afterDeleteEventCounter)
+	if !m.AddQuestionMock.invocationsDone() && afterAddQuestionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.AddQuestion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddQuestionMock.expectedInvocations), m.AddQuestionMock.expectedInvocationsOrigin, afterAddQuestionCounter)
 	}
 }
 
-type mEventsServiceMockEvent struct {
+type mEventsServiceMockAddReview struct {
 	optional           bool
 	mock               *EventsServiceMock
-	defaultExpectation *EventsServiceMockEventExpectation
-	expectations       []*EventsServiceMockEventExpectation
+	defaultExpectation *EventsServiceMockAddReviewExpectation
+	expectations       []*EventsServiceMockAddReviewExpectation
 
-	callArgs []*EventsServiceMockEventParams
+	callArgs []*EventsServiceMockAddReviewParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// EventsServiceMockEventExpectation specifies expectation struct of the EventsService.Event
-type EventsServiceMockEventExpectation struct {
+// EventsServiceMockAddReviewExpectation specifies expectation struct of the EventsService.AddReview
+type EventsServiceMockAddReviewExpectation struct {
 	mock               *EventsServiceMock
-	params             *EventsServiceMockEventParams
-	paramPtrs          *EventsServiceMockEventParamPtrs
-	expectationOrigins EventsServiceMockEventExpectationOrigins
-	results            *EventsServiceMockEventResults
+	params             *EventsServiceMockAddReviewParams
+	paramPtrs          *EventsServiceMockAddReviewParamPtrs
+	expectationOrigins EventsServiceMockAddReviewExpectationOrigins
+	results            *EventsServiceMockAddReviewResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// EventsServiceMockEventParams contains parameters of the EventsService.Event
-type EventsServiceMockEventParams struct {
+// EventsServiceMockAddReviewParams contains parameters of the EventsService.AddReview
+type EventsServiceMockAddReviewParams struct {
 	ctx      context.Context
+	userID   int64
 	urlTitle string
+	review   *models.Review
 }
 
-// EventsServiceMockEventParamPtrs contains pointers to parameters of the EventsService.Event
-type EventsServiceMockEventParamPtrs struct {
+// EventsServiceMockAddReviewParamPtrs contains pointers to parameters of the EventsService.AddReview
+type EventsServiceMockAddReviewParamPtrs struct {
 	ctx      *context.Context
+	userID   *int64
 	urlTitle *string
+	review   **models.Review
 }
 
-// EventsServiceMockEventResults contains results of the EventsService.Event
-type EventsServiceMockEventResults struct {
-	ep1 *models.Event
+// EventsServiceMockAddReviewResults contains results of the EventsService.AddReview
+type EventsServiceMockAddReviewResults struct {
+	i1  int64
 	err error
 }
 
-// EventsServiceMockEventOrigins contains origins of expectations of the EventsService.Event
-type EventsServiceMockEventExpectationOrigins struct {
+// EventsServiceMockAddReviewOrigins contains origins of expectations of the EventsService.AddReview
+type EventsServiceMockAddReviewExpectationOrigins struct {
 	origin         string
 	originCtx      string
+	originUserID   string
 	originUrlTitle string
+	originReview   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -863,342 +1312,13819 @@ type EventsServiceMockEventExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmEvent *mEventsServiceMockEvent) Optional() *mEventsServiceMockEvent {
-	mmEvent.optional = true
-	return mmEvent
+func (mmAddReview *mEventsServiceMockAddReview) Optional() *mEventsServiceMockAddReview {
+	mmAddReview.optional = true
+	return mmAddReview
 }
 
-// Expect sets up expected params for EventsService.Event
-func (mmEvent *mEventsServiceMockEvent) Expect(ctx context.Context, urlTitle string) *mEventsServiceMockEvent {
-	if mmEvent.mock.funcEvent != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+// Expect sets up expected params for EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) Expect(ctx context.Context, userID int64, urlTitle string, review *models.Review) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
 	}
 
-	if mmEvent.defaultExpectation == nil {
-		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{}
 	}
 
-	if mmEvent.defaultExpectation.paramPtrs != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by ExpectParams functions")
+	if mmAddReview.defaultExpectation.paramPtrs != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by ExpectParams functions")
 	}
 
-	mmEvent.defaultExpectation.params = &EventsServiceMockEventParams{ctx, urlTitle}
-	mmEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmEvent.expectations {
-		if minimock.Equal(e.params, mmEvent.defaultExpectation.params) {
-			mmEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvent.defaultExpectation.params)
+	mmAddReview.defaultExpectation.params = &EventsServiceMockAddReviewParams{ctx, userID, urlTitle, review}
+	mmAddReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReview.expectations {
+		if minimock.Equal(e.params, mmAddReview.defaultExpectation.params) {
+			mmAddReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReview.defaultExpectation.params)
 		}
 	}
 
-	return mmEvent
+	return mmAddReview
 }
 
-// ExpectCtxParam1 sets up expected param ctx for EventsService.Event
-func (mmEvent *mEventsServiceMockEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEvent {
-	if mmEvent.mock.funcEvent != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
 	}
 
-	if mmEvent.defaultExpectation == nil {
-		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{}
 	}
 
-	if mmEvent.defaultExpectation.params != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Expect")
+	if mmAddReview.defaultExpectation.params != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Expect")
 	}
 
-	if mmEvent.defaultExpectation.paramPtrs == nil {
-		mmEvent.defaultExpectation.paramPtrs = &EventsServiceMockEventParamPtrs{}
+	if mmAddReview.defaultExpectation.paramPtrs == nil {
+		mmAddReview.defaultExpectation.paramPtrs = &EventsServiceMockAddReviewParamPtrs{}
 	}
-	mmEvent.defaultExpectation.paramPtrs.ctx = &ctx
-	mmEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmEvent
+	return mmAddReview
 }
 
-// ExpectUrlTitleParam2 sets up expected param urlTitle for EventsService.Event
-func (mmEvent *mEventsServiceMockEvent) ExpectUrlTitleParam2(urlTitle string) *mEventsServiceMockEvent {
-	if mmEvent.mock.funcEvent != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) ExpectUserIDParam2(userID int64) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
 	}
 
-	if mmEvent.defaultExpectation == nil {
-		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{}
 	}
 
-	if mmEvent.defaultExpectation.params != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Expect")
+	if mmAddReview.defaultExpectation.params != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Expect")
 	}
 
-	if mmEvent.defaultExpectation.paramPtrs == nil {
-		mmEvent.defaultExpectation.paramPtrs = &EventsServiceMockEventParamPtrs{}
+	if mmAddReview.defaultExpectation.paramPtrs == nil {
+		mmAddReview.defaultExpectation.paramPtrs = &EventsServiceMockAddReviewParamPtrs{}
+	}
+	mmAddReview.defaultExpectation.paramPtrs.userID = &userID
+	mmAddReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddReview
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
+	}
+
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{}
+	}
+
+	if mmAddReview.defaultExpectation.params != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Expect")
+	}
+
+	if mmAddReview.defaultExpectation.paramPtrs == nil {
+		mmAddReview.defaultExpectation.paramPtrs = &EventsServiceMockAddReviewParamPtrs{}
+	}
+	mmAddReview.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAddReview.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmAddReview
+}
+
+// ExpectReviewParam4 sets up expected param review for EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) ExpectReviewParam4(review *models.Review) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
+	}
+
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{}
+	}
+
+	if mmAddReview.defaultExpectation.params != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Expect")
+	}
+
+	if mmAddReview.defaultExpectation.paramPtrs == nil {
+		mmAddReview.defaultExpectation.paramPtrs = &EventsServiceMockAddReviewParamPtrs{}
+	}
+	mmAddReview.defaultExpectation.paramPtrs.review = &review
+	mmAddReview.defaultExpectation.expectationOrigins.originReview = minimock.CallerInfo(1)
+
+	return mmAddReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) Inspect(f func(ctx context.Context, userID int64, urlTitle string, review *models.Review)) *mEventsServiceMockAddReview {
+	if mmAddReview.mock.inspectFuncAddReview != nil {
+		mmAddReview.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.AddReview")
+	}
+
+	mmAddReview.mock.inspectFuncAddReview = f
+
+	return mmAddReview
+}
+
+// Return sets up results that will be returned by EventsService.AddReview
+func (mmAddReview *mEventsServiceMockAddReview) Return(i1 int64, err error) *EventsServiceMock {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
+	}
+
+	if mmAddReview.defaultExpectation == nil {
+		mmAddReview.defaultExpectation = &EventsServiceMockAddReviewExpectation{mock: mmAddReview.mock}
+	}
+	mmAddReview.defaultExpectation.results = &EventsServiceMockAddReviewResults{i1, err}
+	mmAddReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReview.mock
+}
+
+// Set uses given function f to mock the EventsService.AddReview method
+func (mmAddReview *mEventsServiceMockAddReview) Set(f func(ctx context.Context, userID int64, urlTitle string, review *models.Review) (i1 int64, err error)) *EventsServiceMock {
+	if mmAddReview.defaultExpectation != nil {
+		mmAddReview.mock.t.Fatalf("Default expectation is already set for the EventsService.AddReview method")
+	}
+
+	if len(mmAddReview.expectations) > 0 {
+		mmAddReview.mock.t.Fatalf("Some expectations are already set for the EventsService.AddReview method")
+	}
+
+	mmAddReview.mock.funcAddReview = f
+	mmAddReview.mock.funcAddReviewOrigin = minimock.CallerInfo(1)
+	return mmAddReview.mock
+}
+
+// When sets expectation for the EventsService.AddReview which will trigger the result defined by the following
+// Then helper
+func (mmAddReview *mEventsServiceMockAddReview) When(ctx context.Context, userID int64, urlTitle string, review *models.Review) *EventsServiceMockAddReviewExpectation {
+	if mmAddReview.mock.funcAddReview != nil {
+		mmAddReview.mock.t.Fatalf("EventsServiceMock.AddReview mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockAddReviewExpectation{
+		mock:               mmAddReview.mock,
+		params:             &EventsServiceMockAddReviewParams{ctx, userID, urlTitle, review},
+		expectationOrigins: EventsServiceMockAddReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReview.expectations = append(mmAddReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.AddReview return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockAddReviewExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockAddReviewResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.AddReview should be invoked
+func (mmAddReview *mEventsServiceMockAddReview) Times(n uint64) *mEventsServiceMockAddReview {
+	if n == 0 {
+		mmAddReview.mock.t.Fatalf("Times of EventsServiceMock.AddReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReview.expectedInvocations, n)
+	mmAddReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReview
+}
+
+func (mmAddReview *mEventsServiceMockAddReview) invocationsDone() bool {
+	if len(mmAddReview.expectations) == 0 && mmAddReview.defaultExpectation == nil && mmAddReview.mock.funcAddReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReview.mock.afterAddReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReview implements mm_service.EventsService
+func (mmAddReview *EventsServiceMock) AddReview(ctx context.Context, userID int64, urlTitle string, review *models.Review) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmAddReview.beforeAddReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReview.afterAddReviewCounter, 1)
+
+	mmAddReview.t.Helper()
+
+	if mmAddReview.inspectFuncAddReview != nil {
+		mmAddReview.inspectFuncAddReview(ctx, userID, urlTitle, review)
+	}
+
+	mm_params := EventsServiceMockAddReviewParams{ctx, userID, urlTitle, review}
+
+	// Record call args
+	mmAddReview.AddReviewMock.mutex.Lock()
+	mmAddReview.AddReviewMock.callArgs = append(mmAddReview.AddReviewMock.callArgs, &mm_params)
+	mmAddReview.AddReviewMock.mutex.Unlock()
+
+	for _, e := range mmAddReview.AddReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmAddReview.AddReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReview.AddReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReview.AddReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReview.AddReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockAddReviewParams{ctx, userID, urlTitle, review}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReview.t.Errorf("EventsServiceMock.AddReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReview.AddReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddReview.t.Errorf("EventsServiceMock.AddReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReview.AddReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmAddReview.t.Errorf("EventsServiceMock.AddReview got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReview.AddReviewMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.review != nil && !minimock.Equal(*mm_want_ptrs.review, mm_got.review) {
+				mmAddReview.t.Errorf("EventsServiceMock.AddReview got unexpected parameter review, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReview.AddReviewMock.defaultExpectation.expectationOrigins.originReview, *mm_want_ptrs.review, mm_got.review, minimock.Diff(*mm_want_ptrs.review, mm_got.review))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReview.t.Errorf("EventsServiceMock.AddReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReview.AddReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReview.AddReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReview.t.Fatal("No results are set for the EventsServiceMock.AddReview")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmAddReview.funcAddReview != nil {
+		return mmAddReview.funcAddReview(ctx, userID, urlTitle, review)
+	}
+	mmAddReview.t.Fatalf("Unexpected call to EventsServiceMock.AddReview. %v %v %v %v", ctx, userID, urlTitle, review)
+	return
+}
+
+// AddReviewAfterCounter returns a count of finished EventsServiceMock.AddReview invocations
+func (mmAddReview *EventsServiceMock) AddReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReview.afterAddReviewCounter)
+}
+
+// AddReviewBeforeCounter returns a count of EventsServiceMock.AddReview invocations
+func (mmAddReview *EventsServiceMock) AddReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReview.beforeAddReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.AddReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReview *mEventsServiceMockAddReview) Calls() []*EventsServiceMockAddReviewParams {
+	mmAddReview.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockAddReviewParams, len(mmAddReview.callArgs))
+	copy(argCopy, mmAddReview.callArgs)
+
+	mmAddReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReviewDone returns true if the count of the AddReview invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockAddReviewDone() bool {
+	if m.AddReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReviewMock.invocationsDone()
+}
+
+// MinimockAddReviewInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockAddReviewInspect() {
+	for _, e := range m.AddReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.AddReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReviewCounter := mm_atomic.LoadUint64(&m.afterAddReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReviewMock.defaultExpectation != nil && afterAddReviewCounter < 1 {
+		if m.AddReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.AddReview at\n%s", m.AddReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.AddReview at\n%s with params: %#v", m.AddReviewMock.defaultExpectation.expectationOrigins.origin, *m.AddReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReview != nil && afterAddReviewCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.AddReview at\n%s", m.funcAddReviewOrigin)
+	}
+
+	if !m.AddReviewMock.invocationsDone() && afterAddReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.AddReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReviewMock.expectedInvocations), m.AddReviewMock.expectedInvocationsOrigin, afterAddReviewCounter)
+	}
+}
+
+type mEventsServiceMockAddSession struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockAddSessionExpectation
+	expectations       []*EventsServiceMockAddSessionExpectation
+
+	callArgs []*EventsServiceMockAddSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockAddSessionExpectation specifies expectation struct of the EventsService.AddSession
+type EventsServiceMockAddSessionExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockAddSessionParams
+	paramPtrs          *EventsServiceMockAddSessionParamPtrs
+	expectationOrigins EventsServiceMockAddSessionExpectationOrigins
+	results            *EventsServiceMockAddSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockAddSessionParams contains parameters of the EventsService.AddSession
+type EventsServiceMockAddSessionParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	session  *models.Session
+}
+
+// EventsServiceMockAddSessionParamPtrs contains pointers to parameters of the EventsService.AddSession
+type EventsServiceMockAddSessionParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	session  **models.Session
+}
+
+// EventsServiceMockAddSessionResults contains results of the EventsService.AddSession
+type EventsServiceMockAddSessionResults struct {
+	i1  int64
+	err error
+}
+
+// EventsServiceMockAddSessionOrigins contains origins of expectations of the EventsService.AddSession
+type EventsServiceMockAddSessionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originSession  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddSession *mEventsServiceMockAddSession) Optional() *mEventsServiceMockAddSession {
+	mmAddSession.optional = true
+	return mmAddSession
+}
+
+// Expect sets up expected params for EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) Expect(ctx context.Context, userID int64, urlTitle string, session *models.Session) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{}
+	}
+
+	if mmAddSession.defaultExpectation.paramPtrs != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by ExpectParams functions")
+	}
+
+	mmAddSession.defaultExpectation.params = &EventsServiceMockAddSessionParams{ctx, userID, urlTitle, session}
+	mmAddSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddSession.expectations {
+		if minimock.Equal(e.params, mmAddSession.defaultExpectation.params) {
+			mmAddSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddSession.defaultExpectation.params)
+		}
+	}
+
+	return mmAddSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{}
+	}
+
+	if mmAddSession.defaultExpectation.params != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Expect")
+	}
+
+	if mmAddSession.defaultExpectation.paramPtrs == nil {
+		mmAddSession.defaultExpectation.paramPtrs = &EventsServiceMockAddSessionParamPtrs{}
+	}
+	mmAddSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) ExpectUserIDParam2(userID int64) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{}
+	}
+
+	if mmAddSession.defaultExpectation.params != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Expect")
+	}
+
+	if mmAddSession.defaultExpectation.paramPtrs == nil {
+		mmAddSession.defaultExpectation.paramPtrs = &EventsServiceMockAddSessionParamPtrs{}
+	}
+	mmAddSession.defaultExpectation.paramPtrs.userID = &userID
+	mmAddSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddSession
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{}
+	}
+
+	if mmAddSession.defaultExpectation.params != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Expect")
+	}
+
+	if mmAddSession.defaultExpectation.paramPtrs == nil {
+		mmAddSession.defaultExpectation.paramPtrs = &EventsServiceMockAddSessionParamPtrs{}
+	}
+	mmAddSession.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAddSession.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmAddSession
+}
+
+// ExpectSessionParam4 sets up expected param session for EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) ExpectSessionParam4(session *models.Session) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{}
+	}
+
+	if mmAddSession.defaultExpectation.params != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Expect")
+	}
+
+	if mmAddSession.defaultExpectation.paramPtrs == nil {
+		mmAddSession.defaultExpectation.paramPtrs = &EventsServiceMockAddSessionParamPtrs{}
+	}
+	mmAddSession.defaultExpectation.paramPtrs.session = &session
+	mmAddSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmAddSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) Inspect(f func(ctx context.Context, userID int64, urlTitle string, session *models.Session)) *mEventsServiceMockAddSession {
+	if mmAddSession.mock.inspectFuncAddSession != nil {
+		mmAddSession.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.AddSession")
+	}
+
+	mmAddSession.mock.inspectFuncAddSession = f
+
+	return mmAddSession
+}
+
+// Return sets up results that will be returned by EventsService.AddSession
+func (mmAddSession *mEventsServiceMockAddSession) Return(i1 int64, err error) *EventsServiceMock {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	if mmAddSession.defaultExpectation == nil {
+		mmAddSession.defaultExpectation = &EventsServiceMockAddSessionExpectation{mock: mmAddSession.mock}
+	}
+	mmAddSession.defaultExpectation.results = &EventsServiceMockAddSessionResults{i1, err}
+	mmAddSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddSession.mock
+}
+
+// Set uses given function f to mock the EventsService.AddSession method
+func (mmAddSession *mEventsServiceMockAddSession) Set(f func(ctx context.Context, userID int64, urlTitle string, session *models.Session) (i1 int64, err error)) *EventsServiceMock {
+	if mmAddSession.defaultExpectation != nil {
+		mmAddSession.mock.t.Fatalf("Default expectation is already set for the EventsService.AddSession method")
+	}
+
+	if len(mmAddSession.expectations) > 0 {
+		mmAddSession.mock.t.Fatalf("Some expectations are already set for the EventsService.AddSession method")
+	}
+
+	mmAddSession.mock.funcAddSession = f
+	mmAddSession.mock.funcAddSessionOrigin = minimock.CallerInfo(1)
+	return mmAddSession.mock
+}
+
+// When sets expectation for the EventsService.AddSession which will trigger the result defined by the following
+// Then helper
+func (mmAddSession *mEventsServiceMockAddSession) When(ctx context.Context, userID int64, urlTitle string, session *models.Session) *EventsServiceMockAddSessionExpectation {
+	if mmAddSession.mock.funcAddSession != nil {
+		mmAddSession.mock.t.Fatalf("EventsServiceMock.AddSession mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockAddSessionExpectation{
+		mock:               mmAddSession.mock,
+		params:             &EventsServiceMockAddSessionParams{ctx, userID, urlTitle, session},
+		expectationOrigins: EventsServiceMockAddSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddSession.expectations = append(mmAddSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.AddSession return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockAddSessionExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockAddSessionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.AddSession should be invoked
+func (mmAddSession *mEventsServiceMockAddSession) Times(n uint64) *mEventsServiceMockAddSession {
+	if n == 0 {
+		mmAddSession.mock.t.Fatalf("Times of EventsServiceMock.AddSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddSession.expectedInvocations, n)
+	mmAddSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddSession
+}
+
+func (mmAddSession *mEventsServiceMockAddSession) invocationsDone() bool {
+	if len(mmAddSession.expectations) == 0 && mmAddSession.defaultExpectation == nil && mmAddSession.mock.funcAddSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddSession.mock.afterAddSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddSession implements mm_service.EventsService
+func (mmAddSession *EventsServiceMock) AddSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmAddSession.beforeAddSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddSession.afterAddSessionCounter, 1)
+
+	mmAddSession.t.Helper()
+
+	if mmAddSession.inspectFuncAddSession != nil {
+		mmAddSession.inspectFuncAddSession(ctx, userID, urlTitle, session)
+	}
+
+	mm_params := EventsServiceMockAddSessionParams{ctx, userID, urlTitle, session}
+
+	// Record call args
+	mmAddSession.AddSessionMock.mutex.Lock()
+	mmAddSession.AddSessionMock.callArgs = append(mmAddSession.AddSessionMock.callArgs, &mm_params)
+	mmAddSession.AddSessionMock.mutex.Unlock()
+
+	for _, e := range mmAddSession.AddSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmAddSession.AddSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddSession.AddSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddSession.AddSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddSession.AddSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockAddSessionParams{ctx, userID, urlTitle, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddSession.t.Errorf("EventsServiceMock.AddSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSession.AddSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddSession.t.Errorf("EventsServiceMock.AddSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSession.AddSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmAddSession.t.Errorf("EventsServiceMock.AddSession got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSession.AddSessionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmAddSession.t.Errorf("EventsServiceMock.AddSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSession.AddSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddSession.t.Errorf("EventsServiceMock.AddSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddSession.AddSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddSession.AddSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddSession.t.Fatal("No results are set for the EventsServiceMock.AddSession")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmAddSession.funcAddSession != nil {
+		return mmAddSession.funcAddSession(ctx, userID, urlTitle, session)
+	}
+	mmAddSession.t.Fatalf("Unexpected call to EventsServiceMock.AddSession. %v %v %v %v", ctx, userID, urlTitle, session)
+	return
+}
+
+// AddSessionAfterCounter returns a count of finished EventsServiceMock.AddSession invocations
+func (mmAddSession *EventsServiceMock) AddSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddSession.afterAddSessionCounter)
+}
+
+// AddSessionBeforeCounter returns a count of EventsServiceMock.AddSession invocations
+func (mmAddSession *EventsServiceMock) AddSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddSession.beforeAddSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.AddSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddSession *mEventsServiceMockAddSession) Calls() []*EventsServiceMockAddSessionParams {
+	mmAddSession.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockAddSessionParams, len(mmAddSession.callArgs))
+	copy(argCopy, mmAddSession.callArgs)
+
+	mmAddSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddSessionDone returns true if the count of the AddSession invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockAddSessionDone() bool {
+	if m.AddSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddSessionMock.invocationsDone()
+}
+
+// MinimockAddSessionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockAddSessionInspect() {
+	for _, e := range m.AddSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddSessionCounter := mm_atomic.LoadUint64(&m.afterAddSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddSessionMock.defaultExpectation != nil && afterAddSessionCounter < 1 {
+		if m.AddSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSession at\n%s", m.AddSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSession at\n%s with params: %#v", m.AddSessionMock.defaultExpectation.expectationOrigins.origin, *m.AddSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddSession != nil && afterAddSessionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.AddSession at\n%s", m.funcAddSessionOrigin)
+	}
+
+	if !m.AddSessionMock.invocationsDone() && afterAddSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.AddSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddSessionMock.expectedInvocations), m.AddSessionMock.expectedInvocationsOrigin, afterAddSessionCounter)
+	}
+}
+
+type mEventsServiceMockAddSpeaker struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockAddSpeakerExpectation
+	expectations       []*EventsServiceMockAddSpeakerExpectation
+
+	callArgs []*EventsServiceMockAddSpeakerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockAddSpeakerExpectation specifies expectation struct of the EventsService.AddSpeaker
+type EventsServiceMockAddSpeakerExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockAddSpeakerParams
+	paramPtrs          *EventsServiceMockAddSpeakerParamPtrs
+	expectationOrigins EventsServiceMockAddSpeakerExpectationOrigins
+	results            *EventsServiceMockAddSpeakerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockAddSpeakerParams contains parameters of the EventsService.AddSpeaker
+type EventsServiceMockAddSpeakerParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	speaker  *models.Speaker
+}
+
+// EventsServiceMockAddSpeakerParamPtrs contains pointers to parameters of the EventsService.AddSpeaker
+type EventsServiceMockAddSpeakerParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	speaker  **models.Speaker
+}
+
+// EventsServiceMockAddSpeakerResults contains results of the EventsService.AddSpeaker
+type EventsServiceMockAddSpeakerResults struct {
+	i1  int64
+	err error
+}
+
+// EventsServiceMockAddSpeakerOrigins contains origins of expectations of the EventsService.AddSpeaker
+type EventsServiceMockAddSpeakerExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originSpeaker  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Optional() *mEventsServiceMockAddSpeaker {
+	mmAddSpeaker.optional = true
+	return mmAddSpeaker
+}
+
+// Expect sets up expected params for EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Expect(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{}
+	}
+
+	if mmAddSpeaker.defaultExpectation.paramPtrs != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by ExpectParams functions")
+	}
+
+	mmAddSpeaker.defaultExpectation.params = &EventsServiceMockAddSpeakerParams{ctx, userID, urlTitle, speaker}
+	mmAddSpeaker.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddSpeaker.expectations {
+		if minimock.Equal(e.params, mmAddSpeaker.defaultExpectation.params) {
+			mmAddSpeaker.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddSpeaker.defaultExpectation.params)
+		}
+	}
+
+	return mmAddSpeaker
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{}
+	}
+
+	if mmAddSpeaker.defaultExpectation.params != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Expect")
+	}
+
+	if mmAddSpeaker.defaultExpectation.paramPtrs == nil {
+		mmAddSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockAddSpeakerParamPtrs{}
+	}
+	mmAddSpeaker.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddSpeaker.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddSpeaker
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) ExpectUserIDParam2(userID int64) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{}
+	}
+
+	if mmAddSpeaker.defaultExpectation.params != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Expect")
+	}
+
+	if mmAddSpeaker.defaultExpectation.paramPtrs == nil {
+		mmAddSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockAddSpeakerParamPtrs{}
+	}
+	mmAddSpeaker.defaultExpectation.paramPtrs.userID = &userID
+	mmAddSpeaker.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddSpeaker
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{}
+	}
+
+	if mmAddSpeaker.defaultExpectation.params != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Expect")
+	}
+
+	if mmAddSpeaker.defaultExpectation.paramPtrs == nil {
+		mmAddSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockAddSpeakerParamPtrs{}
+	}
+	mmAddSpeaker.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAddSpeaker.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmAddSpeaker
+}
+
+// ExpectSpeakerParam4 sets up expected param speaker for EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) ExpectSpeakerParam4(speaker *models.Speaker) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{}
+	}
+
+	if mmAddSpeaker.defaultExpectation.params != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Expect")
+	}
+
+	if mmAddSpeaker.defaultExpectation.paramPtrs == nil {
+		mmAddSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockAddSpeakerParamPtrs{}
+	}
+	mmAddSpeaker.defaultExpectation.paramPtrs.speaker = &speaker
+	mmAddSpeaker.defaultExpectation.expectationOrigins.originSpeaker = minimock.CallerInfo(1)
+
+	return mmAddSpeaker
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Inspect(f func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker)) *mEventsServiceMockAddSpeaker {
+	if mmAddSpeaker.mock.inspectFuncAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.AddSpeaker")
+	}
+
+	mmAddSpeaker.mock.inspectFuncAddSpeaker = f
+
+	return mmAddSpeaker
+}
+
+// Return sets up results that will be returned by EventsService.AddSpeaker
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Return(i1 int64, err error) *EventsServiceMock {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	if mmAddSpeaker.defaultExpectation == nil {
+		mmAddSpeaker.defaultExpectation = &EventsServiceMockAddSpeakerExpectation{mock: mmAddSpeaker.mock}
+	}
+	mmAddSpeaker.defaultExpectation.results = &EventsServiceMockAddSpeakerResults{i1, err}
+	mmAddSpeaker.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddSpeaker.mock
+}
+
+// Set uses given function f to mock the EventsService.AddSpeaker method
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Set(f func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (i1 int64, err error)) *EventsServiceMock {
+	if mmAddSpeaker.defaultExpectation != nil {
+		mmAddSpeaker.mock.t.Fatalf("Default expectation is already set for the EventsService.AddSpeaker method")
+	}
+
+	if len(mmAddSpeaker.expectations) > 0 {
+		mmAddSpeaker.mock.t.Fatalf("Some expectations are already set for the EventsService.AddSpeaker method")
+	}
+
+	mmAddSpeaker.mock.funcAddSpeaker = f
+	mmAddSpeaker.mock.funcAddSpeakerOrigin = minimock.CallerInfo(1)
+	return mmAddSpeaker.mock
+}
+
+// When sets expectation for the EventsService.AddSpeaker which will trigger the result defined by the following
+// Then helper
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) When(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) *EventsServiceMockAddSpeakerExpectation {
+	if mmAddSpeaker.mock.funcAddSpeaker != nil {
+		mmAddSpeaker.mock.t.Fatalf("EventsServiceMock.AddSpeaker mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockAddSpeakerExpectation{
+		mock:               mmAddSpeaker.mock,
+		params:             &EventsServiceMockAddSpeakerParams{ctx, userID, urlTitle, speaker},
+		expectationOrigins: EventsServiceMockAddSpeakerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddSpeaker.expectations = append(mmAddSpeaker.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.AddSpeaker return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockAddSpeakerExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockAddSpeakerResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.AddSpeaker should be invoked
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Times(n uint64) *mEventsServiceMockAddSpeaker {
+	if n == 0 {
+		mmAddSpeaker.mock.t.Fatalf("Times of EventsServiceMock.AddSpeaker mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddSpeaker.expectedInvocations, n)
+	mmAddSpeaker.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddSpeaker
+}
+
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) invocationsDone() bool {
+	if len(mmAddSpeaker.expectations) == 0 && mmAddSpeaker.defaultExpectation == nil && mmAddSpeaker.mock.funcAddSpeaker == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddSpeaker.mock.afterAddSpeakerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddSpeaker.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddSpeaker implements mm_service.EventsService
+func (mmAddSpeaker *EventsServiceMock) AddSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmAddSpeaker.beforeAddSpeakerCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddSpeaker.afterAddSpeakerCounter, 1)
+
+	mmAddSpeaker.t.Helper()
+
+	if mmAddSpeaker.inspectFuncAddSpeaker != nil {
+		mmAddSpeaker.inspectFuncAddSpeaker(ctx, userID, urlTitle, speaker)
+	}
+
+	mm_params := EventsServiceMockAddSpeakerParams{ctx, userID, urlTitle, speaker}
+
+	// Record call args
+	mmAddSpeaker.AddSpeakerMock.mutex.Lock()
+	mmAddSpeaker.AddSpeakerMock.callArgs = append(mmAddSpeaker.AddSpeakerMock.callArgs, &mm_params)
+	mmAddSpeaker.AddSpeakerMock.mutex.Unlock()
+
+	for _, e := range mmAddSpeaker.AddSpeakerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmAddSpeaker.AddSpeakerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddSpeaker.AddSpeakerMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddSpeaker.AddSpeakerMock.defaultExpectation.params
+		mm_want_ptrs := mmAddSpeaker.AddSpeakerMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockAddSpeakerParams{ctx, userID, urlTitle, speaker}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddSpeaker.t.Errorf("EventsServiceMock.AddSpeaker got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSpeaker.AddSpeakerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddSpeaker.t.Errorf("EventsServiceMock.AddSpeaker got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSpeaker.AddSpeakerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmAddSpeaker.t.Errorf("EventsServiceMock.AddSpeaker got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSpeaker.AddSpeakerMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.speaker != nil && !minimock.Equal(*mm_want_ptrs.speaker, mm_got.speaker) {
+				mmAddSpeaker.t.Errorf("EventsServiceMock.AddSpeaker got unexpected parameter speaker, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddSpeaker.AddSpeakerMock.defaultExpectation.expectationOrigins.originSpeaker, *mm_want_ptrs.speaker, mm_got.speaker, minimock.Diff(*mm_want_ptrs.speaker, mm_got.speaker))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddSpeaker.t.Errorf("EventsServiceMock.AddSpeaker got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddSpeaker.AddSpeakerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddSpeaker.AddSpeakerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddSpeaker.t.Fatal("No results are set for the EventsServiceMock.AddSpeaker")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmAddSpeaker.funcAddSpeaker != nil {
+		return mmAddSpeaker.funcAddSpeaker(ctx, userID, urlTitle, speaker)
+	}
+	mmAddSpeaker.t.Fatalf("Unexpected call to EventsServiceMock.AddSpeaker. %v %v %v %v", ctx, userID, urlTitle, speaker)
+	return
+}
+
+// AddSpeakerAfterCounter returns a count of finished EventsServiceMock.AddSpeaker invocations
+func (mmAddSpeaker *EventsServiceMock) AddSpeakerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddSpeaker.afterAddSpeakerCounter)
+}
+
+// AddSpeakerBeforeCounter returns a count of EventsServiceMock.AddSpeaker invocations
+func (mmAddSpeaker *EventsServiceMock) AddSpeakerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddSpeaker.beforeAddSpeakerCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.AddSpeaker.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddSpeaker *mEventsServiceMockAddSpeaker) Calls() []*EventsServiceMockAddSpeakerParams {
+	mmAddSpeaker.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockAddSpeakerParams, len(mmAddSpeaker.callArgs))
+	copy(argCopy, mmAddSpeaker.callArgs)
+
+	mmAddSpeaker.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddSpeakerDone returns true if the count of the AddSpeaker invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockAddSpeakerDone() bool {
+	if m.AddSpeakerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddSpeakerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddSpeakerMock.invocationsDone()
+}
+
+// MinimockAddSpeakerInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockAddSpeakerInspect() {
+	for _, e := range m.AddSpeakerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSpeaker at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddSpeakerCounter := mm_atomic.LoadUint64(&m.afterAddSpeakerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddSpeakerMock.defaultExpectation != nil && afterAddSpeakerCounter < 1 {
+		if m.AddSpeakerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSpeaker at\n%s", m.AddSpeakerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.AddSpeaker at\n%s with params: %#v", m.AddSpeakerMock.defaultExpectation.expectationOrigins.origin, *m.AddSpeakerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddSpeaker != nil && afterAddSpeakerCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.AddSpeaker at\n%s", m.funcAddSpeakerOrigin)
+	}
+
+	if !m.AddSpeakerMock.invocationsDone() && afterAddSpeakerCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.AddSpeaker at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddSpeakerMock.expectedInvocations), m.AddSpeakerMock.expectedInvocationsOrigin, afterAddSpeakerCounter)
+	}
+}
+
+type mEventsServiceMockBookmarkEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockBookmarkEventExpectation
+	expectations       []*EventsServiceMockBookmarkEventExpectation
+
+	callArgs []*EventsServiceMockBookmarkEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockBookmarkEventExpectation specifies expectation struct of the EventsService.BookmarkEvent
+type EventsServiceMockBookmarkEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockBookmarkEventParams
+	paramPtrs          *EventsServiceMockBookmarkEventParamPtrs
+	expectationOrigins EventsServiceMockBookmarkEventExpectationOrigins
+	results            *EventsServiceMockBookmarkEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockBookmarkEventParams contains parameters of the EventsService.BookmarkEvent
+type EventsServiceMockBookmarkEventParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockBookmarkEventParamPtrs contains pointers to parameters of the EventsService.BookmarkEvent
+type EventsServiceMockBookmarkEventParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockBookmarkEventResults contains results of the EventsService.BookmarkEvent
+type EventsServiceMockBookmarkEventResults struct {
+	err error
+}
+
+// EventsServiceMockBookmarkEventOrigins contains origins of expectations of the EventsService.BookmarkEvent
+type EventsServiceMockBookmarkEventExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Optional() *mEventsServiceMockBookmarkEvent {
+	mmBookmarkEvent.optional = true
+	return mmBookmarkEvent
+}
+
+// Expect sets up expected params for EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockBookmarkEvent {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	if mmBookmarkEvent.defaultExpectation == nil {
+		mmBookmarkEvent.defaultExpectation = &EventsServiceMockBookmarkEventExpectation{}
+	}
+
+	if mmBookmarkEvent.defaultExpectation.paramPtrs != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by ExpectParams functions")
+	}
+
+	mmBookmarkEvent.defaultExpectation.params = &EventsServiceMockBookmarkEventParams{ctx, userID, urlTitle}
+	mmBookmarkEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBookmarkEvent.expectations {
+		if minimock.Equal(e.params, mmBookmarkEvent.defaultExpectation.params) {
+			mmBookmarkEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBookmarkEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmBookmarkEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockBookmarkEvent {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	if mmBookmarkEvent.defaultExpectation == nil {
+		mmBookmarkEvent.defaultExpectation = &EventsServiceMockBookmarkEventExpectation{}
+	}
+
+	if mmBookmarkEvent.defaultExpectation.params != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Expect")
+	}
+
+	if mmBookmarkEvent.defaultExpectation.paramPtrs == nil {
+		mmBookmarkEvent.defaultExpectation.paramPtrs = &EventsServiceMockBookmarkEventParamPtrs{}
+	}
+	mmBookmarkEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBookmarkEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBookmarkEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockBookmarkEvent {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	if mmBookmarkEvent.defaultExpectation == nil {
+		mmBookmarkEvent.defaultExpectation = &EventsServiceMockBookmarkEventExpectation{}
+	}
+
+	if mmBookmarkEvent.defaultExpectation.params != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Expect")
+	}
+
+	if mmBookmarkEvent.defaultExpectation.paramPtrs == nil {
+		mmBookmarkEvent.defaultExpectation.paramPtrs = &EventsServiceMockBookmarkEventParamPtrs{}
+	}
+	mmBookmarkEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmBookmarkEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmBookmarkEvent
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockBookmarkEvent {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	if mmBookmarkEvent.defaultExpectation == nil {
+		mmBookmarkEvent.defaultExpectation = &EventsServiceMockBookmarkEventExpectation{}
+	}
+
+	if mmBookmarkEvent.defaultExpectation.params != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Expect")
+	}
+
+	if mmBookmarkEvent.defaultExpectation.paramPtrs == nil {
+		mmBookmarkEvent.defaultExpectation.paramPtrs = &EventsServiceMockBookmarkEventParamPtrs{}
+	}
+	mmBookmarkEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmBookmarkEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmBookmarkEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockBookmarkEvent {
+	if mmBookmarkEvent.mock.inspectFuncBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.BookmarkEvent")
+	}
+
+	mmBookmarkEvent.mock.inspectFuncBookmarkEvent = f
+
+	return mmBookmarkEvent
+}
+
+// Return sets up results that will be returned by EventsService.BookmarkEvent
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Return(err error) *EventsServiceMock {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	if mmBookmarkEvent.defaultExpectation == nil {
+		mmBookmarkEvent.defaultExpectation = &EventsServiceMockBookmarkEventExpectation{mock: mmBookmarkEvent.mock}
+	}
+	mmBookmarkEvent.defaultExpectation.results = &EventsServiceMockBookmarkEventResults{err}
+	mmBookmarkEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBookmarkEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.BookmarkEvent method
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Set(f func(ctx context.Context, userID int64, urlTitle string) (err error)) *EventsServiceMock {
+	if mmBookmarkEvent.defaultExpectation != nil {
+		mmBookmarkEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.BookmarkEvent method")
+	}
+
+	if len(mmBookmarkEvent.expectations) > 0 {
+		mmBookmarkEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.BookmarkEvent method")
+	}
+
+	mmBookmarkEvent.mock.funcBookmarkEvent = f
+	mmBookmarkEvent.mock.funcBookmarkEventOrigin = minimock.CallerInfo(1)
+	return mmBookmarkEvent.mock
+}
+
+// When sets expectation for the EventsService.BookmarkEvent which will trigger the result defined by the following
+// Then helper
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockBookmarkEventExpectation {
+	if mmBookmarkEvent.mock.funcBookmarkEvent != nil {
+		mmBookmarkEvent.mock.t.Fatalf("EventsServiceMock.BookmarkEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockBookmarkEventExpectation{
+		mock:               mmBookmarkEvent.mock,
+		params:             &EventsServiceMockBookmarkEventParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockBookmarkEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBookmarkEvent.expectations = append(mmBookmarkEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.BookmarkEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockBookmarkEventExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockBookmarkEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.BookmarkEvent should be invoked
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Times(n uint64) *mEventsServiceMockBookmarkEvent {
+	if n == 0 {
+		mmBookmarkEvent.mock.t.Fatalf("Times of EventsServiceMock.BookmarkEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBookmarkEvent.expectedInvocations, n)
+	mmBookmarkEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBookmarkEvent
+}
+
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) invocationsDone() bool {
+	if len(mmBookmarkEvent.expectations) == 0 && mmBookmarkEvent.defaultExpectation == nil && mmBookmarkEvent.mock.funcBookmarkEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBookmarkEvent.mock.afterBookmarkEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBookmarkEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BookmarkEvent implements mm_service.EventsService
+func (mmBookmarkEvent *EventsServiceMock) BookmarkEvent(ctx context.Context, userID int64, urlTitle string) (err error) {
+	mm_atomic.AddUint64(&mmBookmarkEvent.beforeBookmarkEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmBookmarkEvent.afterBookmarkEventCounter, 1)
+
+	mmBookmarkEvent.t.Helper()
+
+	if mmBookmarkEvent.inspectFuncBookmarkEvent != nil {
+		mmBookmarkEvent.inspectFuncBookmarkEvent(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockBookmarkEventParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmBookmarkEvent.BookmarkEventMock.mutex.Lock()
+	mmBookmarkEvent.BookmarkEventMock.callArgs = append(mmBookmarkEvent.BookmarkEventMock.callArgs, &mm_params)
+	mmBookmarkEvent.BookmarkEventMock.mutex.Unlock()
+
+	for _, e := range mmBookmarkEvent.BookmarkEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBookmarkEvent.BookmarkEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBookmarkEvent.BookmarkEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmBookmarkEvent.BookmarkEventMock.defaultExpectation.params
+		mm_want_ptrs := mmBookmarkEvent.BookmarkEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockBookmarkEventParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBookmarkEvent.t.Errorf("EventsServiceMock.BookmarkEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBookmarkEvent.BookmarkEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBookmarkEvent.t.Errorf("EventsServiceMock.BookmarkEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBookmarkEvent.BookmarkEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmBookmarkEvent.t.Errorf("EventsServiceMock.BookmarkEvent got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBookmarkEvent.BookmarkEventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBookmarkEvent.t.Errorf("EventsServiceMock.BookmarkEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBookmarkEvent.BookmarkEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBookmarkEvent.BookmarkEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBookmarkEvent.t.Fatal("No results are set for the EventsServiceMock.BookmarkEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmBookmarkEvent.funcBookmarkEvent != nil {
+		return mmBookmarkEvent.funcBookmarkEvent(ctx, userID, urlTitle)
+	}
+	mmBookmarkEvent.t.Fatalf("Unexpected call to EventsServiceMock.BookmarkEvent. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// BookmarkEventAfterCounter returns a count of finished EventsServiceMock.BookmarkEvent invocations
+func (mmBookmarkEvent *EventsServiceMock) BookmarkEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBookmarkEvent.afterBookmarkEventCounter)
+}
+
+// BookmarkEventBeforeCounter returns a count of EventsServiceMock.BookmarkEvent invocations
+func (mmBookmarkEvent *EventsServiceMock) BookmarkEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBookmarkEvent.beforeBookmarkEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.BookmarkEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBookmarkEvent *mEventsServiceMockBookmarkEvent) Calls() []*EventsServiceMockBookmarkEventParams {
+	mmBookmarkEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockBookmarkEventParams, len(mmBookmarkEvent.callArgs))
+	copy(argCopy, mmBookmarkEvent.callArgs)
+
+	mmBookmarkEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBookmarkEventDone returns true if the count of the BookmarkEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockBookmarkEventDone() bool {
+	if m.BookmarkEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BookmarkEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BookmarkEventMock.invocationsDone()
+}
+
+// MinimockBookmarkEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockBookmarkEventInspect() {
+	for _, e := range m.BookmarkEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.BookmarkEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBookmarkEventCounter := mm_atomic.LoadUint64(&m.afterBookmarkEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BookmarkEventMock.defaultExpectation != nil && afterBookmarkEventCounter < 1 {
+		if m.BookmarkEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.BookmarkEvent at\n%s", m.BookmarkEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.BookmarkEvent at\n%s with params: %#v", m.BookmarkEventMock.defaultExpectation.expectationOrigins.origin, *m.BookmarkEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBookmarkEvent != nil && afterBookmarkEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.BookmarkEvent at\n%s", m.funcBookmarkEventOrigin)
+	}
+
+	if !m.BookmarkEventMock.invocationsDone() && afterBookmarkEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.BookmarkEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BookmarkEventMock.expectedInvocations), m.BookmarkEventMock.expectedInvocationsOrigin, afterBookmarkEventCounter)
+	}
+}
+
+type mEventsServiceMockBookmarks struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockBookmarksExpectation
+	expectations       []*EventsServiceMockBookmarksExpectation
+
+	callArgs []*EventsServiceMockBookmarksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockBookmarksExpectation specifies expectation struct of the EventsService.Bookmarks
+type EventsServiceMockBookmarksExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockBookmarksParams
+	paramPtrs          *EventsServiceMockBookmarksParamPtrs
+	expectationOrigins EventsServiceMockBookmarksExpectationOrigins
+	results            *EventsServiceMockBookmarksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockBookmarksParams contains parameters of the EventsService.Bookmarks
+type EventsServiceMockBookmarksParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// EventsServiceMockBookmarksParamPtrs contains pointers to parameters of the EventsService.Bookmarks
+type EventsServiceMockBookmarksParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// EventsServiceMockBookmarksResults contains results of the EventsService.Bookmarks
+type EventsServiceMockBookmarksResults struct {
+	epa1 []*models.Event
+	err  error
+}
+
+// EventsServiceMockBookmarksOrigins contains origins of expectations of the EventsService.Bookmarks
+type EventsServiceMockBookmarksExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBookmarks *mEventsServiceMockBookmarks) Optional() *mEventsServiceMockBookmarks {
+	mmBookmarks.optional = true
+	return mmBookmarks
+}
+
+// Expect sets up expected params for EventsService.Bookmarks
+func (mmBookmarks *mEventsServiceMockBookmarks) Expect(ctx context.Context, userID int64) *mEventsServiceMockBookmarks {
+	if mmBookmarks.mock.funcBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Set")
+	}
+
+	if mmBookmarks.defaultExpectation == nil {
+		mmBookmarks.defaultExpectation = &EventsServiceMockBookmarksExpectation{}
+	}
+
+	if mmBookmarks.defaultExpectation.paramPtrs != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by ExpectParams functions")
+	}
+
+	mmBookmarks.defaultExpectation.params = &EventsServiceMockBookmarksParams{ctx, userID}
+	mmBookmarks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBookmarks.expectations {
+		if minimock.Equal(e.params, mmBookmarks.defaultExpectation.params) {
+			mmBookmarks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBookmarks.defaultExpectation.params)
+		}
+	}
+
+	return mmBookmarks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.Bookmarks
+func (mmBookmarks *mEventsServiceMockBookmarks) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockBookmarks {
+	if mmBookmarks.mock.funcBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Set")
+	}
+
+	if mmBookmarks.defaultExpectation == nil {
+		mmBookmarks.defaultExpectation = &EventsServiceMockBookmarksExpectation{}
+	}
+
+	if mmBookmarks.defaultExpectation.params != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Expect")
+	}
+
+	if mmBookmarks.defaultExpectation.paramPtrs == nil {
+		mmBookmarks.defaultExpectation.paramPtrs = &EventsServiceMockBookmarksParamPtrs{}
+	}
+	mmBookmarks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBookmarks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBookmarks
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.Bookmarks
+func (mmBookmarks *mEventsServiceMockBookmarks) ExpectUserIDParam2(userID int64) *mEventsServiceMockBookmarks {
+	if mmBookmarks.mock.funcBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Set")
+	}
+
+	if mmBookmarks.defaultExpectation == nil {
+		mmBookmarks.defaultExpectation = &EventsServiceMockBookmarksExpectation{}
+	}
+
+	if mmBookmarks.defaultExpectation.params != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Expect")
+	}
+
+	if mmBookmarks.defaultExpectation.paramPtrs == nil {
+		mmBookmarks.defaultExpectation.paramPtrs = &EventsServiceMockBookmarksParamPtrs{}
+	}
+	mmBookmarks.defaultExpectation.paramPtrs.userID = &userID
+	mmBookmarks.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmBookmarks
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.Bookmarks
+func (mmBookmarks *mEventsServiceMockBookmarks) Inspect(f func(ctx context.Context, userID int64)) *mEventsServiceMockBookmarks {
+	if mmBookmarks.mock.inspectFuncBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Bookmarks")
+	}
+
+	mmBookmarks.mock.inspectFuncBookmarks = f
+
+	return mmBookmarks
+}
+
+// Return sets up results that will be returned by EventsService.Bookmarks
+func (mmBookmarks *mEventsServiceMockBookmarks) Return(epa1 []*models.Event, err error) *EventsServiceMock {
+	if mmBookmarks.mock.funcBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Set")
+	}
+
+	if mmBookmarks.defaultExpectation == nil {
+		mmBookmarks.defaultExpectation = &EventsServiceMockBookmarksExpectation{mock: mmBookmarks.mock}
+	}
+	mmBookmarks.defaultExpectation.results = &EventsServiceMockBookmarksResults{epa1, err}
+	mmBookmarks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBookmarks.mock
+}
+
+// Set uses given function f to mock the EventsService.Bookmarks method
+func (mmBookmarks *mEventsServiceMockBookmarks) Set(f func(ctx context.Context, userID int64) (epa1 []*models.Event, err error)) *EventsServiceMock {
+	if mmBookmarks.defaultExpectation != nil {
+		mmBookmarks.mock.t.Fatalf("Default expectation is already set for the EventsService.Bookmarks method")
+	}
+
+	if len(mmBookmarks.expectations) > 0 {
+		mmBookmarks.mock.t.Fatalf("Some expectations are already set for the EventsService.Bookmarks method")
+	}
+
+	mmBookmarks.mock.funcBookmarks = f
+	mmBookmarks.mock.funcBookmarksOrigin = minimock.CallerInfo(1)
+	return mmBookmarks.mock
+}
+
+// When sets expectation for the EventsService.Bookmarks which will trigger the result defined by the following
+// Then helper
+func (mmBookmarks *mEventsServiceMockBookmarks) When(ctx context.Context, userID int64) *EventsServiceMockBookmarksExpectation {
+	if mmBookmarks.mock.funcBookmarks != nil {
+		mmBookmarks.mock.t.Fatalf("EventsServiceMock.Bookmarks mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockBookmarksExpectation{
+		mock:               mmBookmarks.mock,
+		params:             &EventsServiceMockBookmarksParams{ctx, userID},
+		expectationOrigins: EventsServiceMockBookmarksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBookmarks.expectations = append(mmBookmarks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.Bookmarks return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockBookmarksExpectation) Then(epa1 []*models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockBookmarksResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.Bookmarks should be invoked
+func (mmBookmarks *mEventsServiceMockBookmarks) Times(n uint64) *mEventsServiceMockBookmarks {
+	if n == 0 {
+		mmBookmarks.mock.t.Fatalf("Times of EventsServiceMock.Bookmarks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBookmarks.expectedInvocations, n)
+	mmBookmarks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBookmarks
+}
+
+func (mmBookmarks *mEventsServiceMockBookmarks) invocationsDone() bool {
+	if len(mmBookmarks.expectations) == 0 && mmBookmarks.defaultExpectation == nil && mmBookmarks.mock.funcBookmarks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBookmarks.mock.afterBookmarksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBookmarks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Bookmarks implements mm_service.EventsService
+func (mmBookmarks *EventsServiceMock) Bookmarks(ctx context.Context, userID int64) (epa1 []*models.Event, err error) {
+	mm_atomic.AddUint64(&mmBookmarks.beforeBookmarksCounter, 1)
+	defer mm_atomic.AddUint64(&mmBookmarks.afterBookmarksCounter, 1)
+
+	mmBookmarks.t.Helper()
+
+	if mmBookmarks.inspectFuncBookmarks != nil {
+		mmBookmarks.inspectFuncBookmarks(ctx, userID)
+	}
+
+	mm_params := EventsServiceMockBookmarksParams{ctx, userID}
+
+	// Record call args
+	mmBookmarks.BookmarksMock.mutex.Lock()
+	mmBookmarks.BookmarksMock.callArgs = append(mmBookmarks.BookmarksMock.callArgs, &mm_params)
+	mmBookmarks.BookmarksMock.mutex.Unlock()
+
+	for _, e := range mmBookmarks.BookmarksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmBookmarks.BookmarksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBookmarks.BookmarksMock.defaultExpectation.Counter, 1)
+		mm_want := mmBookmarks.BookmarksMock.defaultExpectation.params
+		mm_want_ptrs := mmBookmarks.BookmarksMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockBookmarksParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBookmarks.t.Errorf("EventsServiceMock.Bookmarks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBookmarks.BookmarksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBookmarks.t.Errorf("EventsServiceMock.Bookmarks got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBookmarks.BookmarksMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBookmarks.t.Errorf("EventsServiceMock.Bookmarks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBookmarks.BookmarksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBookmarks.BookmarksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBookmarks.t.Fatal("No results are set for the EventsServiceMock.Bookmarks")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmBookmarks.funcBookmarks != nil {
+		return mmBookmarks.funcBookmarks(ctx, userID)
+	}
+	mmBookmarks.t.Fatalf("Unexpected call to EventsServiceMock.Bookmarks. %v %v", ctx, userID)
+	return
+}
+
+// BookmarksAfterCounter returns a count of finished EventsServiceMock.Bookmarks invocations
+func (mmBookmarks *EventsServiceMock) BookmarksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBookmarks.afterBookmarksCounter)
+}
+
+// BookmarksBeforeCounter returns a count of EventsServiceMock.Bookmarks invocations
+func (mmBookmarks *EventsServiceMock) BookmarksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBookmarks.beforeBookmarksCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.Bookmarks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBookmarks *mEventsServiceMockBookmarks) Calls() []*EventsServiceMockBookmarksParams {
+	mmBookmarks.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockBookmarksParams, len(mmBookmarks.callArgs))
+	copy(argCopy, mmBookmarks.callArgs)
+
+	mmBookmarks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBookmarksDone returns true if the count of the Bookmarks invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockBookmarksDone() bool {
+	if m.BookmarksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BookmarksMock.invocationsDone()
+}
+
+// MinimockBookmarksInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockBookmarksInspect() {
+	for _, e := range m.BookmarksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.Bookmarks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBookmarksCounter := mm_atomic.LoadUint64(&m.afterBookmarksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BookmarksMock.defaultExpectation != nil && afterBookmarksCounter < 1 {
+		if m.BookmarksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.Bookmarks at\n%s", m.BookmarksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.Bookmarks at\n%s with params: %#v", m.BookmarksMock.defaultExpectation.expectationOrigins.origin, *m.BookmarksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBookmarks != nil && afterBookmarksCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.Bookmarks at\n%s", m.funcBookmarksOrigin)
+	}
+
+	if !m.BookmarksMock.invocationsDone() && afterBookmarksCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.Bookmarks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BookmarksMock.expectedInvocations), m.BookmarksMock.expectedInvocationsOrigin, afterBookmarksCounter)
+	}
+}
+
+type mEventsServiceMockCancelEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockCancelEventExpectation
+	expectations       []*EventsServiceMockCancelEventExpectation
+
+	callArgs []*EventsServiceMockCancelEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockCancelEventExpectation specifies expectation struct of the EventsService.CancelEvent
+type EventsServiceMockCancelEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockCancelEventParams
+	paramPtrs          *EventsServiceMockCancelEventParamPtrs
+	expectationOrigins EventsServiceMockCancelEventExpectationOrigins
+	results            *EventsServiceMockCancelEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockCancelEventParams contains parameters of the EventsService.CancelEvent
+type EventsServiceMockCancelEventParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	reason   string
+}
+
+// EventsServiceMockCancelEventParamPtrs contains pointers to parameters of the EventsService.CancelEvent
+type EventsServiceMockCancelEventParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	reason   *string
+}
+
+// EventsServiceMockCancelEventResults contains results of the EventsService.CancelEvent
+type EventsServiceMockCancelEventResults struct {
+	err error
+}
+
+// EventsServiceMockCancelEventOrigins contains origins of expectations of the EventsService.CancelEvent
+type EventsServiceMockCancelEventExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originReason   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Optional() *mEventsServiceMockCancelEvent {
+	mmCancelEvent.optional = true
+	return mmCancelEvent
+}
+
+// Expect sets up expected params for EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Expect(ctx context.Context, userID int64, urlTitle string, reason string) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{}
+	}
+
+	if mmCancelEvent.defaultExpectation.paramPtrs != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCancelEvent.defaultExpectation.params = &EventsServiceMockCancelEventParams{ctx, userID, urlTitle, reason}
+	mmCancelEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelEvent.expectations {
+		if minimock.Equal(e.params, mmCancelEvent.defaultExpectation.params) {
+			mmCancelEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{}
+	}
+
+	if mmCancelEvent.defaultExpectation.params != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Expect")
+	}
+
+	if mmCancelEvent.defaultExpectation.paramPtrs == nil {
+		mmCancelEvent.defaultExpectation.paramPtrs = &EventsServiceMockCancelEventParamPtrs{}
+	}
+	mmCancelEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{}
+	}
+
+	if mmCancelEvent.defaultExpectation.params != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Expect")
+	}
+
+	if mmCancelEvent.defaultExpectation.paramPtrs == nil {
+		mmCancelEvent.defaultExpectation.paramPtrs = &EventsServiceMockCancelEventParamPtrs{}
+	}
+	mmCancelEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmCancelEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCancelEvent
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{}
+	}
+
+	if mmCancelEvent.defaultExpectation.params != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Expect")
+	}
+
+	if mmCancelEvent.defaultExpectation.paramPtrs == nil {
+		mmCancelEvent.defaultExpectation.paramPtrs = &EventsServiceMockCancelEventParamPtrs{}
+	}
+	mmCancelEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmCancelEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmCancelEvent
+}
+
+// ExpectReasonParam4 sets up expected param reason for EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) ExpectReasonParam4(reason string) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{}
+	}
+
+	if mmCancelEvent.defaultExpectation.params != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Expect")
+	}
+
+	if mmCancelEvent.defaultExpectation.paramPtrs == nil {
+		mmCancelEvent.defaultExpectation.paramPtrs = &EventsServiceMockCancelEventParamPtrs{}
+	}
+	mmCancelEvent.defaultExpectation.paramPtrs.reason = &reason
+	mmCancelEvent.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmCancelEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string, reason string)) *mEventsServiceMockCancelEvent {
+	if mmCancelEvent.mock.inspectFuncCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.CancelEvent")
+	}
+
+	mmCancelEvent.mock.inspectFuncCancelEvent = f
+
+	return mmCancelEvent
+}
+
+// Return sets up results that will be returned by EventsService.CancelEvent
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Return(err error) *EventsServiceMock {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	if mmCancelEvent.defaultExpectation == nil {
+		mmCancelEvent.defaultExpectation = &EventsServiceMockCancelEventExpectation{mock: mmCancelEvent.mock}
+	}
+	mmCancelEvent.defaultExpectation.results = &EventsServiceMockCancelEventResults{err}
+	mmCancelEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.CancelEvent method
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Set(f func(ctx context.Context, userID int64, urlTitle string, reason string) (err error)) *EventsServiceMock {
+	if mmCancelEvent.defaultExpectation != nil {
+		mmCancelEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.CancelEvent method")
+	}
+
+	if len(mmCancelEvent.expectations) > 0 {
+		mmCancelEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.CancelEvent method")
+	}
+
+	mmCancelEvent.mock.funcCancelEvent = f
+	mmCancelEvent.mock.funcCancelEventOrigin = minimock.CallerInfo(1)
+	return mmCancelEvent.mock
+}
+
+// When sets expectation for the EventsService.CancelEvent which will trigger the result defined by the following
+// Then helper
+func (mmCancelEvent *mEventsServiceMockCancelEvent) When(ctx context.Context, userID int64, urlTitle string, reason string) *EventsServiceMockCancelEventExpectation {
+	if mmCancelEvent.mock.funcCancelEvent != nil {
+		mmCancelEvent.mock.t.Fatalf("EventsServiceMock.CancelEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockCancelEventExpectation{
+		mock:               mmCancelEvent.mock,
+		params:             &EventsServiceMockCancelEventParams{ctx, userID, urlTitle, reason},
+		expectationOrigins: EventsServiceMockCancelEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelEvent.expectations = append(mmCancelEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.CancelEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockCancelEventExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockCancelEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.CancelEvent should be invoked
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Times(n uint64) *mEventsServiceMockCancelEvent {
+	if n == 0 {
+		mmCancelEvent.mock.t.Fatalf("Times of EventsServiceMock.CancelEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelEvent.expectedInvocations, n)
+	mmCancelEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelEvent
+}
+
+func (mmCancelEvent *mEventsServiceMockCancelEvent) invocationsDone() bool {
+	if len(mmCancelEvent.expectations) == 0 && mmCancelEvent.defaultExpectation == nil && mmCancelEvent.mock.funcCancelEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelEvent.mock.afterCancelEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelEvent implements mm_service.EventsService
+func (mmCancelEvent *EventsServiceMock) CancelEvent(ctx context.Context, userID int64, urlTitle string, reason string) (err error) {
+	mm_atomic.AddUint64(&mmCancelEvent.beforeCancelEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelEvent.afterCancelEventCounter, 1)
+
+	mmCancelEvent.t.Helper()
+
+	if mmCancelEvent.inspectFuncCancelEvent != nil {
+		mmCancelEvent.inspectFuncCancelEvent(ctx, userID, urlTitle, reason)
+	}
+
+	mm_params := EventsServiceMockCancelEventParams{ctx, userID, urlTitle, reason}
+
+	// Record call args
+	mmCancelEvent.CancelEventMock.mutex.Lock()
+	mmCancelEvent.CancelEventMock.callArgs = append(mmCancelEvent.CancelEventMock.callArgs, &mm_params)
+	mmCancelEvent.CancelEventMock.mutex.Unlock()
+
+	for _, e := range mmCancelEvent.CancelEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelEvent.CancelEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelEvent.CancelEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelEvent.CancelEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelEvent.CancelEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockCancelEventParams{ctx, userID, urlTitle, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelEvent.t.Errorf("EventsServiceMock.CancelEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelEvent.CancelEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCancelEvent.t.Errorf("EventsServiceMock.CancelEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelEvent.CancelEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmCancelEvent.t.Errorf("EventsServiceMock.CancelEvent got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelEvent.CancelEventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmCancelEvent.t.Errorf("EventsServiceMock.CancelEvent got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelEvent.CancelEventMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelEvent.t.Errorf("EventsServiceMock.CancelEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelEvent.CancelEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelEvent.CancelEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelEvent.t.Fatal("No results are set for the EventsServiceMock.CancelEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelEvent.funcCancelEvent != nil {
+		return mmCancelEvent.funcCancelEvent(ctx, userID, urlTitle, reason)
+	}
+	mmCancelEvent.t.Fatalf("Unexpected call to EventsServiceMock.CancelEvent. %v %v %v %v", ctx, userID, urlTitle, reason)
+	return
+}
+
+// CancelEventAfterCounter returns a count of finished EventsServiceMock.CancelEvent invocations
+func (mmCancelEvent *EventsServiceMock) CancelEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelEvent.afterCancelEventCounter)
+}
+
+// CancelEventBeforeCounter returns a count of EventsServiceMock.CancelEvent invocations
+func (mmCancelEvent *EventsServiceMock) CancelEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelEvent.beforeCancelEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.CancelEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelEvent *mEventsServiceMockCancelEvent) Calls() []*EventsServiceMockCancelEventParams {
+	mmCancelEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockCancelEventParams, len(mmCancelEvent.callArgs))
+	copy(argCopy, mmCancelEvent.callArgs)
+
+	mmCancelEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelEventDone returns true if the count of the CancelEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockCancelEventDone() bool {
+	if m.CancelEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelEventMock.invocationsDone()
+}
+
+// MinimockCancelEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockCancelEventInspect() {
+	for _, e := range m.CancelEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.CancelEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelEventCounter := mm_atomic.LoadUint64(&m.afterCancelEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelEventMock.defaultExpectation != nil && afterCancelEventCounter < 1 {
+		if m.CancelEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.CancelEvent at\n%s", m.CancelEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.CancelEvent at\n%s with params: %#v", m.CancelEventMock.defaultExpectation.expectationOrigins.origin, *m.CancelEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelEvent != nil && afterCancelEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.CancelEvent at\n%s", m.funcCancelEventOrigin)
+	}
+
+	if !m.CancelEventMock.invocationsDone() && afterCancelEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.CancelEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelEventMock.expectedInvocations), m.CancelEventMock.expectedInvocationsOrigin, afterCancelEventCounter)
+	}
+}
+
+type mEventsServiceMockCancellationProgress struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockCancellationProgressExpectation
+	expectations       []*EventsServiceMockCancellationProgressExpectation
+
+	callArgs []*EventsServiceMockCancellationProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockCancellationProgressExpectation specifies expectation struct of the EventsService.CancellationProgress
+type EventsServiceMockCancellationProgressExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockCancellationProgressParams
+	paramPtrs          *EventsServiceMockCancellationProgressParamPtrs
+	expectationOrigins EventsServiceMockCancellationProgressExpectationOrigins
+	results            *EventsServiceMockCancellationProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockCancellationProgressParams contains parameters of the EventsService.CancellationProgress
+type EventsServiceMockCancellationProgressParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockCancellationProgressParamPtrs contains pointers to parameters of the EventsService.CancellationProgress
+type EventsServiceMockCancellationProgressParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockCancellationProgressResults contains results of the EventsService.CancellationProgress
+type EventsServiceMockCancellationProgressResults struct {
+	cp1 *models.CancellationProgress
+	err error
+}
+
+// EventsServiceMockCancellationProgressOrigins contains origins of expectations of the EventsService.CancellationProgress
+type EventsServiceMockCancellationProgressExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Optional() *mEventsServiceMockCancellationProgress {
+	mmCancellationProgress.optional = true
+	return mmCancellationProgress
+}
+
+// Expect sets up expected params for EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockCancellationProgress {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	if mmCancellationProgress.defaultExpectation == nil {
+		mmCancellationProgress.defaultExpectation = &EventsServiceMockCancellationProgressExpectation{}
+	}
+
+	if mmCancellationProgress.defaultExpectation.paramPtrs != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by ExpectParams functions")
+	}
+
+	mmCancellationProgress.defaultExpectation.params = &EventsServiceMockCancellationProgressParams{ctx, userID, urlTitle}
+	mmCancellationProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancellationProgress.expectations {
+		if minimock.Equal(e.params, mmCancellationProgress.defaultExpectation.params) {
+			mmCancellationProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancellationProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmCancellationProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockCancellationProgress {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	if mmCancellationProgress.defaultExpectation == nil {
+		mmCancellationProgress.defaultExpectation = &EventsServiceMockCancellationProgressExpectation{}
+	}
+
+	if mmCancellationProgress.defaultExpectation.params != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Expect")
+	}
+
+	if mmCancellationProgress.defaultExpectation.paramPtrs == nil {
+		mmCancellationProgress.defaultExpectation.paramPtrs = &EventsServiceMockCancellationProgressParamPtrs{}
+	}
+	mmCancellationProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancellationProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancellationProgress
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) ExpectUserIDParam2(userID int64) *mEventsServiceMockCancellationProgress {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	if mmCancellationProgress.defaultExpectation == nil {
+		mmCancellationProgress.defaultExpectation = &EventsServiceMockCancellationProgressExpectation{}
+	}
+
+	if mmCancellationProgress.defaultExpectation.params != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Expect")
+	}
+
+	if mmCancellationProgress.defaultExpectation.paramPtrs == nil {
+		mmCancellationProgress.defaultExpectation.paramPtrs = &EventsServiceMockCancellationProgressParamPtrs{}
+	}
+	mmCancellationProgress.defaultExpectation.paramPtrs.userID = &userID
+	mmCancellationProgress.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCancellationProgress
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockCancellationProgress {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	if mmCancellationProgress.defaultExpectation == nil {
+		mmCancellationProgress.defaultExpectation = &EventsServiceMockCancellationProgressExpectation{}
+	}
+
+	if mmCancellationProgress.defaultExpectation.params != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Expect")
+	}
+
+	if mmCancellationProgress.defaultExpectation.paramPtrs == nil {
+		mmCancellationProgress.defaultExpectation.paramPtrs = &EventsServiceMockCancellationProgressParamPtrs{}
+	}
+	mmCancellationProgress.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmCancellationProgress.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmCancellationProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockCancellationProgress {
+	if mmCancellationProgress.mock.inspectFuncCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.CancellationProgress")
+	}
+
+	mmCancellationProgress.mock.inspectFuncCancellationProgress = f
+
+	return mmCancellationProgress
+}
+
+// Return sets up results that will be returned by EventsService.CancellationProgress
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Return(cp1 *models.CancellationProgress, err error) *EventsServiceMock {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	if mmCancellationProgress.defaultExpectation == nil {
+		mmCancellationProgress.defaultExpectation = &EventsServiceMockCancellationProgressExpectation{mock: mmCancellationProgress.mock}
+	}
+	mmCancellationProgress.defaultExpectation.results = &EventsServiceMockCancellationProgressResults{cp1, err}
+	mmCancellationProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancellationProgress.mock
+}
+
+// Set uses given function f to mock the EventsService.CancellationProgress method
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Set(f func(ctx context.Context, userID int64, urlTitle string) (cp1 *models.CancellationProgress, err error)) *EventsServiceMock {
+	if mmCancellationProgress.defaultExpectation != nil {
+		mmCancellationProgress.mock.t.Fatalf("Default expectation is already set for the EventsService.CancellationProgress method")
+	}
+
+	if len(mmCancellationProgress.expectations) > 0 {
+		mmCancellationProgress.mock.t.Fatalf("Some expectations are already set for the EventsService.CancellationProgress method")
+	}
+
+	mmCancellationProgress.mock.funcCancellationProgress = f
+	mmCancellationProgress.mock.funcCancellationProgressOrigin = minimock.CallerInfo(1)
+	return mmCancellationProgress.mock
+}
+
+// When sets expectation for the EventsService.CancellationProgress which will trigger the result defined by the following
+// Then helper
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockCancellationProgressExpectation {
+	if mmCancellationProgress.mock.funcCancellationProgress != nil {
+		mmCancellationProgress.mock.t.Fatalf("EventsServiceMock.CancellationProgress mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockCancellationProgressExpectation{
+		mock:               mmCancellationProgress.mock,
+		params:             &EventsServiceMockCancellationProgressParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockCancellationProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancellationProgress.expectations = append(mmCancellationProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.CancellationProgress return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockCancellationProgressExpectation) Then(cp1 *models.CancellationProgress, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockCancellationProgressResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.CancellationProgress should be invoked
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Times(n uint64) *mEventsServiceMockCancellationProgress {
+	if n == 0 {
+		mmCancellationProgress.mock.t.Fatalf("Times of EventsServiceMock.CancellationProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancellationProgress.expectedInvocations, n)
+	mmCancellationProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancellationProgress
+}
+
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) invocationsDone() bool {
+	if len(mmCancellationProgress.expectations) == 0 && mmCancellationProgress.defaultExpectation == nil && mmCancellationProgress.mock.funcCancellationProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancellationProgress.mock.afterCancellationProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancellationProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancellationProgress implements mm_service.EventsService
+func (mmCancellationProgress *EventsServiceMock) CancellationProgress(ctx context.Context, userID int64, urlTitle string) (cp1 *models.CancellationProgress, err error) {
+	mm_atomic.AddUint64(&mmCancellationProgress.beforeCancellationProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancellationProgress.afterCancellationProgressCounter, 1)
+
+	mmCancellationProgress.t.Helper()
+
+	if mmCancellationProgress.inspectFuncCancellationProgress != nil {
+		mmCancellationProgress.inspectFuncCancellationProgress(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockCancellationProgressParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmCancellationProgress.CancellationProgressMock.mutex.Lock()
+	mmCancellationProgress.CancellationProgressMock.callArgs = append(mmCancellationProgress.CancellationProgressMock.callArgs, &mm_params)
+	mmCancellationProgress.CancellationProgressMock.mutex.Unlock()
+
+	for _, e := range mmCancellationProgress.CancellationProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmCancellationProgress.CancellationProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancellationProgress.CancellationProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancellationProgress.CancellationProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmCancellationProgress.CancellationProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockCancellationProgressParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancellationProgress.t.Errorf("EventsServiceMock.CancellationProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancellationProgress.CancellationProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCancellationProgress.t.Errorf("EventsServiceMock.CancellationProgress got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancellationProgress.CancellationProgressMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmCancellationProgress.t.Errorf("EventsServiceMock.CancellationProgress got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancellationProgress.CancellationProgressMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancellationProgress.t.Errorf("EventsServiceMock.CancellationProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancellationProgress.CancellationProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancellationProgress.CancellationProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancellationProgress.t.Fatal("No results are set for the EventsServiceMock.CancellationProgress")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmCancellationProgress.funcCancellationProgress != nil {
+		return mmCancellationProgress.funcCancellationProgress(ctx, userID, urlTitle)
+	}
+	mmCancellationProgress.t.Fatalf("Unexpected call to EventsServiceMock.CancellationProgress. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// CancellationProgressAfterCounter returns a count of finished EventsServiceMock.CancellationProgress invocations
+func (mmCancellationProgress *EventsServiceMock) CancellationProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancellationProgress.afterCancellationProgressCounter)
+}
+
+// CancellationProgressBeforeCounter returns a count of EventsServiceMock.CancellationProgress invocations
+func (mmCancellationProgress *EventsServiceMock) CancellationProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancellationProgress.beforeCancellationProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.CancellationProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancellationProgress *mEventsServiceMockCancellationProgress) Calls() []*EventsServiceMockCancellationProgressParams {
+	mmCancellationProgress.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockCancellationProgressParams, len(mmCancellationProgress.callArgs))
+	copy(argCopy, mmCancellationProgress.callArgs)
+
+	mmCancellationProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancellationProgressDone returns true if the count of the CancellationProgress invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockCancellationProgressDone() bool {
+	if m.CancellationProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancellationProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancellationProgressMock.invocationsDone()
+}
+
+// MinimockCancellationProgressInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockCancellationProgressInspect() {
+	for _, e := range m.CancellationProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.CancellationProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancellationProgressCounter := mm_atomic.LoadUint64(&m.afterCancellationProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancellationProgressMock.defaultExpectation != nil && afterCancellationProgressCounter < 1 {
+		if m.CancellationProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.CancellationProgress at\n%s", m.CancellationProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.CancellationProgress at\n%s with params: %#v", m.CancellationProgressMock.defaultExpectation.expectationOrigins.origin, *m.CancellationProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancellationProgress != nil && afterCancellationProgressCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.CancellationProgress at\n%s", m.funcCancellationProgressOrigin)
+	}
+
+	if !m.CancellationProgressMock.invocationsDone() && afterCancellationProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.CancellationProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancellationProgressMock.expectedInvocations), m.CancellationProgressMock.expectedInvocationsOrigin, afterCancellationProgressCounter)
+	}
+}
+
+type mEventsServiceMockCloneEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockCloneEventExpectation
+	expectations       []*EventsServiceMockCloneEventExpectation
+
+	callArgs []*EventsServiceMockCloneEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockCloneEventExpectation specifies expectation struct of the EventsService.CloneEvent
+type EventsServiceMockCloneEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockCloneEventParams
+	paramPtrs          *EventsServiceMockCloneEventParamPtrs
+	expectationOrigins EventsServiceMockCloneEventExpectationOrigins
+	results            *EventsServiceMockCloneEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockCloneEventParams contains parameters of the EventsService.CloneEvent
+type EventsServiceMockCloneEventParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	req      *models.CloneEventRequest
+}
+
+// EventsServiceMockCloneEventParamPtrs contains pointers to parameters of the EventsService.CloneEvent
+type EventsServiceMockCloneEventParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	req      **models.CloneEventRequest
+}
+
+// EventsServiceMockCloneEventResults contains results of the EventsService.CloneEvent
+type EventsServiceMockCloneEventResults struct {
+	ep1 *models.Event
+	err error
+}
+
+// EventsServiceMockCloneEventOrigins contains origins of expectations of the EventsService.CloneEvent
+type EventsServiceMockCloneEventExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originReq      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Optional() *mEventsServiceMockCloneEvent {
+	mmCloneEvent.optional = true
+	return mmCloneEvent
+}
+
+// Expect sets up expected params for EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Expect(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{}
+	}
+
+	if mmCloneEvent.defaultExpectation.paramPtrs != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCloneEvent.defaultExpectation.params = &EventsServiceMockCloneEventParams{ctx, userID, urlTitle, req}
+	mmCloneEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCloneEvent.expectations {
+		if minimock.Equal(e.params, mmCloneEvent.defaultExpectation.params) {
+			mmCloneEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCloneEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCloneEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{}
+	}
+
+	if mmCloneEvent.defaultExpectation.params != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Expect")
+	}
+
+	if mmCloneEvent.defaultExpectation.paramPtrs == nil {
+		mmCloneEvent.defaultExpectation.paramPtrs = &EventsServiceMockCloneEventParamPtrs{}
+	}
+	mmCloneEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCloneEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCloneEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{}
+	}
+
+	if mmCloneEvent.defaultExpectation.params != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Expect")
+	}
+
+	if mmCloneEvent.defaultExpectation.paramPtrs == nil {
+		mmCloneEvent.defaultExpectation.paramPtrs = &EventsServiceMockCloneEventParamPtrs{}
+	}
+	mmCloneEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmCloneEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCloneEvent
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{}
+	}
+
+	if mmCloneEvent.defaultExpectation.params != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Expect")
+	}
+
+	if mmCloneEvent.defaultExpectation.paramPtrs == nil {
+		mmCloneEvent.defaultExpectation.paramPtrs = &EventsServiceMockCloneEventParamPtrs{}
+	}
+	mmCloneEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmCloneEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmCloneEvent
+}
+
+// ExpectReqParam4 sets up expected param req for EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) ExpectReqParam4(req *models.CloneEventRequest) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{}
+	}
+
+	if mmCloneEvent.defaultExpectation.params != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Expect")
+	}
+
+	if mmCloneEvent.defaultExpectation.paramPtrs == nil {
+		mmCloneEvent.defaultExpectation.paramPtrs = &EventsServiceMockCloneEventParamPtrs{}
+	}
+	mmCloneEvent.defaultExpectation.paramPtrs.req = &req
+	mmCloneEvent.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCloneEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest)) *mEventsServiceMockCloneEvent {
+	if mmCloneEvent.mock.inspectFuncCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.CloneEvent")
+	}
+
+	mmCloneEvent.mock.inspectFuncCloneEvent = f
+
+	return mmCloneEvent
+}
+
+// Return sets up results that will be returned by EventsService.CloneEvent
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Return(ep1 *models.Event, err error) *EventsServiceMock {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	if mmCloneEvent.defaultExpectation == nil {
+		mmCloneEvent.defaultExpectation = &EventsServiceMockCloneEventExpectation{mock: mmCloneEvent.mock}
+	}
+	mmCloneEvent.defaultExpectation.results = &EventsServiceMockCloneEventResults{ep1, err}
+	mmCloneEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCloneEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.CloneEvent method
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Set(f func(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) (ep1 *models.Event, err error)) *EventsServiceMock {
+	if mmCloneEvent.defaultExpectation != nil {
+		mmCloneEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.CloneEvent method")
+	}
+
+	if len(mmCloneEvent.expectations) > 0 {
+		mmCloneEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.CloneEvent method")
+	}
+
+	mmCloneEvent.mock.funcCloneEvent = f
+	mmCloneEvent.mock.funcCloneEventOrigin = minimock.CallerInfo(1)
+	return mmCloneEvent.mock
+}
+
+// When sets expectation for the EventsService.CloneEvent which will trigger the result defined by the following
+// Then helper
+func (mmCloneEvent *mEventsServiceMockCloneEvent) When(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) *EventsServiceMockCloneEventExpectation {
+	if mmCloneEvent.mock.funcCloneEvent != nil {
+		mmCloneEvent.mock.t.Fatalf("EventsServiceMock.CloneEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockCloneEventExpectation{
+		mock:               mmCloneEvent.mock,
+		params:             &EventsServiceMockCloneEventParams{ctx, userID, urlTitle, req},
+		expectationOrigins: EventsServiceMockCloneEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCloneEvent.expectations = append(mmCloneEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.CloneEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockCloneEventExpectation) Then(ep1 *models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockCloneEventResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.CloneEvent should be invoked
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Times(n uint64) *mEventsServiceMockCloneEvent {
+	if n == 0 {
+		mmCloneEvent.mock.t.Fatalf("Times of EventsServiceMock.CloneEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCloneEvent.expectedInvocations, n)
+	mmCloneEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCloneEvent
+}
+
+func (mmCloneEvent *mEventsServiceMockCloneEvent) invocationsDone() bool {
+	if len(mmCloneEvent.expectations) == 0 && mmCloneEvent.defaultExpectation == nil && mmCloneEvent.mock.funcCloneEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCloneEvent.mock.afterCloneEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCloneEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CloneEvent implements mm_service.EventsService
+func (mmCloneEvent *EventsServiceMock) CloneEvent(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) (ep1 *models.Event, err error) {
+	mm_atomic.AddUint64(&mmCloneEvent.beforeCloneEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCloneEvent.afterCloneEventCounter, 1)
+
+	mmCloneEvent.t.Helper()
+
+	if mmCloneEvent.inspectFuncCloneEvent != nil {
+		mmCloneEvent.inspectFuncCloneEvent(ctx, userID, urlTitle, req)
+	}
+
+	mm_params := EventsServiceMockCloneEventParams{ctx, userID, urlTitle, req}
+
+	// Record call args
+	mmCloneEvent.CloneEventMock.mutex.Lock()
+	mmCloneEvent.CloneEventMock.callArgs = append(mmCloneEvent.CloneEventMock.callArgs, &mm_params)
+	mmCloneEvent.CloneEventMock.mutex.Unlock()
+
+	for _, e := range mmCloneEvent.CloneEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmCloneEvent.CloneEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCloneEvent.CloneEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCloneEvent.CloneEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCloneEvent.CloneEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockCloneEventParams{ctx, userID, urlTitle, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCloneEvent.t.Errorf("EventsServiceMock.CloneEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloneEvent.CloneEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCloneEvent.t.Errorf("EventsServiceMock.CloneEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloneEvent.CloneEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmCloneEvent.t.Errorf("EventsServiceMock.CloneEvent got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloneEvent.CloneEventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCloneEvent.t.Errorf("EventsServiceMock.CloneEvent got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloneEvent.CloneEventMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCloneEvent.t.Errorf("EventsServiceMock.CloneEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCloneEvent.CloneEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCloneEvent.CloneEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCloneEvent.t.Fatal("No results are set for the EventsServiceMock.CloneEvent")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmCloneEvent.funcCloneEvent != nil {
+		return mmCloneEvent.funcCloneEvent(ctx, userID, urlTitle, req)
+	}
+	mmCloneEvent.t.Fatalf("Unexpected call to EventsServiceMock.CloneEvent. %v %v %v %v", ctx, userID, urlTitle, req)
+	return
+}
+
+// CloneEventAfterCounter returns a count of finished EventsServiceMock.CloneEvent invocations
+func (mmCloneEvent *EventsServiceMock) CloneEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloneEvent.afterCloneEventCounter)
+}
+
+// CloneEventBeforeCounter returns a count of EventsServiceMock.CloneEvent invocations
+func (mmCloneEvent *EventsServiceMock) CloneEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloneEvent.beforeCloneEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.CloneEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCloneEvent *mEventsServiceMockCloneEvent) Calls() []*EventsServiceMockCloneEventParams {
+	mmCloneEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockCloneEventParams, len(mmCloneEvent.callArgs))
+	copy(argCopy, mmCloneEvent.callArgs)
+
+	mmCloneEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCloneEventDone returns true if the count of the CloneEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockCloneEventDone() bool {
+	if m.CloneEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloneEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloneEventMock.invocationsDone()
+}
+
+// MinimockCloneEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockCloneEventInspect() {
+	for _, e := range m.CloneEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.CloneEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCloneEventCounter := mm_atomic.LoadUint64(&m.afterCloneEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloneEventMock.defaultExpectation != nil && afterCloneEventCounter < 1 {
+		if m.CloneEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.CloneEvent at\n%s", m.CloneEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.CloneEvent at\n%s with params: %#v", m.CloneEventMock.defaultExpectation.expectationOrigins.origin, *m.CloneEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCloneEvent != nil && afterCloneEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.CloneEvent at\n%s", m.funcCloneEventOrigin)
+	}
+
+	if !m.CloneEventMock.invocationsDone() && afterCloneEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.CloneEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloneEventMock.expectedInvocations), m.CloneEventMock.expectedInvocationsOrigin, afterCloneEventCounter)
+	}
+}
+
+type mEventsServiceMockCreateEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockCreateEventExpectation
+	expectations       []*EventsServiceMockCreateEventExpectation
+
+	callArgs []*EventsServiceMockCreateEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockCreateEventExpectation specifies expectation struct of the EventsService.CreateEvent
+type EventsServiceMockCreateEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockCreateEventParams
+	paramPtrs          *EventsServiceMockCreateEventParamPtrs
+	expectationOrigins EventsServiceMockCreateEventExpectationOrigins
+	results            *EventsServiceMockCreateEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockCreateEventParams contains parameters of the EventsService.CreateEvent
+type EventsServiceMockCreateEventParams struct {
+	ctx   context.Context
+	event *models.Event
+}
+
+// EventsServiceMockCreateEventParamPtrs contains pointers to parameters of the EventsService.CreateEvent
+type EventsServiceMockCreateEventParamPtrs struct {
+	ctx   *context.Context
+	event **models.Event
+}
+
+// EventsServiceMockCreateEventResults contains results of the EventsService.CreateEvent
+type EventsServiceMockCreateEventResults struct {
+	i1  int64
+	err error
+}
+
+// EventsServiceMockCreateEventOrigins contains origins of expectations of the EventsService.CreateEvent
+type EventsServiceMockCreateEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Optional() *mEventsServiceMockCreateEvent {
+	mmCreateEvent.optional = true
+	return mmCreateEvent
+}
+
+// Expect sets up expected params for EventsService.CreateEvent
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Expect(ctx context.Context, event *models.Event) *mEventsServiceMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCreateEvent.defaultExpectation.params = &EventsServiceMockCreateEventParams{ctx, event}
+	mmCreateEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateEvent.expectations {
+		if minimock.Equal(e.params, mmCreateEvent.defaultExpectation.params) {
+			mmCreateEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.CreateEvent
+func (mmCreateEvent *mEventsServiceMockCreateEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.params != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Expect")
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateEvent.defaultExpectation.paramPtrs = &EventsServiceMockCreateEventParamPtrs{}
+	}
+	mmCreateEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateEvent
+}
+
+// ExpectEventParam2 sets up expected param event for EventsService.CreateEvent
+func (mmCreateEvent *mEventsServiceMockCreateEvent) ExpectEventParam2(event *models.Event) *mEventsServiceMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.params != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Expect")
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateEvent.defaultExpectation.paramPtrs = &EventsServiceMockCreateEventParamPtrs{}
+	}
+	mmCreateEvent.defaultExpectation.paramPtrs.event = &event
+	mmCreateEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmCreateEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.CreateEvent
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Inspect(f func(ctx context.Context, event *models.Event)) *mEventsServiceMockCreateEvent {
+	if mmCreateEvent.mock.inspectFuncCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.CreateEvent")
+	}
+
+	mmCreateEvent.mock.inspectFuncCreateEvent = f
+
+	return mmCreateEvent
+}
+
+// Return sets up results that will be returned by EventsService.CreateEvent
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Return(i1 int64, err error) *EventsServiceMock {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &EventsServiceMockCreateEventExpectation{mock: mmCreateEvent.mock}
+	}
+	mmCreateEvent.defaultExpectation.results = &EventsServiceMockCreateEventResults{i1, err}
+	mmCreateEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.CreateEvent method
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Set(f func(ctx context.Context, event *models.Event) (i1 int64, err error)) *EventsServiceMock {
+	if mmCreateEvent.defaultExpectation != nil {
+		mmCreateEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.CreateEvent method")
+	}
+
+	if len(mmCreateEvent.expectations) > 0 {
+		mmCreateEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.CreateEvent method")
+	}
+
+	mmCreateEvent.mock.funcCreateEvent = f
+	mmCreateEvent.mock.funcCreateEventOrigin = minimock.CallerInfo(1)
+	return mmCreateEvent.mock
+}
+
+// When sets expectation for the EventsService.CreateEvent which will trigger the result defined by the following
+// Then helper
+func (mmCreateEvent *mEventsServiceMockCreateEvent) When(ctx context.Context, event *models.Event) *EventsServiceMockCreateEventExpectation {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("EventsServiceMock.CreateEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockCreateEventExpectation{
+		mock:               mmCreateEvent.mock,
+		params:             &EventsServiceMockCreateEventParams{ctx, event},
+		expectationOrigins: EventsServiceMockCreateEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateEvent.expectations = append(mmCreateEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.CreateEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockCreateEventExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockCreateEventResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.CreateEvent should be invoked
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Times(n uint64) *mEventsServiceMockCreateEvent {
+	if n == 0 {
+		mmCreateEvent.mock.t.Fatalf("Times of EventsServiceMock.CreateEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateEvent.expectedInvocations, n)
+	mmCreateEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateEvent
+}
+
+func (mmCreateEvent *mEventsServiceMockCreateEvent) invocationsDone() bool {
+	if len(mmCreateEvent.expectations) == 0 && mmCreateEvent.defaultExpectation == nil && mmCreateEvent.mock.funcCreateEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateEvent.mock.afterCreateEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateEvent implements mm_service.EventsService
+func (mmCreateEvent *EventsServiceMock) CreateEvent(ctx context.Context, event *models.Event) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateEvent.beforeCreateEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateEvent.afterCreateEventCounter, 1)
+
+	mmCreateEvent.t.Helper()
+
+	if mmCreateEvent.inspectFuncCreateEvent != nil {
+		mmCreateEvent.inspectFuncCreateEvent(ctx, event)
+	}
+
+	mm_params := EventsServiceMockCreateEventParams{ctx, event}
+
+	// Record call args
+	mmCreateEvent.CreateEventMock.mutex.Lock()
+	mmCreateEvent.CreateEventMock.callArgs = append(mmCreateEvent.CreateEventMock.callArgs, &mm_params)
+	mmCreateEvent.CreateEventMock.mutex.Unlock()
+
+	for _, e := range mmCreateEvent.CreateEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateEvent.CreateEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateEvent.CreateEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateEvent.CreateEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateEvent.CreateEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockCreateEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateEvent.t.Errorf("EventsServiceMock.CreateEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateEvent.CreateEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateEvent.CreateEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateEvent.t.Fatal("No results are set for the EventsServiceMock.CreateEvent")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateEvent.funcCreateEvent != nil {
+		return mmCreateEvent.funcCreateEvent(ctx, event)
+	}
+	mmCreateEvent.t.Fatalf("Unexpected call to EventsServiceMock.CreateEvent. %v %v", ctx, event)
+	return
+}
+
+// CreateEventAfterCounter returns a count of finished EventsServiceMock.CreateEvent invocations
+func (mmCreateEvent *EventsServiceMock) CreateEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEvent.afterCreateEventCounter)
+}
+
+// CreateEventBeforeCounter returns a count of EventsServiceMock.CreateEvent invocations
+func (mmCreateEvent *EventsServiceMock) CreateEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEvent.beforeCreateEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.CreateEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateEvent *mEventsServiceMockCreateEvent) Calls() []*EventsServiceMockCreateEventParams {
+	mmCreateEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockCreateEventParams, len(mmCreateEvent.callArgs))
+	copy(argCopy, mmCreateEvent.callArgs)
+
+	mmCreateEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateEventDone returns true if the count of the CreateEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockCreateEventDone() bool {
+	if m.CreateEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateEventMock.invocationsDone()
+}
+
+// MinimockCreateEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockCreateEventInspect() {
+	for _, e := range m.CreateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateEventCounter := mm_atomic.LoadUint64(&m.afterCreateEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateEventMock.defaultExpectation != nil && afterCreateEventCounter < 1 {
+		if m.CreateEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s", m.CreateEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s with params: %#v", m.CreateEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateEvent != nil && afterCreateEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.CreateEvent at\n%s", m.funcCreateEventOrigin)
+	}
+
+	if !m.CreateEventMock.invocationsDone() && afterCreateEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.CreateEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateEventMock.expectedInvocations), m.CreateEventMock.expectedInvocationsOrigin, afterCreateEventCounter)
+	}
+}
+
+type mEventsServiceMockDeleteBookmark struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteBookmarkExpectation
+	expectations       []*EventsServiceMockDeleteBookmarkExpectation
+
+	callArgs []*EventsServiceMockDeleteBookmarkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteBookmarkExpectation specifies expectation struct of the EventsService.DeleteBookmark
+type EventsServiceMockDeleteBookmarkExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteBookmarkParams
+	paramPtrs          *EventsServiceMockDeleteBookmarkParamPtrs
+	expectationOrigins EventsServiceMockDeleteBookmarkExpectationOrigins
+	results            *EventsServiceMockDeleteBookmarkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteBookmarkParams contains parameters of the EventsService.DeleteBookmark
+type EventsServiceMockDeleteBookmarkParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockDeleteBookmarkParamPtrs contains pointers to parameters of the EventsService.DeleteBookmark
+type EventsServiceMockDeleteBookmarkParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockDeleteBookmarkResults contains results of the EventsService.DeleteBookmark
+type EventsServiceMockDeleteBookmarkResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteBookmarkOrigins contains origins of expectations of the EventsService.DeleteBookmark
+type EventsServiceMockDeleteBookmarkExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Optional() *mEventsServiceMockDeleteBookmark {
+	mmDeleteBookmark.optional = true
+	return mmDeleteBookmark
+}
+
+// Expect sets up expected params for EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockDeleteBookmark {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	if mmDeleteBookmark.defaultExpectation == nil {
+		mmDeleteBookmark.defaultExpectation = &EventsServiceMockDeleteBookmarkExpectation{}
+	}
+
+	if mmDeleteBookmark.defaultExpectation.paramPtrs != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteBookmark.defaultExpectation.params = &EventsServiceMockDeleteBookmarkParams{ctx, userID, urlTitle}
+	mmDeleteBookmark.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteBookmark.expectations {
+		if minimock.Equal(e.params, mmDeleteBookmark.defaultExpectation.params) {
+			mmDeleteBookmark.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBookmark.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteBookmark
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteBookmark {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	if mmDeleteBookmark.defaultExpectation == nil {
+		mmDeleteBookmark.defaultExpectation = &EventsServiceMockDeleteBookmarkExpectation{}
+	}
+
+	if mmDeleteBookmark.defaultExpectation.params != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Expect")
+	}
+
+	if mmDeleteBookmark.defaultExpectation.paramPtrs == nil {
+		mmDeleteBookmark.defaultExpectation.paramPtrs = &EventsServiceMockDeleteBookmarkParamPtrs{}
+	}
+	mmDeleteBookmark.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteBookmark.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteBookmark
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteBookmark {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	if mmDeleteBookmark.defaultExpectation == nil {
+		mmDeleteBookmark.defaultExpectation = &EventsServiceMockDeleteBookmarkExpectation{}
+	}
+
+	if mmDeleteBookmark.defaultExpectation.params != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Expect")
+	}
+
+	if mmDeleteBookmark.defaultExpectation.paramPtrs == nil {
+		mmDeleteBookmark.defaultExpectation.paramPtrs = &EventsServiceMockDeleteBookmarkParamPtrs{}
+	}
+	mmDeleteBookmark.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteBookmark.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteBookmark
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteBookmark {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	if mmDeleteBookmark.defaultExpectation == nil {
+		mmDeleteBookmark.defaultExpectation = &EventsServiceMockDeleteBookmarkExpectation{}
+	}
+
+	if mmDeleteBookmark.defaultExpectation.params != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Expect")
+	}
+
+	if mmDeleteBookmark.defaultExpectation.paramPtrs == nil {
+		mmDeleteBookmark.defaultExpectation.paramPtrs = &EventsServiceMockDeleteBookmarkParamPtrs{}
+	}
+	mmDeleteBookmark.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteBookmark.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteBookmark
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockDeleteBookmark {
+	if mmDeleteBookmark.mock.inspectFuncDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteBookmark")
+	}
+
+	mmDeleteBookmark.mock.inspectFuncDeleteBookmark = f
+
+	return mmDeleteBookmark
+}
+
+// Return sets up results that will be returned by EventsService.DeleteBookmark
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Return(err error) *EventsServiceMock {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	if mmDeleteBookmark.defaultExpectation == nil {
+		mmDeleteBookmark.defaultExpectation = &EventsServiceMockDeleteBookmarkExpectation{mock: mmDeleteBookmark.mock}
+	}
+	mmDeleteBookmark.defaultExpectation.results = &EventsServiceMockDeleteBookmarkResults{err}
+	mmDeleteBookmark.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteBookmark.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteBookmark method
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Set(f func(ctx context.Context, userID int64, urlTitle string) (err error)) *EventsServiceMock {
+	if mmDeleteBookmark.defaultExpectation != nil {
+		mmDeleteBookmark.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteBookmark method")
+	}
+
+	if len(mmDeleteBookmark.expectations) > 0 {
+		mmDeleteBookmark.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteBookmark method")
+	}
+
+	mmDeleteBookmark.mock.funcDeleteBookmark = f
+	mmDeleteBookmark.mock.funcDeleteBookmarkOrigin = minimock.CallerInfo(1)
+	return mmDeleteBookmark.mock
+}
+
+// When sets expectation for the EventsService.DeleteBookmark which will trigger the result defined by the following
+// Then helper
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockDeleteBookmarkExpectation {
+	if mmDeleteBookmark.mock.funcDeleteBookmark != nil {
+		mmDeleteBookmark.mock.t.Fatalf("EventsServiceMock.DeleteBookmark mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteBookmarkExpectation{
+		mock:               mmDeleteBookmark.mock,
+		params:             &EventsServiceMockDeleteBookmarkParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockDeleteBookmarkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteBookmark.expectations = append(mmDeleteBookmark.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteBookmark return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteBookmarkExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteBookmarkResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteBookmark should be invoked
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Times(n uint64) *mEventsServiceMockDeleteBookmark {
+	if n == 0 {
+		mmDeleteBookmark.mock.t.Fatalf("Times of EventsServiceMock.DeleteBookmark mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteBookmark.expectedInvocations, n)
+	mmDeleteBookmark.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteBookmark
+}
+
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) invocationsDone() bool {
+	if len(mmDeleteBookmark.expectations) == 0 && mmDeleteBookmark.defaultExpectation == nil && mmDeleteBookmark.mock.funcDeleteBookmark == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteBookmark.mock.afterDeleteBookmarkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteBookmark.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteBookmark implements mm_service.EventsService
+func (mmDeleteBookmark *EventsServiceMock) DeleteBookmark(ctx context.Context, userID int64, urlTitle string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteBookmark.beforeDeleteBookmarkCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteBookmark.afterDeleteBookmarkCounter, 1)
+
+	mmDeleteBookmark.t.Helper()
+
+	if mmDeleteBookmark.inspectFuncDeleteBookmark != nil {
+		mmDeleteBookmark.inspectFuncDeleteBookmark(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockDeleteBookmarkParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmDeleteBookmark.DeleteBookmarkMock.mutex.Lock()
+	mmDeleteBookmark.DeleteBookmarkMock.callArgs = append(mmDeleteBookmark.DeleteBookmarkMock.callArgs, &mm_params)
+	mmDeleteBookmark.DeleteBookmarkMock.mutex.Unlock()
+
+	for _, e := range mmDeleteBookmark.DeleteBookmarkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteBookmarkParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteBookmark.t.Errorf("EventsServiceMock.DeleteBookmark got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteBookmark.t.Errorf("EventsServiceMock.DeleteBookmark got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteBookmark.t.Errorf("EventsServiceMock.DeleteBookmark got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteBookmark.t.Errorf("EventsServiceMock.DeleteBookmark got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteBookmark.DeleteBookmarkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteBookmark.t.Fatal("No results are set for the EventsServiceMock.DeleteBookmark")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteBookmark.funcDeleteBookmark != nil {
+		return mmDeleteBookmark.funcDeleteBookmark(ctx, userID, urlTitle)
+	}
+	mmDeleteBookmark.t.Fatalf("Unexpected call to EventsServiceMock.DeleteBookmark. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// DeleteBookmarkAfterCounter returns a count of finished EventsServiceMock.DeleteBookmark invocations
+func (mmDeleteBookmark *EventsServiceMock) DeleteBookmarkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBookmark.afterDeleteBookmarkCounter)
+}
+
+// DeleteBookmarkBeforeCounter returns a count of EventsServiceMock.DeleteBookmark invocations
+func (mmDeleteBookmark *EventsServiceMock) DeleteBookmarkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBookmark.beforeDeleteBookmarkCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteBookmark.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteBookmark *mEventsServiceMockDeleteBookmark) Calls() []*EventsServiceMockDeleteBookmarkParams {
+	mmDeleteBookmark.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteBookmarkParams, len(mmDeleteBookmark.callArgs))
+	copy(argCopy, mmDeleteBookmark.callArgs)
+
+	mmDeleteBookmark.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteBookmarkDone returns true if the count of the DeleteBookmark invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteBookmarkDone() bool {
+	if m.DeleteBookmarkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteBookmarkMock.invocationsDone()
+}
+
+// MinimockDeleteBookmarkInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteBookmarkInspect() {
+	for _, e := range m.DeleteBookmarkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteBookmark at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteBookmarkCounter := mm_atomic.LoadUint64(&m.afterDeleteBookmarkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteBookmarkMock.defaultExpectation != nil && afterDeleteBookmarkCounter < 1 {
+		if m.DeleteBookmarkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteBookmark at\n%s", m.DeleteBookmarkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteBookmark at\n%s with params: %#v", m.DeleteBookmarkMock.defaultExpectation.expectationOrigins.origin, *m.DeleteBookmarkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBookmark != nil && afterDeleteBookmarkCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteBookmark at\n%s", m.funcDeleteBookmarkOrigin)
+	}
+
+	if !m.DeleteBookmarkMock.invocationsDone() && afterDeleteBookmarkCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteBookmark at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteBookmarkMock.expectedInvocations), m.DeleteBookmarkMock.expectedInvocationsOrigin, afterDeleteBookmarkCounter)
+	}
+}
+
+type mEventsServiceMockDeleteEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteEventExpectation
+	expectations       []*EventsServiceMockDeleteEventExpectation
+
+	callArgs []*EventsServiceMockDeleteEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteEventExpectation specifies expectation struct of the EventsService.DeleteEvent
+type EventsServiceMockDeleteEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteEventParams
+	paramPtrs          *EventsServiceMockDeleteEventParamPtrs
+	expectationOrigins EventsServiceMockDeleteEventExpectationOrigins
+	results            *EventsServiceMockDeleteEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteEventParams contains parameters of the EventsService.DeleteEvent
+type EventsServiceMockDeleteEventParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockDeleteEventParamPtrs contains pointers to parameters of the EventsService.DeleteEvent
+type EventsServiceMockDeleteEventParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockDeleteEventResults contains results of the EventsService.DeleteEvent
+type EventsServiceMockDeleteEventResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteEventOrigins contains origins of expectations of the EventsService.DeleteEvent
+type EventsServiceMockDeleteEventExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Optional() *mEventsServiceMockDeleteEvent {
+	mmDeleteEvent.optional = true
+	return mmDeleteEvent
+}
+
+// Expect sets up expected params for EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockDeleteEvent {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	if mmDeleteEvent.defaultExpectation == nil {
+		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	}
+
+	if mmDeleteEvent.defaultExpectation.paramPtrs != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteEvent.defaultExpectation.params = &EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
+	mmDeleteEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteEvent.expectations {
+		if minimock.Equal(e.params, mmDeleteEvent.defaultExpectation.params) {
+			mmDeleteEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteEvent {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	if mmDeleteEvent.defaultExpectation == nil {
+		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	}
+
+	if mmDeleteEvent.defaultExpectation.params != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	}
+
+	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
+		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	}
+	mmDeleteEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteEvent {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	if mmDeleteEvent.defaultExpectation == nil {
+		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	}
+
+	if mmDeleteEvent.defaultExpectation.params != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	}
+
+	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
+		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	}
+	mmDeleteEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteEvent
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteEvent {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	if mmDeleteEvent.defaultExpectation == nil {
+		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{}
+	}
+
+	if mmDeleteEvent.defaultExpectation.params != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Expect")
+	}
+
+	if mmDeleteEvent.defaultExpectation.paramPtrs == nil {
+		mmDeleteEvent.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventParamPtrs{}
+	}
+	mmDeleteEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockDeleteEvent {
+	if mmDeleteEvent.mock.inspectFuncDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteEvent")
+	}
+
+	mmDeleteEvent.mock.inspectFuncDeleteEvent = f
+
+	return mmDeleteEvent
+}
+
+// Return sets up results that will be returned by EventsService.DeleteEvent
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Return(err error) *EventsServiceMock {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	if mmDeleteEvent.defaultExpectation == nil {
+		mmDeleteEvent.defaultExpectation = &EventsServiceMockDeleteEventExpectation{mock: mmDeleteEvent.mock}
+	}
+	mmDeleteEvent.defaultExpectation.results = &EventsServiceMockDeleteEventResults{err}
+	mmDeleteEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteEvent method
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Set(f func(ctx context.Context, userID int64, urlTitle string) (err error)) *EventsServiceMock {
+	if mmDeleteEvent.defaultExpectation != nil {
+		mmDeleteEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteEvent method")
+	}
+
+	if len(mmDeleteEvent.expectations) > 0 {
+		mmDeleteEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteEvent method")
+	}
+
+	mmDeleteEvent.mock.funcDeleteEvent = f
+	mmDeleteEvent.mock.funcDeleteEventOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvent.mock
+}
+
+// When sets expectation for the EventsService.DeleteEvent which will trigger the result defined by the following
+// Then helper
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockDeleteEventExpectation {
+	if mmDeleteEvent.mock.funcDeleteEvent != nil {
+		mmDeleteEvent.mock.t.Fatalf("EventsServiceMock.DeleteEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteEventExpectation{
+		mock:               mmDeleteEvent.mock,
+		params:             &EventsServiceMockDeleteEventParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockDeleteEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteEvent.expectations = append(mmDeleteEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteEventExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteEvent should be invoked
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Times(n uint64) *mEventsServiceMockDeleteEvent {
+	if n == 0 {
+		mmDeleteEvent.mock.t.Fatalf("Times of EventsServiceMock.DeleteEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteEvent.expectedInvocations, n)
+	mmDeleteEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEvent
+}
+
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) invocationsDone() bool {
+	if len(mmDeleteEvent.expectations) == 0 && mmDeleteEvent.defaultExpectation == nil && mmDeleteEvent.mock.funcDeleteEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEvent.mock.afterDeleteEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteEvent implements mm_service.EventsService
+func (mmDeleteEvent *EventsServiceMock) DeleteEvent(ctx context.Context, userID int64, urlTitle string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteEvent.beforeDeleteEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteEvent.afterDeleteEventCounter, 1)
+
+	mmDeleteEvent.t.Helper()
+
+	if mmDeleteEvent.inspectFuncDeleteEvent != nil {
+		mmDeleteEvent.inspectFuncDeleteEvent(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmDeleteEvent.DeleteEventMock.mutex.Lock()
+	mmDeleteEvent.DeleteEventMock.callArgs = append(mmDeleteEvent.DeleteEventMock.callArgs, &mm_params)
+	mmDeleteEvent.DeleteEventMock.mutex.Unlock()
+
+	for _, e := range mmDeleteEvent.DeleteEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteEvent.DeleteEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteEvent.DeleteEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteEvent.DeleteEventMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteEvent.DeleteEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteEventParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteEvent.t.Errorf("EventsServiceMock.DeleteEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteEvent.DeleteEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteEvent.DeleteEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteEvent.t.Fatal("No results are set for the EventsServiceMock.DeleteEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteEvent.funcDeleteEvent != nil {
+		return mmDeleteEvent.funcDeleteEvent(ctx, userID, urlTitle)
+	}
+	mmDeleteEvent.t.Fatalf("Unexpected call to EventsServiceMock.DeleteEvent. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// DeleteEventAfterCounter returns a count of finished EventsServiceMock.DeleteEvent invocations
+func (mmDeleteEvent *EventsServiceMock) DeleteEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEvent.afterDeleteEventCounter)
+}
+
+// DeleteEventBeforeCounter returns a count of EventsServiceMock.DeleteEvent invocations
+func (mmDeleteEvent *EventsServiceMock) DeleteEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEvent.beforeDeleteEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteEvent *mEventsServiceMockDeleteEvent) Calls() []*EventsServiceMockDeleteEventParams {
+	mmDeleteEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteEventParams, len(mmDeleteEvent.callArgs))
+	copy(argCopy, mmDeleteEvent.callArgs)
+
+	mmDeleteEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteEventDone returns true if the count of the DeleteEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteEventDone() bool {
+	if m.DeleteEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteEventMock.invocationsDone()
+}
+
+// MinimockDeleteEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteEventInspect() {
+	for _, e := range m.DeleteEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteEventCounter := mm_atomic.LoadUint64(&m.afterDeleteEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteEventMock.defaultExpectation != nil && afterDeleteEventCounter < 1 {
+		if m.DeleteEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s", m.DeleteEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s with params: %#v", m.DeleteEventMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteEvent != nil && afterDeleteEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteEvent at\n%s", m.funcDeleteEventOrigin)
+	}
+
+	if !m.DeleteEventMock.invocationsDone() && afterDeleteEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteEventMock.expectedInvocations), m.DeleteEventMock.expectedInvocationsOrigin, afterDeleteEventCounter)
+	}
+}
+
+type mEventsServiceMockDeleteEventImage struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteEventImageExpectation
+	expectations       []*EventsServiceMockDeleteEventImageExpectation
+
+	callArgs []*EventsServiceMockDeleteEventImageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteEventImageExpectation specifies expectation struct of the EventsService.DeleteEventImage
+type EventsServiceMockDeleteEventImageExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteEventImageParams
+	paramPtrs          *EventsServiceMockDeleteEventImageParamPtrs
+	expectationOrigins EventsServiceMockDeleteEventImageExpectationOrigins
+	results            *EventsServiceMockDeleteEventImageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteEventImageParams contains parameters of the EventsService.DeleteEventImage
+type EventsServiceMockDeleteEventImageParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	imageID  int64
+}
+
+// EventsServiceMockDeleteEventImageParamPtrs contains pointers to parameters of the EventsService.DeleteEventImage
+type EventsServiceMockDeleteEventImageParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	imageID  *int64
+}
+
+// EventsServiceMockDeleteEventImageResults contains results of the EventsService.DeleteEventImage
+type EventsServiceMockDeleteEventImageResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteEventImageOrigins contains origins of expectations of the EventsService.DeleteEventImage
+type EventsServiceMockDeleteEventImageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originImageID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Optional() *mEventsServiceMockDeleteEventImage {
+	mmDeleteEventImage.optional = true
+	return mmDeleteEventImage
+}
+
+// Expect sets up expected params for EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Expect(ctx context.Context, userID int64, urlTitle string, imageID int64) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{}
+	}
+
+	if mmDeleteEventImage.defaultExpectation.paramPtrs != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteEventImage.defaultExpectation.params = &EventsServiceMockDeleteEventImageParams{ctx, userID, urlTitle, imageID}
+	mmDeleteEventImage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteEventImage.expectations {
+		if minimock.Equal(e.params, mmDeleteEventImage.defaultExpectation.params) {
+			mmDeleteEventImage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEventImage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteEventImage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{}
+	}
+
+	if mmDeleteEventImage.defaultExpectation.params != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Expect")
+	}
+
+	if mmDeleteEventImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventImage.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventImageParamPtrs{}
+	}
+	mmDeleteEventImage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteEventImage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteEventImage
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{}
+	}
+
+	if mmDeleteEventImage.defaultExpectation.params != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Expect")
+	}
+
+	if mmDeleteEventImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventImage.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventImageParamPtrs{}
+	}
+	mmDeleteEventImage.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteEventImage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteEventImage
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{}
+	}
+
+	if mmDeleteEventImage.defaultExpectation.params != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Expect")
+	}
+
+	if mmDeleteEventImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventImage.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventImageParamPtrs{}
+	}
+	mmDeleteEventImage.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteEventImage.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteEventImage
+}
+
+// ExpectImageIDParam4 sets up expected param imageID for EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) ExpectImageIDParam4(imageID int64) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{}
+	}
+
+	if mmDeleteEventImage.defaultExpectation.params != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Expect")
+	}
+
+	if mmDeleteEventImage.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventImage.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventImageParamPtrs{}
+	}
+	mmDeleteEventImage.defaultExpectation.paramPtrs.imageID = &imageID
+	mmDeleteEventImage.defaultExpectation.expectationOrigins.originImageID = minimock.CallerInfo(1)
+
+	return mmDeleteEventImage
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Inspect(f func(ctx context.Context, userID int64, urlTitle string, imageID int64)) *mEventsServiceMockDeleteEventImage {
+	if mmDeleteEventImage.mock.inspectFuncDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteEventImage")
+	}
+
+	mmDeleteEventImage.mock.inspectFuncDeleteEventImage = f
+
+	return mmDeleteEventImage
+}
+
+// Return sets up results that will be returned by EventsService.DeleteEventImage
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Return(err error) *EventsServiceMock {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	if mmDeleteEventImage.defaultExpectation == nil {
+		mmDeleteEventImage.defaultExpectation = &EventsServiceMockDeleteEventImageExpectation{mock: mmDeleteEventImage.mock}
+	}
+	mmDeleteEventImage.defaultExpectation.results = &EventsServiceMockDeleteEventImageResults{err}
+	mmDeleteEventImage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventImage.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteEventImage method
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Set(f func(ctx context.Context, userID int64, urlTitle string, imageID int64) (err error)) *EventsServiceMock {
+	if mmDeleteEventImage.defaultExpectation != nil {
+		mmDeleteEventImage.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteEventImage method")
+	}
+
+	if len(mmDeleteEventImage.expectations) > 0 {
+		mmDeleteEventImage.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteEventImage method")
+	}
+
+	mmDeleteEventImage.mock.funcDeleteEventImage = f
+	mmDeleteEventImage.mock.funcDeleteEventImageOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventImage.mock
+}
+
+// When sets expectation for the EventsService.DeleteEventImage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) When(ctx context.Context, userID int64, urlTitle string, imageID int64) *EventsServiceMockDeleteEventImageExpectation {
+	if mmDeleteEventImage.mock.funcDeleteEventImage != nil {
+		mmDeleteEventImage.mock.t.Fatalf("EventsServiceMock.DeleteEventImage mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteEventImageExpectation{
+		mock:               mmDeleteEventImage.mock,
+		params:             &EventsServiceMockDeleteEventImageParams{ctx, userID, urlTitle, imageID},
+		expectationOrigins: EventsServiceMockDeleteEventImageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteEventImage.expectations = append(mmDeleteEventImage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteEventImage return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteEventImageExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteEventImageResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteEventImage should be invoked
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Times(n uint64) *mEventsServiceMockDeleteEventImage {
+	if n == 0 {
+		mmDeleteEventImage.mock.t.Fatalf("Times of EventsServiceMock.DeleteEventImage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteEventImage.expectedInvocations, n)
+	mmDeleteEventImage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventImage
+}
+
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) invocationsDone() bool {
+	if len(mmDeleteEventImage.expectations) == 0 && mmDeleteEventImage.defaultExpectation == nil && mmDeleteEventImage.mock.funcDeleteEventImage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEventImage.mock.afterDeleteEventImageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEventImage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteEventImage implements mm_service.EventsService
+func (mmDeleteEventImage *EventsServiceMock) DeleteEventImage(ctx context.Context, userID int64, urlTitle string, imageID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteEventImage.beforeDeleteEventImageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteEventImage.afterDeleteEventImageCounter, 1)
+
+	mmDeleteEventImage.t.Helper()
+
+	if mmDeleteEventImage.inspectFuncDeleteEventImage != nil {
+		mmDeleteEventImage.inspectFuncDeleteEventImage(ctx, userID, urlTitle, imageID)
+	}
+
+	mm_params := EventsServiceMockDeleteEventImageParams{ctx, userID, urlTitle, imageID}
+
+	// Record call args
+	mmDeleteEventImage.DeleteEventImageMock.mutex.Lock()
+	mmDeleteEventImage.DeleteEventImageMock.callArgs = append(mmDeleteEventImage.DeleteEventImageMock.callArgs, &mm_params)
+	mmDeleteEventImage.DeleteEventImageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteEventImage.DeleteEventImageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteEventImage.DeleteEventImageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteEventImageParams{ctx, userID, urlTitle, imageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteEventImage.t.Errorf("EventsServiceMock.DeleteEventImage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteEventImage.t.Errorf("EventsServiceMock.DeleteEventImage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteEventImage.t.Errorf("EventsServiceMock.DeleteEventImage got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.imageID != nil && !minimock.Equal(*mm_want_ptrs.imageID, mm_got.imageID) {
+				mmDeleteEventImage.t.Errorf("EventsServiceMock.DeleteEventImage got unexpected parameter imageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.expectationOrigins.originImageID, *mm_want_ptrs.imageID, mm_got.imageID, minimock.Diff(*mm_want_ptrs.imageID, mm_got.imageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteEventImage.t.Errorf("EventsServiceMock.DeleteEventImage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteEventImage.DeleteEventImageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteEventImage.t.Fatal("No results are set for the EventsServiceMock.DeleteEventImage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteEventImage.funcDeleteEventImage != nil {
+		return mmDeleteEventImage.funcDeleteEventImage(ctx, userID, urlTitle, imageID)
+	}
+	mmDeleteEventImage.t.Fatalf("Unexpected call to EventsServiceMock.DeleteEventImage. %v %v %v %v", ctx, userID, urlTitle, imageID)
+	return
+}
+
+// DeleteEventImageAfterCounter returns a count of finished EventsServiceMock.DeleteEventImage invocations
+func (mmDeleteEventImage *EventsServiceMock) DeleteEventImageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEventImage.afterDeleteEventImageCounter)
+}
+
+// DeleteEventImageBeforeCounter returns a count of EventsServiceMock.DeleteEventImage invocations
+func (mmDeleteEventImage *EventsServiceMock) DeleteEventImageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEventImage.beforeDeleteEventImageCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteEventImage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteEventImage *mEventsServiceMockDeleteEventImage) Calls() []*EventsServiceMockDeleteEventImageParams {
+	mmDeleteEventImage.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteEventImageParams, len(mmDeleteEventImage.callArgs))
+	copy(argCopy, mmDeleteEventImage.callArgs)
+
+	mmDeleteEventImage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteEventImageDone returns true if the count of the DeleteEventImage invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteEventImageDone() bool {
+	if m.DeleteEventImageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteEventImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteEventImageMock.invocationsDone()
+}
+
+// MinimockDeleteEventImageInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteEventImageInspect() {
+	for _, e := range m.DeleteEventImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventImage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteEventImageCounter := mm_atomic.LoadUint64(&m.afterDeleteEventImageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteEventImageMock.defaultExpectation != nil && afterDeleteEventImageCounter < 1 {
+		if m.DeleteEventImageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventImage at\n%s", m.DeleteEventImageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventImage at\n%s with params: %#v", m.DeleteEventImageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEventImageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteEventImage != nil && afterDeleteEventImageCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteEventImage at\n%s", m.funcDeleteEventImageOrigin)
+	}
+
+	if !m.DeleteEventImageMock.invocationsDone() && afterDeleteEventImageCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteEventImage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteEventImageMock.expectedInvocations), m.DeleteEventImageMock.expectedInvocationsOrigin, afterDeleteEventImageCounter)
+	}
+}
+
+type mEventsServiceMockDeleteEventMember struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteEventMemberExpectation
+	expectations       []*EventsServiceMockDeleteEventMemberExpectation
+
+	callArgs []*EventsServiceMockDeleteEventMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteEventMemberExpectation specifies expectation struct of the EventsService.DeleteEventMember
+type EventsServiceMockDeleteEventMemberExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteEventMemberParams
+	paramPtrs          *EventsServiceMockDeleteEventMemberParamPtrs
+	expectationOrigins EventsServiceMockDeleteEventMemberExpectationOrigins
+	results            *EventsServiceMockDeleteEventMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteEventMemberParams contains parameters of the EventsService.DeleteEventMember
+type EventsServiceMockDeleteEventMemberParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	memberID int64
+}
+
+// EventsServiceMockDeleteEventMemberParamPtrs contains pointers to parameters of the EventsService.DeleteEventMember
+type EventsServiceMockDeleteEventMemberParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	memberID *int64
+}
+
+// EventsServiceMockDeleteEventMemberResults contains results of the EventsService.DeleteEventMember
+type EventsServiceMockDeleteEventMemberResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteEventMemberOrigins contains origins of expectations of the EventsService.DeleteEventMember
+type EventsServiceMockDeleteEventMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originMemberID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Optional() *mEventsServiceMockDeleteEventMember {
+	mmDeleteEventMember.optional = true
+	return mmDeleteEventMember
+}
+
+// Expect sets up expected params for EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Expect(ctx context.Context, userID int64, urlTitle string, memberID int64) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{}
+	}
+
+	if mmDeleteEventMember.defaultExpectation.paramPtrs != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteEventMember.defaultExpectation.params = &EventsServiceMockDeleteEventMemberParams{ctx, userID, urlTitle, memberID}
+	mmDeleteEventMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteEventMember.expectations {
+		if minimock.Equal(e.params, mmDeleteEventMember.defaultExpectation.params) {
+			mmDeleteEventMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteEventMember.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteEventMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{}
+	}
+
+	if mmDeleteEventMember.defaultExpectation.params != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Expect")
+	}
+
+	if mmDeleteEventMember.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventMemberParamPtrs{}
+	}
+	mmDeleteEventMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteEventMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteEventMember
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{}
+	}
+
+	if mmDeleteEventMember.defaultExpectation.params != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Expect")
+	}
+
+	if mmDeleteEventMember.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventMemberParamPtrs{}
+	}
+	mmDeleteEventMember.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteEventMember.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteEventMember
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{}
+	}
+
+	if mmDeleteEventMember.defaultExpectation.params != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Expect")
+	}
+
+	if mmDeleteEventMember.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventMemberParamPtrs{}
+	}
+	mmDeleteEventMember.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteEventMember.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteEventMember
+}
+
+// ExpectMemberIDParam4 sets up expected param memberID for EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) ExpectMemberIDParam4(memberID int64) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{}
+	}
+
+	if mmDeleteEventMember.defaultExpectation.params != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Expect")
+	}
+
+	if mmDeleteEventMember.defaultExpectation.paramPtrs == nil {
+		mmDeleteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockDeleteEventMemberParamPtrs{}
+	}
+	mmDeleteEventMember.defaultExpectation.paramPtrs.memberID = &memberID
+	mmDeleteEventMember.defaultExpectation.expectationOrigins.originMemberID = minimock.CallerInfo(1)
+
+	return mmDeleteEventMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Inspect(f func(ctx context.Context, userID int64, urlTitle string, memberID int64)) *mEventsServiceMockDeleteEventMember {
+	if mmDeleteEventMember.mock.inspectFuncDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteEventMember")
+	}
+
+	mmDeleteEventMember.mock.inspectFuncDeleteEventMember = f
+
+	return mmDeleteEventMember
+}
+
+// Return sets up results that will be returned by EventsService.DeleteEventMember
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Return(err error) *EventsServiceMock {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	if mmDeleteEventMember.defaultExpectation == nil {
+		mmDeleteEventMember.defaultExpectation = &EventsServiceMockDeleteEventMemberExpectation{mock: mmDeleteEventMember.mock}
+	}
+	mmDeleteEventMember.defaultExpectation.results = &EventsServiceMockDeleteEventMemberResults{err}
+	mmDeleteEventMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventMember.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteEventMember method
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Set(f func(ctx context.Context, userID int64, urlTitle string, memberID int64) (err error)) *EventsServiceMock {
+	if mmDeleteEventMember.defaultExpectation != nil {
+		mmDeleteEventMember.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteEventMember method")
+	}
+
+	if len(mmDeleteEventMember.expectations) > 0 {
+		mmDeleteEventMember.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteEventMember method")
+	}
+
+	mmDeleteEventMember.mock.funcDeleteEventMember = f
+	mmDeleteEventMember.mock.funcDeleteEventMemberOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventMember.mock
+}
+
+// When sets expectation for the EventsService.DeleteEventMember which will trigger the result defined by the following
+// Then helper
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) When(ctx context.Context, userID int64, urlTitle string, memberID int64) *EventsServiceMockDeleteEventMemberExpectation {
+	if mmDeleteEventMember.mock.funcDeleteEventMember != nil {
+		mmDeleteEventMember.mock.t.Fatalf("EventsServiceMock.DeleteEventMember mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteEventMemberExpectation{
+		mock:               mmDeleteEventMember.mock,
+		params:             &EventsServiceMockDeleteEventMemberParams{ctx, userID, urlTitle, memberID},
+		expectationOrigins: EventsServiceMockDeleteEventMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteEventMember.expectations = append(mmDeleteEventMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteEventMember return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteEventMemberExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteEventMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteEventMember should be invoked
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Times(n uint64) *mEventsServiceMockDeleteEventMember {
+	if n == 0 {
+		mmDeleteEventMember.mock.t.Fatalf("Times of EventsServiceMock.DeleteEventMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteEventMember.expectedInvocations, n)
+	mmDeleteEventMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteEventMember
+}
+
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) invocationsDone() bool {
+	if len(mmDeleteEventMember.expectations) == 0 && mmDeleteEventMember.defaultExpectation == nil && mmDeleteEventMember.mock.funcDeleteEventMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteEventMember.mock.afterDeleteEventMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteEventMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteEventMember implements mm_service.EventsService
+func (mmDeleteEventMember *EventsServiceMock) DeleteEventMember(ctx context.Context, userID int64, urlTitle string, memberID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteEventMember.beforeDeleteEventMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteEventMember.afterDeleteEventMemberCounter, 1)
+
+	mmDeleteEventMember.t.Helper()
+
+	if mmDeleteEventMember.inspectFuncDeleteEventMember != nil {
+		mmDeleteEventMember.inspectFuncDeleteEventMember(ctx, userID, urlTitle, memberID)
+	}
+
+	mm_params := EventsServiceMockDeleteEventMemberParams{ctx, userID, urlTitle, memberID}
+
+	// Record call args
+	mmDeleteEventMember.DeleteEventMemberMock.mutex.Lock()
+	mmDeleteEventMember.DeleteEventMemberMock.callArgs = append(mmDeleteEventMember.DeleteEventMemberMock.callArgs, &mm_params)
+	mmDeleteEventMember.DeleteEventMemberMock.mutex.Unlock()
+
+	for _, e := range mmDeleteEventMember.DeleteEventMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteEventMemberParams{ctx, userID, urlTitle, memberID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteEventMember.t.Errorf("EventsServiceMock.DeleteEventMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteEventMember.t.Errorf("EventsServiceMock.DeleteEventMember got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteEventMember.t.Errorf("EventsServiceMock.DeleteEventMember got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.memberID != nil && !minimock.Equal(*mm_want_ptrs.memberID, mm_got.memberID) {
+				mmDeleteEventMember.t.Errorf("EventsServiceMock.DeleteEventMember got unexpected parameter memberID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.expectationOrigins.originMemberID, *mm_want_ptrs.memberID, mm_got.memberID, minimock.Diff(*mm_want_ptrs.memberID, mm_got.memberID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteEventMember.t.Errorf("EventsServiceMock.DeleteEventMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteEventMember.DeleteEventMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteEventMember.t.Fatal("No results are set for the EventsServiceMock.DeleteEventMember")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteEventMember.funcDeleteEventMember != nil {
+		return mmDeleteEventMember.funcDeleteEventMember(ctx, userID, urlTitle, memberID)
+	}
+	mmDeleteEventMember.t.Fatalf("Unexpected call to EventsServiceMock.DeleteEventMember. %v %v %v %v", ctx, userID, urlTitle, memberID)
+	return
+}
+
+// DeleteEventMemberAfterCounter returns a count of finished EventsServiceMock.DeleteEventMember invocations
+func (mmDeleteEventMember *EventsServiceMock) DeleteEventMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEventMember.afterDeleteEventMemberCounter)
+}
+
+// DeleteEventMemberBeforeCounter returns a count of EventsServiceMock.DeleteEventMember invocations
+func (mmDeleteEventMember *EventsServiceMock) DeleteEventMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteEventMember.beforeDeleteEventMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteEventMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteEventMember *mEventsServiceMockDeleteEventMember) Calls() []*EventsServiceMockDeleteEventMemberParams {
+	mmDeleteEventMember.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteEventMemberParams, len(mmDeleteEventMember.callArgs))
+	copy(argCopy, mmDeleteEventMember.callArgs)
+
+	mmDeleteEventMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteEventMemberDone returns true if the count of the DeleteEventMember invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteEventMemberDone() bool {
+	if m.DeleteEventMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteEventMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteEventMemberMock.invocationsDone()
+}
+
+// MinimockDeleteEventMemberInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteEventMemberInspect() {
+	for _, e := range m.DeleteEventMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteEventMemberCounter := mm_atomic.LoadUint64(&m.afterDeleteEventMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteEventMemberMock.defaultExpectation != nil && afterDeleteEventMemberCounter < 1 {
+		if m.DeleteEventMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventMember at\n%s", m.DeleteEventMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteEventMember at\n%s with params: %#v", m.DeleteEventMemberMock.defaultExpectation.expectationOrigins.origin, *m.DeleteEventMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteEventMember != nil && afterDeleteEventMemberCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteEventMember at\n%s", m.funcDeleteEventMemberOrigin)
+	}
+
+	if !m.DeleteEventMemberMock.invocationsDone() && afterDeleteEventMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteEventMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteEventMemberMock.expectedInvocations), m.DeleteEventMemberMock.expectedInvocationsOrigin, afterDeleteEventMemberCounter)
+	}
+}
+
+type mEventsServiceMockDeleteQuestion struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteQuestionExpectation
+	expectations       []*EventsServiceMockDeleteQuestionExpectation
+
+	callArgs []*EventsServiceMockDeleteQuestionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteQuestionExpectation specifies expectation struct of the EventsService.DeleteQuestion
+type EventsServiceMockDeleteQuestionExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteQuestionParams
+	paramPtrs          *EventsServiceMockDeleteQuestionParamPtrs
+	expectationOrigins EventsServiceMockDeleteQuestionExpectationOrigins
+	results            *EventsServiceMockDeleteQuestionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteQuestionParams contains parameters of the EventsService.DeleteQuestion
+type EventsServiceMockDeleteQuestionParams struct {
+	ctx        context.Context
+	userID     int64
+	urlTitle   string
+	questionID int64
+}
+
+// EventsServiceMockDeleteQuestionParamPtrs contains pointers to parameters of the EventsService.DeleteQuestion
+type EventsServiceMockDeleteQuestionParamPtrs struct {
+	ctx        *context.Context
+	userID     *int64
+	urlTitle   *string
+	questionID *int64
+}
+
+// EventsServiceMockDeleteQuestionResults contains results of the EventsService.DeleteQuestion
+type EventsServiceMockDeleteQuestionResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteQuestionOrigins contains origins of expectations of the EventsService.DeleteQuestion
+type EventsServiceMockDeleteQuestionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originUrlTitle   string
+	originQuestionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Optional() *mEventsServiceMockDeleteQuestion {
+	mmDeleteQuestion.optional = true
+	return mmDeleteQuestion
+}
+
+// Expect sets up expected params for EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Expect(ctx context.Context, userID int64, urlTitle string, questionID int64) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{}
+	}
+
+	if mmDeleteQuestion.defaultExpectation.paramPtrs != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteQuestion.defaultExpectation.params = &EventsServiceMockDeleteQuestionParams{ctx, userID, urlTitle, questionID}
+	mmDeleteQuestion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteQuestion.expectations {
+		if minimock.Equal(e.params, mmDeleteQuestion.defaultExpectation.params) {
+			mmDeleteQuestion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteQuestion.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteQuestion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{}
+	}
+
+	if mmDeleteQuestion.defaultExpectation.params != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Expect")
+	}
+
+	if mmDeleteQuestion.defaultExpectation.paramPtrs == nil {
+		mmDeleteQuestion.defaultExpectation.paramPtrs = &EventsServiceMockDeleteQuestionParamPtrs{}
+	}
+	mmDeleteQuestion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteQuestion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteQuestion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{}
+	}
+
+	if mmDeleteQuestion.defaultExpectation.params != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Expect")
+	}
+
+	if mmDeleteQuestion.defaultExpectation.paramPtrs == nil {
+		mmDeleteQuestion.defaultExpectation.paramPtrs = &EventsServiceMockDeleteQuestionParamPtrs{}
+	}
+	mmDeleteQuestion.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteQuestion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteQuestion
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{}
+	}
+
+	if mmDeleteQuestion.defaultExpectation.params != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Expect")
+	}
+
+	if mmDeleteQuestion.defaultExpectation.paramPtrs == nil {
+		mmDeleteQuestion.defaultExpectation.paramPtrs = &EventsServiceMockDeleteQuestionParamPtrs{}
+	}
+	mmDeleteQuestion.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteQuestion.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteQuestion
+}
+
+// ExpectQuestionIDParam4 sets up expected param questionID for EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) ExpectQuestionIDParam4(questionID int64) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{}
+	}
+
+	if mmDeleteQuestion.defaultExpectation.params != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Expect")
+	}
+
+	if mmDeleteQuestion.defaultExpectation.paramPtrs == nil {
+		mmDeleteQuestion.defaultExpectation.paramPtrs = &EventsServiceMockDeleteQuestionParamPtrs{}
+	}
+	mmDeleteQuestion.defaultExpectation.paramPtrs.questionID = &questionID
+	mmDeleteQuestion.defaultExpectation.expectationOrigins.originQuestionID = minimock.CallerInfo(1)
+
+	return mmDeleteQuestion
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Inspect(f func(ctx context.Context, userID int64, urlTitle string, questionID int64)) *mEventsServiceMockDeleteQuestion {
+	if mmDeleteQuestion.mock.inspectFuncDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteQuestion")
+	}
+
+	mmDeleteQuestion.mock.inspectFuncDeleteQuestion = f
+
+	return mmDeleteQuestion
+}
+
+// Return sets up results that will be returned by EventsService.DeleteQuestion
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Return(err error) *EventsServiceMock {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	if mmDeleteQuestion.defaultExpectation == nil {
+		mmDeleteQuestion.defaultExpectation = &EventsServiceMockDeleteQuestionExpectation{mock: mmDeleteQuestion.mock}
+	}
+	mmDeleteQuestion.defaultExpectation.results = &EventsServiceMockDeleteQuestionResults{err}
+	mmDeleteQuestion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteQuestion.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteQuestion method
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Set(f func(ctx context.Context, userID int64, urlTitle string, questionID int64) (err error)) *EventsServiceMock {
+	if mmDeleteQuestion.defaultExpectation != nil {
+		mmDeleteQuestion.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteQuestion method")
+	}
+
+	if len(mmDeleteQuestion.expectations) > 0 {
+		mmDeleteQuestion.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteQuestion method")
+	}
+
+	mmDeleteQuestion.mock.funcDeleteQuestion = f
+	mmDeleteQuestion.mock.funcDeleteQuestionOrigin = minimock.CallerInfo(1)
+	return mmDeleteQuestion.mock
+}
+
+// When sets expectation for the EventsService.DeleteQuestion which will trigger the result defined by the following
+// Then helper
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) When(ctx context.Context, userID int64, urlTitle string, questionID int64) *EventsServiceMockDeleteQuestionExpectation {
+	if mmDeleteQuestion.mock.funcDeleteQuestion != nil {
+		mmDeleteQuestion.mock.t.Fatalf("EventsServiceMock.DeleteQuestion mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteQuestionExpectation{
+		mock:               mmDeleteQuestion.mock,
+		params:             &EventsServiceMockDeleteQuestionParams{ctx, userID, urlTitle, questionID},
+		expectationOrigins: EventsServiceMockDeleteQuestionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteQuestion.expectations = append(mmDeleteQuestion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteQuestion return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteQuestionExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteQuestionResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteQuestion should be invoked
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Times(n uint64) *mEventsServiceMockDeleteQuestion {
+	if n == 0 {
+		mmDeleteQuestion.mock.t.Fatalf("Times of EventsServiceMock.DeleteQuestion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteQuestion.expectedInvocations, n)
+	mmDeleteQuestion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteQuestion
+}
+
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) invocationsDone() bool {
+	if len(mmDeleteQuestion.expectations) == 0 && mmDeleteQuestion.defaultExpectation == nil && mmDeleteQuestion.mock.funcDeleteQuestion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteQuestion.mock.afterDeleteQuestionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteQuestion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteQuestion implements mm_service.EventsService
+func (mmDeleteQuestion *EventsServiceMock) DeleteQuestion(ctx context.Context, userID int64, urlTitle string, questionID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteQuestion.beforeDeleteQuestionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteQuestion.afterDeleteQuestionCounter, 1)
+
+	mmDeleteQuestion.t.Helper()
+
+	if mmDeleteQuestion.inspectFuncDeleteQuestion != nil {
+		mmDeleteQuestion.inspectFuncDeleteQuestion(ctx, userID, urlTitle, questionID)
+	}
+
+	mm_params := EventsServiceMockDeleteQuestionParams{ctx, userID, urlTitle, questionID}
+
+	// Record call args
+	mmDeleteQuestion.DeleteQuestionMock.mutex.Lock()
+	mmDeleteQuestion.DeleteQuestionMock.callArgs = append(mmDeleteQuestion.DeleteQuestionMock.callArgs, &mm_params)
+	mmDeleteQuestion.DeleteQuestionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteQuestion.DeleteQuestionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteQuestion.DeleteQuestionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteQuestionParams{ctx, userID, urlTitle, questionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteQuestion.t.Errorf("EventsServiceMock.DeleteQuestion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteQuestion.t.Errorf("EventsServiceMock.DeleteQuestion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteQuestion.t.Errorf("EventsServiceMock.DeleteQuestion got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.questionID != nil && !minimock.Equal(*mm_want_ptrs.questionID, mm_got.questionID) {
+				mmDeleteQuestion.t.Errorf("EventsServiceMock.DeleteQuestion got unexpected parameter questionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.expectationOrigins.originQuestionID, *mm_want_ptrs.questionID, mm_got.questionID, minimock.Diff(*mm_want_ptrs.questionID, mm_got.questionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteQuestion.t.Errorf("EventsServiceMock.DeleteQuestion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteQuestion.DeleteQuestionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteQuestion.t.Fatal("No results are set for the EventsServiceMock.DeleteQuestion")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteQuestion.funcDeleteQuestion != nil {
+		return mmDeleteQuestion.funcDeleteQuestion(ctx, userID, urlTitle, questionID)
+	}
+	mmDeleteQuestion.t.Fatalf("Unexpected call to EventsServiceMock.DeleteQuestion. %v %v %v %v", ctx, userID, urlTitle, questionID)
+	return
+}
+
+// DeleteQuestionAfterCounter returns a count of finished EventsServiceMock.DeleteQuestion invocations
+func (mmDeleteQuestion *EventsServiceMock) DeleteQuestionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteQuestion.afterDeleteQuestionCounter)
+}
+
+// DeleteQuestionBeforeCounter returns a count of EventsServiceMock.DeleteQuestion invocations
+func (mmDeleteQuestion *EventsServiceMock) DeleteQuestionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteQuestion.beforeDeleteQuestionCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteQuestion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteQuestion *mEventsServiceMockDeleteQuestion) Calls() []*EventsServiceMockDeleteQuestionParams {
+	mmDeleteQuestion.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteQuestionParams, len(mmDeleteQuestion.callArgs))
+	copy(argCopy, mmDeleteQuestion.callArgs)
+
+	mmDeleteQuestion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteQuestionDone returns true if the count of the DeleteQuestion invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteQuestionDone() bool {
+	if m.DeleteQuestionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteQuestionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteQuestionMock.invocationsDone()
+}
+
+// MinimockDeleteQuestionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteQuestionInspect() {
+	for _, e := range m.DeleteQuestionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteQuestion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteQuestionCounter := mm_atomic.LoadUint64(&m.afterDeleteQuestionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteQuestionMock.defaultExpectation != nil && afterDeleteQuestionCounter < 1 {
+		if m.DeleteQuestionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteQuestion at\n%s", m.DeleteQuestionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteQuestion at\n%s with params: %#v", m.DeleteQuestionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteQuestionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteQuestion != nil && afterDeleteQuestionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteQuestion at\n%s", m.funcDeleteQuestionOrigin)
+	}
+
+	if !m.DeleteQuestionMock.invocationsDone() && afterDeleteQuestionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteQuestion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteQuestionMock.expectedInvocations), m.DeleteQuestionMock.expectedInvocationsOrigin, afterDeleteQuestionCounter)
+	}
+}
+
+type mEventsServiceMockDeleteSession struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteSessionExpectation
+	expectations       []*EventsServiceMockDeleteSessionExpectation
+
+	callArgs []*EventsServiceMockDeleteSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteSessionExpectation specifies expectation struct of the EventsService.DeleteSession
+type EventsServiceMockDeleteSessionExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteSessionParams
+	paramPtrs          *EventsServiceMockDeleteSessionParamPtrs
+	expectationOrigins EventsServiceMockDeleteSessionExpectationOrigins
+	results            *EventsServiceMockDeleteSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteSessionParams contains parameters of the EventsService.DeleteSession
+type EventsServiceMockDeleteSessionParams struct {
+	ctx       context.Context
+	userID    int64
+	urlTitle  string
+	sessionID int64
+}
+
+// EventsServiceMockDeleteSessionParamPtrs contains pointers to parameters of the EventsService.DeleteSession
+type EventsServiceMockDeleteSessionParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	urlTitle  *string
+	sessionID *int64
+}
+
+// EventsServiceMockDeleteSessionResults contains results of the EventsService.DeleteSession
+type EventsServiceMockDeleteSessionResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteSessionOrigins contains origins of expectations of the EventsService.DeleteSession
+type EventsServiceMockDeleteSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originUrlTitle  string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Optional() *mEventsServiceMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
+}
+
+// Expect sets up expected params for EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Expect(ctx context.Context, userID int64, urlTitle string, sessionID int64) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSession.defaultExpectation.params = &EventsServiceMockDeleteSessionParams{ctx, userID, urlTitle, sessionID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectSessionIDParam4 sets up expected param sessionID for EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) ExpectSessionIDParam4(sessionID int64) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Inspect(f func(ctx context.Context, userID int64, urlTitle string, sessionID int64)) *mEventsServiceMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteSession")
+	}
+
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by EventsService.DeleteSession
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Return(err error) *EventsServiceMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &EventsServiceMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &EventsServiceMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteSession method
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Set(f func(ctx context.Context, userID int64, urlTitle string, sessionID int64) (err error)) *EventsServiceMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the EventsService.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mEventsServiceMockDeleteSession) When(ctx context.Context, userID int64, urlTitle string, sessionID int64) *EventsServiceMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("EventsServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &EventsServiceMockDeleteSessionParams{ctx, userID, urlTitle, sessionID},
+		expectationOrigins: EventsServiceMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteSessionExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteSession should be invoked
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Times(n uint64) *mEventsServiceMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of EventsServiceMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mEventsServiceMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements mm_service.EventsService
+func (mmDeleteSession *EventsServiceMock) DeleteSession(ctx context.Context, userID int64, urlTitle string, sessionID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, urlTitle, sessionID)
+	}
+
+	mm_params := EventsServiceMockDeleteSessionParams{ctx, userID, urlTitle, sessionID}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteSessionParams{ctx, userID, urlTitle, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("EventsServiceMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("EventsServiceMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteSession.t.Errorf("EventsServiceMock.DeleteSession got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmDeleteSession.t.Errorf("EventsServiceMock.DeleteSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("EventsServiceMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the EventsServiceMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, userID, urlTitle, sessionID)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to EventsServiceMock.DeleteSession. %v %v %v %v", ctx, userID, urlTitle, sessionID)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished EventsServiceMock.DeleteSession invocations
+func (mmDeleteSession *EventsServiceMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of EventsServiceMock.DeleteSession invocations
+func (mmDeleteSession *EventsServiceMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mEventsServiceMockDeleteSession) Calls() []*EventsServiceMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mEventsServiceMockDeleteSpeaker struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockDeleteSpeakerExpectation
+	expectations       []*EventsServiceMockDeleteSpeakerExpectation
+
+	callArgs []*EventsServiceMockDeleteSpeakerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockDeleteSpeakerExpectation specifies expectation struct of the EventsService.DeleteSpeaker
+type EventsServiceMockDeleteSpeakerExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockDeleteSpeakerParams
+	paramPtrs          *EventsServiceMockDeleteSpeakerParamPtrs
+	expectationOrigins EventsServiceMockDeleteSpeakerExpectationOrigins
+	results            *EventsServiceMockDeleteSpeakerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockDeleteSpeakerParams contains parameters of the EventsService.DeleteSpeaker
+type EventsServiceMockDeleteSpeakerParams struct {
+	ctx       context.Context
+	userID    int64
+	urlTitle  string
+	speakerID int64
+}
+
+// EventsServiceMockDeleteSpeakerParamPtrs contains pointers to parameters of the EventsService.DeleteSpeaker
+type EventsServiceMockDeleteSpeakerParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	urlTitle  *string
+	speakerID *int64
+}
+
+// EventsServiceMockDeleteSpeakerResults contains results of the EventsService.DeleteSpeaker
+type EventsServiceMockDeleteSpeakerResults struct {
+	err error
+}
+
+// EventsServiceMockDeleteSpeakerOrigins contains origins of expectations of the EventsService.DeleteSpeaker
+type EventsServiceMockDeleteSpeakerExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originUrlTitle  string
+	originSpeakerID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Optional() *mEventsServiceMockDeleteSpeaker {
+	mmDeleteSpeaker.optional = true
+	return mmDeleteSpeaker
+}
+
+// Expect sets up expected params for EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Expect(ctx context.Context, userID int64, urlTitle string, speakerID int64) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{}
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.paramPtrs != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSpeaker.defaultExpectation.params = &EventsServiceMockDeleteSpeakerParams{ctx, userID, urlTitle, speakerID}
+	mmDeleteSpeaker.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSpeaker.expectations {
+		if minimock.Equal(e.params, mmDeleteSpeaker.defaultExpectation.params) {
+			mmDeleteSpeaker.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSpeaker.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSpeaker
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{}
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.params != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Expect")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.paramPtrs == nil {
+		mmDeleteSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSpeakerParamPtrs{}
+	}
+	mmDeleteSpeaker.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSpeaker.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSpeaker
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) ExpectUserIDParam2(userID int64) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{}
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.params != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Expect")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.paramPtrs == nil {
+		mmDeleteSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSpeakerParamPtrs{}
+	}
+	mmDeleteSpeaker.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSpeaker.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSpeaker
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{}
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.params != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Expect")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.paramPtrs == nil {
+		mmDeleteSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSpeakerParamPtrs{}
+	}
+	mmDeleteSpeaker.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmDeleteSpeaker.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmDeleteSpeaker
+}
+
+// ExpectSpeakerIDParam4 sets up expected param speakerID for EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) ExpectSpeakerIDParam4(speakerID int64) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{}
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.params != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Expect")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation.paramPtrs == nil {
+		mmDeleteSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockDeleteSpeakerParamPtrs{}
+	}
+	mmDeleteSpeaker.defaultExpectation.paramPtrs.speakerID = &speakerID
+	mmDeleteSpeaker.defaultExpectation.expectationOrigins.originSpeakerID = minimock.CallerInfo(1)
+
+	return mmDeleteSpeaker
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Inspect(f func(ctx context.Context, userID int64, urlTitle string, speakerID int64)) *mEventsServiceMockDeleteSpeaker {
+	if mmDeleteSpeaker.mock.inspectFuncDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.DeleteSpeaker")
+	}
+
+	mmDeleteSpeaker.mock.inspectFuncDeleteSpeaker = f
+
+	return mmDeleteSpeaker
+}
+
+// Return sets up results that will be returned by EventsService.DeleteSpeaker
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Return(err error) *EventsServiceMock {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	if mmDeleteSpeaker.defaultExpectation == nil {
+		mmDeleteSpeaker.defaultExpectation = &EventsServiceMockDeleteSpeakerExpectation{mock: mmDeleteSpeaker.mock}
+	}
+	mmDeleteSpeaker.defaultExpectation.results = &EventsServiceMockDeleteSpeakerResults{err}
+	mmDeleteSpeaker.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSpeaker.mock
+}
+
+// Set uses given function f to mock the EventsService.DeleteSpeaker method
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Set(f func(ctx context.Context, userID int64, urlTitle string, speakerID int64) (err error)) *EventsServiceMock {
+	if mmDeleteSpeaker.defaultExpectation != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("Default expectation is already set for the EventsService.DeleteSpeaker method")
+	}
+
+	if len(mmDeleteSpeaker.expectations) > 0 {
+		mmDeleteSpeaker.mock.t.Fatalf("Some expectations are already set for the EventsService.DeleteSpeaker method")
+	}
+
+	mmDeleteSpeaker.mock.funcDeleteSpeaker = f
+	mmDeleteSpeaker.mock.funcDeleteSpeakerOrigin = minimock.CallerInfo(1)
+	return mmDeleteSpeaker.mock
+}
+
+// When sets expectation for the EventsService.DeleteSpeaker which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) When(ctx context.Context, userID int64, urlTitle string, speakerID int64) *EventsServiceMockDeleteSpeakerExpectation {
+	if mmDeleteSpeaker.mock.funcDeleteSpeaker != nil {
+		mmDeleteSpeaker.mock.t.Fatalf("EventsServiceMock.DeleteSpeaker mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockDeleteSpeakerExpectation{
+		mock:               mmDeleteSpeaker.mock,
+		params:             &EventsServiceMockDeleteSpeakerParams{ctx, userID, urlTitle, speakerID},
+		expectationOrigins: EventsServiceMockDeleteSpeakerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSpeaker.expectations = append(mmDeleteSpeaker.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.DeleteSpeaker return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockDeleteSpeakerExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockDeleteSpeakerResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.DeleteSpeaker should be invoked
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Times(n uint64) *mEventsServiceMockDeleteSpeaker {
+	if n == 0 {
+		mmDeleteSpeaker.mock.t.Fatalf("Times of EventsServiceMock.DeleteSpeaker mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSpeaker.expectedInvocations, n)
+	mmDeleteSpeaker.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSpeaker
+}
+
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) invocationsDone() bool {
+	if len(mmDeleteSpeaker.expectations) == 0 && mmDeleteSpeaker.defaultExpectation == nil && mmDeleteSpeaker.mock.funcDeleteSpeaker == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSpeaker.mock.afterDeleteSpeakerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSpeaker.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSpeaker implements mm_service.EventsService
+func (mmDeleteSpeaker *EventsServiceMock) DeleteSpeaker(ctx context.Context, userID int64, urlTitle string, speakerID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSpeaker.beforeDeleteSpeakerCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSpeaker.afterDeleteSpeakerCounter, 1)
+
+	mmDeleteSpeaker.t.Helper()
+
+	if mmDeleteSpeaker.inspectFuncDeleteSpeaker != nil {
+		mmDeleteSpeaker.inspectFuncDeleteSpeaker(ctx, userID, urlTitle, speakerID)
+	}
+
+	mm_params := EventsServiceMockDeleteSpeakerParams{ctx, userID, urlTitle, speakerID}
+
+	// Record call args
+	mmDeleteSpeaker.DeleteSpeakerMock.mutex.Lock()
+	mmDeleteSpeaker.DeleteSpeakerMock.callArgs = append(mmDeleteSpeaker.DeleteSpeakerMock.callArgs, &mm_params)
+	mmDeleteSpeaker.DeleteSpeakerMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSpeaker.DeleteSpeakerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockDeleteSpeakerParams{ctx, userID, urlTitle, speakerID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSpeaker.t.Errorf("EventsServiceMock.DeleteSpeaker got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSpeaker.t.Errorf("EventsServiceMock.DeleteSpeaker got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmDeleteSpeaker.t.Errorf("EventsServiceMock.DeleteSpeaker got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.speakerID != nil && !minimock.Equal(*mm_want_ptrs.speakerID, mm_got.speakerID) {
+				mmDeleteSpeaker.t.Errorf("EventsServiceMock.DeleteSpeaker got unexpected parameter speakerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.expectationOrigins.originSpeakerID, *mm_want_ptrs.speakerID, mm_got.speakerID, minimock.Diff(*mm_want_ptrs.speakerID, mm_got.speakerID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSpeaker.t.Errorf("EventsServiceMock.DeleteSpeaker got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSpeaker.DeleteSpeakerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSpeaker.t.Fatal("No results are set for the EventsServiceMock.DeleteSpeaker")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSpeaker.funcDeleteSpeaker != nil {
+		return mmDeleteSpeaker.funcDeleteSpeaker(ctx, userID, urlTitle, speakerID)
+	}
+	mmDeleteSpeaker.t.Fatalf("Unexpected call to EventsServiceMock.DeleteSpeaker. %v %v %v %v", ctx, userID, urlTitle, speakerID)
+	return
+}
+
+// DeleteSpeakerAfterCounter returns a count of finished EventsServiceMock.DeleteSpeaker invocations
+func (mmDeleteSpeaker *EventsServiceMock) DeleteSpeakerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSpeaker.afterDeleteSpeakerCounter)
+}
+
+// DeleteSpeakerBeforeCounter returns a count of EventsServiceMock.DeleteSpeaker invocations
+func (mmDeleteSpeaker *EventsServiceMock) DeleteSpeakerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSpeaker.beforeDeleteSpeakerCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.DeleteSpeaker.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSpeaker *mEventsServiceMockDeleteSpeaker) Calls() []*EventsServiceMockDeleteSpeakerParams {
+	mmDeleteSpeaker.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockDeleteSpeakerParams, len(mmDeleteSpeaker.callArgs))
+	copy(argCopy, mmDeleteSpeaker.callArgs)
+
+	mmDeleteSpeaker.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSpeakerDone returns true if the count of the DeleteSpeaker invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockDeleteSpeakerDone() bool {
+	if m.DeleteSpeakerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSpeakerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSpeakerMock.invocationsDone()
+}
+
+// MinimockDeleteSpeakerInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockDeleteSpeakerInspect() {
+	for _, e := range m.DeleteSpeakerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSpeaker at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSpeakerCounter := mm_atomic.LoadUint64(&m.afterDeleteSpeakerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSpeakerMock.defaultExpectation != nil && afterDeleteSpeakerCounter < 1 {
+		if m.DeleteSpeakerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSpeaker at\n%s", m.DeleteSpeakerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.DeleteSpeaker at\n%s with params: %#v", m.DeleteSpeakerMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSpeakerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSpeaker != nil && afterDeleteSpeakerCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.DeleteSpeaker at\n%s", m.funcDeleteSpeakerOrigin)
+	}
+
+	if !m.DeleteSpeakerMock.invocationsDone() && afterDeleteSpeakerCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.DeleteSpeaker at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSpeakerMock.expectedInvocations), m.DeleteSpeakerMock.expectedInvocationsOrigin, afterDeleteSpeakerCounter)
+	}
+}
+
+type mEventsServiceMockEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventExpectation
+	expectations       []*EventsServiceMockEventExpectation
+
+	callArgs []*EventsServiceMockEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventExpectation specifies expectation struct of the EventsService.Event
+type EventsServiceMockEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventParams
+	paramPtrs          *EventsServiceMockEventParamPtrs
+	expectationOrigins EventsServiceMockEventExpectationOrigins
+	results            *EventsServiceMockEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventParams contains parameters of the EventsService.Event
+type EventsServiceMockEventParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockEventParamPtrs contains pointers to parameters of the EventsService.Event
+type EventsServiceMockEventParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockEventResults contains results of the EventsService.Event
+type EventsServiceMockEventResults struct {
+	ep1 *models.Event
+	err error
+}
+
+// EventsServiceMockEventOrigins contains origins of expectations of the EventsService.Event
+type EventsServiceMockEventExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEvent *mEventsServiceMockEvent) Optional() *mEventsServiceMockEvent {
+	mmEvent.optional = true
+	return mmEvent
+}
+
+// Expect sets up expected params for EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockEvent {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	if mmEvent.defaultExpectation == nil {
+		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	}
+
+	if mmEvent.defaultExpectation.paramPtrs != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by ExpectParams functions")
+	}
+
+	mmEvent.defaultExpectation.params = &EventsServiceMockEventParams{ctx, userID, urlTitle}
+	mmEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEvent.expectations {
+		if minimock.Equal(e.params, mmEvent.defaultExpectation.params) {
+			mmEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEvent {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	if mmEvent.defaultExpectation == nil {
+		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	}
+
+	if mmEvent.defaultExpectation.params != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Expect")
+	}
+
+	if mmEvent.defaultExpectation.paramPtrs == nil {
+		mmEvent.defaultExpectation.paramPtrs = &EventsServiceMockEventParamPtrs{}
+	}
+	mmEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockEvent {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	if mmEvent.defaultExpectation == nil {
+		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	}
+
+	if mmEvent.defaultExpectation.params != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Expect")
+	}
+
+	if mmEvent.defaultExpectation.paramPtrs == nil {
+		mmEvent.defaultExpectation.paramPtrs = &EventsServiceMockEventParamPtrs{}
+	}
+	mmEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEvent
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockEvent {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	if mmEvent.defaultExpectation == nil {
+		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{}
+	}
+
+	if mmEvent.defaultExpectation.params != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Expect")
+	}
+
+	if mmEvent.defaultExpectation.paramPtrs == nil {
+		mmEvent.defaultExpectation.paramPtrs = &EventsServiceMockEventParamPtrs{}
 	}
 	mmEvent.defaultExpectation.paramPtrs.urlTitle = &urlTitle
 	mmEvent.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
 
-	return mmEvent
+	return mmEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockEvent {
+	if mmEvent.mock.inspectFuncEvent != nil {
+		mmEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Event")
+	}
+
+	mmEvent.mock.inspectFuncEvent = f
+
+	return mmEvent
+}
+
+// Return sets up results that will be returned by EventsService.Event
+func (mmEvent *mEventsServiceMockEvent) Return(ep1 *models.Event, err error) *EventsServiceMock {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	if mmEvent.defaultExpectation == nil {
+		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{mock: mmEvent.mock}
+	}
+	mmEvent.defaultExpectation.results = &EventsServiceMockEventResults{ep1, err}
+	mmEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.Event method
+func (mmEvent *mEventsServiceMockEvent) Set(f func(ctx context.Context, userID int64, urlTitle string) (ep1 *models.Event, err error)) *EventsServiceMock {
+	if mmEvent.defaultExpectation != nil {
+		mmEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.Event method")
+	}
+
+	if len(mmEvent.expectations) > 0 {
+		mmEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.Event method")
+	}
+
+	mmEvent.mock.funcEvent = f
+	mmEvent.mock.funcEventOrigin = minimock.CallerInfo(1)
+	return mmEvent.mock
+}
+
+// When sets expectation for the EventsService.Event which will trigger the result defined by the following
+// Then helper
+func (mmEvent *mEventsServiceMockEvent) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockEventExpectation {
+	if mmEvent.mock.funcEvent != nil {
+		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventExpectation{
+		mock:               mmEvent.mock,
+		params:             &EventsServiceMockEventParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEvent.expectations = append(mmEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.Event return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventExpectation) Then(ep1 *models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.Event should be invoked
+func (mmEvent *mEventsServiceMockEvent) Times(n uint64) *mEventsServiceMockEvent {
+	if n == 0 {
+		mmEvent.mock.t.Fatalf("Times of EventsServiceMock.Event mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEvent.expectedInvocations, n)
+	mmEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEvent
+}
+
+func (mmEvent *mEventsServiceMockEvent) invocationsDone() bool {
+	if len(mmEvent.expectations) == 0 && mmEvent.defaultExpectation == nil && mmEvent.mock.funcEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEvent.mock.afterEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Event implements mm_service.EventsService
+func (mmEvent *EventsServiceMock) Event(ctx context.Context, userID int64, urlTitle string) (ep1 *models.Event, err error) {
+	mm_atomic.AddUint64(&mmEvent.beforeEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmEvent.afterEventCounter, 1)
+
+	mmEvent.t.Helper()
+
+	if mmEvent.inspectFuncEvent != nil {
+		mmEvent.inspectFuncEvent(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockEventParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmEvent.EventMock.mutex.Lock()
+	mmEvent.EventMock.callArgs = append(mmEvent.EventMock.callArgs, &mm_params)
+	mmEvent.EventMock.mutex.Unlock()
+
+	for _, e := range mmEvent.EventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmEvent.EventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEvent.EventMock.defaultExpectation.Counter, 1)
+		mm_want := mmEvent.EventMock.defaultExpectation.params
+		mm_want_ptrs := mmEvent.EventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvent.EventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvent.EventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvent.EventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEvent.EventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEvent.EventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEvent.t.Fatal("No results are set for the EventsServiceMock.Event")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmEvent.funcEvent != nil {
+		return mmEvent.funcEvent(ctx, userID, urlTitle)
+	}
+	mmEvent.t.Fatalf("Unexpected call to EventsServiceMock.Event. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// EventAfterCounter returns a count of finished EventsServiceMock.Event invocations
+func (mmEvent *EventsServiceMock) EventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvent.afterEventCounter)
+}
+
+// EventBeforeCounter returns a count of EventsServiceMock.Event invocations
+func (mmEvent *EventsServiceMock) EventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvent.beforeEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.Event.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEvent *mEventsServiceMockEvent) Calls() []*EventsServiceMockEventParams {
+	mmEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventParams, len(mmEvent.callArgs))
+	copy(argCopy, mmEvent.callArgs)
+
+	mmEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventDone returns true if the count of the Event invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventDone() bool {
+	if m.EventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventMock.invocationsDone()
+}
+
+// MinimockEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventInspect() {
+	for _, e := range m.EventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventCounter := mm_atomic.LoadUint64(&m.afterEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventMock.defaultExpectation != nil && afterEventCounter < 1 {
+		if m.EventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s", m.EventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s with params: %#v", m.EventMock.defaultExpectation.expectationOrigins.origin, *m.EventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEvent != nil && afterEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s", m.funcEventOrigin)
+	}
+
+	if !m.EventMock.invocationsDone() && afterEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.Event at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventMock.expectedInvocations), m.EventMock.expectedInvocationsOrigin, afterEventCounter)
+	}
+}
+
+type mEventsServiceMockEventHistory struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventHistoryExpectation
+	expectations       []*EventsServiceMockEventHistoryExpectation
+
+	callArgs []*EventsServiceMockEventHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventHistoryExpectation specifies expectation struct of the EventsService.EventHistory
+type EventsServiceMockEventHistoryExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventHistoryParams
+	paramPtrs          *EventsServiceMockEventHistoryParamPtrs
+	expectationOrigins EventsServiceMockEventHistoryExpectationOrigins
+	results            *EventsServiceMockEventHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventHistoryParams contains parameters of the EventsService.EventHistory
+type EventsServiceMockEventHistoryParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockEventHistoryParamPtrs contains pointers to parameters of the EventsService.EventHistory
+type EventsServiceMockEventHistoryParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockEventHistoryResults contains results of the EventsService.EventHistory
+type EventsServiceMockEventHistoryResults struct {
+	epa1 []*models.EventRevision
+	err  error
+}
+
+// EventsServiceMockEventHistoryOrigins contains origins of expectations of the EventsService.EventHistory
+type EventsServiceMockEventHistoryExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEventHistory *mEventsServiceMockEventHistory) Optional() *mEventsServiceMockEventHistory {
+	mmEventHistory.optional = true
+	return mmEventHistory
+}
+
+// Expect sets up expected params for EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockEventHistory {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	if mmEventHistory.defaultExpectation == nil {
+		mmEventHistory.defaultExpectation = &EventsServiceMockEventHistoryExpectation{}
+	}
+
+	if mmEventHistory.defaultExpectation.paramPtrs != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by ExpectParams functions")
+	}
+
+	mmEventHistory.defaultExpectation.params = &EventsServiceMockEventHistoryParams{ctx, userID, urlTitle}
+	mmEventHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEventHistory.expectations {
+		if minimock.Equal(e.params, mmEventHistory.defaultExpectation.params) {
+			mmEventHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEventHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmEventHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEventHistory {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	if mmEventHistory.defaultExpectation == nil {
+		mmEventHistory.defaultExpectation = &EventsServiceMockEventHistoryExpectation{}
+	}
+
+	if mmEventHistory.defaultExpectation.params != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Expect")
+	}
+
+	if mmEventHistory.defaultExpectation.paramPtrs == nil {
+		mmEventHistory.defaultExpectation.paramPtrs = &EventsServiceMockEventHistoryParamPtrs{}
+	}
+	mmEventHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEventHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEventHistory
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) ExpectUserIDParam2(userID int64) *mEventsServiceMockEventHistory {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	if mmEventHistory.defaultExpectation == nil {
+		mmEventHistory.defaultExpectation = &EventsServiceMockEventHistoryExpectation{}
+	}
+
+	if mmEventHistory.defaultExpectation.params != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Expect")
+	}
+
+	if mmEventHistory.defaultExpectation.paramPtrs == nil {
+		mmEventHistory.defaultExpectation.paramPtrs = &EventsServiceMockEventHistoryParamPtrs{}
+	}
+	mmEventHistory.defaultExpectation.paramPtrs.userID = &userID
+	mmEventHistory.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEventHistory
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockEventHistory {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	if mmEventHistory.defaultExpectation == nil {
+		mmEventHistory.defaultExpectation = &EventsServiceMockEventHistoryExpectation{}
+	}
+
+	if mmEventHistory.defaultExpectation.params != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Expect")
+	}
+
+	if mmEventHistory.defaultExpectation.paramPtrs == nil {
+		mmEventHistory.defaultExpectation.paramPtrs = &EventsServiceMockEventHistoryParamPtrs{}
+	}
+	mmEventHistory.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmEventHistory.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmEventHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockEventHistory {
+	if mmEventHistory.mock.inspectFuncEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.EventHistory")
+	}
+
+	mmEventHistory.mock.inspectFuncEventHistory = f
+
+	return mmEventHistory
+}
+
+// Return sets up results that will be returned by EventsService.EventHistory
+func (mmEventHistory *mEventsServiceMockEventHistory) Return(epa1 []*models.EventRevision, err error) *EventsServiceMock {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	if mmEventHistory.defaultExpectation == nil {
+		mmEventHistory.defaultExpectation = &EventsServiceMockEventHistoryExpectation{mock: mmEventHistory.mock}
+	}
+	mmEventHistory.defaultExpectation.results = &EventsServiceMockEventHistoryResults{epa1, err}
+	mmEventHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEventHistory.mock
+}
+
+// Set uses given function f to mock the EventsService.EventHistory method
+func (mmEventHistory *mEventsServiceMockEventHistory) Set(f func(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventRevision, err error)) *EventsServiceMock {
+	if mmEventHistory.defaultExpectation != nil {
+		mmEventHistory.mock.t.Fatalf("Default expectation is already set for the EventsService.EventHistory method")
+	}
+
+	if len(mmEventHistory.expectations) > 0 {
+		mmEventHistory.mock.t.Fatalf("Some expectations are already set for the EventsService.EventHistory method")
+	}
+
+	mmEventHistory.mock.funcEventHistory = f
+	mmEventHistory.mock.funcEventHistoryOrigin = minimock.CallerInfo(1)
+	return mmEventHistory.mock
+}
+
+// When sets expectation for the EventsService.EventHistory which will trigger the result defined by the following
+// Then helper
+func (mmEventHistory *mEventsServiceMockEventHistory) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockEventHistoryExpectation {
+	if mmEventHistory.mock.funcEventHistory != nil {
+		mmEventHistory.mock.t.Fatalf("EventsServiceMock.EventHistory mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventHistoryExpectation{
+		mock:               mmEventHistory.mock,
+		params:             &EventsServiceMockEventHistoryParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockEventHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEventHistory.expectations = append(mmEventHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.EventHistory return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventHistoryExpectation) Then(epa1 []*models.EventRevision, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventHistoryResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.EventHistory should be invoked
+func (mmEventHistory *mEventsServiceMockEventHistory) Times(n uint64) *mEventsServiceMockEventHistory {
+	if n == 0 {
+		mmEventHistory.mock.t.Fatalf("Times of EventsServiceMock.EventHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEventHistory.expectedInvocations, n)
+	mmEventHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEventHistory
+}
+
+func (mmEventHistory *mEventsServiceMockEventHistory) invocationsDone() bool {
+	if len(mmEventHistory.expectations) == 0 && mmEventHistory.defaultExpectation == nil && mmEventHistory.mock.funcEventHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEventHistory.mock.afterEventHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEventHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EventHistory implements mm_service.EventsService
+func (mmEventHistory *EventsServiceMock) EventHistory(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventRevision, err error) {
+	mm_atomic.AddUint64(&mmEventHistory.beforeEventHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmEventHistory.afterEventHistoryCounter, 1)
+
+	mmEventHistory.t.Helper()
+
+	if mmEventHistory.inspectFuncEventHistory != nil {
+		mmEventHistory.inspectFuncEventHistory(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockEventHistoryParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmEventHistory.EventHistoryMock.mutex.Lock()
+	mmEventHistory.EventHistoryMock.callArgs = append(mmEventHistory.EventHistoryMock.callArgs, &mm_params)
+	mmEventHistory.EventHistoryMock.mutex.Unlock()
+
+	for _, e := range mmEventHistory.EventHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmEventHistory.EventHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEventHistory.EventHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmEventHistory.EventHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmEventHistory.EventHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventHistoryParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEventHistory.t.Errorf("EventsServiceMock.EventHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventHistory.EventHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEventHistory.t.Errorf("EventsServiceMock.EventHistory got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventHistory.EventHistoryMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmEventHistory.t.Errorf("EventsServiceMock.EventHistory got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventHistory.EventHistoryMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEventHistory.t.Errorf("EventsServiceMock.EventHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEventHistory.EventHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEventHistory.EventHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEventHistory.t.Fatal("No results are set for the EventsServiceMock.EventHistory")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmEventHistory.funcEventHistory != nil {
+		return mmEventHistory.funcEventHistory(ctx, userID, urlTitle)
+	}
+	mmEventHistory.t.Fatalf("Unexpected call to EventsServiceMock.EventHistory. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// EventHistoryAfterCounter returns a count of finished EventsServiceMock.EventHistory invocations
+func (mmEventHistory *EventsServiceMock) EventHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventHistory.afterEventHistoryCounter)
+}
+
+// EventHistoryBeforeCounter returns a count of EventsServiceMock.EventHistory invocations
+func (mmEventHistory *EventsServiceMock) EventHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventHistory.beforeEventHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.EventHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEventHistory *mEventsServiceMockEventHistory) Calls() []*EventsServiceMockEventHistoryParams {
+	mmEventHistory.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventHistoryParams, len(mmEventHistory.callArgs))
+	copy(argCopy, mmEventHistory.callArgs)
+
+	mmEventHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventHistoryDone returns true if the count of the EventHistory invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventHistoryDone() bool {
+	if m.EventHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventHistoryMock.invocationsDone()
+}
+
+// MinimockEventHistoryInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventHistoryInspect() {
+	for _, e := range m.EventHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.EventHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventHistoryCounter := mm_atomic.LoadUint64(&m.afterEventHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventHistoryMock.defaultExpectation != nil && afterEventHistoryCounter < 1 {
+		if m.EventHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.EventHistory at\n%s", m.EventHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.EventHistory at\n%s with params: %#v", m.EventHistoryMock.defaultExpectation.expectationOrigins.origin, *m.EventHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEventHistory != nil && afterEventHistoryCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.EventHistory at\n%s", m.funcEventHistoryOrigin)
+	}
+
+	if !m.EventHistoryMock.invocationsDone() && afterEventHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.EventHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventHistoryMock.expectedInvocations), m.EventHistoryMock.expectedInvocationsOrigin, afterEventHistoryCounter)
+	}
+}
+
+type mEventsServiceMockEventMembers struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventMembersExpectation
+	expectations       []*EventsServiceMockEventMembersExpectation
+
+	callArgs []*EventsServiceMockEventMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventMembersExpectation specifies expectation struct of the EventsService.EventMembers
+type EventsServiceMockEventMembersExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventMembersParams
+	paramPtrs          *EventsServiceMockEventMembersParamPtrs
+	expectationOrigins EventsServiceMockEventMembersExpectationOrigins
+	results            *EventsServiceMockEventMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventMembersParams contains parameters of the EventsService.EventMembers
+type EventsServiceMockEventMembersParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// EventsServiceMockEventMembersParamPtrs contains pointers to parameters of the EventsService.EventMembers
+type EventsServiceMockEventMembersParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// EventsServiceMockEventMembersResults contains results of the EventsService.EventMembers
+type EventsServiceMockEventMembersResults struct {
+	epa1 []*models.EventMember
+	err  error
+}
+
+// EventsServiceMockEventMembersOrigins contains origins of expectations of the EventsService.EventMembers
+type EventsServiceMockEventMembersExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEventMembers *mEventsServiceMockEventMembers) Optional() *mEventsServiceMockEventMembers {
+	mmEventMembers.optional = true
+	return mmEventMembers
+}
+
+// Expect sets up expected params for EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) Expect(ctx context.Context, userID int64, urlTitle string) *mEventsServiceMockEventMembers {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	if mmEventMembers.defaultExpectation == nil {
+		mmEventMembers.defaultExpectation = &EventsServiceMockEventMembersExpectation{}
+	}
+
+	if mmEventMembers.defaultExpectation.paramPtrs != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by ExpectParams functions")
+	}
+
+	mmEventMembers.defaultExpectation.params = &EventsServiceMockEventMembersParams{ctx, userID, urlTitle}
+	mmEventMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEventMembers.expectations {
+		if minimock.Equal(e.params, mmEventMembers.defaultExpectation.params) {
+			mmEventMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEventMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmEventMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEventMembers {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	if mmEventMembers.defaultExpectation == nil {
+		mmEventMembers.defaultExpectation = &EventsServiceMockEventMembersExpectation{}
+	}
+
+	if mmEventMembers.defaultExpectation.params != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Expect")
+	}
+
+	if mmEventMembers.defaultExpectation.paramPtrs == nil {
+		mmEventMembers.defaultExpectation.paramPtrs = &EventsServiceMockEventMembersParamPtrs{}
+	}
+	mmEventMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEventMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEventMembers
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) ExpectUserIDParam2(userID int64) *mEventsServiceMockEventMembers {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	if mmEventMembers.defaultExpectation == nil {
+		mmEventMembers.defaultExpectation = &EventsServiceMockEventMembersExpectation{}
+	}
+
+	if mmEventMembers.defaultExpectation.params != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Expect")
+	}
+
+	if mmEventMembers.defaultExpectation.paramPtrs == nil {
+		mmEventMembers.defaultExpectation.paramPtrs = &EventsServiceMockEventMembersParamPtrs{}
+	}
+	mmEventMembers.defaultExpectation.paramPtrs.userID = &userID
+	mmEventMembers.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEventMembers
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockEventMembers {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	if mmEventMembers.defaultExpectation == nil {
+		mmEventMembers.defaultExpectation = &EventsServiceMockEventMembersExpectation{}
+	}
+
+	if mmEventMembers.defaultExpectation.params != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Expect")
+	}
+
+	if mmEventMembers.defaultExpectation.paramPtrs == nil {
+		mmEventMembers.defaultExpectation.paramPtrs = &EventsServiceMockEventMembersParamPtrs{}
+	}
+	mmEventMembers.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmEventMembers.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmEventMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mEventsServiceMockEventMembers {
+	if mmEventMembers.mock.inspectFuncEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.EventMembers")
+	}
+
+	mmEventMembers.mock.inspectFuncEventMembers = f
+
+	return mmEventMembers
+}
+
+// Return sets up results that will be returned by EventsService.EventMembers
+func (mmEventMembers *mEventsServiceMockEventMembers) Return(epa1 []*models.EventMember, err error) *EventsServiceMock {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	if mmEventMembers.defaultExpectation == nil {
+		mmEventMembers.defaultExpectation = &EventsServiceMockEventMembersExpectation{mock: mmEventMembers.mock}
+	}
+	mmEventMembers.defaultExpectation.results = &EventsServiceMockEventMembersResults{epa1, err}
+	mmEventMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEventMembers.mock
+}
+
+// Set uses given function f to mock the EventsService.EventMembers method
+func (mmEventMembers *mEventsServiceMockEventMembers) Set(f func(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventMember, err error)) *EventsServiceMock {
+	if mmEventMembers.defaultExpectation != nil {
+		mmEventMembers.mock.t.Fatalf("Default expectation is already set for the EventsService.EventMembers method")
+	}
+
+	if len(mmEventMembers.expectations) > 0 {
+		mmEventMembers.mock.t.Fatalf("Some expectations are already set for the EventsService.EventMembers method")
+	}
+
+	mmEventMembers.mock.funcEventMembers = f
+	mmEventMembers.mock.funcEventMembersOrigin = minimock.CallerInfo(1)
+	return mmEventMembers.mock
+}
+
+// When sets expectation for the EventsService.EventMembers which will trigger the result defined by the following
+// Then helper
+func (mmEventMembers *mEventsServiceMockEventMembers) When(ctx context.Context, userID int64, urlTitle string) *EventsServiceMockEventMembersExpectation {
+	if mmEventMembers.mock.funcEventMembers != nil {
+		mmEventMembers.mock.t.Fatalf("EventsServiceMock.EventMembers mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventMembersExpectation{
+		mock:               mmEventMembers.mock,
+		params:             &EventsServiceMockEventMembersParams{ctx, userID, urlTitle},
+		expectationOrigins: EventsServiceMockEventMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEventMembers.expectations = append(mmEventMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.EventMembers return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventMembersExpectation) Then(epa1 []*models.EventMember, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventMembersResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.EventMembers should be invoked
+func (mmEventMembers *mEventsServiceMockEventMembers) Times(n uint64) *mEventsServiceMockEventMembers {
+	if n == 0 {
+		mmEventMembers.mock.t.Fatalf("Times of EventsServiceMock.EventMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEventMembers.expectedInvocations, n)
+	mmEventMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEventMembers
+}
+
+func (mmEventMembers *mEventsServiceMockEventMembers) invocationsDone() bool {
+	if len(mmEventMembers.expectations) == 0 && mmEventMembers.defaultExpectation == nil && mmEventMembers.mock.funcEventMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEventMembers.mock.afterEventMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEventMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EventMembers implements mm_service.EventsService
+func (mmEventMembers *EventsServiceMock) EventMembers(ctx context.Context, userID int64, urlTitle string) (epa1 []*models.EventMember, err error) {
+	mm_atomic.AddUint64(&mmEventMembers.beforeEventMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmEventMembers.afterEventMembersCounter, 1)
+
+	mmEventMembers.t.Helper()
+
+	if mmEventMembers.inspectFuncEventMembers != nil {
+		mmEventMembers.inspectFuncEventMembers(ctx, userID, urlTitle)
+	}
+
+	mm_params := EventsServiceMockEventMembersParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmEventMembers.EventMembersMock.mutex.Lock()
+	mmEventMembers.EventMembersMock.callArgs = append(mmEventMembers.EventMembersMock.callArgs, &mm_params)
+	mmEventMembers.EventMembersMock.mutex.Unlock()
+
+	for _, e := range mmEventMembers.EventMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmEventMembers.EventMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEventMembers.EventMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmEventMembers.EventMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmEventMembers.EventMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventMembersParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEventMembers.t.Errorf("EventsServiceMock.EventMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventMembers.EventMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEventMembers.t.Errorf("EventsServiceMock.EventMembers got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventMembers.EventMembersMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmEventMembers.t.Errorf("EventsServiceMock.EventMembers got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventMembers.EventMembersMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEventMembers.t.Errorf("EventsServiceMock.EventMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEventMembers.EventMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEventMembers.EventMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEventMembers.t.Fatal("No results are set for the EventsServiceMock.EventMembers")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmEventMembers.funcEventMembers != nil {
+		return mmEventMembers.funcEventMembers(ctx, userID, urlTitle)
+	}
+	mmEventMembers.t.Fatalf("Unexpected call to EventsServiceMock.EventMembers. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// EventMembersAfterCounter returns a count of finished EventsServiceMock.EventMembers invocations
+func (mmEventMembers *EventsServiceMock) EventMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventMembers.afterEventMembersCounter)
+}
+
+// EventMembersBeforeCounter returns a count of EventsServiceMock.EventMembers invocations
+func (mmEventMembers *EventsServiceMock) EventMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventMembers.beforeEventMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.EventMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEventMembers *mEventsServiceMockEventMembers) Calls() []*EventsServiceMockEventMembersParams {
+	mmEventMembers.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventMembersParams, len(mmEventMembers.callArgs))
+	copy(argCopy, mmEventMembers.callArgs)
+
+	mmEventMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventMembersDone returns true if the count of the EventMembers invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventMembersDone() bool {
+	if m.EventMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventMembersMock.invocationsDone()
+}
+
+// MinimockEventMembersInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventMembersInspect() {
+	for _, e := range m.EventMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.EventMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventMembersCounter := mm_atomic.LoadUint64(&m.afterEventMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventMembersMock.defaultExpectation != nil && afterEventMembersCounter < 1 {
+		if m.EventMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.EventMembers at\n%s", m.EventMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.EventMembers at\n%s with params: %#v", m.EventMembersMock.defaultExpectation.expectationOrigins.origin, *m.EventMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEventMembers != nil && afterEventMembersCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.EventMembers at\n%s", m.funcEventMembersOrigin)
+	}
+
+	if !m.EventMembersMock.invocationsDone() && afterEventMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.EventMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventMembersMock.expectedInvocations), m.EventMembersMock.expectedInvocationsOrigin, afterEventMembersCounter)
+	}
+}
+
+type mEventsServiceMockEventRedirect struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventRedirectExpectation
+	expectations       []*EventsServiceMockEventRedirectExpectation
+
+	callArgs []*EventsServiceMockEventRedirectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventRedirectExpectation specifies expectation struct of the EventsService.EventRedirect
+type EventsServiceMockEventRedirectExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventRedirectParams
+	paramPtrs          *EventsServiceMockEventRedirectParamPtrs
+	expectationOrigins EventsServiceMockEventRedirectExpectationOrigins
+	results            *EventsServiceMockEventRedirectResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventRedirectParams contains parameters of the EventsService.EventRedirect
+type EventsServiceMockEventRedirectParams struct {
+	ctx      context.Context
+	urlTitle string
+}
+
+// EventsServiceMockEventRedirectParamPtrs contains pointers to parameters of the EventsService.EventRedirect
+type EventsServiceMockEventRedirectParamPtrs struct {
+	ctx      *context.Context
+	urlTitle *string
+}
+
+// EventsServiceMockEventRedirectResults contains results of the EventsService.EventRedirect
+type EventsServiceMockEventRedirectResults struct {
+	s1  string
+	err error
+}
+
+// EventsServiceMockEventRedirectOrigins contains origins of expectations of the EventsService.EventRedirect
+type EventsServiceMockEventRedirectExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Optional() *mEventsServiceMockEventRedirect {
+	mmEventRedirect.optional = true
+	return mmEventRedirect
+}
+
+// Expect sets up expected params for EventsService.EventRedirect
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Expect(ctx context.Context, urlTitle string) *mEventsServiceMockEventRedirect {
+	if mmEventRedirect.mock.funcEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Set")
+	}
+
+	if mmEventRedirect.defaultExpectation == nil {
+		mmEventRedirect.defaultExpectation = &EventsServiceMockEventRedirectExpectation{}
+	}
+
+	if mmEventRedirect.defaultExpectation.paramPtrs != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by ExpectParams functions")
+	}
+
+	mmEventRedirect.defaultExpectation.params = &EventsServiceMockEventRedirectParams{ctx, urlTitle}
+	mmEventRedirect.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEventRedirect.expectations {
+		if minimock.Equal(e.params, mmEventRedirect.defaultExpectation.params) {
+			mmEventRedirect.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEventRedirect.defaultExpectation.params)
+		}
+	}
+
+	return mmEventRedirect
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.EventRedirect
+func (mmEventRedirect *mEventsServiceMockEventRedirect) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEventRedirect {
+	if mmEventRedirect.mock.funcEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Set")
+	}
+
+	if mmEventRedirect.defaultExpectation == nil {
+		mmEventRedirect.defaultExpectation = &EventsServiceMockEventRedirectExpectation{}
+	}
+
+	if mmEventRedirect.defaultExpectation.params != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Expect")
+	}
+
+	if mmEventRedirect.defaultExpectation.paramPtrs == nil {
+		mmEventRedirect.defaultExpectation.paramPtrs = &EventsServiceMockEventRedirectParamPtrs{}
+	}
+	mmEventRedirect.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEventRedirect.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEventRedirect
+}
+
+// ExpectUrlTitleParam2 sets up expected param urlTitle for EventsService.EventRedirect
+func (mmEventRedirect *mEventsServiceMockEventRedirect) ExpectUrlTitleParam2(urlTitle string) *mEventsServiceMockEventRedirect {
+	if mmEventRedirect.mock.funcEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Set")
+	}
+
+	if mmEventRedirect.defaultExpectation == nil {
+		mmEventRedirect.defaultExpectation = &EventsServiceMockEventRedirectExpectation{}
+	}
+
+	if mmEventRedirect.defaultExpectation.params != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Expect")
+	}
+
+	if mmEventRedirect.defaultExpectation.paramPtrs == nil {
+		mmEventRedirect.defaultExpectation.paramPtrs = &EventsServiceMockEventRedirectParamPtrs{}
+	}
+	mmEventRedirect.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmEventRedirect.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmEventRedirect
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.EventRedirect
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Inspect(f func(ctx context.Context, urlTitle string)) *mEventsServiceMockEventRedirect {
+	if mmEventRedirect.mock.inspectFuncEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.EventRedirect")
+	}
+
+	mmEventRedirect.mock.inspectFuncEventRedirect = f
+
+	return mmEventRedirect
+}
+
+// Return sets up results that will be returned by EventsService.EventRedirect
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Return(s1 string, err error) *EventsServiceMock {
+	if mmEventRedirect.mock.funcEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Set")
+	}
+
+	if mmEventRedirect.defaultExpectation == nil {
+		mmEventRedirect.defaultExpectation = &EventsServiceMockEventRedirectExpectation{mock: mmEventRedirect.mock}
+	}
+	mmEventRedirect.defaultExpectation.results = &EventsServiceMockEventRedirectResults{s1, err}
+	mmEventRedirect.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEventRedirect.mock
+}
+
+// Set uses given function f to mock the EventsService.EventRedirect method
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Set(f func(ctx context.Context, urlTitle string) (s1 string, err error)) *EventsServiceMock {
+	if mmEventRedirect.defaultExpectation != nil {
+		mmEventRedirect.mock.t.Fatalf("Default expectation is already set for the EventsService.EventRedirect method")
+	}
+
+	if len(mmEventRedirect.expectations) > 0 {
+		mmEventRedirect.mock.t.Fatalf("Some expectations are already set for the EventsService.EventRedirect method")
+	}
+
+	mmEventRedirect.mock.funcEventRedirect = f
+	mmEventRedirect.mock.funcEventRedirectOrigin = minimock.CallerInfo(1)
+	return mmEventRedirect.mock
+}
+
+// When sets expectation for the EventsService.EventRedirect which will trigger the result defined by the following
+// Then helper
+func (mmEventRedirect *mEventsServiceMockEventRedirect) When(ctx context.Context, urlTitle string) *EventsServiceMockEventRedirectExpectation {
+	if mmEventRedirect.mock.funcEventRedirect != nil {
+		mmEventRedirect.mock.t.Fatalf("EventsServiceMock.EventRedirect mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventRedirectExpectation{
+		mock:               mmEventRedirect.mock,
+		params:             &EventsServiceMockEventRedirectParams{ctx, urlTitle},
+		expectationOrigins: EventsServiceMockEventRedirectExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEventRedirect.expectations = append(mmEventRedirect.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.EventRedirect return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventRedirectExpectation) Then(s1 string, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventRedirectResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.EventRedirect should be invoked
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Times(n uint64) *mEventsServiceMockEventRedirect {
+	if n == 0 {
+		mmEventRedirect.mock.t.Fatalf("Times of EventsServiceMock.EventRedirect mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEventRedirect.expectedInvocations, n)
+	mmEventRedirect.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEventRedirect
+}
+
+func (mmEventRedirect *mEventsServiceMockEventRedirect) invocationsDone() bool {
+	if len(mmEventRedirect.expectations) == 0 && mmEventRedirect.defaultExpectation == nil && mmEventRedirect.mock.funcEventRedirect == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEventRedirect.mock.afterEventRedirectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEventRedirect.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EventRedirect implements mm_service.EventsService
+func (mmEventRedirect *EventsServiceMock) EventRedirect(ctx context.Context, urlTitle string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmEventRedirect.beforeEventRedirectCounter, 1)
+	defer mm_atomic.AddUint64(&mmEventRedirect.afterEventRedirectCounter, 1)
+
+	mmEventRedirect.t.Helper()
+
+	if mmEventRedirect.inspectFuncEventRedirect != nil {
+		mmEventRedirect.inspectFuncEventRedirect(ctx, urlTitle)
+	}
+
+	mm_params := EventsServiceMockEventRedirectParams{ctx, urlTitle}
+
+	// Record call args
+	mmEventRedirect.EventRedirectMock.mutex.Lock()
+	mmEventRedirect.EventRedirectMock.callArgs = append(mmEventRedirect.EventRedirectMock.callArgs, &mm_params)
+	mmEventRedirect.EventRedirectMock.mutex.Unlock()
+
+	for _, e := range mmEventRedirect.EventRedirectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmEventRedirect.EventRedirectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEventRedirect.EventRedirectMock.defaultExpectation.Counter, 1)
+		mm_want := mmEventRedirect.EventRedirectMock.defaultExpectation.params
+		mm_want_ptrs := mmEventRedirect.EventRedirectMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventRedirectParams{ctx, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEventRedirect.t.Errorf("EventsServiceMock.EventRedirect got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventRedirect.EventRedirectMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmEventRedirect.t.Errorf("EventsServiceMock.EventRedirect got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventRedirect.EventRedirectMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEventRedirect.t.Errorf("EventsServiceMock.EventRedirect got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEventRedirect.EventRedirectMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEventRedirect.EventRedirectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEventRedirect.t.Fatal("No results are set for the EventsServiceMock.EventRedirect")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmEventRedirect.funcEventRedirect != nil {
+		return mmEventRedirect.funcEventRedirect(ctx, urlTitle)
+	}
+	mmEventRedirect.t.Fatalf("Unexpected call to EventsServiceMock.EventRedirect. %v %v", ctx, urlTitle)
+	return
+}
+
+// EventRedirectAfterCounter returns a count of finished EventsServiceMock.EventRedirect invocations
+func (mmEventRedirect *EventsServiceMock) EventRedirectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventRedirect.afterEventRedirectCounter)
+}
+
+// EventRedirectBeforeCounter returns a count of EventsServiceMock.EventRedirect invocations
+func (mmEventRedirect *EventsServiceMock) EventRedirectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventRedirect.beforeEventRedirectCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.EventRedirect.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEventRedirect *mEventsServiceMockEventRedirect) Calls() []*EventsServiceMockEventRedirectParams {
+	mmEventRedirect.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventRedirectParams, len(mmEventRedirect.callArgs))
+	copy(argCopy, mmEventRedirect.callArgs)
+
+	mmEventRedirect.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventRedirectDone returns true if the count of the EventRedirect invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventRedirectDone() bool {
+	if m.EventRedirectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventRedirectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventRedirectMock.invocationsDone()
+}
+
+// MinimockEventRedirectInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventRedirectInspect() {
+	for _, e := range m.EventRedirectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.EventRedirect at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventRedirectCounter := mm_atomic.LoadUint64(&m.afterEventRedirectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventRedirectMock.defaultExpectation != nil && afterEventRedirectCounter < 1 {
+		if m.EventRedirectMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.EventRedirect at\n%s", m.EventRedirectMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.EventRedirect at\n%s with params: %#v", m.EventRedirectMock.defaultExpectation.expectationOrigins.origin, *m.EventRedirectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEventRedirect != nil && afterEventRedirectCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.EventRedirect at\n%s", m.funcEventRedirectOrigin)
+	}
+
+	if !m.EventRedirectMock.invocationsDone() && afterEventRedirectCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.EventRedirect at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventRedirectMock.expectedInvocations), m.EventRedirectMock.expectedInvocationsOrigin, afterEventRedirectCounter)
+	}
+}
+
+type mEventsServiceMockEvents struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventsExpectation
+	expectations       []*EventsServiceMockEventsExpectation
+
+	callArgs []*EventsServiceMockEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventsExpectation specifies expectation struct of the EventsService.Events
+type EventsServiceMockEventsExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventsParams
+	paramPtrs          *EventsServiceMockEventsParamPtrs
+	expectationOrigins EventsServiceMockEventsExpectationOrigins
+	results            *EventsServiceMockEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventsParams contains parameters of the EventsService.Events
+type EventsServiceMockEventsParams struct {
+	ctx  context.Context
+	page int
+}
+
+// EventsServiceMockEventsParamPtrs contains pointers to parameters of the EventsService.Events
+type EventsServiceMockEventsParamPtrs struct {
+	ctx  *context.Context
+	page *int
+}
+
+// EventsServiceMockEventsResults contains results of the EventsService.Events
+type EventsServiceMockEventsResults struct {
+	epa1 []*models.Event
+	err  error
+}
+
+// EventsServiceMockEventsOrigins contains origins of expectations of the EventsService.Events
+type EventsServiceMockEventsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEvents *mEventsServiceMockEvents) Optional() *mEventsServiceMockEvents {
+	mmEvents.optional = true
+	return mmEvents
+}
+
+// Expect sets up expected params for EventsService.Events
+func (mmEvents *mEventsServiceMockEvents) Expect(ctx context.Context, page int) *mEventsServiceMockEvents {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	}
+
+	if mmEvents.defaultExpectation.paramPtrs != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by ExpectParams functions")
+	}
+
+	mmEvents.defaultExpectation.params = &EventsServiceMockEventsParams{ctx, page}
+	mmEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEvents.expectations {
+		if minimock.Equal(e.params, mmEvents.defaultExpectation.params) {
+			mmEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.Events
+func (mmEvents *mEventsServiceMockEvents) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEvents {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	}
+
+	if mmEvents.defaultExpectation.params != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Expect")
+	}
+
+	if mmEvents.defaultExpectation.paramPtrs == nil {
+		mmEvents.defaultExpectation.paramPtrs = &EventsServiceMockEventsParamPtrs{}
+	}
+	mmEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEvents
+}
+
+// ExpectPageParam2 sets up expected param page for EventsService.Events
+func (mmEvents *mEventsServiceMockEvents) ExpectPageParam2(page int) *mEventsServiceMockEvents {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	}
+
+	if mmEvents.defaultExpectation.params != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Expect")
+	}
+
+	if mmEvents.defaultExpectation.paramPtrs == nil {
+		mmEvents.defaultExpectation.paramPtrs = &EventsServiceMockEventsParamPtrs{}
+	}
+	mmEvents.defaultExpectation.paramPtrs.page = &page
+	mmEvents.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.Events
+func (mmEvents *mEventsServiceMockEvents) Inspect(f func(ctx context.Context, page int)) *mEventsServiceMockEvents {
+	if mmEvents.mock.inspectFuncEvents != nil {
+		mmEvents.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Events")
+	}
+
+	mmEvents.mock.inspectFuncEvents = f
+
+	return mmEvents
+}
+
+// Return sets up results that will be returned by EventsService.Events
+func (mmEvents *mEventsServiceMockEvents) Return(epa1 []*models.Event, err error) *EventsServiceMock {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{mock: mmEvents.mock}
+	}
+	mmEvents.defaultExpectation.results = &EventsServiceMockEventsResults{epa1, err}
+	mmEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEvents.mock
+}
+
+// Set uses given function f to mock the EventsService.Events method
+func (mmEvents *mEventsServiceMockEvents) Set(f func(ctx context.Context, page int) (epa1 []*models.Event, err error)) *EventsServiceMock {
+	if mmEvents.defaultExpectation != nil {
+		mmEvents.mock.t.Fatalf("Default expectation is already set for the EventsService.Events method")
+	}
+
+	if len(mmEvents.expectations) > 0 {
+		mmEvents.mock.t.Fatalf("Some expectations are already set for the EventsService.Events method")
+	}
+
+	mmEvents.mock.funcEvents = f
+	mmEvents.mock.funcEventsOrigin = minimock.CallerInfo(1)
+	return mmEvents.mock
+}
+
+// When sets expectation for the EventsService.Events which will trigger the result defined by the following
+// Then helper
+func (mmEvents *mEventsServiceMockEvents) When(ctx context.Context, page int) *EventsServiceMockEventsExpectation {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventsExpectation{
+		mock:               mmEvents.mock,
+		params:             &EventsServiceMockEventsParams{ctx, page},
+		expectationOrigins: EventsServiceMockEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEvents.expectations = append(mmEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.Events return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventsExpectation) Then(epa1 []*models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventsResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.Events should be invoked
+func (mmEvents *mEventsServiceMockEvents) Times(n uint64) *mEventsServiceMockEvents {
+	if n == 0 {
+		mmEvents.mock.t.Fatalf("Times of EventsServiceMock.Events mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEvents.expectedInvocations, n)
+	mmEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEvents
+}
+
+func (mmEvents *mEventsServiceMockEvents) invocationsDone() bool {
+	if len(mmEvents.expectations) == 0 && mmEvents.defaultExpectation == nil && mmEvents.mock.funcEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEvents.mock.afterEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Events implements mm_service.EventsService
+func (mmEvents *EventsServiceMock) Events(ctx context.Context, page int) (epa1 []*models.Event, err error) {
+	mm_atomic.AddUint64(&mmEvents.beforeEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmEvents.afterEventsCounter, 1)
+
+	mmEvents.t.Helper()
+
+	if mmEvents.inspectFuncEvents != nil {
+		mmEvents.inspectFuncEvents(ctx, page)
+	}
+
+	mm_params := EventsServiceMockEventsParams{ctx, page}
+
+	// Record call args
+	mmEvents.EventsMock.mutex.Lock()
+	mmEvents.EventsMock.callArgs = append(mmEvents.EventsMock.callArgs, &mm_params)
+	mmEvents.EventsMock.mutex.Unlock()
+
+	for _, e := range mmEvents.EventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmEvents.EventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEvents.EventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmEvents.EventsMock.defaultExpectation.params
+		mm_want_ptrs := mmEvents.EventsMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventsParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvents.EventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvents.EventsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEvents.EventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEvents.EventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEvents.t.Fatal("No results are set for the EventsServiceMock.Events")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmEvents.funcEvents != nil {
+		return mmEvents.funcEvents(ctx, page)
+	}
+	mmEvents.t.Fatalf("Unexpected call to EventsServiceMock.Events. %v %v", ctx, page)
+	return
+}
+
+// EventsAfterCounter returns a count of finished EventsServiceMock.Events invocations
+func (mmEvents *EventsServiceMock) EventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvents.afterEventsCounter)
+}
+
+// EventsBeforeCounter returns a count of EventsServiceMock.Events invocations
+func (mmEvents *EventsServiceMock) EventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvents.beforeEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.Events.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEvents *mEventsServiceMockEvents) Calls() []*EventsServiceMockEventsParams {
+	mmEvents.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventsParams, len(mmEvents.callArgs))
+	copy(argCopy, mmEvents.callArgs)
+
+	mmEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventsDone returns true if the count of the Events invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventsDone() bool {
+	if m.EventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventsMock.invocationsDone()
+}
+
+// MinimockEventsInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventsInspect() {
+	for _, e := range m.EventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventsCounter := mm_atomic.LoadUint64(&m.afterEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventsMock.defaultExpectation != nil && afterEventsCounter < 1 {
+		if m.EventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s", m.EventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s with params: %#v", m.EventsMock.defaultExpectation.expectationOrigins.origin, *m.EventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEvents != nil && afterEventsCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s", m.funcEventsOrigin)
+	}
+
+	if !m.EventsMock.invocationsDone() && afterEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.Events at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventsMock.expectedInvocations), m.EventsMock.expectedInvocationsOrigin, afterEventsCounter)
+	}
+}
+
+type mEventsServiceMockEventsNear struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockEventsNearExpectation
+	expectations       []*EventsServiceMockEventsNearExpectation
+
+	callArgs []*EventsServiceMockEventsNearParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockEventsNearExpectation specifies expectation struct of the EventsService.EventsNear
+type EventsServiceMockEventsNearExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockEventsNearParams
+	paramPtrs          *EventsServiceMockEventsNearParamPtrs
+	expectationOrigins EventsServiceMockEventsNearExpectationOrigins
+	results            *EventsServiceMockEventsNearResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockEventsNearParams contains parameters of the EventsService.EventsNear
+type EventsServiceMockEventsNearParams struct {
+	ctx      context.Context
+	lat      float64
+	lng      float64
+	radiusKm float64
+	page     int
+}
+
+// EventsServiceMockEventsNearParamPtrs contains pointers to parameters of the EventsService.EventsNear
+type EventsServiceMockEventsNearParamPtrs struct {
+	ctx      *context.Context
+	lat      *float64
+	lng      *float64
+	radiusKm *float64
+	page     *int
+}
+
+// EventsServiceMockEventsNearResults contains results of the EventsService.EventsNear
+type EventsServiceMockEventsNearResults struct {
+	epa1 []*models.Event
+	err  error
+}
+
+// EventsServiceMockEventsNearOrigins contains origins of expectations of the EventsService.EventsNear
+type EventsServiceMockEventsNearExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originLat      string
+	originLng      string
+	originRadiusKm string
+	originPage     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEventsNear *mEventsServiceMockEventsNear) Optional() *mEventsServiceMockEventsNear {
+	mmEventsNear.optional = true
+	return mmEventsNear
+}
+
+// Expect sets up expected params for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) Expect(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by ExpectParams functions")
+	}
+
+	mmEventsNear.defaultExpectation.params = &EventsServiceMockEventsNearParams{ctx, lat, lng, radiusKm, page}
+	mmEventsNear.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEventsNear.expectations {
+		if minimock.Equal(e.params, mmEventsNear.defaultExpectation.params) {
+			mmEventsNear.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEventsNear.defaultExpectation.params)
+		}
+	}
+
+	return mmEventsNear
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.params != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Expect")
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs == nil {
+		mmEventsNear.defaultExpectation.paramPtrs = &EventsServiceMockEventsNearParamPtrs{}
+	}
+	mmEventsNear.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEventsNear.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEventsNear
+}
+
+// ExpectLatParam2 sets up expected param lat for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) ExpectLatParam2(lat float64) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.params != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Expect")
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs == nil {
+		mmEventsNear.defaultExpectation.paramPtrs = &EventsServiceMockEventsNearParamPtrs{}
+	}
+	mmEventsNear.defaultExpectation.paramPtrs.lat = &lat
+	mmEventsNear.defaultExpectation.expectationOrigins.originLat = minimock.CallerInfo(1)
+
+	return mmEventsNear
+}
+
+// ExpectLngParam3 sets up expected param lng for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) ExpectLngParam3(lng float64) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.params != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Expect")
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs == nil {
+		mmEventsNear.defaultExpectation.paramPtrs = &EventsServiceMockEventsNearParamPtrs{}
+	}
+	mmEventsNear.defaultExpectation.paramPtrs.lng = &lng
+	mmEventsNear.defaultExpectation.expectationOrigins.originLng = minimock.CallerInfo(1)
+
+	return mmEventsNear
+}
+
+// ExpectRadiusKmParam4 sets up expected param radiusKm for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) ExpectRadiusKmParam4(radiusKm float64) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.params != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Expect")
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs == nil {
+		mmEventsNear.defaultExpectation.paramPtrs = &EventsServiceMockEventsNearParamPtrs{}
+	}
+	mmEventsNear.defaultExpectation.paramPtrs.radiusKm = &radiusKm
+	mmEventsNear.defaultExpectation.expectationOrigins.originRadiusKm = minimock.CallerInfo(1)
+
+	return mmEventsNear
+}
+
+// ExpectPageParam5 sets up expected param page for EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) ExpectPageParam5(page int) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{}
+	}
+
+	if mmEventsNear.defaultExpectation.params != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Expect")
+	}
+
+	if mmEventsNear.defaultExpectation.paramPtrs == nil {
+		mmEventsNear.defaultExpectation.paramPtrs = &EventsServiceMockEventsNearParamPtrs{}
+	}
+	mmEventsNear.defaultExpectation.paramPtrs.page = &page
+	mmEventsNear.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmEventsNear
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) Inspect(f func(ctx context.Context, lat float64, lng float64, radiusKm float64, page int)) *mEventsServiceMockEventsNear {
+	if mmEventsNear.mock.inspectFuncEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.EventsNear")
+	}
+
+	mmEventsNear.mock.inspectFuncEventsNear = f
+
+	return mmEventsNear
+}
+
+// Return sets up results that will be returned by EventsService.EventsNear
+func (mmEventsNear *mEventsServiceMockEventsNear) Return(epa1 []*models.Event, err error) *EventsServiceMock {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	if mmEventsNear.defaultExpectation == nil {
+		mmEventsNear.defaultExpectation = &EventsServiceMockEventsNearExpectation{mock: mmEventsNear.mock}
+	}
+	mmEventsNear.defaultExpectation.results = &EventsServiceMockEventsNearResults{epa1, err}
+	mmEventsNear.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEventsNear.mock
+}
+
+// Set uses given function f to mock the EventsService.EventsNear method
+func (mmEventsNear *mEventsServiceMockEventsNear) Set(f func(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) (epa1 []*models.Event, err error)) *EventsServiceMock {
+	if mmEventsNear.defaultExpectation != nil {
+		mmEventsNear.mock.t.Fatalf("Default expectation is already set for the EventsService.EventsNear method")
+	}
+
+	if len(mmEventsNear.expectations) > 0 {
+		mmEventsNear.mock.t.Fatalf("Some expectations are already set for the EventsService.EventsNear method")
+	}
+
+	mmEventsNear.mock.funcEventsNear = f
+	mmEventsNear.mock.funcEventsNearOrigin = minimock.CallerInfo(1)
+	return mmEventsNear.mock
+}
+
+// When sets expectation for the EventsService.EventsNear which will trigger the result defined by the following
+// Then helper
+func (mmEventsNear *mEventsServiceMockEventsNear) When(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) *EventsServiceMockEventsNearExpectation {
+	if mmEventsNear.mock.funcEventsNear != nil {
+		mmEventsNear.mock.t.Fatalf("EventsServiceMock.EventsNear mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockEventsNearExpectation{
+		mock:               mmEventsNear.mock,
+		params:             &EventsServiceMockEventsNearParams{ctx, lat, lng, radiusKm, page},
+		expectationOrigins: EventsServiceMockEventsNearExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEventsNear.expectations = append(mmEventsNear.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.EventsNear return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockEventsNearExpectation) Then(epa1 []*models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockEventsNearResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.EventsNear should be invoked
+func (mmEventsNear *mEventsServiceMockEventsNear) Times(n uint64) *mEventsServiceMockEventsNear {
+	if n == 0 {
+		mmEventsNear.mock.t.Fatalf("Times of EventsServiceMock.EventsNear mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEventsNear.expectedInvocations, n)
+	mmEventsNear.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEventsNear
+}
+
+func (mmEventsNear *mEventsServiceMockEventsNear) invocationsDone() bool {
+	if len(mmEventsNear.expectations) == 0 && mmEventsNear.defaultExpectation == nil && mmEventsNear.mock.funcEventsNear == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEventsNear.mock.afterEventsNearCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEventsNear.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EventsNear implements mm_service.EventsService
+func (mmEventsNear *EventsServiceMock) EventsNear(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) (epa1 []*models.Event, err error) {
+	mm_atomic.AddUint64(&mmEventsNear.beforeEventsNearCounter, 1)
+	defer mm_atomic.AddUint64(&mmEventsNear.afterEventsNearCounter, 1)
+
+	mmEventsNear.t.Helper()
+
+	if mmEventsNear.inspectFuncEventsNear != nil {
+		mmEventsNear.inspectFuncEventsNear(ctx, lat, lng, radiusKm, page)
+	}
+
+	mm_params := EventsServiceMockEventsNearParams{ctx, lat, lng, radiusKm, page}
+
+	// Record call args
+	mmEventsNear.EventsNearMock.mutex.Lock()
+	mmEventsNear.EventsNearMock.callArgs = append(mmEventsNear.EventsNearMock.callArgs, &mm_params)
+	mmEventsNear.EventsNearMock.mutex.Unlock()
+
+	for _, e := range mmEventsNear.EventsNearMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmEventsNear.EventsNearMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEventsNear.EventsNearMock.defaultExpectation.Counter, 1)
+		mm_want := mmEventsNear.EventsNearMock.defaultExpectation.params
+		mm_want_ptrs := mmEventsNear.EventsNearMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockEventsNearParams{ctx, lat, lng, radiusKm, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.lat != nil && !minimock.Equal(*mm_want_ptrs.lat, mm_got.lat) {
+				mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameter lat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.originLat, *mm_want_ptrs.lat, mm_got.lat, minimock.Diff(*mm_want_ptrs.lat, mm_got.lat))
+			}
+
+			if mm_want_ptrs.lng != nil && !minimock.Equal(*mm_want_ptrs.lng, mm_got.lng) {
+				mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameter lng, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.originLng, *mm_want_ptrs.lng, mm_got.lng, minimock.Diff(*mm_want_ptrs.lng, mm_got.lng))
+			}
+
+			if mm_want_ptrs.radiusKm != nil && !minimock.Equal(*mm_want_ptrs.radiusKm, mm_got.radiusKm) {
+				mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameter radiusKm, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.originRadiusKm, *mm_want_ptrs.radiusKm, mm_got.radiusKm, minimock.Diff(*mm_want_ptrs.radiusKm, mm_got.radiusKm))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEventsNear.t.Errorf("EventsServiceMock.EventsNear got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEventsNear.EventsNearMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEventsNear.EventsNearMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEventsNear.t.Fatal("No results are set for the EventsServiceMock.EventsNear")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmEventsNear.funcEventsNear != nil {
+		return mmEventsNear.funcEventsNear(ctx, lat, lng, radiusKm, page)
+	}
+	mmEventsNear.t.Fatalf("Unexpected call to EventsServiceMock.EventsNear. %v %v %v %v %v", ctx, lat, lng, radiusKm, page)
+	return
+}
+
+// EventsNearAfterCounter returns a count of finished EventsServiceMock.EventsNear invocations
+func (mmEventsNear *EventsServiceMock) EventsNearAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventsNear.afterEventsNearCounter)
+}
+
+// EventsNearBeforeCounter returns a count of EventsServiceMock.EventsNear invocations
+func (mmEventsNear *EventsServiceMock) EventsNearBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventsNear.beforeEventsNearCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.EventsNear.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEventsNear *mEventsServiceMockEventsNear) Calls() []*EventsServiceMockEventsNearParams {
+	mmEventsNear.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockEventsNearParams, len(mmEventsNear.callArgs))
+	copy(argCopy, mmEventsNear.callArgs)
+
+	mmEventsNear.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventsNearDone returns true if the count of the EventsNear invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockEventsNearDone() bool {
+	if m.EventsNearMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventsNearMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventsNearMock.invocationsDone()
+}
+
+// MinimockEventsNearInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockEventsNearInspect() {
+	for _, e := range m.EventsNearMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.EventsNear at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventsNearCounter := mm_atomic.LoadUint64(&m.afterEventsNearCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventsNearMock.defaultExpectation != nil && afterEventsNearCounter < 1 {
+		if m.EventsNearMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.EventsNear at\n%s", m.EventsNearMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.EventsNear at\n%s with params: %#v", m.EventsNearMock.defaultExpectation.expectationOrigins.origin, *m.EventsNearMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEventsNear != nil && afterEventsNearCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.EventsNear at\n%s", m.funcEventsNearOrigin)
+	}
+
+	if !m.EventsNearMock.invocationsDone() && afterEventsNearCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.EventsNear at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventsNearMock.expectedInvocations), m.EventsNearMock.expectedInvocationsOrigin, afterEventsNearCounter)
+	}
+}
+
+type mEventsServiceMockFeed struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockFeedExpectation
+	expectations       []*EventsServiceMockFeedExpectation
+
+	callArgs []*EventsServiceMockFeedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockFeedExpectation specifies expectation struct of the EventsService.Feed
+type EventsServiceMockFeedExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockFeedParams
+	paramPtrs          *EventsServiceMockFeedParamPtrs
+	expectationOrigins EventsServiceMockFeedExpectationOrigins
+	results            *EventsServiceMockFeedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockFeedParams contains parameters of the EventsService.Feed
+type EventsServiceMockFeedParams struct {
+	ctx    context.Context
+	userID int64
+	page   int
+}
+
+// EventsServiceMockFeedParamPtrs contains pointers to parameters of the EventsService.Feed
+type EventsServiceMockFeedParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	page   *int
+}
+
+// EventsServiceMockFeedResults contains results of the EventsService.Feed
+type EventsServiceMockFeedResults struct {
+	epa1 []*models.Event
+	err  error
+}
+
+// EventsServiceMockFeedOrigins contains origins of expectations of the EventsService.Feed
+type EventsServiceMockFeedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originPage   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFeed *mEventsServiceMockFeed) Optional() *mEventsServiceMockFeed {
+	mmFeed.optional = true
+	return mmFeed
+}
+
+// Expect sets up expected params for EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) Expect(ctx context.Context, userID int64, page int) *mEventsServiceMockFeed {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	if mmFeed.defaultExpectation == nil {
+		mmFeed.defaultExpectation = &EventsServiceMockFeedExpectation{}
+	}
+
+	if mmFeed.defaultExpectation.paramPtrs != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by ExpectParams functions")
+	}
+
+	mmFeed.defaultExpectation.params = &EventsServiceMockFeedParams{ctx, userID, page}
+	mmFeed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFeed.expectations {
+		if minimock.Equal(e.params, mmFeed.defaultExpectation.params) {
+			mmFeed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFeed.defaultExpectation.params)
+		}
+	}
+
+	return mmFeed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockFeed {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	if mmFeed.defaultExpectation == nil {
+		mmFeed.defaultExpectation = &EventsServiceMockFeedExpectation{}
+	}
+
+	if mmFeed.defaultExpectation.params != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Expect")
+	}
+
+	if mmFeed.defaultExpectation.paramPtrs == nil {
+		mmFeed.defaultExpectation.paramPtrs = &EventsServiceMockFeedParamPtrs{}
+	}
+	mmFeed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFeed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFeed
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) ExpectUserIDParam2(userID int64) *mEventsServiceMockFeed {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	if mmFeed.defaultExpectation == nil {
+		mmFeed.defaultExpectation = &EventsServiceMockFeedExpectation{}
+	}
+
+	if mmFeed.defaultExpectation.params != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Expect")
+	}
+
+	if mmFeed.defaultExpectation.paramPtrs == nil {
+		mmFeed.defaultExpectation.paramPtrs = &EventsServiceMockFeedParamPtrs{}
+	}
+	mmFeed.defaultExpectation.paramPtrs.userID = &userID
+	mmFeed.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFeed
+}
+
+// ExpectPageParam3 sets up expected param page for EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) ExpectPageParam3(page int) *mEventsServiceMockFeed {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	if mmFeed.defaultExpectation == nil {
+		mmFeed.defaultExpectation = &EventsServiceMockFeedExpectation{}
+	}
+
+	if mmFeed.defaultExpectation.params != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Expect")
+	}
+
+	if mmFeed.defaultExpectation.paramPtrs == nil {
+		mmFeed.defaultExpectation.paramPtrs = &EventsServiceMockFeedParamPtrs{}
+	}
+	mmFeed.defaultExpectation.paramPtrs.page = &page
+	mmFeed.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmFeed
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) Inspect(f func(ctx context.Context, userID int64, page int)) *mEventsServiceMockFeed {
+	if mmFeed.mock.inspectFuncFeed != nil {
+		mmFeed.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Feed")
+	}
+
+	mmFeed.mock.inspectFuncFeed = f
+
+	return mmFeed
+}
+
+// Return sets up results that will be returned by EventsService.Feed
+func (mmFeed *mEventsServiceMockFeed) Return(epa1 []*models.Event, err error) *EventsServiceMock {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	if mmFeed.defaultExpectation == nil {
+		mmFeed.defaultExpectation = &EventsServiceMockFeedExpectation{mock: mmFeed.mock}
+	}
+	mmFeed.defaultExpectation.results = &EventsServiceMockFeedResults{epa1, err}
+	mmFeed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFeed.mock
+}
+
+// Set uses given function f to mock the EventsService.Feed method
+func (mmFeed *mEventsServiceMockFeed) Set(f func(ctx context.Context, userID int64, page int) (epa1 []*models.Event, err error)) *EventsServiceMock {
+	if mmFeed.defaultExpectation != nil {
+		mmFeed.mock.t.Fatalf("Default expectation is already set for the EventsService.Feed method")
+	}
+
+	if len(mmFeed.expectations) > 0 {
+		mmFeed.mock.t.Fatalf("Some expectations are already set for the EventsService.Feed method")
+	}
+
+	mmFeed.mock.funcFeed = f
+	mmFeed.mock.funcFeedOrigin = minimock.CallerInfo(1)
+	return mmFeed.mock
+}
+
+// When sets expectation for the EventsService.Feed which will trigger the result defined by the following
+// Then helper
+func (mmFeed *mEventsServiceMockFeed) When(ctx context.Context, userID int64, page int) *EventsServiceMockFeedExpectation {
+	if mmFeed.mock.funcFeed != nil {
+		mmFeed.mock.t.Fatalf("EventsServiceMock.Feed mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockFeedExpectation{
+		mock:               mmFeed.mock,
+		params:             &EventsServiceMockFeedParams{ctx, userID, page},
+		expectationOrigins: EventsServiceMockFeedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFeed.expectations = append(mmFeed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.Feed return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockFeedExpectation) Then(epa1 []*models.Event, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockFeedResults{epa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.Feed should be invoked
+func (mmFeed *mEventsServiceMockFeed) Times(n uint64) *mEventsServiceMockFeed {
+	if n == 0 {
+		mmFeed.mock.t.Fatalf("Times of EventsServiceMock.Feed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFeed.expectedInvocations, n)
+	mmFeed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFeed
+}
+
+func (mmFeed *mEventsServiceMockFeed) invocationsDone() bool {
+	if len(mmFeed.expectations) == 0 && mmFeed.defaultExpectation == nil && mmFeed.mock.funcFeed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFeed.mock.afterFeedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFeed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Feed implements mm_service.EventsService
+func (mmFeed *EventsServiceMock) Feed(ctx context.Context, userID int64, page int) (epa1 []*models.Event, err error) {
+	mm_atomic.AddUint64(&mmFeed.beforeFeedCounter, 1)
+	defer mm_atomic.AddUint64(&mmFeed.afterFeedCounter, 1)
+
+	mmFeed.t.Helper()
+
+	if mmFeed.inspectFuncFeed != nil {
+		mmFeed.inspectFuncFeed(ctx, userID, page)
+	}
+
+	mm_params := EventsServiceMockFeedParams{ctx, userID, page}
+
+	// Record call args
+	mmFeed.FeedMock.mutex.Lock()
+	mmFeed.FeedMock.callArgs = append(mmFeed.FeedMock.callArgs, &mm_params)
+	mmFeed.FeedMock.mutex.Unlock()
+
+	for _, e := range mmFeed.FeedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.epa1, e.results.err
+		}
+	}
+
+	if mmFeed.FeedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFeed.FeedMock.defaultExpectation.Counter, 1)
+		mm_want := mmFeed.FeedMock.defaultExpectation.params
+		mm_want_ptrs := mmFeed.FeedMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockFeedParams{ctx, userID, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFeed.t.Errorf("EventsServiceMock.Feed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFeed.FeedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFeed.t.Errorf("EventsServiceMock.Feed got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFeed.FeedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmFeed.t.Errorf("EventsServiceMock.Feed got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFeed.FeedMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFeed.t.Errorf("EventsServiceMock.Feed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFeed.FeedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFeed.FeedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFeed.t.Fatal("No results are set for the EventsServiceMock.Feed")
+		}
+		return (*mm_results).epa1, (*mm_results).err
+	}
+	if mmFeed.funcFeed != nil {
+		return mmFeed.funcFeed(ctx, userID, page)
+	}
+	mmFeed.t.Fatalf("Unexpected call to EventsServiceMock.Feed. %v %v %v", ctx, userID, page)
+	return
+}
+
+// FeedAfterCounter returns a count of finished EventsServiceMock.Feed invocations
+func (mmFeed *EventsServiceMock) FeedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFeed.afterFeedCounter)
+}
+
+// FeedBeforeCounter returns a count of EventsServiceMock.Feed invocations
+func (mmFeed *EventsServiceMock) FeedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFeed.beforeFeedCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.Feed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFeed *mEventsServiceMockFeed) Calls() []*EventsServiceMockFeedParams {
+	mmFeed.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockFeedParams, len(mmFeed.callArgs))
+	copy(argCopy, mmFeed.callArgs)
+
+	mmFeed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFeedDone returns true if the count of the Feed invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockFeedDone() bool {
+	if m.FeedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FeedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FeedMock.invocationsDone()
+}
+
+// MinimockFeedInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockFeedInspect() {
+	for _, e := range m.FeedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.Feed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFeedCounter := mm_atomic.LoadUint64(&m.afterFeedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FeedMock.defaultExpectation != nil && afterFeedCounter < 1 {
+		if m.FeedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.Feed at\n%s", m.FeedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.Feed at\n%s with params: %#v", m.FeedMock.defaultExpectation.expectationOrigins.origin, *m.FeedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFeed != nil && afterFeedCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.Feed at\n%s", m.funcFeedOrigin)
+	}
+
+	if !m.FeedMock.invocationsDone() && afterFeedCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.Feed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FeedMock.expectedInvocations), m.FeedMock.expectedInvocationsOrigin, afterFeedCounter)
+	}
+}
+
+type mEventsServiceMockFollowOrganizer struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockFollowOrganizerExpectation
+	expectations       []*EventsServiceMockFollowOrganizerExpectation
+
+	callArgs []*EventsServiceMockFollowOrganizerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockFollowOrganizerExpectation specifies expectation struct of the EventsService.FollowOrganizer
+type EventsServiceMockFollowOrganizerExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockFollowOrganizerParams
+	paramPtrs          *EventsServiceMockFollowOrganizerParamPtrs
+	expectationOrigins EventsServiceMockFollowOrganizerExpectationOrigins
+	results            *EventsServiceMockFollowOrganizerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockFollowOrganizerParams contains parameters of the EventsService.FollowOrganizer
+type EventsServiceMockFollowOrganizerParams struct {
+	ctx         context.Context
+	userID      int64
+	organizerID int64
+}
+
+// EventsServiceMockFollowOrganizerParamPtrs contains pointers to parameters of the EventsService.FollowOrganizer
+type EventsServiceMockFollowOrganizerParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	organizerID *int64
+}
+
+// EventsServiceMockFollowOrganizerResults contains results of the EventsService.FollowOrganizer
+type EventsServiceMockFollowOrganizerResults struct {
+	err error
+}
+
+// EventsServiceMockFollowOrganizerOrigins contains origins of expectations of the EventsService.FollowOrganizer
+type EventsServiceMockFollowOrganizerExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originOrganizerID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Optional() *mEventsServiceMockFollowOrganizer {
+	mmFollowOrganizer.optional = true
+	return mmFollowOrganizer
+}
+
+// Expect sets up expected params for EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Expect(ctx context.Context, userID int64, organizerID int64) *mEventsServiceMockFollowOrganizer {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	if mmFollowOrganizer.defaultExpectation == nil {
+		mmFollowOrganizer.defaultExpectation = &EventsServiceMockFollowOrganizerExpectation{}
+	}
+
+	if mmFollowOrganizer.defaultExpectation.paramPtrs != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by ExpectParams functions")
+	}
+
+	mmFollowOrganizer.defaultExpectation.params = &EventsServiceMockFollowOrganizerParams{ctx, userID, organizerID}
+	mmFollowOrganizer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFollowOrganizer.expectations {
+		if minimock.Equal(e.params, mmFollowOrganizer.defaultExpectation.params) {
+			mmFollowOrganizer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFollowOrganizer.defaultExpectation.params)
+		}
+	}
+
+	return mmFollowOrganizer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockFollowOrganizer {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	if mmFollowOrganizer.defaultExpectation == nil {
+		mmFollowOrganizer.defaultExpectation = &EventsServiceMockFollowOrganizerExpectation{}
+	}
+
+	if mmFollowOrganizer.defaultExpectation.params != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Expect")
+	}
+
+	if mmFollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmFollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockFollowOrganizerParamPtrs{}
+	}
+	mmFollowOrganizer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFollowOrganizer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFollowOrganizer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) ExpectUserIDParam2(userID int64) *mEventsServiceMockFollowOrganizer {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	if mmFollowOrganizer.defaultExpectation == nil {
+		mmFollowOrganizer.defaultExpectation = &EventsServiceMockFollowOrganizerExpectation{}
+	}
+
+	if mmFollowOrganizer.defaultExpectation.params != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Expect")
+	}
+
+	if mmFollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmFollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockFollowOrganizerParamPtrs{}
+	}
+	mmFollowOrganizer.defaultExpectation.paramPtrs.userID = &userID
+	mmFollowOrganizer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFollowOrganizer
+}
+
+// ExpectOrganizerIDParam3 sets up expected param organizerID for EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) ExpectOrganizerIDParam3(organizerID int64) *mEventsServiceMockFollowOrganizer {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	if mmFollowOrganizer.defaultExpectation == nil {
+		mmFollowOrganizer.defaultExpectation = &EventsServiceMockFollowOrganizerExpectation{}
+	}
+
+	if mmFollowOrganizer.defaultExpectation.params != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Expect")
+	}
+
+	if mmFollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmFollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockFollowOrganizerParamPtrs{}
+	}
+	mmFollowOrganizer.defaultExpectation.paramPtrs.organizerID = &organizerID
+	mmFollowOrganizer.defaultExpectation.expectationOrigins.originOrganizerID = minimock.CallerInfo(1)
+
+	return mmFollowOrganizer
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Inspect(f func(ctx context.Context, userID int64, organizerID int64)) *mEventsServiceMockFollowOrganizer {
+	if mmFollowOrganizer.mock.inspectFuncFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.FollowOrganizer")
+	}
+
+	mmFollowOrganizer.mock.inspectFuncFollowOrganizer = f
+
+	return mmFollowOrganizer
+}
+
+// Return sets up results that will be returned by EventsService.FollowOrganizer
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Return(err error) *EventsServiceMock {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	if mmFollowOrganizer.defaultExpectation == nil {
+		mmFollowOrganizer.defaultExpectation = &EventsServiceMockFollowOrganizerExpectation{mock: mmFollowOrganizer.mock}
+	}
+	mmFollowOrganizer.defaultExpectation.results = &EventsServiceMockFollowOrganizerResults{err}
+	mmFollowOrganizer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFollowOrganizer.mock
+}
+
+// Set uses given function f to mock the EventsService.FollowOrganizer method
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Set(f func(ctx context.Context, userID int64, organizerID int64) (err error)) *EventsServiceMock {
+	if mmFollowOrganizer.defaultExpectation != nil {
+		mmFollowOrganizer.mock.t.Fatalf("Default expectation is already set for the EventsService.FollowOrganizer method")
+	}
+
+	if len(mmFollowOrganizer.expectations) > 0 {
+		mmFollowOrganizer.mock.t.Fatalf("Some expectations are already set for the EventsService.FollowOrganizer method")
+	}
+
+	mmFollowOrganizer.mock.funcFollowOrganizer = f
+	mmFollowOrganizer.mock.funcFollowOrganizerOrigin = minimock.CallerInfo(1)
+	return mmFollowOrganizer.mock
+}
+
+// When sets expectation for the EventsService.FollowOrganizer which will trigger the result defined by the following
+// Then helper
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) When(ctx context.Context, userID int64, organizerID int64) *EventsServiceMockFollowOrganizerExpectation {
+	if mmFollowOrganizer.mock.funcFollowOrganizer != nil {
+		mmFollowOrganizer.mock.t.Fatalf("EventsServiceMock.FollowOrganizer mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockFollowOrganizerExpectation{
+		mock:               mmFollowOrganizer.mock,
+		params:             &EventsServiceMockFollowOrganizerParams{ctx, userID, organizerID},
+		expectationOrigins: EventsServiceMockFollowOrganizerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFollowOrganizer.expectations = append(mmFollowOrganizer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.FollowOrganizer return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockFollowOrganizerExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockFollowOrganizerResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.FollowOrganizer should be invoked
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Times(n uint64) *mEventsServiceMockFollowOrganizer {
+	if n == 0 {
+		mmFollowOrganizer.mock.t.Fatalf("Times of EventsServiceMock.FollowOrganizer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFollowOrganizer.expectedInvocations, n)
+	mmFollowOrganizer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFollowOrganizer
+}
+
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) invocationsDone() bool {
+	if len(mmFollowOrganizer.expectations) == 0 && mmFollowOrganizer.defaultExpectation == nil && mmFollowOrganizer.mock.funcFollowOrganizer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFollowOrganizer.mock.afterFollowOrganizerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFollowOrganizer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FollowOrganizer implements mm_service.EventsService
+func (mmFollowOrganizer *EventsServiceMock) FollowOrganizer(ctx context.Context, userID int64, organizerID int64) (err error) {
+	mm_atomic.AddUint64(&mmFollowOrganizer.beforeFollowOrganizerCounter, 1)
+	defer mm_atomic.AddUint64(&mmFollowOrganizer.afterFollowOrganizerCounter, 1)
+
+	mmFollowOrganizer.t.Helper()
+
+	if mmFollowOrganizer.inspectFuncFollowOrganizer != nil {
+		mmFollowOrganizer.inspectFuncFollowOrganizer(ctx, userID, organizerID)
+	}
+
+	mm_params := EventsServiceMockFollowOrganizerParams{ctx, userID, organizerID}
+
+	// Record call args
+	mmFollowOrganizer.FollowOrganizerMock.mutex.Lock()
+	mmFollowOrganizer.FollowOrganizerMock.callArgs = append(mmFollowOrganizer.FollowOrganizerMock.callArgs, &mm_params)
+	mmFollowOrganizer.FollowOrganizerMock.mutex.Unlock()
+
+	for _, e := range mmFollowOrganizer.FollowOrganizerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFollowOrganizer.FollowOrganizerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.Counter, 1)
+		mm_want := mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.params
+		mm_want_ptrs := mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockFollowOrganizerParams{ctx, userID, organizerID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFollowOrganizer.t.Errorf("EventsServiceMock.FollowOrganizer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFollowOrganizer.t.Errorf("EventsServiceMock.FollowOrganizer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.organizerID != nil && !minimock.Equal(*mm_want_ptrs.organizerID, mm_got.organizerID) {
+				mmFollowOrganizer.t.Errorf("EventsServiceMock.FollowOrganizer got unexpected parameter organizerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.expectationOrigins.originOrganizerID, *mm_want_ptrs.organizerID, mm_got.organizerID, minimock.Diff(*mm_want_ptrs.organizerID, mm_got.organizerID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFollowOrganizer.t.Errorf("EventsServiceMock.FollowOrganizer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFollowOrganizer.FollowOrganizerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFollowOrganizer.t.Fatal("No results are set for the EventsServiceMock.FollowOrganizer")
+		}
+		return (*mm_results).err
+	}
+	if mmFollowOrganizer.funcFollowOrganizer != nil {
+		return mmFollowOrganizer.funcFollowOrganizer(ctx, userID, organizerID)
+	}
+	mmFollowOrganizer.t.Fatalf("Unexpected call to EventsServiceMock.FollowOrganizer. %v %v %v", ctx, userID, organizerID)
+	return
+}
+
+// FollowOrganizerAfterCounter returns a count of finished EventsServiceMock.FollowOrganizer invocations
+func (mmFollowOrganizer *EventsServiceMock) FollowOrganizerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFollowOrganizer.afterFollowOrganizerCounter)
+}
+
+// FollowOrganizerBeforeCounter returns a count of EventsServiceMock.FollowOrganizer invocations
+func (mmFollowOrganizer *EventsServiceMock) FollowOrganizerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFollowOrganizer.beforeFollowOrganizerCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.FollowOrganizer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFollowOrganizer *mEventsServiceMockFollowOrganizer) Calls() []*EventsServiceMockFollowOrganizerParams {
+	mmFollowOrganizer.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockFollowOrganizerParams, len(mmFollowOrganizer.callArgs))
+	copy(argCopy, mmFollowOrganizer.callArgs)
+
+	mmFollowOrganizer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFollowOrganizerDone returns true if the count of the FollowOrganizer invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockFollowOrganizerDone() bool {
+	if m.FollowOrganizerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FollowOrganizerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FollowOrganizerMock.invocationsDone()
+}
+
+// MinimockFollowOrganizerInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockFollowOrganizerInspect() {
+	for _, e := range m.FollowOrganizerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.FollowOrganizer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFollowOrganizerCounter := mm_atomic.LoadUint64(&m.afterFollowOrganizerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FollowOrganizerMock.defaultExpectation != nil && afterFollowOrganizerCounter < 1 {
+		if m.FollowOrganizerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.FollowOrganizer at\n%s", m.FollowOrganizerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.FollowOrganizer at\n%s with params: %#v", m.FollowOrganizerMock.defaultExpectation.expectationOrigins.origin, *m.FollowOrganizerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFollowOrganizer != nil && afterFollowOrganizerCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.FollowOrganizer at\n%s", m.funcFollowOrganizerOrigin)
+	}
+
+	if !m.FollowOrganizerMock.invocationsDone() && afterFollowOrganizerCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.FollowOrganizer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FollowOrganizerMock.expectedInvocations), m.FollowOrganizerMock.expectedInvocationsOrigin, afterFollowOrganizerCounter)
+	}
+}
+
+type mEventsServiceMockInviteEventMember struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockInviteEventMemberExpectation
+	expectations       []*EventsServiceMockInviteEventMemberExpectation
+
+	callArgs []*EventsServiceMockInviteEventMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockInviteEventMemberExpectation specifies expectation struct of the EventsService.InviteEventMember
+type EventsServiceMockInviteEventMemberExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockInviteEventMemberParams
+	paramPtrs          *EventsServiceMockInviteEventMemberParamPtrs
+	expectationOrigins EventsServiceMockInviteEventMemberExpectationOrigins
+	results            *EventsServiceMockInviteEventMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockInviteEventMemberParams contains parameters of the EventsService.InviteEventMember
+type EventsServiceMockInviteEventMemberParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	member   *models.EventMember
+}
+
+// EventsServiceMockInviteEventMemberParamPtrs contains pointers to parameters of the EventsService.InviteEventMember
+type EventsServiceMockInviteEventMemberParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	member   **models.EventMember
+}
+
+// EventsServiceMockInviteEventMemberResults contains results of the EventsService.InviteEventMember
+type EventsServiceMockInviteEventMemberResults struct {
+	i1  int64
+	err error
+}
+
+// EventsServiceMockInviteEventMemberOrigins contains origins of expectations of the EventsService.InviteEventMember
+type EventsServiceMockInviteEventMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originMember   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Optional() *mEventsServiceMockInviteEventMember {
+	mmInviteEventMember.optional = true
+	return mmInviteEventMember
+}
+
+// Expect sets up expected params for EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Expect(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{}
+	}
+
+	if mmInviteEventMember.defaultExpectation.paramPtrs != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by ExpectParams functions")
+	}
+
+	mmInviteEventMember.defaultExpectation.params = &EventsServiceMockInviteEventMemberParams{ctx, userID, urlTitle, member}
+	mmInviteEventMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInviteEventMember.expectations {
+		if minimock.Equal(e.params, mmInviteEventMember.defaultExpectation.params) {
+			mmInviteEventMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInviteEventMember.defaultExpectation.params)
+		}
+	}
+
+	return mmInviteEventMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{}
+	}
+
+	if mmInviteEventMember.defaultExpectation.params != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Expect")
+	}
+
+	if mmInviteEventMember.defaultExpectation.paramPtrs == nil {
+		mmInviteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockInviteEventMemberParamPtrs{}
+	}
+	mmInviteEventMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmInviteEventMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmInviteEventMember
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) ExpectUserIDParam2(userID int64) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{}
+	}
+
+	if mmInviteEventMember.defaultExpectation.params != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Expect")
+	}
+
+	if mmInviteEventMember.defaultExpectation.paramPtrs == nil {
+		mmInviteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockInviteEventMemberParamPtrs{}
+	}
+	mmInviteEventMember.defaultExpectation.paramPtrs.userID = &userID
+	mmInviteEventMember.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmInviteEventMember
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{}
+	}
+
+	if mmInviteEventMember.defaultExpectation.params != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Expect")
+	}
+
+	if mmInviteEventMember.defaultExpectation.paramPtrs == nil {
+		mmInviteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockInviteEventMemberParamPtrs{}
+	}
+	mmInviteEventMember.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmInviteEventMember.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmInviteEventMember
+}
+
+// ExpectMemberParam4 sets up expected param member for EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) ExpectMemberParam4(member *models.EventMember) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{}
+	}
+
+	if mmInviteEventMember.defaultExpectation.params != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Expect")
+	}
+
+	if mmInviteEventMember.defaultExpectation.paramPtrs == nil {
+		mmInviteEventMember.defaultExpectation.paramPtrs = &EventsServiceMockInviteEventMemberParamPtrs{}
+	}
+	mmInviteEventMember.defaultExpectation.paramPtrs.member = &member
+	mmInviteEventMember.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmInviteEventMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Inspect(f func(ctx context.Context, userID int64, urlTitle string, member *models.EventMember)) *mEventsServiceMockInviteEventMember {
+	if mmInviteEventMember.mock.inspectFuncInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.InviteEventMember")
+	}
+
+	mmInviteEventMember.mock.inspectFuncInviteEventMember = f
+
+	return mmInviteEventMember
+}
+
+// Return sets up results that will be returned by EventsService.InviteEventMember
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Return(i1 int64, err error) *EventsServiceMock {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	if mmInviteEventMember.defaultExpectation == nil {
+		mmInviteEventMember.defaultExpectation = &EventsServiceMockInviteEventMemberExpectation{mock: mmInviteEventMember.mock}
+	}
+	mmInviteEventMember.defaultExpectation.results = &EventsServiceMockInviteEventMemberResults{i1, err}
+	mmInviteEventMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInviteEventMember.mock
+}
+
+// Set uses given function f to mock the EventsService.InviteEventMember method
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Set(f func(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) (i1 int64, err error)) *EventsServiceMock {
+	if mmInviteEventMember.defaultExpectation != nil {
+		mmInviteEventMember.mock.t.Fatalf("Default expectation is already set for the EventsService.InviteEventMember method")
+	}
+
+	if len(mmInviteEventMember.expectations) > 0 {
+		mmInviteEventMember.mock.t.Fatalf("Some expectations are already set for the EventsService.InviteEventMember method")
+	}
+
+	mmInviteEventMember.mock.funcInviteEventMember = f
+	mmInviteEventMember.mock.funcInviteEventMemberOrigin = minimock.CallerInfo(1)
+	return mmInviteEventMember.mock
+}
+
+// When sets expectation for the EventsService.InviteEventMember which will trigger the result defined by the following
+// Then helper
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) When(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) *EventsServiceMockInviteEventMemberExpectation {
+	if mmInviteEventMember.mock.funcInviteEventMember != nil {
+		mmInviteEventMember.mock.t.Fatalf("EventsServiceMock.InviteEventMember mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockInviteEventMemberExpectation{
+		mock:               mmInviteEventMember.mock,
+		params:             &EventsServiceMockInviteEventMemberParams{ctx, userID, urlTitle, member},
+		expectationOrigins: EventsServiceMockInviteEventMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmInviteEventMember.expectations = append(mmInviteEventMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.InviteEventMember return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockInviteEventMemberExpectation) Then(i1 int64, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockInviteEventMemberResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.InviteEventMember should be invoked
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Times(n uint64) *mEventsServiceMockInviteEventMember {
+	if n == 0 {
+		mmInviteEventMember.mock.t.Fatalf("Times of EventsServiceMock.InviteEventMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInviteEventMember.expectedInvocations, n)
+	mmInviteEventMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInviteEventMember
+}
+
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) invocationsDone() bool {
+	if len(mmInviteEventMember.expectations) == 0 && mmInviteEventMember.defaultExpectation == nil && mmInviteEventMember.mock.funcInviteEventMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInviteEventMember.mock.afterInviteEventMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInviteEventMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InviteEventMember implements mm_service.EventsService
+func (mmInviteEventMember *EventsServiceMock) InviteEventMember(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmInviteEventMember.beforeInviteEventMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmInviteEventMember.afterInviteEventMemberCounter, 1)
+
+	mmInviteEventMember.t.Helper()
+
+	if mmInviteEventMember.inspectFuncInviteEventMember != nil {
+		mmInviteEventMember.inspectFuncInviteEventMember(ctx, userID, urlTitle, member)
+	}
+
+	mm_params := EventsServiceMockInviteEventMemberParams{ctx, userID, urlTitle, member}
+
+	// Record call args
+	mmInviteEventMember.InviteEventMemberMock.mutex.Lock()
+	mmInviteEventMember.InviteEventMemberMock.callArgs = append(mmInviteEventMember.InviteEventMemberMock.callArgs, &mm_params)
+	mmInviteEventMember.InviteEventMemberMock.mutex.Unlock()
+
+	for _, e := range mmInviteEventMember.InviteEventMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmInviteEventMember.InviteEventMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInviteEventMember.InviteEventMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmInviteEventMember.InviteEventMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmInviteEventMember.InviteEventMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockInviteEventMemberParams{ctx, userID, urlTitle, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInviteEventMember.t.Errorf("EventsServiceMock.InviteEventMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteEventMember.InviteEventMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmInviteEventMember.t.Errorf("EventsServiceMock.InviteEventMember got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteEventMember.InviteEventMemberMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmInviteEventMember.t.Errorf("EventsServiceMock.InviteEventMember got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteEventMember.InviteEventMemberMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmInviteEventMember.t.Errorf("EventsServiceMock.InviteEventMember got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInviteEventMember.InviteEventMemberMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInviteEventMember.t.Errorf("EventsServiceMock.InviteEventMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInviteEventMember.InviteEventMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInviteEventMember.InviteEventMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInviteEventMember.t.Fatal("No results are set for the EventsServiceMock.InviteEventMember")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmInviteEventMember.funcInviteEventMember != nil {
+		return mmInviteEventMember.funcInviteEventMember(ctx, userID, urlTitle, member)
+	}
+	mmInviteEventMember.t.Fatalf("Unexpected call to EventsServiceMock.InviteEventMember. %v %v %v %v", ctx, userID, urlTitle, member)
+	return
+}
+
+// InviteEventMemberAfterCounter returns a count of finished EventsServiceMock.InviteEventMember invocations
+func (mmInviteEventMember *EventsServiceMock) InviteEventMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInviteEventMember.afterInviteEventMemberCounter)
+}
+
+// InviteEventMemberBeforeCounter returns a count of EventsServiceMock.InviteEventMember invocations
+func (mmInviteEventMember *EventsServiceMock) InviteEventMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInviteEventMember.beforeInviteEventMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.InviteEventMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInviteEventMember *mEventsServiceMockInviteEventMember) Calls() []*EventsServiceMockInviteEventMemberParams {
+	mmInviteEventMember.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockInviteEventMemberParams, len(mmInviteEventMember.callArgs))
+	copy(argCopy, mmInviteEventMember.callArgs)
+
+	mmInviteEventMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInviteEventMemberDone returns true if the count of the InviteEventMember invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockInviteEventMemberDone() bool {
+	if m.InviteEventMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InviteEventMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InviteEventMemberMock.invocationsDone()
+}
+
+// MinimockInviteEventMemberInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockInviteEventMemberInspect() {
+	for _, e := range m.InviteEventMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.InviteEventMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInviteEventMemberCounter := mm_atomic.LoadUint64(&m.afterInviteEventMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InviteEventMemberMock.defaultExpectation != nil && afterInviteEventMemberCounter < 1 {
+		if m.InviteEventMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.InviteEventMember at\n%s", m.InviteEventMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.InviteEventMember at\n%s with params: %#v", m.InviteEventMemberMock.defaultExpectation.expectationOrigins.origin, *m.InviteEventMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInviteEventMember != nil && afterInviteEventMemberCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.InviteEventMember at\n%s", m.funcInviteEventMemberOrigin)
+	}
+
+	if !m.InviteEventMemberMock.invocationsDone() && afterInviteEventMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.InviteEventMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InviteEventMemberMock.expectedInvocations), m.InviteEventMemberMock.expectedInvocationsOrigin, afterInviteEventMemberCounter)
+	}
+}
+
+type mEventsServiceMockOrganizerProfile struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockOrganizerProfileExpectation
+	expectations       []*EventsServiceMockOrganizerProfileExpectation
+
+	callArgs []*EventsServiceMockOrganizerProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockOrganizerProfileExpectation specifies expectation struct of the EventsService.OrganizerProfile
+type EventsServiceMockOrganizerProfileExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockOrganizerProfileParams
+	paramPtrs          *EventsServiceMockOrganizerProfileParamPtrs
+	expectationOrigins EventsServiceMockOrganizerProfileExpectationOrigins
+	results            *EventsServiceMockOrganizerProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockOrganizerProfileParams contains parameters of the EventsService.OrganizerProfile
+type EventsServiceMockOrganizerProfileParams struct {
+	ctx    context.Context
+	handle string
+}
+
+// EventsServiceMockOrganizerProfileParamPtrs contains pointers to parameters of the EventsService.OrganizerProfile
+type EventsServiceMockOrganizerProfileParamPtrs struct {
+	ctx    *context.Context
+	handle *string
+}
+
+// EventsServiceMockOrganizerProfileResults contains results of the EventsService.OrganizerProfile
+type EventsServiceMockOrganizerProfileResults struct {
+	op1 *models.OrganizerProfile
+	err error
+}
+
+// EventsServiceMockOrganizerProfileOrigins contains origins of expectations of the EventsService.OrganizerProfile
+type EventsServiceMockOrganizerProfileExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originHandle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Optional() *mEventsServiceMockOrganizerProfile {
+	mmOrganizerProfile.optional = true
+	return mmOrganizerProfile
+}
+
+// Expect sets up expected params for EventsService.OrganizerProfile
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Expect(ctx context.Context, handle string) *mEventsServiceMockOrganizerProfile {
+	if mmOrganizerProfile.mock.funcOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Set")
+	}
+
+	if mmOrganizerProfile.defaultExpectation == nil {
+		mmOrganizerProfile.defaultExpectation = &EventsServiceMockOrganizerProfileExpectation{}
+	}
+
+	if mmOrganizerProfile.defaultExpectation.paramPtrs != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by ExpectParams functions")
+	}
+
+	mmOrganizerProfile.defaultExpectation.params = &EventsServiceMockOrganizerProfileParams{ctx, handle}
+	mmOrganizerProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOrganizerProfile.expectations {
+		if minimock.Equal(e.params, mmOrganizerProfile.defaultExpectation.params) {
+			mmOrganizerProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOrganizerProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmOrganizerProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.OrganizerProfile
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockOrganizerProfile {
+	if mmOrganizerProfile.mock.funcOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Set")
+	}
+
+	if mmOrganizerProfile.defaultExpectation == nil {
+		mmOrganizerProfile.defaultExpectation = &EventsServiceMockOrganizerProfileExpectation{}
+	}
+
+	if mmOrganizerProfile.defaultExpectation.params != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Expect")
+	}
+
+	if mmOrganizerProfile.defaultExpectation.paramPtrs == nil {
+		mmOrganizerProfile.defaultExpectation.paramPtrs = &EventsServiceMockOrganizerProfileParamPtrs{}
+	}
+	mmOrganizerProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOrganizerProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOrganizerProfile
+}
+
+// ExpectHandleParam2 sets up expected param handle for EventsService.OrganizerProfile
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) ExpectHandleParam2(handle string) *mEventsServiceMockOrganizerProfile {
+	if mmOrganizerProfile.mock.funcOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Set")
+	}
+
+	if mmOrganizerProfile.defaultExpectation == nil {
+		mmOrganizerProfile.defaultExpectation = &EventsServiceMockOrganizerProfileExpectation{}
+	}
+
+	if mmOrganizerProfile.defaultExpectation.params != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Expect")
+	}
+
+	if mmOrganizerProfile.defaultExpectation.paramPtrs == nil {
+		mmOrganizerProfile.defaultExpectation.paramPtrs = &EventsServiceMockOrganizerProfileParamPtrs{}
+	}
+	mmOrganizerProfile.defaultExpectation.paramPtrs.handle = &handle
+	mmOrganizerProfile.defaultExpectation.expectationOrigins.originHandle = minimock.CallerInfo(1)
+
+	return mmOrganizerProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.OrganizerProfile
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Inspect(f func(ctx context.Context, handle string)) *mEventsServiceMockOrganizerProfile {
+	if mmOrganizerProfile.mock.inspectFuncOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.OrganizerProfile")
+	}
+
+	mmOrganizerProfile.mock.inspectFuncOrganizerProfile = f
+
+	return mmOrganizerProfile
+}
+
+// Return sets up results that will be returned by EventsService.OrganizerProfile
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Return(op1 *models.OrganizerProfile, err error) *EventsServiceMock {
+	if mmOrganizerProfile.mock.funcOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Set")
+	}
+
+	if mmOrganizerProfile.defaultExpectation == nil {
+		mmOrganizerProfile.defaultExpectation = &EventsServiceMockOrganizerProfileExpectation{mock: mmOrganizerProfile.mock}
+	}
+	mmOrganizerProfile.defaultExpectation.results = &EventsServiceMockOrganizerProfileResults{op1, err}
+	mmOrganizerProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOrganizerProfile.mock
+}
+
+// Set uses given function f to mock the EventsService.OrganizerProfile method
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Set(f func(ctx context.Context, handle string) (op1 *models.OrganizerProfile, err error)) *EventsServiceMock {
+	if mmOrganizerProfile.defaultExpectation != nil {
+		mmOrganizerProfile.mock.t.Fatalf("Default expectation is already set for the EventsService.OrganizerProfile method")
+	}
+
+	if len(mmOrganizerProfile.expectations) > 0 {
+		mmOrganizerProfile.mock.t.Fatalf("Some expectations are already set for the EventsService.OrganizerProfile method")
+	}
+
+	mmOrganizerProfile.mock.funcOrganizerProfile = f
+	mmOrganizerProfile.mock.funcOrganizerProfileOrigin = minimock.CallerInfo(1)
+	return mmOrganizerProfile.mock
+}
+
+// When sets expectation for the EventsService.OrganizerProfile which will trigger the result defined by the following
+// Then helper
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) When(ctx context.Context, handle string) *EventsServiceMockOrganizerProfileExpectation {
+	if mmOrganizerProfile.mock.funcOrganizerProfile != nil {
+		mmOrganizerProfile.mock.t.Fatalf("EventsServiceMock.OrganizerProfile mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockOrganizerProfileExpectation{
+		mock:               mmOrganizerProfile.mock,
+		params:             &EventsServiceMockOrganizerProfileParams{ctx, handle},
+		expectationOrigins: EventsServiceMockOrganizerProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOrganizerProfile.expectations = append(mmOrganizerProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.OrganizerProfile return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockOrganizerProfileExpectation) Then(op1 *models.OrganizerProfile, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockOrganizerProfileResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.OrganizerProfile should be invoked
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Times(n uint64) *mEventsServiceMockOrganizerProfile {
+	if n == 0 {
+		mmOrganizerProfile.mock.t.Fatalf("Times of EventsServiceMock.OrganizerProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOrganizerProfile.expectedInvocations, n)
+	mmOrganizerProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOrganizerProfile
+}
+
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) invocationsDone() bool {
+	if len(mmOrganizerProfile.expectations) == 0 && mmOrganizerProfile.defaultExpectation == nil && mmOrganizerProfile.mock.funcOrganizerProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOrganizerProfile.mock.afterOrganizerProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOrganizerProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OrganizerProfile implements mm_service.EventsService
+func (mmOrganizerProfile *EventsServiceMock) OrganizerProfile(ctx context.Context, handle string) (op1 *models.OrganizerProfile, err error) {
+	mm_atomic.AddUint64(&mmOrganizerProfile.beforeOrganizerProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmOrganizerProfile.afterOrganizerProfileCounter, 1)
+
+	mmOrganizerProfile.t.Helper()
+
+	if mmOrganizerProfile.inspectFuncOrganizerProfile != nil {
+		mmOrganizerProfile.inspectFuncOrganizerProfile(ctx, handle)
+	}
+
+	mm_params := EventsServiceMockOrganizerProfileParams{ctx, handle}
+
+	// Record call args
+	mmOrganizerProfile.OrganizerProfileMock.mutex.Lock()
+	mmOrganizerProfile.OrganizerProfileMock.callArgs = append(mmOrganizerProfile.OrganizerProfileMock.callArgs, &mm_params)
+	mmOrganizerProfile.OrganizerProfileMock.mutex.Unlock()
+
+	for _, e := range mmOrganizerProfile.OrganizerProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmOrganizerProfile.OrganizerProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockOrganizerProfileParams{ctx, handle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOrganizerProfile.t.Errorf("EventsServiceMock.OrganizerProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.handle != nil && !minimock.Equal(*mm_want_ptrs.handle, mm_got.handle) {
+				mmOrganizerProfile.t.Errorf("EventsServiceMock.OrganizerProfile got unexpected parameter handle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.expectationOrigins.originHandle, *mm_want_ptrs.handle, mm_got.handle, minimock.Diff(*mm_want_ptrs.handle, mm_got.handle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOrganizerProfile.t.Errorf("EventsServiceMock.OrganizerProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOrganizerProfile.OrganizerProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOrganizerProfile.t.Fatal("No results are set for the EventsServiceMock.OrganizerProfile")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmOrganizerProfile.funcOrganizerProfile != nil {
+		return mmOrganizerProfile.funcOrganizerProfile(ctx, handle)
+	}
+	mmOrganizerProfile.t.Fatalf("Unexpected call to EventsServiceMock.OrganizerProfile. %v %v", ctx, handle)
+	return
+}
+
+// OrganizerProfileAfterCounter returns a count of finished EventsServiceMock.OrganizerProfile invocations
+func (mmOrganizerProfile *EventsServiceMock) OrganizerProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrganizerProfile.afterOrganizerProfileCounter)
+}
+
+// OrganizerProfileBeforeCounter returns a count of EventsServiceMock.OrganizerProfile invocations
+func (mmOrganizerProfile *EventsServiceMock) OrganizerProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrganizerProfile.beforeOrganizerProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.OrganizerProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOrganizerProfile *mEventsServiceMockOrganizerProfile) Calls() []*EventsServiceMockOrganizerProfileParams {
+	mmOrganizerProfile.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockOrganizerProfileParams, len(mmOrganizerProfile.callArgs))
+	copy(argCopy, mmOrganizerProfile.callArgs)
+
+	mmOrganizerProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOrganizerProfileDone returns true if the count of the OrganizerProfile invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockOrganizerProfileDone() bool {
+	if m.OrganizerProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OrganizerProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OrganizerProfileMock.invocationsDone()
+}
+
+// MinimockOrganizerProfileInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockOrganizerProfileInspect() {
+	for _, e := range m.OrganizerProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.OrganizerProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOrganizerProfileCounter := mm_atomic.LoadUint64(&m.afterOrganizerProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OrganizerProfileMock.defaultExpectation != nil && afterOrganizerProfileCounter < 1 {
+		if m.OrganizerProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.OrganizerProfile at\n%s", m.OrganizerProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.OrganizerProfile at\n%s with params: %#v", m.OrganizerProfileMock.defaultExpectation.expectationOrigins.origin, *m.OrganizerProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOrganizerProfile != nil && afterOrganizerProfileCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.OrganizerProfile at\n%s", m.funcOrganizerProfileOrigin)
+	}
+
+	if !m.OrganizerProfileMock.invocationsDone() && afterOrganizerProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.OrganizerProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OrganizerProfileMock.expectedInvocations), m.OrganizerProfileMock.expectedInvocationsOrigin, afterOrganizerProfileCounter)
+	}
+}
+
+type mEventsServiceMockRecordView struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockRecordViewExpectation
+	expectations       []*EventsServiceMockRecordViewExpectation
+
+	callArgs []*EventsServiceMockRecordViewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockRecordViewExpectation specifies expectation struct of the EventsService.RecordView
+type EventsServiceMockRecordViewExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockRecordViewParams
+	paramPtrs          *EventsServiceMockRecordViewParamPtrs
+	expectationOrigins EventsServiceMockRecordViewExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// EventsServiceMockRecordViewParams contains parameters of the EventsService.RecordView
+type EventsServiceMockRecordViewParams struct {
+	event   *models.Event
+	visitor string
+}
+
+// EventsServiceMockRecordViewParamPtrs contains pointers to parameters of the EventsService.RecordView
+type EventsServiceMockRecordViewParamPtrs struct {
+	event   **models.Event
+	visitor *string
+}
+
+// EventsServiceMockRecordViewOrigins contains origins of expectations of the EventsService.RecordView
+type EventsServiceMockRecordViewExpectationOrigins struct {
+	origin        string
+	originEvent   string
+	originVisitor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordView *mEventsServiceMockRecordView) Optional() *mEventsServiceMockRecordView {
+	mmRecordView.optional = true
+	return mmRecordView
+}
+
+// Expect sets up expected params for EventsService.RecordView
+func (mmRecordView *mEventsServiceMockRecordView) Expect(event *models.Event, visitor string) *mEventsServiceMockRecordView {
+	if mmRecordView.mock.funcRecordView != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Set")
+	}
+
+	if mmRecordView.defaultExpectation == nil {
+		mmRecordView.defaultExpectation = &EventsServiceMockRecordViewExpectation{}
+	}
+
+	if mmRecordView.defaultExpectation.paramPtrs != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by ExpectParams functions")
+	}
+
+	mmRecordView.defaultExpectation.params = &EventsServiceMockRecordViewParams{event, visitor}
+	mmRecordView.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordView.expectations {
+		if minimock.Equal(e.params, mmRecordView.defaultExpectation.params) {
+			mmRecordView.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordView.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordView
+}
+
+// ExpectEventParam1 sets up expected param event for EventsService.RecordView
+func (mmRecordView *mEventsServiceMockRecordView) ExpectEventParam1(event *models.Event) *mEventsServiceMockRecordView {
+	if mmRecordView.mock.funcRecordView != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Set")
+	}
+
+	if mmRecordView.defaultExpectation == nil {
+		mmRecordView.defaultExpectation = &EventsServiceMockRecordViewExpectation{}
+	}
+
+	if mmRecordView.defaultExpectation.params != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Expect")
+	}
+
+	if mmRecordView.defaultExpectation.paramPtrs == nil {
+		mmRecordView.defaultExpectation.paramPtrs = &EventsServiceMockRecordViewParamPtrs{}
+	}
+	mmRecordView.defaultExpectation.paramPtrs.event = &event
+	mmRecordView.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmRecordView
+}
+
+// ExpectVisitorParam2 sets up expected param visitor for EventsService.RecordView
+func (mmRecordView *mEventsServiceMockRecordView) ExpectVisitorParam2(visitor string) *mEventsServiceMockRecordView {
+	if mmRecordView.mock.funcRecordView != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Set")
+	}
+
+	if mmRecordView.defaultExpectation == nil {
+		mmRecordView.defaultExpectation = &EventsServiceMockRecordViewExpectation{}
+	}
+
+	if mmRecordView.defaultExpectation.params != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Expect")
+	}
+
+	if mmRecordView.defaultExpectation.paramPtrs == nil {
+		mmRecordView.defaultExpectation.paramPtrs = &EventsServiceMockRecordViewParamPtrs{}
+	}
+	mmRecordView.defaultExpectation.paramPtrs.visitor = &visitor
+	mmRecordView.defaultExpectation.expectationOrigins.originVisitor = minimock.CallerInfo(1)
+
+	return mmRecordView
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.RecordView
+func (mmRecordView *mEventsServiceMockRecordView) Inspect(f func(event *models.Event, visitor string)) *mEventsServiceMockRecordView {
+	if mmRecordView.mock.inspectFuncRecordView != nil {
+		mmRecordView.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.RecordView")
+	}
+
+	mmRecordView.mock.inspectFuncRecordView = f
+
+	return mmRecordView
+}
+
+// Return sets up results that will be returned by EventsService.RecordView
+func (mmRecordView *mEventsServiceMockRecordView) Return() *EventsServiceMock {
+	if mmRecordView.mock.funcRecordView != nil {
+		mmRecordView.mock.t.Fatalf("EventsServiceMock.RecordView mock is already set by Set")
+	}
+
+	if mmRecordView.defaultExpectation == nil {
+		mmRecordView.defaultExpectation = &EventsServiceMockRecordViewExpectation{mock: mmRecordView.mock}
+	}
+
+	mmRecordView.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordView.mock
+}
+
+// Set uses given function f to mock the EventsService.RecordView method
+func (mmRecordView *mEventsServiceMockRecordView) Set(f func(event *models.Event, visitor string)) *EventsServiceMock {
+	if mmRecordView.defaultExpectation != nil {
+		mmRecordView.mock.t.Fatalf("Default expectation is already set for the EventsService.RecordView method")
+	}
+
+	if len(mmRecordView.expectations) > 0 {
+		mmRecordView.mock.t.Fatalf("Some expectations are already set for the EventsService.RecordView method")
+	}
+
+	mmRecordView.mock.funcRecordView = f
+	mmRecordView.mock.funcRecordViewOrigin = minimock.CallerInfo(1)
+	return mmRecordView.mock
+}
+
+// Times sets number of times EventsService.RecordView should be invoked
+func (mmRecordView *mEventsServiceMockRecordView) Times(n uint64) *mEventsServiceMockRecordView {
+	if n == 0 {
+		mmRecordView.mock.t.Fatalf("Times of EventsServiceMock.RecordView mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordView.expectedInvocations, n)
+	mmRecordView.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordView
+}
+
+func (mmRecordView *mEventsServiceMockRecordView) invocationsDone() bool {
+	if len(mmRecordView.expectations) == 0 && mmRecordView.defaultExpectation == nil && mmRecordView.mock.funcRecordView == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordView.mock.afterRecordViewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordView.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordView implements mm_service.EventsService
+func (mmRecordView *EventsServiceMock) RecordView(event *models.Event, visitor string) {
+	mm_atomic.AddUint64(&mmRecordView.beforeRecordViewCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordView.afterRecordViewCounter, 1)
+
+	mmRecordView.t.Helper()
+
+	if mmRecordView.inspectFuncRecordView != nil {
+		mmRecordView.inspectFuncRecordView(event, visitor)
+	}
+
+	mm_params := EventsServiceMockRecordViewParams{event, visitor}
+
+	// Record call args
+	mmRecordView.RecordViewMock.mutex.Lock()
+	mmRecordView.RecordViewMock.callArgs = append(mmRecordView.RecordViewMock.callArgs, &mm_params)
+	mmRecordView.RecordViewMock.mutex.Unlock()
+
+	for _, e := range mmRecordView.RecordViewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRecordView.RecordViewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordView.RecordViewMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordView.RecordViewMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordView.RecordViewMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockRecordViewParams{event, visitor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmRecordView.t.Errorf("EventsServiceMock.RecordView got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordView.RecordViewMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+			if mm_want_ptrs.visitor != nil && !minimock.Equal(*mm_want_ptrs.visitor, mm_got.visitor) {
+				mmRecordView.t.Errorf("EventsServiceMock.RecordView got unexpected parameter visitor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordView.RecordViewMock.defaultExpectation.expectationOrigins.originVisitor, *mm_want_ptrs.visitor, mm_got.visitor, minimock.Diff(*mm_want_ptrs.visitor, mm_got.visitor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordView.t.Errorf("EventsServiceMock.RecordView got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordView.RecordViewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRecordView.funcRecordView != nil {
+		mmRecordView.funcRecordView(event, visitor)
+		return
+	}
+	mmRecordView.t.Fatalf("Unexpected call to EventsServiceMock.RecordView. %v %v", event, visitor)
+
+}
+
+// RecordViewAfterCounter returns a count of finished EventsServiceMock.RecordView invocations
+func (mmRecordView *EventsServiceMock) RecordViewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordView.afterRecordViewCounter)
+}
+
+// RecordViewBeforeCounter returns a count of EventsServiceMock.RecordView invocations
+func (mmRecordView *EventsServiceMock) RecordViewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordView.beforeRecordViewCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.RecordView.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordView *mEventsServiceMockRecordView) Calls() []*EventsServiceMockRecordViewParams {
+	mmRecordView.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockRecordViewParams, len(mmRecordView.callArgs))
+	copy(argCopy, mmRecordView.callArgs)
+
+	mmRecordView.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordViewDone returns true if the count of the RecordView invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockRecordViewDone() bool {
+	if m.RecordViewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordViewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordViewMock.invocationsDone()
+}
+
+// MinimockRecordViewInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockRecordViewInspect() {
+	for _, e := range m.RecordViewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.RecordView at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordViewCounter := mm_atomic.LoadUint64(&m.afterRecordViewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordViewMock.defaultExpectation != nil && afterRecordViewCounter < 1 {
+		if m.RecordViewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.RecordView at\n%s", m.RecordViewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.RecordView at\n%s with params: %#v", m.RecordViewMock.defaultExpectation.expectationOrigins.origin, *m.RecordViewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordView != nil && afterRecordViewCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.RecordView at\n%s", m.funcRecordViewOrigin)
+	}
+
+	if !m.RecordViewMock.invocationsDone() && afterRecordViewCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.RecordView at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordViewMock.expectedInvocations), m.RecordViewMock.expectedInvocationsOrigin, afterRecordViewCounter)
+	}
+}
+
+type mEventsServiceMockReorderEventImages struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockReorderEventImagesExpectation
+	expectations       []*EventsServiceMockReorderEventImagesExpectation
+
+	callArgs []*EventsServiceMockReorderEventImagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockReorderEventImagesExpectation specifies expectation struct of the EventsService.ReorderEventImages
+type EventsServiceMockReorderEventImagesExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockReorderEventImagesParams
+	paramPtrs          *EventsServiceMockReorderEventImagesParamPtrs
+	expectationOrigins EventsServiceMockReorderEventImagesExpectationOrigins
+	results            *EventsServiceMockReorderEventImagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockReorderEventImagesParams contains parameters of the EventsService.ReorderEventImages
+type EventsServiceMockReorderEventImagesParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	ids      []int64
+}
+
+// EventsServiceMockReorderEventImagesParamPtrs contains pointers to parameters of the EventsService.ReorderEventImages
+type EventsServiceMockReorderEventImagesParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	ids      *[]int64
+}
+
+// EventsServiceMockReorderEventImagesResults contains results of the EventsService.ReorderEventImages
+type EventsServiceMockReorderEventImagesResults struct {
+	err error
+}
+
+// EventsServiceMockReorderEventImagesOrigins contains origins of expectations of the EventsService.ReorderEventImages
+type EventsServiceMockReorderEventImagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originIds      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Optional() *mEventsServiceMockReorderEventImages {
+	mmReorderEventImages.optional = true
+	return mmReorderEventImages
+}
+
+// Expect sets up expected params for EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Expect(ctx context.Context, userID int64, urlTitle string, ids []int64) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{}
+	}
+
+	if mmReorderEventImages.defaultExpectation.paramPtrs != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by ExpectParams functions")
+	}
+
+	mmReorderEventImages.defaultExpectation.params = &EventsServiceMockReorderEventImagesParams{ctx, userID, urlTitle, ids}
+	mmReorderEventImages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReorderEventImages.expectations {
+		if minimock.Equal(e.params, mmReorderEventImages.defaultExpectation.params) {
+			mmReorderEventImages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReorderEventImages.defaultExpectation.params)
+		}
+	}
+
+	return mmReorderEventImages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{}
+	}
+
+	if mmReorderEventImages.defaultExpectation.params != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Expect")
+	}
+
+	if mmReorderEventImages.defaultExpectation.paramPtrs == nil {
+		mmReorderEventImages.defaultExpectation.paramPtrs = &EventsServiceMockReorderEventImagesParamPtrs{}
+	}
+	mmReorderEventImages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReorderEventImages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReorderEventImages
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) ExpectUserIDParam2(userID int64) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{}
+	}
+
+	if mmReorderEventImages.defaultExpectation.params != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Expect")
+	}
+
+	if mmReorderEventImages.defaultExpectation.paramPtrs == nil {
+		mmReorderEventImages.defaultExpectation.paramPtrs = &EventsServiceMockReorderEventImagesParamPtrs{}
+	}
+	mmReorderEventImages.defaultExpectation.paramPtrs.userID = &userID
+	mmReorderEventImages.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReorderEventImages
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{}
+	}
+
+	if mmReorderEventImages.defaultExpectation.params != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Expect")
+	}
+
+	if mmReorderEventImages.defaultExpectation.paramPtrs == nil {
+		mmReorderEventImages.defaultExpectation.paramPtrs = &EventsServiceMockReorderEventImagesParamPtrs{}
+	}
+	mmReorderEventImages.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmReorderEventImages.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmReorderEventImages
+}
+
+// ExpectIdsParam4 sets up expected param ids for EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) ExpectIdsParam4(ids []int64) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{}
+	}
+
+	if mmReorderEventImages.defaultExpectation.params != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Expect")
+	}
+
+	if mmReorderEventImages.defaultExpectation.paramPtrs == nil {
+		mmReorderEventImages.defaultExpectation.paramPtrs = &EventsServiceMockReorderEventImagesParamPtrs{}
+	}
+	mmReorderEventImages.defaultExpectation.paramPtrs.ids = &ids
+	mmReorderEventImages.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmReorderEventImages
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Inspect(f func(ctx context.Context, userID int64, urlTitle string, ids []int64)) *mEventsServiceMockReorderEventImages {
+	if mmReorderEventImages.mock.inspectFuncReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.ReorderEventImages")
+	}
+
+	mmReorderEventImages.mock.inspectFuncReorderEventImages = f
+
+	return mmReorderEventImages
+}
+
+// Return sets up results that will be returned by EventsService.ReorderEventImages
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Return(err error) *EventsServiceMock {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	if mmReorderEventImages.defaultExpectation == nil {
+		mmReorderEventImages.defaultExpectation = &EventsServiceMockReorderEventImagesExpectation{mock: mmReorderEventImages.mock}
+	}
+	mmReorderEventImages.defaultExpectation.results = &EventsServiceMockReorderEventImagesResults{err}
+	mmReorderEventImages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReorderEventImages.mock
+}
+
+// Set uses given function f to mock the EventsService.ReorderEventImages method
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Set(f func(ctx context.Context, userID int64, urlTitle string, ids []int64) (err error)) *EventsServiceMock {
+	if mmReorderEventImages.defaultExpectation != nil {
+		mmReorderEventImages.mock.t.Fatalf("Default expectation is already set for the EventsService.ReorderEventImages method")
+	}
+
+	if len(mmReorderEventImages.expectations) > 0 {
+		mmReorderEventImages.mock.t.Fatalf("Some expectations are already set for the EventsService.ReorderEventImages method")
+	}
+
+	mmReorderEventImages.mock.funcReorderEventImages = f
+	mmReorderEventImages.mock.funcReorderEventImagesOrigin = minimock.CallerInfo(1)
+	return mmReorderEventImages.mock
+}
+
+// When sets expectation for the EventsService.ReorderEventImages which will trigger the result defined by the following
+// Then helper
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) When(ctx context.Context, userID int64, urlTitle string, ids []int64) *EventsServiceMockReorderEventImagesExpectation {
+	if mmReorderEventImages.mock.funcReorderEventImages != nil {
+		mmReorderEventImages.mock.t.Fatalf("EventsServiceMock.ReorderEventImages mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockReorderEventImagesExpectation{
+		mock:               mmReorderEventImages.mock,
+		params:             &EventsServiceMockReorderEventImagesParams{ctx, userID, urlTitle, ids},
+		expectationOrigins: EventsServiceMockReorderEventImagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReorderEventImages.expectations = append(mmReorderEventImages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.ReorderEventImages return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockReorderEventImagesExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockReorderEventImagesResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.ReorderEventImages should be invoked
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Times(n uint64) *mEventsServiceMockReorderEventImages {
+	if n == 0 {
+		mmReorderEventImages.mock.t.Fatalf("Times of EventsServiceMock.ReorderEventImages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReorderEventImages.expectedInvocations, n)
+	mmReorderEventImages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReorderEventImages
+}
+
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) invocationsDone() bool {
+	if len(mmReorderEventImages.expectations) == 0 && mmReorderEventImages.defaultExpectation == nil && mmReorderEventImages.mock.funcReorderEventImages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReorderEventImages.mock.afterReorderEventImagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReorderEventImages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReorderEventImages implements mm_service.EventsService
+func (mmReorderEventImages *EventsServiceMock) ReorderEventImages(ctx context.Context, userID int64, urlTitle string, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmReorderEventImages.beforeReorderEventImagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReorderEventImages.afterReorderEventImagesCounter, 1)
+
+	mmReorderEventImages.t.Helper()
+
+	if mmReorderEventImages.inspectFuncReorderEventImages != nil {
+		mmReorderEventImages.inspectFuncReorderEventImages(ctx, userID, urlTitle, ids)
+	}
+
+	mm_params := EventsServiceMockReorderEventImagesParams{ctx, userID, urlTitle, ids}
+
+	// Record call args
+	mmReorderEventImages.ReorderEventImagesMock.mutex.Lock()
+	mmReorderEventImages.ReorderEventImagesMock.callArgs = append(mmReorderEventImages.ReorderEventImagesMock.callArgs, &mm_params)
+	mmReorderEventImages.ReorderEventImagesMock.mutex.Unlock()
+
+	for _, e := range mmReorderEventImages.ReorderEventImagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReorderEventImages.ReorderEventImagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.params
+		mm_want_ptrs := mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockReorderEventImagesParams{ctx, userID, urlTitle, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReorderEventImages.t.Errorf("EventsServiceMock.ReorderEventImages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReorderEventImages.t.Errorf("EventsServiceMock.ReorderEventImages got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmReorderEventImages.t.Errorf("EventsServiceMock.ReorderEventImages got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmReorderEventImages.t.Errorf("EventsServiceMock.ReorderEventImages got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReorderEventImages.t.Errorf("EventsServiceMock.ReorderEventImages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReorderEventImages.ReorderEventImagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReorderEventImages.t.Fatal("No results are set for the EventsServiceMock.ReorderEventImages")
+		}
+		return (*mm_results).err
+	}
+	if mmReorderEventImages.funcReorderEventImages != nil {
+		return mmReorderEventImages.funcReorderEventImages(ctx, userID, urlTitle, ids)
+	}
+	mmReorderEventImages.t.Fatalf("Unexpected call to EventsServiceMock.ReorderEventImages. %v %v %v %v", ctx, userID, urlTitle, ids)
+	return
+}
+
+// ReorderEventImagesAfterCounter returns a count of finished EventsServiceMock.ReorderEventImages invocations
+func (mmReorderEventImages *EventsServiceMock) ReorderEventImagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderEventImages.afterReorderEventImagesCounter)
+}
+
+// ReorderEventImagesBeforeCounter returns a count of EventsServiceMock.ReorderEventImages invocations
+func (mmReorderEventImages *EventsServiceMock) ReorderEventImagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReorderEventImages.beforeReorderEventImagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.ReorderEventImages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReorderEventImages *mEventsServiceMockReorderEventImages) Calls() []*EventsServiceMockReorderEventImagesParams {
+	mmReorderEventImages.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockReorderEventImagesParams, len(mmReorderEventImages.callArgs))
+	copy(argCopy, mmReorderEventImages.callArgs)
+
+	mmReorderEventImages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReorderEventImagesDone returns true if the count of the ReorderEventImages invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockReorderEventImagesDone() bool {
+	if m.ReorderEventImagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReorderEventImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReorderEventImagesMock.invocationsDone()
+}
+
+// MinimockReorderEventImagesInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockReorderEventImagesInspect() {
+	for _, e := range m.ReorderEventImagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.ReorderEventImages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReorderEventImagesCounter := mm_atomic.LoadUint64(&m.afterReorderEventImagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReorderEventImagesMock.defaultExpectation != nil && afterReorderEventImagesCounter < 1 {
+		if m.ReorderEventImagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.ReorderEventImages at\n%s", m.ReorderEventImagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.ReorderEventImages at\n%s with params: %#v", m.ReorderEventImagesMock.defaultExpectation.expectationOrigins.origin, *m.ReorderEventImagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReorderEventImages != nil && afterReorderEventImagesCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.ReorderEventImages at\n%s", m.funcReorderEventImagesOrigin)
+	}
+
+	if !m.ReorderEventImagesMock.invocationsDone() && afterReorderEventImagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.ReorderEventImages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReorderEventImagesMock.expectedInvocations), m.ReorderEventImagesMock.expectedInvocationsOrigin, afterReorderEventImagesCounter)
+	}
+}
+
+type mEventsServiceMockReplyToReview struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockReplyToReviewExpectation
+	expectations       []*EventsServiceMockReplyToReviewExpectation
+
+	callArgs []*EventsServiceMockReplyToReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockReplyToReviewExpectation specifies expectation struct of the EventsService.ReplyToReview
+type EventsServiceMockReplyToReviewExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockReplyToReviewParams
+	paramPtrs          *EventsServiceMockReplyToReviewParamPtrs
+	expectationOrigins EventsServiceMockReplyToReviewExpectationOrigins
+	results            *EventsServiceMockReplyToReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockReplyToReviewParams contains parameters of the EventsService.ReplyToReview
+type EventsServiceMockReplyToReviewParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	reviewID int64
+	reply    string
+}
+
+// EventsServiceMockReplyToReviewParamPtrs contains pointers to parameters of the EventsService.ReplyToReview
+type EventsServiceMockReplyToReviewParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	reviewID *int64
+	reply    *string
+}
+
+// EventsServiceMockReplyToReviewResults contains results of the EventsService.ReplyToReview
+type EventsServiceMockReplyToReviewResults struct {
+	err error
+}
+
+// EventsServiceMockReplyToReviewOrigins contains origins of expectations of the EventsService.ReplyToReview
+type EventsServiceMockReplyToReviewExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originReviewID string
+	originReply    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Optional() *mEventsServiceMockReplyToReview {
+	mmReplyToReview.optional = true
+	return mmReplyToReview
+}
+
+// Expect sets up expected params for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Expect(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by ExpectParams functions")
+	}
+
+	mmReplyToReview.defaultExpectation.params = &EventsServiceMockReplyToReviewParams{ctx, userID, urlTitle, reviewID, reply}
+	mmReplyToReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplyToReview.expectations {
+		if minimock.Equal(e.params, mmReplyToReview.defaultExpectation.params) {
+			mmReplyToReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplyToReview.defaultExpectation.params)
+		}
+	}
+
+	return mmReplyToReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.params != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Expect")
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs == nil {
+		mmReplyToReview.defaultExpectation.paramPtrs = &EventsServiceMockReplyToReviewParamPtrs{}
+	}
+	mmReplyToReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplyToReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplyToReview
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) ExpectUserIDParam2(userID int64) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.params != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Expect")
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs == nil {
+		mmReplyToReview.defaultExpectation.paramPtrs = &EventsServiceMockReplyToReviewParamPtrs{}
+	}
+	mmReplyToReview.defaultExpectation.paramPtrs.userID = &userID
+	mmReplyToReview.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReplyToReview
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.params != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Expect")
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs == nil {
+		mmReplyToReview.defaultExpectation.paramPtrs = &EventsServiceMockReplyToReviewParamPtrs{}
+	}
+	mmReplyToReview.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmReplyToReview.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmReplyToReview
+}
+
+// ExpectReviewIDParam4 sets up expected param reviewID for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) ExpectReviewIDParam4(reviewID int64) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.params != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Expect")
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs == nil {
+		mmReplyToReview.defaultExpectation.paramPtrs = &EventsServiceMockReplyToReviewParamPtrs{}
+	}
+	mmReplyToReview.defaultExpectation.paramPtrs.reviewID = &reviewID
+	mmReplyToReview.defaultExpectation.expectationOrigins.originReviewID = minimock.CallerInfo(1)
+
+	return mmReplyToReview
+}
+
+// ExpectReplyParam5 sets up expected param reply for EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) ExpectReplyParam5(reply string) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{}
+	}
+
+	if mmReplyToReview.defaultExpectation.params != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Expect")
+	}
+
+	if mmReplyToReview.defaultExpectation.paramPtrs == nil {
+		mmReplyToReview.defaultExpectation.paramPtrs = &EventsServiceMockReplyToReviewParamPtrs{}
+	}
+	mmReplyToReview.defaultExpectation.paramPtrs.reply = &reply
+	mmReplyToReview.defaultExpectation.expectationOrigins.originReply = minimock.CallerInfo(1)
+
+	return mmReplyToReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Inspect(f func(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string)) *mEventsServiceMockReplyToReview {
+	if mmReplyToReview.mock.inspectFuncReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.ReplyToReview")
+	}
+
+	mmReplyToReview.mock.inspectFuncReplyToReview = f
+
+	return mmReplyToReview
+}
+
+// Return sets up results that will be returned by EventsService.ReplyToReview
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Return(err error) *EventsServiceMock {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	if mmReplyToReview.defaultExpectation == nil {
+		mmReplyToReview.defaultExpectation = &EventsServiceMockReplyToReviewExpectation{mock: mmReplyToReview.mock}
+	}
+	mmReplyToReview.defaultExpectation.results = &EventsServiceMockReplyToReviewResults{err}
+	mmReplyToReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplyToReview.mock
+}
+
+// Set uses given function f to mock the EventsService.ReplyToReview method
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Set(f func(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) (err error)) *EventsServiceMock {
+	if mmReplyToReview.defaultExpectation != nil {
+		mmReplyToReview.mock.t.Fatalf("Default expectation is already set for the EventsService.ReplyToReview method")
+	}
+
+	if len(mmReplyToReview.expectations) > 0 {
+		mmReplyToReview.mock.t.Fatalf("Some expectations are already set for the EventsService.ReplyToReview method")
+	}
+
+	mmReplyToReview.mock.funcReplyToReview = f
+	mmReplyToReview.mock.funcReplyToReviewOrigin = minimock.CallerInfo(1)
+	return mmReplyToReview.mock
+}
+
+// When sets expectation for the EventsService.ReplyToReview which will trigger the result defined by the following
+// Then helper
+func (mmReplyToReview *mEventsServiceMockReplyToReview) When(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) *EventsServiceMockReplyToReviewExpectation {
+	if mmReplyToReview.mock.funcReplyToReview != nil {
+		mmReplyToReview.mock.t.Fatalf("EventsServiceMock.ReplyToReview mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockReplyToReviewExpectation{
+		mock:               mmReplyToReview.mock,
+		params:             &EventsServiceMockReplyToReviewParams{ctx, userID, urlTitle, reviewID, reply},
+		expectationOrigins: EventsServiceMockReplyToReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReplyToReview.expectations = append(mmReplyToReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.ReplyToReview return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockReplyToReviewExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockReplyToReviewResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.ReplyToReview should be invoked
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Times(n uint64) *mEventsServiceMockReplyToReview {
+	if n == 0 {
+		mmReplyToReview.mock.t.Fatalf("Times of EventsServiceMock.ReplyToReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplyToReview.expectedInvocations, n)
+	mmReplyToReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplyToReview
+}
+
+func (mmReplyToReview *mEventsServiceMockReplyToReview) invocationsDone() bool {
+	if len(mmReplyToReview.expectations) == 0 && mmReplyToReview.defaultExpectation == nil && mmReplyToReview.mock.funcReplyToReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplyToReview.mock.afterReplyToReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplyToReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReplyToReview implements mm_service.EventsService
+func (mmReplyToReview *EventsServiceMock) ReplyToReview(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) (err error) {
+	mm_atomic.AddUint64(&mmReplyToReview.beforeReplyToReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplyToReview.afterReplyToReviewCounter, 1)
+
+	mmReplyToReview.t.Helper()
+
+	if mmReplyToReview.inspectFuncReplyToReview != nil {
+		mmReplyToReview.inspectFuncReplyToReview(ctx, userID, urlTitle, reviewID, reply)
+	}
+
+	mm_params := EventsServiceMockReplyToReviewParams{ctx, userID, urlTitle, reviewID, reply}
+
+	// Record call args
+	mmReplyToReview.ReplyToReviewMock.mutex.Lock()
+	mmReplyToReview.ReplyToReviewMock.callArgs = append(mmReplyToReview.ReplyToReviewMock.callArgs, &mm_params)
+	mmReplyToReview.ReplyToReviewMock.mutex.Unlock()
+
+	for _, e := range mmReplyToReview.ReplyToReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplyToReview.ReplyToReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplyToReview.ReplyToReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplyToReview.ReplyToReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmReplyToReview.ReplyToReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockReplyToReviewParams{ctx, userID, urlTitle, reviewID, reply}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.reviewID != nil && !minimock.Equal(*mm_want_ptrs.reviewID, mm_got.reviewID) {
+				mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameter reviewID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.originReviewID, *mm_want_ptrs.reviewID, mm_got.reviewID, minimock.Diff(*mm_want_ptrs.reviewID, mm_got.reviewID))
+			}
+
+			if mm_want_ptrs.reply != nil && !minimock.Equal(*mm_want_ptrs.reply, mm_got.reply) {
+				mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameter reply, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.originReply, *mm_want_ptrs.reply, mm_got.reply, minimock.Diff(*mm_want_ptrs.reply, mm_got.reply))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplyToReview.t.Errorf("EventsServiceMock.ReplyToReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplyToReview.ReplyToReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplyToReview.ReplyToReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplyToReview.t.Fatal("No results are set for the EventsServiceMock.ReplyToReview")
+		}
+		return (*mm_results).err
+	}
+	if mmReplyToReview.funcReplyToReview != nil {
+		return mmReplyToReview.funcReplyToReview(ctx, userID, urlTitle, reviewID, reply)
+	}
+	mmReplyToReview.t.Fatalf("Unexpected call to EventsServiceMock.ReplyToReview. %v %v %v %v %v", ctx, userID, urlTitle, reviewID, reply)
+	return
+}
+
+// ReplyToReviewAfterCounter returns a count of finished EventsServiceMock.ReplyToReview invocations
+func (mmReplyToReview *EventsServiceMock) ReplyToReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplyToReview.afterReplyToReviewCounter)
+}
+
+// ReplyToReviewBeforeCounter returns a count of EventsServiceMock.ReplyToReview invocations
+func (mmReplyToReview *EventsServiceMock) ReplyToReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplyToReview.beforeReplyToReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.ReplyToReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplyToReview *mEventsServiceMockReplyToReview) Calls() []*EventsServiceMockReplyToReviewParams {
+	mmReplyToReview.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockReplyToReviewParams, len(mmReplyToReview.callArgs))
+	copy(argCopy, mmReplyToReview.callArgs)
+
+	mmReplyToReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplyToReviewDone returns true if the count of the ReplyToReview invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockReplyToReviewDone() bool {
+	if m.ReplyToReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplyToReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplyToReviewMock.invocationsDone()
+}
+
+// MinimockReplyToReviewInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockReplyToReviewInspect() {
+	for _, e := range m.ReplyToReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.ReplyToReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReplyToReviewCounter := mm_atomic.LoadUint64(&m.afterReplyToReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplyToReviewMock.defaultExpectation != nil && afterReplyToReviewCounter < 1 {
+		if m.ReplyToReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.ReplyToReview at\n%s", m.ReplyToReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.ReplyToReview at\n%s with params: %#v", m.ReplyToReviewMock.defaultExpectation.expectationOrigins.origin, *m.ReplyToReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplyToReview != nil && afterReplyToReviewCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.ReplyToReview at\n%s", m.funcReplyToReviewOrigin)
+	}
+
+	if !m.ReplyToReviewMock.invocationsDone() && afterReplyToReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.ReplyToReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplyToReviewMock.expectedInvocations), m.ReplyToReviewMock.expectedInvocationsOrigin, afterReplyToReviewCounter)
+	}
+}
+
+type mEventsServiceMockReviews struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockReviewsExpectation
+	expectations       []*EventsServiceMockReviewsExpectation
+
+	callArgs []*EventsServiceMockReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockReviewsExpectation specifies expectation struct of the EventsService.Reviews
+type EventsServiceMockReviewsExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockReviewsParams
+	paramPtrs          *EventsServiceMockReviewsParamPtrs
+	expectationOrigins EventsServiceMockReviewsExpectationOrigins
+	results            *EventsServiceMockReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockReviewsParams contains parameters of the EventsService.Reviews
+type EventsServiceMockReviewsParams struct {
+	ctx      context.Context
+	urlTitle string
+}
+
+// EventsServiceMockReviewsParamPtrs contains pointers to parameters of the EventsService.Reviews
+type EventsServiceMockReviewsParamPtrs struct {
+	ctx      *context.Context
+	urlTitle *string
+}
+
+// EventsServiceMockReviewsResults contains results of the EventsService.Reviews
+type EventsServiceMockReviewsResults struct {
+	rpa1 []*models.Review
+	err  error
+}
+
+// EventsServiceMockReviewsOrigins contains origins of expectations of the EventsService.Reviews
+type EventsServiceMockReviewsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReviews *mEventsServiceMockReviews) Optional() *mEventsServiceMockReviews {
+	mmReviews.optional = true
+	return mmReviews
+}
+
+// Expect sets up expected params for EventsService.Reviews
+func (mmReviews *mEventsServiceMockReviews) Expect(ctx context.Context, urlTitle string) *mEventsServiceMockReviews {
+	if mmReviews.mock.funcReviews != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Set")
+	}
+
+	if mmReviews.defaultExpectation == nil {
+		mmReviews.defaultExpectation = &EventsServiceMockReviewsExpectation{}
+	}
+
+	if mmReviews.defaultExpectation.paramPtrs != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by ExpectParams functions")
+	}
+
+	mmReviews.defaultExpectation.params = &EventsServiceMockReviewsParams{ctx, urlTitle}
+	mmReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReviews.expectations {
+		if minimock.Equal(e.params, mmReviews.defaultExpectation.params) {
+			mmReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.Reviews
+func (mmReviews *mEventsServiceMockReviews) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockReviews {
+	if mmReviews.mock.funcReviews != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Set")
+	}
+
+	if mmReviews.defaultExpectation == nil {
+		mmReviews.defaultExpectation = &EventsServiceMockReviewsExpectation{}
+	}
+
+	if mmReviews.defaultExpectation.params != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Expect")
+	}
+
+	if mmReviews.defaultExpectation.paramPtrs == nil {
+		mmReviews.defaultExpectation.paramPtrs = &EventsServiceMockReviewsParamPtrs{}
+	}
+	mmReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReviews
+}
+
+// ExpectUrlTitleParam2 sets up expected param urlTitle for EventsService.Reviews
+func (mmReviews *mEventsServiceMockReviews) ExpectUrlTitleParam2(urlTitle string) *mEventsServiceMockReviews {
+	if mmReviews.mock.funcReviews != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Set")
+	}
+
+	if mmReviews.defaultExpectation == nil {
+		mmReviews.defaultExpectation = &EventsServiceMockReviewsExpectation{}
+	}
+
+	if mmReviews.defaultExpectation.params != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Expect")
+	}
+
+	if mmReviews.defaultExpectation.paramPtrs == nil {
+		mmReviews.defaultExpectation.paramPtrs = &EventsServiceMockReviewsParamPtrs{}
+	}
+	mmReviews.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmReviews.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.Reviews
+func (mmReviews *mEventsServiceMockReviews) Inspect(f func(ctx context.Context, urlTitle string)) *mEventsServiceMockReviews {
+	if mmReviews.mock.inspectFuncReviews != nil {
+		mmReviews.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Reviews")
+	}
+
+	mmReviews.mock.inspectFuncReviews = f
+
+	return mmReviews
+}
+
+// Return sets up results that will be returned by EventsService.Reviews
+func (mmReviews *mEventsServiceMockReviews) Return(rpa1 []*models.Review, err error) *EventsServiceMock {
+	if mmReviews.mock.funcReviews != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Set")
+	}
+
+	if mmReviews.defaultExpectation == nil {
+		mmReviews.defaultExpectation = &EventsServiceMockReviewsExpectation{mock: mmReviews.mock}
+	}
+	mmReviews.defaultExpectation.results = &EventsServiceMockReviewsResults{rpa1, err}
+	mmReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReviews.mock
+}
+
+// Set uses given function f to mock the EventsService.Reviews method
+func (mmReviews *mEventsServiceMockReviews) Set(f func(ctx context.Context, urlTitle string) (rpa1 []*models.Review, err error)) *EventsServiceMock {
+	if mmReviews.defaultExpectation != nil {
+		mmReviews.mock.t.Fatalf("Default expectation is already set for the EventsService.Reviews method")
+	}
+
+	if len(mmReviews.expectations) > 0 {
+		mmReviews.mock.t.Fatalf("Some expectations are already set for the EventsService.Reviews method")
+	}
+
+	mmReviews.mock.funcReviews = f
+	mmReviews.mock.funcReviewsOrigin = minimock.CallerInfo(1)
+	return mmReviews.mock
+}
+
+// When sets expectation for the EventsService.Reviews which will trigger the result defined by the following
+// Then helper
+func (mmReviews *mEventsServiceMockReviews) When(ctx context.Context, urlTitle string) *EventsServiceMockReviewsExpectation {
+	if mmReviews.mock.funcReviews != nil {
+		mmReviews.mock.t.Fatalf("EventsServiceMock.Reviews mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockReviewsExpectation{
+		mock:               mmReviews.mock,
+		params:             &EventsServiceMockReviewsParams{ctx, urlTitle},
+		expectationOrigins: EventsServiceMockReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReviews.expectations = append(mmReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.Reviews return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockReviewsExpectation) Then(rpa1 []*models.Review, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockReviewsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.Reviews should be invoked
+func (mmReviews *mEventsServiceMockReviews) Times(n uint64) *mEventsServiceMockReviews {
+	if n == 0 {
+		mmReviews.mock.t.Fatalf("Times of EventsServiceMock.Reviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReviews.expectedInvocations, n)
+	mmReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReviews
+}
+
+func (mmReviews *mEventsServiceMockReviews) invocationsDone() bool {
+	if len(mmReviews.expectations) == 0 && mmReviews.defaultExpectation == nil && mmReviews.mock.funcReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReviews.mock.afterReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reviews implements mm_service.EventsService
+func (mmReviews *EventsServiceMock) Reviews(ctx context.Context, urlTitle string) (rpa1 []*models.Review, err error) {
+	mm_atomic.AddUint64(&mmReviews.beforeReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmReviews.afterReviewsCounter, 1)
+
+	mmReviews.t.Helper()
+
+	if mmReviews.inspectFuncReviews != nil {
+		mmReviews.inspectFuncReviews(ctx, urlTitle)
+	}
+
+	mm_params := EventsServiceMockReviewsParams{ctx, urlTitle}
+
+	// Record call args
+	mmReviews.ReviewsMock.mutex.Lock()
+	mmReviews.ReviewsMock.callArgs = append(mmReviews.ReviewsMock.callArgs, &mm_params)
+	mmReviews.ReviewsMock.mutex.Unlock()
+
+	for _, e := range mmReviews.ReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmReviews.ReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReviews.ReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmReviews.ReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmReviews.ReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockReviewsParams{ctx, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReviews.t.Errorf("EventsServiceMock.Reviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReviews.ReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmReviews.t.Errorf("EventsServiceMock.Reviews got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReviews.ReviewsMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReviews.t.Errorf("EventsServiceMock.Reviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReviews.ReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReviews.ReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReviews.t.Fatal("No results are set for the EventsServiceMock.Reviews")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmReviews.funcReviews != nil {
+		return mmReviews.funcReviews(ctx, urlTitle)
+	}
+	mmReviews.t.Fatalf("Unexpected call to EventsServiceMock.Reviews. %v %v", ctx, urlTitle)
+	return
+}
+
+// ReviewsAfterCounter returns a count of finished EventsServiceMock.Reviews invocations
+func (mmReviews *EventsServiceMock) ReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReviews.afterReviewsCounter)
+}
+
+// ReviewsBeforeCounter returns a count of EventsServiceMock.Reviews invocations
+func (mmReviews *EventsServiceMock) ReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReviews.beforeReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.Reviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReviews *mEventsServiceMockReviews) Calls() []*EventsServiceMockReviewsParams {
+	mmReviews.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockReviewsParams, len(mmReviews.callArgs))
+	copy(argCopy, mmReviews.callArgs)
+
+	mmReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReviewsDone returns true if the count of the Reviews invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockReviewsDone() bool {
+	if m.ReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReviewsMock.invocationsDone()
+}
+
+// MinimockReviewsInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockReviewsInspect() {
+	for _, e := range m.ReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.Reviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReviewsCounter := mm_atomic.LoadUint64(&m.afterReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReviewsMock.defaultExpectation != nil && afterReviewsCounter < 1 {
+		if m.ReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.Reviews at\n%s", m.ReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.Reviews at\n%s with params: %#v", m.ReviewsMock.defaultExpectation.expectationOrigins.origin, *m.ReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReviews != nil && afterReviewsCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.Reviews at\n%s", m.funcReviewsOrigin)
+	}
+
+	if !m.ReviewsMock.invocationsDone() && afterReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.Reviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReviewsMock.expectedInvocations), m.ReviewsMock.expectedInvocationsOrigin, afterReviewsCounter)
+	}
+}
+
+type mEventsServiceMockUnfollowOrganizer struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUnfollowOrganizerExpectation
+	expectations       []*EventsServiceMockUnfollowOrganizerExpectation
+
+	callArgs []*EventsServiceMockUnfollowOrganizerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUnfollowOrganizerExpectation specifies expectation struct of the EventsService.UnfollowOrganizer
+type EventsServiceMockUnfollowOrganizerExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUnfollowOrganizerParams
+	paramPtrs          *EventsServiceMockUnfollowOrganizerParamPtrs
+	expectationOrigins EventsServiceMockUnfollowOrganizerExpectationOrigins
+	results            *EventsServiceMockUnfollowOrganizerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUnfollowOrganizerParams contains parameters of the EventsService.UnfollowOrganizer
+type EventsServiceMockUnfollowOrganizerParams struct {
+	ctx         context.Context
+	userID      int64
+	organizerID int64
+}
+
+// EventsServiceMockUnfollowOrganizerParamPtrs contains pointers to parameters of the EventsService.UnfollowOrganizer
+type EventsServiceMockUnfollowOrganizerParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	organizerID *int64
+}
+
+// EventsServiceMockUnfollowOrganizerResults contains results of the EventsService.UnfollowOrganizer
+type EventsServiceMockUnfollowOrganizerResults struct {
+	err error
+}
+
+// EventsServiceMockUnfollowOrganizerOrigins contains origins of expectations of the EventsService.UnfollowOrganizer
+type EventsServiceMockUnfollowOrganizerExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originOrganizerID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Optional() *mEventsServiceMockUnfollowOrganizer {
+	mmUnfollowOrganizer.optional = true
+	return mmUnfollowOrganizer
+}
+
+// Expect sets up expected params for EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Expect(ctx context.Context, userID int64, organizerID int64) *mEventsServiceMockUnfollowOrganizer {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation == nil {
+		mmUnfollowOrganizer.defaultExpectation = &EventsServiceMockUnfollowOrganizerExpectation{}
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.paramPtrs != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by ExpectParams functions")
+	}
+
+	mmUnfollowOrganizer.defaultExpectation.params = &EventsServiceMockUnfollowOrganizerParams{ctx, userID, organizerID}
+	mmUnfollowOrganizer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnfollowOrganizer.expectations {
+		if minimock.Equal(e.params, mmUnfollowOrganizer.defaultExpectation.params) {
+			mmUnfollowOrganizer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnfollowOrganizer.defaultExpectation.params)
+		}
+	}
+
+	return mmUnfollowOrganizer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUnfollowOrganizer {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation == nil {
+		mmUnfollowOrganizer.defaultExpectation = &EventsServiceMockUnfollowOrganizerExpectation{}
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.params != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Expect")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmUnfollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockUnfollowOrganizerParamPtrs{}
+	}
+	mmUnfollowOrganizer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnfollowOrganizer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnfollowOrganizer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) ExpectUserIDParam2(userID int64) *mEventsServiceMockUnfollowOrganizer {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation == nil {
+		mmUnfollowOrganizer.defaultExpectation = &EventsServiceMockUnfollowOrganizerExpectation{}
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.params != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Expect")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmUnfollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockUnfollowOrganizerParamPtrs{}
+	}
+	mmUnfollowOrganizer.defaultExpectation.paramPtrs.userID = &userID
+	mmUnfollowOrganizer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUnfollowOrganizer
+}
+
+// ExpectOrganizerIDParam3 sets up expected param organizerID for EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) ExpectOrganizerIDParam3(organizerID int64) *mEventsServiceMockUnfollowOrganizer {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation == nil {
+		mmUnfollowOrganizer.defaultExpectation = &EventsServiceMockUnfollowOrganizerExpectation{}
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.params != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Expect")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation.paramPtrs == nil {
+		mmUnfollowOrganizer.defaultExpectation.paramPtrs = &EventsServiceMockUnfollowOrganizerParamPtrs{}
+	}
+	mmUnfollowOrganizer.defaultExpectation.paramPtrs.organizerID = &organizerID
+	mmUnfollowOrganizer.defaultExpectation.expectationOrigins.originOrganizerID = minimock.CallerInfo(1)
+
+	return mmUnfollowOrganizer
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Inspect(f func(ctx context.Context, userID int64, organizerID int64)) *mEventsServiceMockUnfollowOrganizer {
+	if mmUnfollowOrganizer.mock.inspectFuncUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UnfollowOrganizer")
+	}
+
+	mmUnfollowOrganizer.mock.inspectFuncUnfollowOrganizer = f
+
+	return mmUnfollowOrganizer
+}
+
+// Return sets up results that will be returned by EventsService.UnfollowOrganizer
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Return(err error) *EventsServiceMock {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	if mmUnfollowOrganizer.defaultExpectation == nil {
+		mmUnfollowOrganizer.defaultExpectation = &EventsServiceMockUnfollowOrganizerExpectation{mock: mmUnfollowOrganizer.mock}
+	}
+	mmUnfollowOrganizer.defaultExpectation.results = &EventsServiceMockUnfollowOrganizerResults{err}
+	mmUnfollowOrganizer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnfollowOrganizer.mock
+}
+
+// Set uses given function f to mock the EventsService.UnfollowOrganizer method
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Set(f func(ctx context.Context, userID int64, organizerID int64) (err error)) *EventsServiceMock {
+	if mmUnfollowOrganizer.defaultExpectation != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("Default expectation is already set for the EventsService.UnfollowOrganizer method")
+	}
+
+	if len(mmUnfollowOrganizer.expectations) > 0 {
+		mmUnfollowOrganizer.mock.t.Fatalf("Some expectations are already set for the EventsService.UnfollowOrganizer method")
+	}
+
+	mmUnfollowOrganizer.mock.funcUnfollowOrganizer = f
+	mmUnfollowOrganizer.mock.funcUnfollowOrganizerOrigin = minimock.CallerInfo(1)
+	return mmUnfollowOrganizer.mock
+}
+
+// When sets expectation for the EventsService.UnfollowOrganizer which will trigger the result defined by the following
+// Then helper
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) When(ctx context.Context, userID int64, organizerID int64) *EventsServiceMockUnfollowOrganizerExpectation {
+	if mmUnfollowOrganizer.mock.funcUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.mock.t.Fatalf("EventsServiceMock.UnfollowOrganizer mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockUnfollowOrganizerExpectation{
+		mock:               mmUnfollowOrganizer.mock,
+		params:             &EventsServiceMockUnfollowOrganizerParams{ctx, userID, organizerID},
+		expectationOrigins: EventsServiceMockUnfollowOrganizerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUnfollowOrganizer.expectations = append(mmUnfollowOrganizer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.UnfollowOrganizer return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUnfollowOrganizerExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUnfollowOrganizerResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.UnfollowOrganizer should be invoked
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Times(n uint64) *mEventsServiceMockUnfollowOrganizer {
+	if n == 0 {
+		mmUnfollowOrganizer.mock.t.Fatalf("Times of EventsServiceMock.UnfollowOrganizer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnfollowOrganizer.expectedInvocations, n)
+	mmUnfollowOrganizer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnfollowOrganizer
+}
+
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) invocationsDone() bool {
+	if len(mmUnfollowOrganizer.expectations) == 0 && mmUnfollowOrganizer.defaultExpectation == nil && mmUnfollowOrganizer.mock.funcUnfollowOrganizer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnfollowOrganizer.mock.afterUnfollowOrganizerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnfollowOrganizer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnfollowOrganizer implements mm_service.EventsService
+func (mmUnfollowOrganizer *EventsServiceMock) UnfollowOrganizer(ctx context.Context, userID int64, organizerID int64) (err error) {
+	mm_atomic.AddUint64(&mmUnfollowOrganizer.beforeUnfollowOrganizerCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnfollowOrganizer.afterUnfollowOrganizerCounter, 1)
+
+	mmUnfollowOrganizer.t.Helper()
+
+	if mmUnfollowOrganizer.inspectFuncUnfollowOrganizer != nil {
+		mmUnfollowOrganizer.inspectFuncUnfollowOrganizer(ctx, userID, organizerID)
+	}
+
+	mm_params := EventsServiceMockUnfollowOrganizerParams{ctx, userID, organizerID}
+
+	// Record call args
+	mmUnfollowOrganizer.UnfollowOrganizerMock.mutex.Lock()
+	mmUnfollowOrganizer.UnfollowOrganizerMock.callArgs = append(mmUnfollowOrganizer.UnfollowOrganizerMock.callArgs, &mm_params)
+	mmUnfollowOrganizer.UnfollowOrganizerMock.mutex.Unlock()
+
+	for _, e := range mmUnfollowOrganizer.UnfollowOrganizerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.params
+		mm_want_ptrs := mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockUnfollowOrganizerParams{ctx, userID, organizerID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnfollowOrganizer.t.Errorf("EventsServiceMock.UnfollowOrganizer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUnfollowOrganizer.t.Errorf("EventsServiceMock.UnfollowOrganizer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.organizerID != nil && !minimock.Equal(*mm_want_ptrs.organizerID, mm_got.organizerID) {
+				mmUnfollowOrganizer.t.Errorf("EventsServiceMock.UnfollowOrganizer got unexpected parameter organizerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.expectationOrigins.originOrganizerID, *mm_want_ptrs.organizerID, mm_got.organizerID, minimock.Diff(*mm_want_ptrs.organizerID, mm_got.organizerID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnfollowOrganizer.t.Errorf("EventsServiceMock.UnfollowOrganizer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnfollowOrganizer.UnfollowOrganizerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnfollowOrganizer.t.Fatal("No results are set for the EventsServiceMock.UnfollowOrganizer")
+		}
+		return (*mm_results).err
+	}
+	if mmUnfollowOrganizer.funcUnfollowOrganizer != nil {
+		return mmUnfollowOrganizer.funcUnfollowOrganizer(ctx, userID, organizerID)
+	}
+	mmUnfollowOrganizer.t.Fatalf("Unexpected call to EventsServiceMock.UnfollowOrganizer. %v %v %v", ctx, userID, organizerID)
+	return
+}
+
+// UnfollowOrganizerAfterCounter returns a count of finished EventsServiceMock.UnfollowOrganizer invocations
+func (mmUnfollowOrganizer *EventsServiceMock) UnfollowOrganizerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnfollowOrganizer.afterUnfollowOrganizerCounter)
+}
+
+// UnfollowOrganizerBeforeCounter returns a count of EventsServiceMock.UnfollowOrganizer invocations
+func (mmUnfollowOrganizer *EventsServiceMock) UnfollowOrganizerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnfollowOrganizer.beforeUnfollowOrganizerCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.UnfollowOrganizer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnfollowOrganizer *mEventsServiceMockUnfollowOrganizer) Calls() []*EventsServiceMockUnfollowOrganizerParams {
+	mmUnfollowOrganizer.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockUnfollowOrganizerParams, len(mmUnfollowOrganizer.callArgs))
+	copy(argCopy, mmUnfollowOrganizer.callArgs)
+
+	mmUnfollowOrganizer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnfollowOrganizerDone returns true if the count of the UnfollowOrganizer invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockUnfollowOrganizerDone() bool {
+	if m.UnfollowOrganizerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnfollowOrganizerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnfollowOrganizerMock.invocationsDone()
+}
+
+// MinimockUnfollowOrganizerInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUnfollowOrganizerInspect() {
+	for _, e := range m.UnfollowOrganizerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.UnfollowOrganizer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUnfollowOrganizerCounter := mm_atomic.LoadUint64(&m.afterUnfollowOrganizerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnfollowOrganizerMock.defaultExpectation != nil && afterUnfollowOrganizerCounter < 1 {
+		if m.UnfollowOrganizerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UnfollowOrganizer at\n%s", m.UnfollowOrganizerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.UnfollowOrganizer at\n%s with params: %#v", m.UnfollowOrganizerMock.defaultExpectation.expectationOrigins.origin, *m.UnfollowOrganizerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnfollowOrganizer != nil && afterUnfollowOrganizerCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UnfollowOrganizer at\n%s", m.funcUnfollowOrganizerOrigin)
+	}
+
+	if !m.UnfollowOrganizerMock.invocationsDone() && afterUnfollowOrganizerCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UnfollowOrganizer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnfollowOrganizerMock.expectedInvocations), m.UnfollowOrganizerMock.expectedInvocationsOrigin, afterUnfollowOrganizerCounter)
+	}
+}
+
+type mEventsServiceMockUpdateEvent struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUpdateEventExpectation
+	expectations       []*EventsServiceMockUpdateEventExpectation
+
+	callArgs []*EventsServiceMockUpdateEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUpdateEventExpectation specifies expectation struct of the EventsService.UpdateEvent
+type EventsServiceMockUpdateEventExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUpdateEventParams
+	paramPtrs          *EventsServiceMockUpdateEventParamPtrs
+	expectationOrigins EventsServiceMockUpdateEventExpectationOrigins
+	results            *EventsServiceMockUpdateEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUpdateEventParams contains parameters of the EventsService.UpdateEvent
+type EventsServiceMockUpdateEventParams struct {
+	ctx    context.Context
+	userID int64
+	event  *models.Event
+}
+
+// EventsServiceMockUpdateEventParamPtrs contains pointers to parameters of the EventsService.UpdateEvent
+type EventsServiceMockUpdateEventParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	event  **models.Event
+}
+
+// EventsServiceMockUpdateEventResults contains results of the EventsService.UpdateEvent
+type EventsServiceMockUpdateEventResults struct {
+	err error
+}
+
+// EventsServiceMockUpdateEventOrigins contains origins of expectations of the EventsService.UpdateEvent
+type EventsServiceMockUpdateEventExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originEvent  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Optional() *mEventsServiceMockUpdateEvent {
+	mmUpdateEvent.optional = true
+	return mmUpdateEvent
+}
+
+// Expect sets up expected params for EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Expect(ctx context.Context, userID int64, event *models.Event) *mEventsServiceMockUpdateEvent {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	if mmUpdateEvent.defaultExpectation == nil {
+		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	}
+
+	if mmUpdateEvent.defaultExpectation.paramPtrs != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateEvent.defaultExpectation.params = &EventsServiceMockUpdateEventParams{ctx, userID, event}
+	mmUpdateEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateEvent.expectations {
+		if minimock.Equal(e.params, mmUpdateEvent.defaultExpectation.params) {
+			mmUpdateEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateEvent {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	if mmUpdateEvent.defaultExpectation == nil {
+		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	}
+
+	if mmUpdateEvent.defaultExpectation.params != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	}
+
+	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
+		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	}
+	mmUpdateEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateEvent
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateEvent {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	if mmUpdateEvent.defaultExpectation == nil {
+		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	}
+
+	if mmUpdateEvent.defaultExpectation.params != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	}
+
+	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
+		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	}
+	mmUpdateEvent.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateEvent
+}
+
+// ExpectEventParam3 sets up expected param event for EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectEventParam3(event *models.Event) *mEventsServiceMockUpdateEvent {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	if mmUpdateEvent.defaultExpectation == nil {
+		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	}
+
+	if mmUpdateEvent.defaultExpectation.params != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	}
+
+	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
+		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	}
+	mmUpdateEvent.defaultExpectation.paramPtrs.event = &event
+	mmUpdateEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmUpdateEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Inspect(f func(ctx context.Context, userID int64, event *models.Event)) *mEventsServiceMockUpdateEvent {
+	if mmUpdateEvent.mock.inspectFuncUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateEvent")
+	}
+
+	mmUpdateEvent.mock.inspectFuncUpdateEvent = f
+
+	return mmUpdateEvent
+}
+
+// Return sets up results that will be returned by EventsService.UpdateEvent
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Return(err error) *EventsServiceMock {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	if mmUpdateEvent.defaultExpectation == nil {
+		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{mock: mmUpdateEvent.mock}
+	}
+	mmUpdateEvent.defaultExpectation.results = &EventsServiceMockUpdateEventResults{err}
+	mmUpdateEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateEvent.mock
+}
+
+// Set uses given function f to mock the EventsService.UpdateEvent method
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Set(f func(ctx context.Context, userID int64, event *models.Event) (err error)) *EventsServiceMock {
+	if mmUpdateEvent.defaultExpectation != nil {
+		mmUpdateEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateEvent method")
+	}
+
+	if len(mmUpdateEvent.expectations) > 0 {
+		mmUpdateEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateEvent method")
+	}
+
+	mmUpdateEvent.mock.funcUpdateEvent = f
+	mmUpdateEvent.mock.funcUpdateEventOrigin = minimock.CallerInfo(1)
+	return mmUpdateEvent.mock
+}
+
+// When sets expectation for the EventsService.UpdateEvent which will trigger the result defined by the following
+// Then helper
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) When(ctx context.Context, userID int64, event *models.Event) *EventsServiceMockUpdateEventExpectation {
+	if mmUpdateEvent.mock.funcUpdateEvent != nil {
+		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockUpdateEventExpectation{
+		mock:               mmUpdateEvent.mock,
+		params:             &EventsServiceMockUpdateEventParams{ctx, userID, event},
+		expectationOrigins: EventsServiceMockUpdateEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateEvent.expectations = append(mmUpdateEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.UpdateEvent return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateEventExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.UpdateEvent should be invoked
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Times(n uint64) *mEventsServiceMockUpdateEvent {
+	if n == 0 {
+		mmUpdateEvent.mock.t.Fatalf("Times of EventsServiceMock.UpdateEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateEvent.expectedInvocations, n)
+	mmUpdateEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateEvent
+}
+
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) invocationsDone() bool {
+	if len(mmUpdateEvent.expectations) == 0 && mmUpdateEvent.defaultExpectation == nil && mmUpdateEvent.mock.funcUpdateEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEvent.mock.afterUpdateEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateEvent implements mm_service.EventsService
+func (mmUpdateEvent *EventsServiceMock) UpdateEvent(ctx context.Context, userID int64, event *models.Event) (err error) {
+	mm_atomic.AddUint64(&mmUpdateEvent.beforeUpdateEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateEvent.afterUpdateEventCounter, 1)
+
+	mmUpdateEvent.t.Helper()
+
+	if mmUpdateEvent.inspectFuncUpdateEvent != nil {
+		mmUpdateEvent.inspectFuncUpdateEvent(ctx, userID, event)
+	}
+
+	mm_params := EventsServiceMockUpdateEventParams{ctx, userID, event}
+
+	// Record call args
+	mmUpdateEvent.UpdateEventMock.mutex.Lock()
+	mmUpdateEvent.UpdateEventMock.callArgs = append(mmUpdateEvent.UpdateEventMock.callArgs, &mm_params)
+	mmUpdateEvent.UpdateEventMock.mutex.Unlock()
+
+	for _, e := range mmUpdateEvent.UpdateEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateEvent.UpdateEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateEvent.UpdateEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateEvent.UpdateEventMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateEvent.UpdateEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockUpdateEventParams{ctx, userID, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateEvent.UpdateEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateEvent.t.Fatal("No results are set for the EventsServiceMock.UpdateEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateEvent.funcUpdateEvent != nil {
+		return mmUpdateEvent.funcUpdateEvent(ctx, userID, event)
+	}
+	mmUpdateEvent.t.Fatalf("Unexpected call to EventsServiceMock.UpdateEvent. %v %v %v", ctx, userID, event)
+	return
+}
+
+// UpdateEventAfterCounter returns a count of finished EventsServiceMock.UpdateEvent invocations
+func (mmUpdateEvent *EventsServiceMock) UpdateEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEvent.afterUpdateEventCounter)
+}
+
+// UpdateEventBeforeCounter returns a count of EventsServiceMock.UpdateEvent invocations
+func (mmUpdateEvent *EventsServiceMock) UpdateEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEvent.beforeUpdateEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Calls() []*EventsServiceMockUpdateEventParams {
+	mmUpdateEvent.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockUpdateEventParams, len(mmUpdateEvent.callArgs))
+	copy(argCopy, mmUpdateEvent.callArgs)
+
+	mmUpdateEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateEventDone returns true if the count of the UpdateEvent invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockUpdateEventDone() bool {
+	if m.UpdateEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateEventMock.invocationsDone()
+}
+
+// MinimockUpdateEventInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateEventInspect() {
+	for _, e := range m.UpdateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateEventCounter := mm_atomic.LoadUint64(&m.afterUpdateEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateEventMock.defaultExpectation != nil && afterUpdateEventCounter < 1 {
+		if m.UpdateEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s", m.UpdateEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s with params: %#v", m.UpdateEventMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateEvent != nil && afterUpdateEventCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s", m.funcUpdateEventOrigin)
+	}
+
+	if !m.UpdateEventMock.invocationsDone() && afterUpdateEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateEventMock.expectedInvocations), m.UpdateEventMock.expectedInvocationsOrigin, afterUpdateEventCounter)
+	}
+}
+
+type mEventsServiceMockUpdateEventImage struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUpdateEventImageExpectation
+	expectations       []*EventsServiceMockUpdateEventImageExpectation
+
+	callArgs []*EventsServiceMockUpdateEventImageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUpdateEventImageExpectation specifies expectation struct of the EventsService.UpdateEventImage
+type EventsServiceMockUpdateEventImageExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUpdateEventImageParams
+	paramPtrs          *EventsServiceMockUpdateEventImageParamPtrs
+	expectationOrigins EventsServiceMockUpdateEventImageExpectationOrigins
+	results            *EventsServiceMockUpdateEventImageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUpdateEventImageParams contains parameters of the EventsService.UpdateEventImage
+type EventsServiceMockUpdateEventImageParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	imageID  int64
+	req      *models.UpdateEventImageRequest
+}
+
+// EventsServiceMockUpdateEventImageParamPtrs contains pointers to parameters of the EventsService.UpdateEventImage
+type EventsServiceMockUpdateEventImageParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	imageID  *int64
+	req      **models.UpdateEventImageRequest
+}
+
+// EventsServiceMockUpdateEventImageResults contains results of the EventsService.UpdateEventImage
+type EventsServiceMockUpdateEventImageResults struct {
+	err error
+}
+
+// EventsServiceMockUpdateEventImageOrigins contains origins of expectations of the EventsService.UpdateEventImage
+type EventsServiceMockUpdateEventImageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originImageID  string
+	originReq      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Optional() *mEventsServiceMockUpdateEventImage {
+	mmUpdateEventImage.optional = true
+	return mmUpdateEventImage
+}
+
+// Expect sets up expected params for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Expect(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateEventImage.defaultExpectation.params = &EventsServiceMockUpdateEventImageParams{ctx, userID, urlTitle, imageID, req}
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateEventImage.expectations {
+		if minimock.Equal(e.params, mmUpdateEventImage.defaultExpectation.params) {
+			mmUpdateEventImage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEventImage.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateEventImage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.params != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Expect")
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventImage.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventImageParamPtrs{}
+	}
+	mmUpdateEventImage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateEventImage
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.params != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Expect")
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventImage.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventImageParamPtrs{}
+	}
+	mmUpdateEventImage.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateEventImage
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.params != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Expect")
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventImage.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventImageParamPtrs{}
+	}
+	mmUpdateEventImage.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmUpdateEventImage
+}
+
+// ExpectImageIDParam4 sets up expected param imageID for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) ExpectImageIDParam4(imageID int64) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.params != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Expect")
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventImage.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventImageParamPtrs{}
+	}
+	mmUpdateEventImage.defaultExpectation.paramPtrs.imageID = &imageID
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.originImageID = minimock.CallerInfo(1)
+
+	return mmUpdateEventImage
+}
+
+// ExpectReqParam5 sets up expected param req for EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) ExpectReqParam5(req *models.UpdateEventImageRequest) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{}
+	}
+
+	if mmUpdateEventImage.defaultExpectation.params != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Expect")
+	}
+
+	if mmUpdateEventImage.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventImage.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventImageParamPtrs{}
+	}
+	mmUpdateEventImage.defaultExpectation.paramPtrs.req = &req
+	mmUpdateEventImage.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdateEventImage
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Inspect(f func(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest)) *mEventsServiceMockUpdateEventImage {
+	if mmUpdateEventImage.mock.inspectFuncUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateEventImage")
+	}
+
+	mmUpdateEventImage.mock.inspectFuncUpdateEventImage = f
+
+	return mmUpdateEventImage
+}
+
+// Return sets up results that will be returned by EventsService.UpdateEventImage
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Return(err error) *EventsServiceMock {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	if mmUpdateEventImage.defaultExpectation == nil {
+		mmUpdateEventImage.defaultExpectation = &EventsServiceMockUpdateEventImageExpectation{mock: mmUpdateEventImage.mock}
+	}
+	mmUpdateEventImage.defaultExpectation.results = &EventsServiceMockUpdateEventImageResults{err}
+	mmUpdateEventImage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventImage.mock
+}
+
+// Set uses given function f to mock the EventsService.UpdateEventImage method
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Set(f func(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) (err error)) *EventsServiceMock {
+	if mmUpdateEventImage.defaultExpectation != nil {
+		mmUpdateEventImage.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateEventImage method")
+	}
+
+	if len(mmUpdateEventImage.expectations) > 0 {
+		mmUpdateEventImage.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateEventImage method")
+	}
+
+	mmUpdateEventImage.mock.funcUpdateEventImage = f
+	mmUpdateEventImage.mock.funcUpdateEventImageOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventImage.mock
+}
+
+// When sets expectation for the EventsService.UpdateEventImage which will trigger the result defined by the following
+// Then helper
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) When(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) *EventsServiceMockUpdateEventImageExpectation {
+	if mmUpdateEventImage.mock.funcUpdateEventImage != nil {
+		mmUpdateEventImage.mock.t.Fatalf("EventsServiceMock.UpdateEventImage mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockUpdateEventImageExpectation{
+		mock:               mmUpdateEventImage.mock,
+		params:             &EventsServiceMockUpdateEventImageParams{ctx, userID, urlTitle, imageID, req},
+		expectationOrigins: EventsServiceMockUpdateEventImageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateEventImage.expectations = append(mmUpdateEventImage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.UpdateEventImage return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateEventImageExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateEventImageResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.UpdateEventImage should be invoked
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Times(n uint64) *mEventsServiceMockUpdateEventImage {
+	if n == 0 {
+		mmUpdateEventImage.mock.t.Fatalf("Times of EventsServiceMock.UpdateEventImage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateEventImage.expectedInvocations, n)
+	mmUpdateEventImage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventImage
+}
+
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) invocationsDone() bool {
+	if len(mmUpdateEventImage.expectations) == 0 && mmUpdateEventImage.defaultExpectation == nil && mmUpdateEventImage.mock.funcUpdateEventImage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEventImage.mock.afterUpdateEventImageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEventImage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateEventImage implements mm_service.EventsService
+func (mmUpdateEventImage *EventsServiceMock) UpdateEventImage(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) (err error) {
+	mm_atomic.AddUint64(&mmUpdateEventImage.beforeUpdateEventImageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateEventImage.afterUpdateEventImageCounter, 1)
+
+	mmUpdateEventImage.t.Helper()
+
+	if mmUpdateEventImage.inspectFuncUpdateEventImage != nil {
+		mmUpdateEventImage.inspectFuncUpdateEventImage(ctx, userID, urlTitle, imageID, req)
+	}
+
+	mm_params := EventsServiceMockUpdateEventImageParams{ctx, userID, urlTitle, imageID, req}
+
+	// Record call args
+	mmUpdateEventImage.UpdateEventImageMock.mutex.Lock()
+	mmUpdateEventImage.UpdateEventImageMock.callArgs = append(mmUpdateEventImage.UpdateEventImageMock.callArgs, &mm_params)
+	mmUpdateEventImage.UpdateEventImageMock.mutex.Unlock()
+
+	for _, e := range mmUpdateEventImage.UpdateEventImageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateEventImage.UpdateEventImageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockUpdateEventImageParams{ctx, userID, urlTitle, imageID, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.imageID != nil && !minimock.Equal(*mm_want_ptrs.imageID, mm_got.imageID) {
+				mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameter imageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.originImageID, *mm_want_ptrs.imageID, mm_got.imageID, minimock.Diff(*mm_want_ptrs.imageID, mm_got.imageID))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateEventImage.t.Errorf("EventsServiceMock.UpdateEventImage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateEventImage.UpdateEventImageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateEventImage.t.Fatal("No results are set for the EventsServiceMock.UpdateEventImage")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateEventImage.funcUpdateEventImage != nil {
+		return mmUpdateEventImage.funcUpdateEventImage(ctx, userID, urlTitle, imageID, req)
+	}
+	mmUpdateEventImage.t.Fatalf("Unexpected call to EventsServiceMock.UpdateEventImage. %v %v %v %v %v", ctx, userID, urlTitle, imageID, req)
+	return
+}
+
+// UpdateEventImageAfterCounter returns a count of finished EventsServiceMock.UpdateEventImage invocations
+func (mmUpdateEventImage *EventsServiceMock) UpdateEventImageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEventImage.afterUpdateEventImageCounter)
+}
+
+// UpdateEventImageBeforeCounter returns a count of EventsServiceMock.UpdateEventImage invocations
+func (mmUpdateEventImage *EventsServiceMock) UpdateEventImageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEventImage.beforeUpdateEventImageCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateEventImage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateEventImage *mEventsServiceMockUpdateEventImage) Calls() []*EventsServiceMockUpdateEventImageParams {
+	mmUpdateEventImage.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockUpdateEventImageParams, len(mmUpdateEventImage.callArgs))
+	copy(argCopy, mmUpdateEventImage.callArgs)
+
+	mmUpdateEventImage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateEventImageDone returns true if the count of the UpdateEventImage invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockUpdateEventImageDone() bool {
+	if m.UpdateEventImageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateEventImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateEventImageMock.invocationsDone()
+}
+
+// MinimockUpdateEventImageInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateEventImageInspect() {
+	for _, e := range m.UpdateEventImageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventImage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateEventImageCounter := mm_atomic.LoadUint64(&m.afterUpdateEventImageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateEventImageMock.defaultExpectation != nil && afterUpdateEventImageCounter < 1 {
+		if m.UpdateEventImageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventImage at\n%s", m.UpdateEventImageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventImage at\n%s with params: %#v", m.UpdateEventImageMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEventImageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateEventImage != nil && afterUpdateEventImageCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateEventImage at\n%s", m.funcUpdateEventImageOrigin)
+	}
+
+	if !m.UpdateEventImageMock.invocationsDone() && afterUpdateEventImageCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateEventImage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateEventImageMock.expectedInvocations), m.UpdateEventImageMock.expectedInvocationsOrigin, afterUpdateEventImageCounter)
+	}
+}
+
+type mEventsServiceMockUpdateEventSlug struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUpdateEventSlugExpectation
+	expectations       []*EventsServiceMockUpdateEventSlugExpectation
+
+	callArgs []*EventsServiceMockUpdateEventSlugParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUpdateEventSlugExpectation specifies expectation struct of the EventsService.UpdateEventSlug
+type EventsServiceMockUpdateEventSlugExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUpdateEventSlugParams
+	paramPtrs          *EventsServiceMockUpdateEventSlugParamPtrs
+	expectationOrigins EventsServiceMockUpdateEventSlugExpectationOrigins
+	results            *EventsServiceMockUpdateEventSlugResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUpdateEventSlugParams contains parameters of the EventsService.UpdateEventSlug
+type EventsServiceMockUpdateEventSlugParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	slug     string
+}
+
+// EventsServiceMockUpdateEventSlugParamPtrs contains pointers to parameters of the EventsService.UpdateEventSlug
+type EventsServiceMockUpdateEventSlugParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	slug     *string
+}
+
+// EventsServiceMockUpdateEventSlugResults contains results of the EventsService.UpdateEventSlug
+type EventsServiceMockUpdateEventSlugResults struct {
+	s1  string
+	err error
+}
+
+// EventsServiceMockUpdateEventSlugOrigins contains origins of expectations of the EventsService.UpdateEventSlug
+type EventsServiceMockUpdateEventSlugExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originSlug     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Optional() *mEventsServiceMockUpdateEventSlug {
+	mmUpdateEventSlug.optional = true
+	return mmUpdateEventSlug
+}
+
+// Expect sets up expected params for EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Expect(ctx context.Context, userID int64, urlTitle string, slug string) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{}
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.paramPtrs != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateEventSlug.defaultExpectation.params = &EventsServiceMockUpdateEventSlugParams{ctx, userID, urlTitle, slug}
+	mmUpdateEventSlug.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateEventSlug.expectations {
+		if minimock.Equal(e.params, mmUpdateEventSlug.defaultExpectation.params) {
+			mmUpdateEventSlug.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEventSlug.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateEventSlug
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{}
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.params != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Expect")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventSlug.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventSlugParamPtrs{}
+	}
+	mmUpdateEventSlug.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateEventSlug.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateEventSlug
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{}
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.params != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Expect")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventSlug.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventSlugParamPtrs{}
+	}
+	mmUpdateEventSlug.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateEventSlug.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateEventSlug
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{}
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.params != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Expect")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventSlug.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventSlugParamPtrs{}
+	}
+	mmUpdateEventSlug.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmUpdateEventSlug.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmUpdateEventSlug
+}
+
+// ExpectSlugParam4 sets up expected param slug for EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) ExpectSlugParam4(slug string) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{}
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.params != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Expect")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation.paramPtrs == nil {
+		mmUpdateEventSlug.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventSlugParamPtrs{}
+	}
+	mmUpdateEventSlug.defaultExpectation.paramPtrs.slug = &slug
+	mmUpdateEventSlug.defaultExpectation.expectationOrigins.originSlug = minimock.CallerInfo(1)
+
+	return mmUpdateEventSlug
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Inspect(f func(ctx context.Context, userID int64, urlTitle string, slug string)) *mEventsServiceMockUpdateEventSlug {
+	if mmUpdateEventSlug.mock.inspectFuncUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateEventSlug")
+	}
+
+	mmUpdateEventSlug.mock.inspectFuncUpdateEventSlug = f
+
+	return mmUpdateEventSlug
+}
+
+// Return sets up results that will be returned by EventsService.UpdateEventSlug
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Return(s1 string, err error) *EventsServiceMock {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	if mmUpdateEventSlug.defaultExpectation == nil {
+		mmUpdateEventSlug.defaultExpectation = &EventsServiceMockUpdateEventSlugExpectation{mock: mmUpdateEventSlug.mock}
+	}
+	mmUpdateEventSlug.defaultExpectation.results = &EventsServiceMockUpdateEventSlugResults{s1, err}
+	mmUpdateEventSlug.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventSlug.mock
+}
+
+// Set uses given function f to mock the EventsService.UpdateEventSlug method
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Set(f func(ctx context.Context, userID int64, urlTitle string, slug string) (s1 string, err error)) *EventsServiceMock {
+	if mmUpdateEventSlug.defaultExpectation != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateEventSlug method")
+	}
+
+	if len(mmUpdateEventSlug.expectations) > 0 {
+		mmUpdateEventSlug.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateEventSlug method")
+	}
+
+	mmUpdateEventSlug.mock.funcUpdateEventSlug = f
+	mmUpdateEventSlug.mock.funcUpdateEventSlugOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventSlug.mock
+}
+
+// When sets expectation for the EventsService.UpdateEventSlug which will trigger the result defined by the following
+// Then helper
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) When(ctx context.Context, userID int64, urlTitle string, slug string) *EventsServiceMockUpdateEventSlugExpectation {
+	if mmUpdateEventSlug.mock.funcUpdateEventSlug != nil {
+		mmUpdateEventSlug.mock.t.Fatalf("EventsServiceMock.UpdateEventSlug mock is already set by Set")
+	}
+
+	expectation := &EventsServiceMockUpdateEventSlugExpectation{
+		mock:               mmUpdateEventSlug.mock,
+		params:             &EventsServiceMockUpdateEventSlugParams{ctx, userID, urlTitle, slug},
+		expectationOrigins: EventsServiceMockUpdateEventSlugExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateEventSlug.expectations = append(mmUpdateEventSlug.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventsService.UpdateEventSlug return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateEventSlugExpectation) Then(s1 string, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateEventSlugResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.UpdateEventSlug should be invoked
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Times(n uint64) *mEventsServiceMockUpdateEventSlug {
+	if n == 0 {
+		mmUpdateEventSlug.mock.t.Fatalf("Times of EventsServiceMock.UpdateEventSlug mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateEventSlug.expectedInvocations, n)
+	mmUpdateEventSlug.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateEventSlug
+}
+
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) invocationsDone() bool {
+	if len(mmUpdateEventSlug.expectations) == 0 && mmUpdateEventSlug.defaultExpectation == nil && mmUpdateEventSlug.mock.funcUpdateEventSlug == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEventSlug.mock.afterUpdateEventSlugCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEventSlug.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateEventSlug implements mm_service.EventsService
+func (mmUpdateEventSlug *EventsServiceMock) UpdateEventSlug(ctx context.Context, userID int64, urlTitle string, slug string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmUpdateEventSlug.beforeUpdateEventSlugCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateEventSlug.afterUpdateEventSlugCounter, 1)
+
+	mmUpdateEventSlug.t.Helper()
+
+	if mmUpdateEventSlug.inspectFuncUpdateEventSlug != nil {
+		mmUpdateEventSlug.inspectFuncUpdateEventSlug(ctx, userID, urlTitle, slug)
+	}
+
+	mm_params := EventsServiceMockUpdateEventSlugParams{ctx, userID, urlTitle, slug}
+
+	// Record call args
+	mmUpdateEventSlug.UpdateEventSlugMock.mutex.Lock()
+	mmUpdateEventSlug.UpdateEventSlugMock.callArgs = append(mmUpdateEventSlug.UpdateEventSlugMock.callArgs, &mm_params)
+	mmUpdateEventSlug.UpdateEventSlugMock.mutex.Unlock()
+
+	for _, e := range mmUpdateEventSlug.UpdateEventSlugMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockUpdateEventSlugParams{ctx, userID, urlTitle, slug}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateEventSlug.t.Errorf("EventsServiceMock.UpdateEventSlug got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateEventSlug.t.Errorf("EventsServiceMock.UpdateEventSlug got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmUpdateEventSlug.t.Errorf("EventsServiceMock.UpdateEventSlug got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmUpdateEventSlug.t.Errorf("EventsServiceMock.UpdateEventSlug got unexpected parameter slug, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.expectationOrigins.originSlug, *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateEventSlug.t.Errorf("EventsServiceMock.UpdateEventSlug got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateEventSlug.UpdateEventSlugMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateEventSlug.t.Fatal("No results are set for the EventsServiceMock.UpdateEventSlug")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmUpdateEventSlug.funcUpdateEventSlug != nil {
+		return mmUpdateEventSlug.funcUpdateEventSlug(ctx, userID, urlTitle, slug)
+	}
+	mmUpdateEventSlug.t.Fatalf("Unexpected call to EventsServiceMock.UpdateEventSlug. %v %v %v %v", ctx, userID, urlTitle, slug)
+	return
+}
+
+// UpdateEventSlugAfterCounter returns a count of finished EventsServiceMock.UpdateEventSlug invocations
+func (mmUpdateEventSlug *EventsServiceMock) UpdateEventSlugAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEventSlug.afterUpdateEventSlugCounter)
+}
+
+// UpdateEventSlugBeforeCounter returns a count of EventsServiceMock.UpdateEventSlug invocations
+func (mmUpdateEventSlug *EventsServiceMock) UpdateEventSlugBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEventSlug.beforeUpdateEventSlugCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateEventSlug.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateEventSlug *mEventsServiceMockUpdateEventSlug) Calls() []*EventsServiceMockUpdateEventSlugParams {
+	mmUpdateEventSlug.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockUpdateEventSlugParams, len(mmUpdateEventSlug.callArgs))
+	copy(argCopy, mmUpdateEventSlug.callArgs)
+
+	mmUpdateEventSlug.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateEventSlugDone returns true if the count of the UpdateEventSlug invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockUpdateEventSlugDone() bool {
+	if m.UpdateEventSlugMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateEventSlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateEventSlugMock.invocationsDone()
+}
+
+// MinimockUpdateEventSlugInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateEventSlugInspect() {
+	for _, e := range m.UpdateEventSlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventSlug at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateEventSlugCounter := mm_atomic.LoadUint64(&m.afterUpdateEventSlugCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateEventSlugMock.defaultExpectation != nil && afterUpdateEventSlugCounter < 1 {
+		if m.UpdateEventSlugMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventSlug at\n%s", m.UpdateEventSlugMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateEventSlug at\n%s with params: %#v", m.UpdateEventSlugMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEventSlugMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateEventSlug != nil && afterUpdateEventSlugCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateEventSlug at\n%s", m.funcUpdateEventSlugOrigin)
+	}
+
+	if !m.UpdateEventSlugMock.invocationsDone() && afterUpdateEventSlugCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateEventSlug at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateEventSlugMock.expectedInvocations), m.UpdateEventSlugMock.expectedInvocationsOrigin, afterUpdateEventSlugCounter)
+	}
+}
+
+type mEventsServiceMockUpdateOrganizerProfile struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUpdateOrganizerProfileExpectation
+	expectations       []*EventsServiceMockUpdateOrganizerProfileExpectation
+
+	callArgs []*EventsServiceMockUpdateOrganizerProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUpdateOrganizerProfileExpectation specifies expectation struct of the EventsService.UpdateOrganizerProfile
+type EventsServiceMockUpdateOrganizerProfileExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUpdateOrganizerProfileParams
+	paramPtrs          *EventsServiceMockUpdateOrganizerProfileParamPtrs
+	expectationOrigins EventsServiceMockUpdateOrganizerProfileExpectationOrigins
+	results            *EventsServiceMockUpdateOrganizerProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUpdateOrganizerProfileParams contains parameters of the EventsService.UpdateOrganizerProfile
+type EventsServiceMockUpdateOrganizerProfileParams struct {
+	ctx     context.Context
+	userID  int64
+	profile *models.OrganizerProfile
+}
+
+// EventsServiceMockUpdateOrganizerProfileParamPtrs contains pointers to parameters of the EventsService.UpdateOrganizerProfile
+type EventsServiceMockUpdateOrganizerProfileParamPtrs struct {
+	ctx     *context.Context
+	userID  *int64
+	profile **models.OrganizerProfile
+}
+
+// EventsServiceMockUpdateOrganizerProfileResults contains results of the EventsService.UpdateOrganizerProfile
+type EventsServiceMockUpdateOrganizerProfileResults struct {
+	op1 *models.OrganizerProfile
+	err error
+}
+
+// EventsServiceMockUpdateOrganizerProfileOrigins contains origins of expectations of the EventsService.UpdateOrganizerProfile
+type EventsServiceMockUpdateOrganizerProfileExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originProfile string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Optional() *mEventsServiceMockUpdateOrganizerProfile {
+	mmUpdateOrganizerProfile.optional = true
+	return mmUpdateOrganizerProfile
+}
+
+// Expect sets up expected params for EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Expect(ctx context.Context, userID int64, profile *models.OrganizerProfile) *mEventsServiceMockUpdateOrganizerProfile {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation == nil {
+		mmUpdateOrganizerProfile.defaultExpectation = &EventsServiceMockUpdateOrganizerProfileExpectation{}
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateOrganizerProfile.defaultExpectation.params = &EventsServiceMockUpdateOrganizerProfileParams{ctx, userID, profile}
+	mmUpdateOrganizerProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateOrganizerProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateOrganizerProfile.defaultExpectation.params) {
+			mmUpdateOrganizerProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrganizerProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOrganizerProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateOrganizerProfile {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation == nil {
+		mmUpdateOrganizerProfile.defaultExpectation = &EventsServiceMockUpdateOrganizerProfileExpectation{}
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.params != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Expect")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrganizerProfile.defaultExpectation.paramPtrs = &EventsServiceMockUpdateOrganizerProfileParamPtrs{}
+	}
+	mmUpdateOrganizerProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateOrganizerProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateOrganizerProfile
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateOrganizerProfile {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation == nil {
+		mmUpdateOrganizerProfile.defaultExpectation = &EventsServiceMockUpdateOrganizerProfileExpectation{}
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.params != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Expect")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrganizerProfile.defaultExpectation.paramPtrs = &EventsServiceMockUpdateOrganizerProfileParamPtrs{}
+	}
+	mmUpdateOrganizerProfile.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateOrganizerProfile.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateOrganizerProfile
+}
+
+// ExpectProfileParam3 sets up expected param profile for EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) ExpectProfileParam3(profile *models.OrganizerProfile) *mEventsServiceMockUpdateOrganizerProfile {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation == nil {
+		mmUpdateOrganizerProfile.defaultExpectation = &EventsServiceMockUpdateOrganizerProfileExpectation{}
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.params != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Expect")
+	}
+
+	if mmUpdateOrganizerProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrganizerProfile.defaultExpectation.paramPtrs = &EventsServiceMockUpdateOrganizerProfileParamPtrs{}
+	}
+	mmUpdateOrganizerProfile.defaultExpectation.paramPtrs.profile = &profile
+	mmUpdateOrganizerProfile.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+
+	return mmUpdateOrganizerProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Inspect(f func(ctx context.Context, userID int64, profile *models.OrganizerProfile)) *mEventsServiceMockUpdateOrganizerProfile {
+	if mmUpdateOrganizerProfile.mock.inspectFuncUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateOrganizerProfile")
+	}
+
+	mmUpdateOrganizerProfile.mock.inspectFuncUpdateOrganizerProfile = f
+
+	return mmUpdateOrganizerProfile
 }
 
-// Inspect accepts an inspector function that has same arguments as the EventsService.Event
-func (mmEvent *mEventsServiceMockEvent) Inspect(f func(ctx context.Context, urlTitle string)) *mEventsServiceMockEvent {
-	if mmEvent.mock.inspectFuncEvent != nil {
-		mmEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Event")
+// Return sets up results that will be returned by EventsService.UpdateOrganizerProfile
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Return(op1 *models.OrganizerProfile, err error) *EventsServiceMock {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
 	}
 
-	mmEvent.mock.inspectFuncEvent = f
+	if mmUpdateOrganizerProfile.defaultExpectation == nil {
+		mmUpdateOrganizerProfile.defaultExpectation = &EventsServiceMockUpdateOrganizerProfileExpectation{mock: mmUpdateOrganizerProfile.mock}
+	}
+	mmUpdateOrganizerProfile.defaultExpectation.results = &EventsServiceMockUpdateOrganizerProfileResults{op1, err}
+	mmUpdateOrganizerProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizerProfile.mock
+}
 
-	return mmEvent
+// Set uses given function f to mock the EventsService.UpdateOrganizerProfile method
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Set(f func(ctx context.Context, userID int64, profile *models.OrganizerProfile) (op1 *models.OrganizerProfile, err error)) *EventsServiceMock {
+	if mmUpdateOrganizerProfile.defaultExpectation != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateOrganizerProfile method")
+	}
+
+	if len(mmUpdateOrganizerProfile.expectations) > 0 {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateOrganizerProfile method")
+	}
+
+	mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile = f
+	mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizerProfile.mock
 }
 
-// Return sets up results that will be returned by EventsService.Event
-func (mmEvent *mEventsServiceMockEvent) Return(ep1 *models.Event, err error) *EventsServiceMock {
-	if mmEvent.mock.funcEvent != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+// When sets expectation for the EventsService.UpdateOrganizerProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) When(ctx context.Context, userID int64, profile *models.OrganizerProfile) *EventsServiceMockUpdateOrganizerProfileExpectation {
+	if mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("EventsServiceMock.UpdateOrganizerProfile mock is already set by Set")
 	}
 
-	if mmEvent.defaultExpectation == nil {
-		mmEvent.defaultExpectation = &EventsServiceMockEventExpectation{mock: mmEvent.mock}
+	expectation := &EventsServiceMockUpdateOrganizerProfileExpectation{
+		mock:               mmUpdateOrganizerProfile.mock,
+		params:             &EventsServiceMockUpdateOrganizerProfileParams{ctx, userID, profile},
+		expectationOrigins: EventsServiceMockUpdateOrganizerProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmEvent.defaultExpectation.results = &EventsServiceMockEventResults{ep1, err}
-	mmEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmEvent.mock
+	mmUpdateOrganizerProfile.expectations = append(mmUpdateOrganizerProfile.expectations, expectation)
+	return expectation
 }
 
-// Set uses given function f to mock the EventsService.Event method
-func (mmEvent *mEventsServiceMockEvent) Set(f func(ctx context.Context, urlTitle string) (ep1 *models.Event, err error)) *EventsServiceMock {
-	if mmEvent.defaultExpectation != nil {
-		mmEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.Event method")
+// Then sets up EventsService.UpdateOrganizerProfile return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateOrganizerProfileExpectation) Then(op1 *models.OrganizerProfile, err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateOrganizerProfileResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times EventsService.UpdateOrganizerProfile should be invoked
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Times(n uint64) *mEventsServiceMockUpdateOrganizerProfile {
+	if n == 0 {
+		mmUpdateOrganizerProfile.mock.t.Fatalf("Times of EventsServiceMock.UpdateOrganizerProfile mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmUpdateOrganizerProfile.expectedInvocations, n)
+	mmUpdateOrganizerProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrganizerProfile
+}
 
-	if len(mmEvent.expectations) > 0 {
-		mmEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.Event method")
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) invocationsDone() bool {
+	if len(mmUpdateOrganizerProfile.expectations) == 0 && mmUpdateOrganizerProfile.defaultExpectation == nil && mmUpdateOrganizerProfile.mock.funcUpdateOrganizerProfile == nil {
+		return true
 	}
 
-	mmEvent.mock.funcEvent = f
-	mmEvent.mock.funcEventOrigin = minimock.CallerInfo(1)
-	return mmEvent.mock
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOrganizerProfile.mock.afterUpdateOrganizerProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOrganizerProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// When sets expectation for the EventsService.Event which will trigger the result defined by the following
+// UpdateOrganizerProfile implements mm_service.EventsService
+func (mmUpdateOrganizerProfile *EventsServiceMock) UpdateOrganizerProfile(ctx context.Context, userID int64, profile *models.OrganizerProfile) (op1 *models.OrganizerProfile, err error) {
+	mm_atomic.AddUint64(&mmUpdateOrganizerProfile.beforeUpdateOrganizerProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrganizerProfile.afterUpdateOrganizerProfileCounter, 1)
+
+	mmUpdateOrganizerProfile.t.Helper()
+
+	if mmUpdateOrganizerProfile.inspectFuncUpdateOrganizerProfile != nil {
+		mmUpdateOrganizerProfile.inspectFuncUpdateOrganizerProfile(ctx, userID, profile)
+	}
+
+	mm_params := EventsServiceMockUpdateOrganizerProfileParams{ctx, userID, profile}
+
+	// Record call args
+	mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.mutex.Lock()
+	mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.callArgs = append(mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.callArgs, &mm_params)
+	mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := EventsServiceMockUpdateOrganizerProfileParams{ctx, userID, profile}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateOrganizerProfile.t.Errorf("EventsServiceMock.UpdateOrganizerProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateOrganizerProfile.t.Errorf("EventsServiceMock.UpdateOrganizerProfile got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
+				mmUpdateOrganizerProfile.t.Errorf("EventsServiceMock.UpdateOrganizerProfile got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateOrganizerProfile.t.Errorf("EventsServiceMock.UpdateOrganizerProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateOrganizerProfile.UpdateOrganizerProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateOrganizerProfile.t.Fatal("No results are set for the EventsServiceMock.UpdateOrganizerProfile")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmUpdateOrganizerProfile.funcUpdateOrganizerProfile != nil {
+		return mmUpdateOrganizerProfile.funcUpdateOrganizerProfile(ctx, userID, profile)
+	}
+	mmUpdateOrganizerProfile.t.Fatalf("Unexpected call to EventsServiceMock.UpdateOrganizerProfile. %v %v %v", ctx, userID, profile)
+	return
+}
+
+// UpdateOrganizerProfileAfterCounter returns a count of finished EventsServiceMock.UpdateOrganizerProfile invocations
+func (mmUpdateOrganizerProfile *EventsServiceMock) UpdateOrganizerProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrganizerProfile.afterUpdateOrganizerProfileCounter)
+}
+
+// UpdateOrganizerProfileBeforeCounter returns a count of EventsServiceMock.UpdateOrganizerProfile invocations
+func (mmUpdateOrganizerProfile *EventsServiceMock) UpdateOrganizerProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrganizerProfile.beforeUpdateOrganizerProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateOrganizerProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateOrganizerProfile *mEventsServiceMockUpdateOrganizerProfile) Calls() []*EventsServiceMockUpdateOrganizerProfileParams {
+	mmUpdateOrganizerProfile.mutex.RLock()
+
+	argCopy := make([]*EventsServiceMockUpdateOrganizerProfileParams, len(mmUpdateOrganizerProfile.callArgs))
+	copy(argCopy, mmUpdateOrganizerProfile.callArgs)
+
+	mmUpdateOrganizerProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateOrganizerProfileDone returns true if the count of the UpdateOrganizerProfile invocations corresponds
+// the number of defined expectations
+func (m *EventsServiceMock) MinimockUpdateOrganizerProfileDone() bool {
+	if m.UpdateOrganizerProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateOrganizerProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateOrganizerProfileMock.invocationsDone()
+}
+
+// MinimockUpdateOrganizerProfileInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateOrganizerProfileInspect() {
+	for _, e := range m.UpdateOrganizerProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateOrganizerProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateOrganizerProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateOrganizerProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOrganizerProfileMock.defaultExpectation != nil && afterUpdateOrganizerProfileCounter < 1 {
+		if m.UpdateOrganizerProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateOrganizerProfile at\n%s", m.UpdateOrganizerProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateOrganizerProfile at\n%s with params: %#v", m.UpdateOrganizerProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOrganizerProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOrganizerProfile != nil && afterUpdateOrganizerProfileCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateOrganizerProfile at\n%s", m.funcUpdateOrganizerProfileOrigin)
+	}
+
+	if !m.UpdateOrganizerProfileMock.invocationsDone() && afterUpdateOrganizerProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateOrganizerProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateOrganizerProfileMock.expectedInvocations), m.UpdateOrganizerProfileMock.expectedInvocationsOrigin, afterUpdateOrganizerProfileCounter)
+	}
+}
+
+type mEventsServiceMockUpdateQuestion struct {
+	optional           bool
+	mock               *EventsServiceMock
+	defaultExpectation *EventsServiceMockUpdateQuestionExpectation
+	expectations       []*EventsServiceMockUpdateQuestionExpectation
+
+	callArgs []*EventsServiceMockUpdateQuestionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventsServiceMockUpdateQuestionExpectation specifies expectation struct of the EventsService.UpdateQuestion
+type EventsServiceMockUpdateQuestionExpectation struct {
+	mock               *EventsServiceMock
+	params             *EventsServiceMockUpdateQuestionParams
+	paramPtrs          *EventsServiceMockUpdateQuestionParamPtrs
+	expectationOrigins EventsServiceMockUpdateQuestionExpectationOrigins
+	results            *EventsServiceMockUpdateQuestionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventsServiceMockUpdateQuestionParams contains parameters of the EventsService.UpdateQuestion
+type EventsServiceMockUpdateQuestionParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	question *models.EventQuestion
+}
+
+// EventsServiceMockUpdateQuestionParamPtrs contains pointers to parameters of the EventsService.UpdateQuestion
+type EventsServiceMockUpdateQuestionParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	question **models.EventQuestion
+}
+
+// EventsServiceMockUpdateQuestionResults contains results of the EventsService.UpdateQuestion
+type EventsServiceMockUpdateQuestionResults struct {
+	err error
+}
+
+// EventsServiceMockUpdateQuestionOrigins contains origins of expectations of the EventsService.UpdateQuestion
+type EventsServiceMockUpdateQuestionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originQuestion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Optional() *mEventsServiceMockUpdateQuestion {
+	mmUpdateQuestion.optional = true
+	return mmUpdateQuestion
+}
+
+// Expect sets up expected params for EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Expect(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{}
+	}
+
+	if mmUpdateQuestion.defaultExpectation.paramPtrs != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateQuestion.defaultExpectation.params = &EventsServiceMockUpdateQuestionParams{ctx, userID, urlTitle, question}
+	mmUpdateQuestion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateQuestion.expectations {
+		if minimock.Equal(e.params, mmUpdateQuestion.defaultExpectation.params) {
+			mmUpdateQuestion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateQuestion.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateQuestion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{}
+	}
+
+	if mmUpdateQuestion.defaultExpectation.params != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Expect")
+	}
+
+	if mmUpdateQuestion.defaultExpectation.paramPtrs == nil {
+		mmUpdateQuestion.defaultExpectation.paramPtrs = &EventsServiceMockUpdateQuestionParamPtrs{}
+	}
+	mmUpdateQuestion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateQuestion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateQuestion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{}
+	}
+
+	if mmUpdateQuestion.defaultExpectation.params != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Expect")
+	}
+
+	if mmUpdateQuestion.defaultExpectation.paramPtrs == nil {
+		mmUpdateQuestion.defaultExpectation.paramPtrs = &EventsServiceMockUpdateQuestionParamPtrs{}
+	}
+	mmUpdateQuestion.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateQuestion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateQuestion
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{}
+	}
+
+	if mmUpdateQuestion.defaultExpectation.params != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Expect")
+	}
+
+	if mmUpdateQuestion.defaultExpectation.paramPtrs == nil {
+		mmUpdateQuestion.defaultExpectation.paramPtrs = &EventsServiceMockUpdateQuestionParamPtrs{}
+	}
+	mmUpdateQuestion.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmUpdateQuestion.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmUpdateQuestion
+}
+
+// ExpectQuestionParam4 sets up expected param question for EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) ExpectQuestionParam4(question *models.EventQuestion) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{}
+	}
+
+	if mmUpdateQuestion.defaultExpectation.params != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Expect")
+	}
+
+	if mmUpdateQuestion.defaultExpectation.paramPtrs == nil {
+		mmUpdateQuestion.defaultExpectation.paramPtrs = &EventsServiceMockUpdateQuestionParamPtrs{}
+	}
+	mmUpdateQuestion.defaultExpectation.paramPtrs.question = &question
+	mmUpdateQuestion.defaultExpectation.expectationOrigins.originQuestion = minimock.CallerInfo(1)
+
+	return mmUpdateQuestion
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Inspect(f func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion)) *mEventsServiceMockUpdateQuestion {
+	if mmUpdateQuestion.mock.inspectFuncUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateQuestion")
+	}
+
+	mmUpdateQuestion.mock.inspectFuncUpdateQuestion = f
+
+	return mmUpdateQuestion
+}
+
+// Return sets up results that will be returned by EventsService.UpdateQuestion
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Return(err error) *EventsServiceMock {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
+	}
+
+	if mmUpdateQuestion.defaultExpectation == nil {
+		mmUpdateQuestion.defaultExpectation = &EventsServiceMockUpdateQuestionExpectation{mock: mmUpdateQuestion.mock}
+	}
+	mmUpdateQuestion.defaultExpectation.results = &EventsServiceMockUpdateQuestionResults{err}
+	mmUpdateQuestion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateQuestion.mock
+}
+
+// Set uses given function f to mock the EventsService.UpdateQuestion method
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Set(f func(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (err error)) *EventsServiceMock {
+	if mmUpdateQuestion.defaultExpectation != nil {
+		mmUpdateQuestion.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateQuestion method")
+	}
+
+	if len(mmUpdateQuestion.expectations) > 0 {
+		mmUpdateQuestion.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateQuestion method")
+	}
+
+	mmUpdateQuestion.mock.funcUpdateQuestion = f
+	mmUpdateQuestion.mock.funcUpdateQuestionOrigin = minimock.CallerInfo(1)
+	return mmUpdateQuestion.mock
+}
+
+// When sets expectation for the EventsService.UpdateQuestion which will trigger the result defined by the following
 // Then helper
-func (mmEvent *mEventsServiceMockEvent) When(ctx context.Context, urlTitle string) *EventsServiceMockEventExpectation {
-	if mmEvent.mock.funcEvent != nil {
-		mmEvent.mock.t.Fatalf("EventsServiceMock.Event mock is already set by Set")
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) When(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) *EventsServiceMockUpdateQuestionExpectation {
+	if mmUpdateQuestion.mock.funcUpdateQuestion != nil {
+		mmUpdateQuestion.mock.t.Fatalf("EventsServiceMock.UpdateQuestion mock is already set by Set")
 	}
 
-	expectation := &EventsServiceMockEventExpectation{
-		mock:               mmEvent.mock,
-		params:             &EventsServiceMockEventParams{ctx, urlTitle},
-		expectationOrigins: EventsServiceMockEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &EventsServiceMockUpdateQuestionExpectation{
+		mock:               mmUpdateQuestion.mock,
+		params:             &EventsServiceMockUpdateQuestionParams{ctx, userID, urlTitle, question},
+		expectationOrigins: EventsServiceMockUpdateQuestionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmEvent.expectations = append(mmEvent.expectations, expectation)
+	mmUpdateQuestion.expectations = append(mmUpdateQuestion.expectations, expectation)
 	return expectation
 }
 
-// Then sets up EventsService.Event return parameters for the expectation previously defined by the When method
-func (e *EventsServiceMockEventExpectation) Then(ep1 *models.Event, err error) *EventsServiceMock {
-	e.results = &EventsServiceMockEventResults{ep1, err}
+// Then sets up EventsService.UpdateQuestion return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateQuestionExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateQuestionResults{err}
 	return e.mock
 }
 
-// Times sets number of times EventsService.Event should be invoked
-func (mmEvent *mEventsServiceMockEvent) Times(n uint64) *mEventsServiceMockEvent {
+// Times sets number of times EventsService.UpdateQuestion should be invoked
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Times(n uint64) *mEventsServiceMockUpdateQuestion {
 	if n == 0 {
-		mmEvent.mock.t.Fatalf("Times of EventsServiceMock.Event mock can not be zero")
+		mmUpdateQuestion.mock.t.Fatalf("Times of EventsServiceMock.UpdateQuestion mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmEvent.expectedInvocations, n)
-	mmEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmEvent
+	mm_atomic.StoreUint64(&mmUpdateQuestion.expectedInvocations, n)
+	mmUpdateQuestion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateQuestion
 }
 
-func (mmEvent *mEventsServiceMockEvent) invocationsDone() bool {
-	if len(mmEvent.expectations) == 0 && mmEvent.defaultExpectation == nil && mmEvent.mock.funcEvent == nil {
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) invocationsDone() bool {
+	if len(mmUpdateQuestion.expectations) == 0 && mmUpdateQuestion.defaultExpectation == nil && mmUpdateQuestion.mock.funcUpdateQuestion == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmEvent.mock.afterEventCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmEvent.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateQuestion.mock.afterUpdateQuestionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateQuestion.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Event implements mm_service.EventsService
-func (mmEvent *EventsServiceMock) Event(ctx context.Context, urlTitle string) (ep1 *models.Event, err error) {
-	mm_atomic.AddUint64(&mmEvent.beforeEventCounter, 1)
-	defer mm_atomic.AddUint64(&mmEvent.afterEventCounter, 1)
+// UpdateQuestion implements mm_service.EventsService
+func (mmUpdateQuestion *EventsServiceMock) UpdateQuestion(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (err error) {
+	mm_atomic.AddUint64(&mmUpdateQuestion.beforeUpdateQuestionCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateQuestion.afterUpdateQuestionCounter, 1)
 
-	mmEvent.t.Helper()
+	mmUpdateQuestion.t.Helper()
 
-	if mmEvent.inspectFuncEvent != nil {
-		mmEvent.inspectFuncEvent(ctx, urlTitle)
+	if mmUpdateQuestion.inspectFuncUpdateQuestion != nil {
+		mmUpdateQuestion.inspectFuncUpdateQuestion(ctx, userID, urlTitle, question)
 	}
 
-	mm_params := EventsServiceMockEventParams{ctx, urlTitle}
+	mm_params := EventsServiceMockUpdateQuestionParams{ctx, userID, urlTitle, question}
 
 	// Record call args
-	mmEvent.EventMock.mutex.Lock()
-	mmEvent.EventMock.callArgs = append(mmEvent.EventMock.callArgs, &mm_params)
-	mmEvent.EventMock.mutex.Unlock()
+	mmUpdateQuestion.UpdateQuestionMock.mutex.Lock()
+	mmUpdateQuestion.UpdateQuestionMock.callArgs = append(mmUpdateQuestion.UpdateQuestionMock.callArgs, &mm_params)
+	mmUpdateQuestion.UpdateQuestionMock.mutex.Unlock()
 
-	for _, e := range mmEvent.EventMock.expectations {
+	for _, e := range mmUpdateQuestion.UpdateQuestionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ep1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmEvent.EventMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmEvent.EventMock.defaultExpectation.Counter, 1)
-		mm_want := mmEvent.EventMock.defaultExpectation.params
-		mm_want_ptrs := mmEvent.EventMock.defaultExpectation.paramPtrs
+	if mmUpdateQuestion.UpdateQuestionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.paramPtrs
 
-		mm_got := EventsServiceMockEventParams{ctx, urlTitle}
+		mm_got := EventsServiceMockUpdateQuestionParams{ctx, userID, urlTitle, question}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmEvent.EventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateQuestion.t.Errorf("EventsServiceMock.UpdateQuestion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateQuestion.t.Errorf("EventsServiceMock.UpdateQuestion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
-				mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmEvent.EventMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+				mmUpdateQuestion.t.Errorf("EventsServiceMock.UpdateQuestion got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.question != nil && !minimock.Equal(*mm_want_ptrs.question, mm_got.question) {
+				mmUpdateQuestion.t.Errorf("EventsServiceMock.UpdateQuestion got unexpected parameter question, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.expectationOrigins.originQuestion, *mm_want_ptrs.question, mm_got.question, minimock.Diff(*mm_want_ptrs.question, mm_got.question))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmEvent.t.Errorf("EventsServiceMock.Event got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmEvent.EventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateQuestion.t.Errorf("EventsServiceMock.UpdateQuestion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmEvent.EventMock.defaultExpectation.results
+		mm_results := mmUpdateQuestion.UpdateQuestionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmEvent.t.Fatal("No results are set for the EventsServiceMock.Event")
+			mmUpdateQuestion.t.Fatal("No results are set for the EventsServiceMock.UpdateQuestion")
 		}
-		return (*mm_results).ep1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmEvent.funcEvent != nil {
-		return mmEvent.funcEvent(ctx, urlTitle)
+	if mmUpdateQuestion.funcUpdateQuestion != nil {
+		return mmUpdateQuestion.funcUpdateQuestion(ctx, userID, urlTitle, question)
 	}
-	mmEvent.t.Fatalf("Unexpected call to EventsServiceMock.Event. %v %v", ctx, urlTitle)
+	mmUpdateQuestion.t.Fatalf("Unexpected call to EventsServiceMock.UpdateQuestion. %v %v %v %v", ctx, userID, urlTitle, question)
 	return
 }
 
-// EventAfterCounter returns a count of finished EventsServiceMock.Event invocations
-func (mmEvent *EventsServiceMock) EventAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmEvent.afterEventCounter)
+// UpdateQuestionAfterCounter returns a count of finished EventsServiceMock.UpdateQuestion invocations
+func (mmUpdateQuestion *EventsServiceMock) UpdateQuestionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateQuestion.afterUpdateQuestionCounter)
 }
 
-// EventBeforeCounter returns a count of EventsServiceMock.Event invocations
-func (mmEvent *EventsServiceMock) EventBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmEvent.beforeEventCounter)
+// UpdateQuestionBeforeCounter returns a count of EventsServiceMock.UpdateQuestion invocations
+func (mmUpdateQuestion *EventsServiceMock) UpdateQuestionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateQuestion.beforeUpdateQuestionCounter)
 }
 
-// Calls returns a list of arguments used in each call to EventsServiceMock.Event.
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateQuestion.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmEvent *mEventsServiceMockEvent) Calls() []*EventsServiceMockEventParams {
-	mmEvent.mutex.RLock()
+func (mmUpdateQuestion *mEventsServiceMockUpdateQuestion) Calls() []*EventsServiceMockUpdateQuestionParams {
+	mmUpdateQuestion.mutex.RLock()
 
-	argCopy := make([]*EventsServiceMockEventParams, len(mmEvent.callArgs))
-	copy(argCopy, mmEvent.callArgs)
+	argCopy := make([]*EventsServiceMockUpdateQuestionParams, len(mmUpdateQuestion.callArgs))
+	copy(argCopy, mmUpdateQuestion.callArgs)
 
-	mmEvent.mutex.RUnlock()
+	mmUpdateQuestion.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockEventDone returns true if the count of the Event invocations corresponds
+// MinimockUpdateQuestionDone returns true if the count of the UpdateQuestion invocations corresponds
 // the number of defined expectations
-func (m *EventsServiceMock) MinimockEventDone() bool {
-	if m.EventMock.optional {
+func (m *EventsServiceMock) MinimockUpdateQuestionDone() bool {
+	if m.UpdateQuestionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.EventMock.expectations {
+	for _, e := range m.UpdateQuestionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.EventMock.invocationsDone()
+	return m.UpdateQuestionMock.invocationsDone()
 }
 
-// MinimockEventInspect logs each unmet expectation
-func (m *EventsServiceMock) MinimockEventInspect() {
-	for _, e := range m.EventMock.expectations {
+// MinimockUpdateQuestionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateQuestionInspect() {
+	for _, e := range m.UpdateQuestionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateQuestion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterEventCounter := mm_atomic.LoadUint64(&m.afterEventCounter)
+	afterUpdateQuestionCounter := mm_atomic.LoadUint64(&m.afterUpdateQuestionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.EventMock.defaultExpectation != nil && afterEventCounter < 1 {
-		if m.EventMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s", m.EventMock.defaultExpectation.returnOrigin)
+	if m.UpdateQuestionMock.defaultExpectation != nil && afterUpdateQuestionCounter < 1 {
+		if m.UpdateQuestionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateQuestion at\n%s", m.UpdateQuestionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s with params: %#v", m.EventMock.defaultExpectation.expectationOrigins.origin, *m.EventMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateQuestion at\n%s with params: %#v", m.UpdateQuestionMock.defaultExpectation.expectationOrigins.origin, *m.UpdateQuestionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcEvent != nil && afterEventCounter < 1 {
-		m.t.Errorf("Expected call to EventsServiceMock.Event at\n%s", m.funcEventOrigin)
+	if m.funcUpdateQuestion != nil && afterUpdateQuestionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateQuestion at\n%s", m.funcUpdateQuestionOrigin)
 	}
 
-	if !m.EventMock.invocationsDone() && afterEventCounter > 0 {
-		m.t.Errorf("Expected %d calls to EventsServiceMock.Event at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.EventMock.expectedInvocations), m.EventMock.expectedInvocationsOrigin, afterEventCounter)
+	if !m.UpdateQuestionMock.invocationsDone() && afterUpdateQuestionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateQuestion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateQuestionMock.expectedInvocations), m.UpdateQuestionMock.expectedInvocationsOrigin, afterUpdateQuestionCounter)
 	}
 }
 
-type mEventsServiceMockEvents struct {
+type mEventsServiceMockUpdateSession struct {
 	optional           bool
 	mock               *EventsServiceMock
-	defaultExpectation *EventsServiceMockEventsExpectation
-	expectations       []*EventsServiceMockEventsExpectation
+	defaultExpectation *EventsServiceMockUpdateSessionExpectation
+	expectations       []*EventsServiceMockUpdateSessionExpectation
 
-	callArgs []*EventsServiceMockEventsParams
+	callArgs []*EventsServiceMockUpdateSessionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// EventsServiceMockEventsExpectation specifies expectation struct of the EventsService.Events
-type EventsServiceMockEventsExpectation struct {
+// EventsServiceMockUpdateSessionExpectation specifies expectation struct of the EventsService.UpdateSession
+type EventsServiceMockUpdateSessionExpectation struct {
 	mock               *EventsServiceMock
-	params             *EventsServiceMockEventsParams
-	paramPtrs          *EventsServiceMockEventsParamPtrs
-	expectationOrigins EventsServiceMockEventsExpectationOrigins
-	results            *EventsServiceMockEventsResults
+	params             *EventsServiceMockUpdateSessionParams
+	paramPtrs          *EventsServiceMockUpdateSessionParamPtrs
+	expectationOrigins EventsServiceMockUpdateSessionExpectationOrigins
+	results            *EventsServiceMockUpdateSessionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// EventsServiceMockEventsParams contains parameters of the EventsService.Events
-type EventsServiceMockEventsParams struct {
-	ctx  context.Context
-	page int
+// EventsServiceMockUpdateSessionParams contains parameters of the EventsService.UpdateSession
+type EventsServiceMockUpdateSessionParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	session  *models.Session
 }
 
-// EventsServiceMockEventsParamPtrs contains pointers to parameters of the EventsService.Events
-type EventsServiceMockEventsParamPtrs struct {
-	ctx  *context.Context
-	page *int
+// EventsServiceMockUpdateSessionParamPtrs contains pointers to parameters of the EventsService.UpdateSession
+type EventsServiceMockUpdateSessionParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	session  **models.Session
 }
 
-// EventsServiceMockEventsResults contains results of the EventsService.Events
-type EventsServiceMockEventsResults struct {
-	epa1 []*models.Event
-	err  error
+// EventsServiceMockUpdateSessionResults contains results of the EventsService.UpdateSession
+type EventsServiceMockUpdateSessionResults struct {
+	err error
 }
 
-// EventsServiceMockEventsOrigins contains origins of expectations of the EventsService.Events
-type EventsServiceMockEventsExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originPage string
+// EventsServiceMockUpdateSessionOrigins contains origins of expectations of the EventsService.UpdateSession
+type EventsServiceMockUpdateSessionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originSession  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1206,344 +15132,403 @@ type EventsServiceMockEventsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmEvents *mEventsServiceMockEvents) Optional() *mEventsServiceMockEvents {
-	mmEvents.optional = true
-	return mmEvents
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Optional() *mEventsServiceMockUpdateSession {
+	mmUpdateSession.optional = true
+	return mmUpdateSession
 }
 
-// Expect sets up expected params for EventsService.Events
-func (mmEvents *mEventsServiceMockEvents) Expect(ctx context.Context, page int) *mEventsServiceMockEvents {
-	if mmEvents.mock.funcEvents != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+// Expect sets up expected params for EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Expect(ctx context.Context, userID int64, urlTitle string, session *models.Session) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	if mmEvents.defaultExpectation == nil {
-		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{}
 	}
 
-	if mmEvents.defaultExpectation.paramPtrs != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by ExpectParams functions")
+	if mmUpdateSession.defaultExpectation.paramPtrs != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by ExpectParams functions")
 	}
 
-	mmEvents.defaultExpectation.params = &EventsServiceMockEventsParams{ctx, page}
-	mmEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmEvents.expectations {
-		if minimock.Equal(e.params, mmEvents.defaultExpectation.params) {
-			mmEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvents.defaultExpectation.params)
+	mmUpdateSession.defaultExpectation.params = &EventsServiceMockUpdateSessionParams{ctx, userID, urlTitle, session}
+	mmUpdateSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSession.expectations {
+		if minimock.Equal(e.params, mmUpdateSession.defaultExpectation.params) {
+			mmUpdateSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSession.defaultExpectation.params)
 		}
 	}
 
-	return mmEvents
+	return mmUpdateSession
 }
 
-// ExpectCtxParam1 sets up expected param ctx for EventsService.Events
-func (mmEvents *mEventsServiceMockEvents) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockEvents {
-	if mmEvents.mock.funcEvents != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	if mmEvents.defaultExpectation == nil {
-		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{}
 	}
 
-	if mmEvents.defaultExpectation.params != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Expect")
+	if mmUpdateSession.defaultExpectation.params != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Expect")
 	}
 
-	if mmEvents.defaultExpectation.paramPtrs == nil {
-		mmEvents.defaultExpectation.paramPtrs = &EventsServiceMockEventsParamPtrs{}
+	if mmUpdateSession.defaultExpectation.paramPtrs == nil {
+		mmUpdateSession.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSessionParamPtrs{}
 	}
-	mmEvents.defaultExpectation.paramPtrs.ctx = &ctx
-	mmEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmEvents
+	return mmUpdateSession
 }
 
-// ExpectPageParam2 sets up expected param page for EventsService.Events
-func (mmEvents *mEventsServiceMockEvents) ExpectPageParam2(page int) *mEventsServiceMockEvents {
-	if mmEvents.mock.funcEvents != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	if mmEvents.defaultExpectation == nil {
-		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{}
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{}
 	}
 
-	if mmEvents.defaultExpectation.params != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Expect")
+	if mmUpdateSession.defaultExpectation.params != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Expect")
 	}
 
-	if mmEvents.defaultExpectation.paramPtrs == nil {
-		mmEvents.defaultExpectation.paramPtrs = &EventsServiceMockEventsParamPtrs{}
+	if mmUpdateSession.defaultExpectation.paramPtrs == nil {
+		mmUpdateSession.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSessionParamPtrs{}
 	}
-	mmEvents.defaultExpectation.paramPtrs.page = &page
-	mmEvents.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+	mmUpdateSession.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmEvents
+	return mmUpdateSession
 }
 
-// Inspect accepts an inspector function that has same arguments as the EventsService.Events
-func (mmEvents *mEventsServiceMockEvents) Inspect(f func(ctx context.Context, page int)) *mEventsServiceMockEvents {
-	if mmEvents.mock.inspectFuncEvents != nil {
-		mmEvents.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.Events")
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	mmEvents.mock.inspectFuncEvents = f
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{}
+	}
 
-	return mmEvents
+	if mmUpdateSession.defaultExpectation.params != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Expect")
+	}
+
+	if mmUpdateSession.defaultExpectation.paramPtrs == nil {
+		mmUpdateSession.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSessionParamPtrs{}
+	}
+	mmUpdateSession.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmUpdateSession.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmUpdateSession
 }
 
-// Return sets up results that will be returned by EventsService.Events
-func (mmEvents *mEventsServiceMockEvents) Return(epa1 []*models.Event, err error) *EventsServiceMock {
-	if mmEvents.mock.funcEvents != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+// ExpectSessionParam4 sets up expected param session for EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) ExpectSessionParam4(session *models.Session) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	if mmEvents.defaultExpectation == nil {
-		mmEvents.defaultExpectation = &EventsServiceMockEventsExpectation{mock: mmEvents.mock}
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{}
 	}
-	mmEvents.defaultExpectation.results = &EventsServiceMockEventsResults{epa1, err}
-	mmEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmEvents.mock
+
+	if mmUpdateSession.defaultExpectation.params != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Expect")
+	}
+
+	if mmUpdateSession.defaultExpectation.paramPtrs == nil {
+		mmUpdateSession.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSessionParamPtrs{}
+	}
+	mmUpdateSession.defaultExpectation.paramPtrs.session = &session
+	mmUpdateSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmUpdateSession
 }
 
-// Set uses given function f to mock the EventsService.Events method
-func (mmEvents *mEventsServiceMockEvents) Set(f func(ctx context.Context, page int) (epa1 []*models.Event, err error)) *EventsServiceMock {
-	if mmEvents.defaultExpectation != nil {
-		mmEvents.mock.t.Fatalf("Default expectation is already set for the EventsService.Events method")
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Inspect(f func(ctx context.Context, userID int64, urlTitle string, session *models.Session)) *mEventsServiceMockUpdateSession {
+	if mmUpdateSession.mock.inspectFuncUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateSession")
 	}
 
-	if len(mmEvents.expectations) > 0 {
-		mmEvents.mock.t.Fatalf("Some expectations are already set for the EventsService.Events method")
+	mmUpdateSession.mock.inspectFuncUpdateSession = f
+
+	return mmUpdateSession
+}
+
+// Return sets up results that will be returned by EventsService.UpdateSession
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Return(err error) *EventsServiceMock {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	mmEvents.mock.funcEvents = f
-	mmEvents.mock.funcEventsOrigin = minimock.CallerInfo(1)
-	return mmEvents.mock
+	if mmUpdateSession.defaultExpectation == nil {
+		mmUpdateSession.defaultExpectation = &EventsServiceMockUpdateSessionExpectation{mock: mmUpdateSession.mock}
+	}
+	mmUpdateSession.defaultExpectation.results = &EventsServiceMockUpdateSessionResults{err}
+	mmUpdateSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSession.mock
 }
 
-// When sets expectation for the EventsService.Events which will trigger the result defined by the following
+// Set uses given function f to mock the EventsService.UpdateSession method
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Set(f func(ctx context.Context, userID int64, urlTitle string, session *models.Session) (err error)) *EventsServiceMock {
+	if mmUpdateSession.defaultExpectation != nil {
+		mmUpdateSession.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateSession method")
+	}
+
+	if len(mmUpdateSession.expectations) > 0 {
+		mmUpdateSession.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateSession method")
+	}
+
+	mmUpdateSession.mock.funcUpdateSession = f
+	mmUpdateSession.mock.funcUpdateSessionOrigin = minimock.CallerInfo(1)
+	return mmUpdateSession.mock
+}
+
+// When sets expectation for the EventsService.UpdateSession which will trigger the result defined by the following
 // Then helper
-func (mmEvents *mEventsServiceMockEvents) When(ctx context.Context, page int) *EventsServiceMockEventsExpectation {
-	if mmEvents.mock.funcEvents != nil {
-		mmEvents.mock.t.Fatalf("EventsServiceMock.Events mock is already set by Set")
+func (mmUpdateSession *mEventsServiceMockUpdateSession) When(ctx context.Context, userID int64, urlTitle string, session *models.Session) *EventsServiceMockUpdateSessionExpectation {
+	if mmUpdateSession.mock.funcUpdateSession != nil {
+		mmUpdateSession.mock.t.Fatalf("EventsServiceMock.UpdateSession mock is already set by Set")
 	}
 
-	expectation := &EventsServiceMockEventsExpectation{
-		mock:               mmEvents.mock,
-		params:             &EventsServiceMockEventsParams{ctx, page},
-		expectationOrigins: EventsServiceMockEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &EventsServiceMockUpdateSessionExpectation{
+		mock:               mmUpdateSession.mock,
+		params:             &EventsServiceMockUpdateSessionParams{ctx, userID, urlTitle, session},
+		expectationOrigins: EventsServiceMockUpdateSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmEvents.expectations = append(mmEvents.expectations, expectation)
+	mmUpdateSession.expectations = append(mmUpdateSession.expectations, expectation)
 	return expectation
 }
 
-// Then sets up EventsService.Events return parameters for the expectation previously defined by the When method
-func (e *EventsServiceMockEventsExpectation) Then(epa1 []*models.Event, err error) *EventsServiceMock {
-	e.results = &EventsServiceMockEventsResults{epa1, err}
+// Then sets up EventsService.UpdateSession return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateSessionExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateSessionResults{err}
 	return e.mock
 }
 
-// Times sets number of times EventsService.Events should be invoked
-func (mmEvents *mEventsServiceMockEvents) Times(n uint64) *mEventsServiceMockEvents {
+// Times sets number of times EventsService.UpdateSession should be invoked
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Times(n uint64) *mEventsServiceMockUpdateSession {
 	if n == 0 {
-		mmEvents.mock.t.Fatalf("Times of EventsServiceMock.Events mock can not be zero")
+		mmUpdateSession.mock.t.Fatalf("Times of EventsServiceMock.UpdateSession mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmEvents.expectedInvocations, n)
-	mmEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmEvents
+	mm_atomic.StoreUint64(&mmUpdateSession.expectedInvocations, n)
+	mmUpdateSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSession
 }
 
-func (mmEvents *mEventsServiceMockEvents) invocationsDone() bool {
-	if len(mmEvents.expectations) == 0 && mmEvents.defaultExpectation == nil && mmEvents.mock.funcEvents == nil {
+func (mmUpdateSession *mEventsServiceMockUpdateSession) invocationsDone() bool {
+	if len(mmUpdateSession.expectations) == 0 && mmUpdateSession.defaultExpectation == nil && mmUpdateSession.mock.funcUpdateSession == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmEvents.mock.afterEventsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmEvents.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSession.mock.afterUpdateSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSession.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Events implements mm_service.EventsService
-func (mmEvents *EventsServiceMock) Events(ctx context.Context, page int) (epa1 []*models.Event, err error) {
-	mm_atomic.AddUint64(&mmEvents.beforeEventsCounter, 1)
-	defer mm_atomic.AddUint64(&mmEvents.afterEventsCounter, 1)
+// UpdateSession implements mm_service.EventsService
+func (mmUpdateSession *EventsServiceMock) UpdateSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSession.beforeUpdateSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSession.afterUpdateSessionCounter, 1)
 
-	mmEvents.t.Helper()
+	mmUpdateSession.t.Helper()
 
-	if mmEvents.inspectFuncEvents != nil {
-		mmEvents.inspectFuncEvents(ctx, page)
+	if mmUpdateSession.inspectFuncUpdateSession != nil {
+		mmUpdateSession.inspectFuncUpdateSession(ctx, userID, urlTitle, session)
 	}
 
-	mm_params := EventsServiceMockEventsParams{ctx, page}
+	mm_params := EventsServiceMockUpdateSessionParams{ctx, userID, urlTitle, session}
 
 	// Record call args
-	mmEvents.EventsMock.mutex.Lock()
-	mmEvents.EventsMock.callArgs = append(mmEvents.EventsMock.callArgs, &mm_params)
-	mmEvents.EventsMock.mutex.Unlock()
+	mmUpdateSession.UpdateSessionMock.mutex.Lock()
+	mmUpdateSession.UpdateSessionMock.callArgs = append(mmUpdateSession.UpdateSessionMock.callArgs, &mm_params)
+	mmUpdateSession.UpdateSessionMock.mutex.Unlock()
 
-	for _, e := range mmEvents.EventsMock.expectations {
+	for _, e := range mmUpdateSession.UpdateSessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.epa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmEvents.EventsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmEvents.EventsMock.defaultExpectation.Counter, 1)
-		mm_want := mmEvents.EventsMock.defaultExpectation.params
-		mm_want_ptrs := mmEvents.EventsMock.defaultExpectation.paramPtrs
+	if mmUpdateSession.UpdateSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSession.UpdateSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSession.UpdateSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSession.UpdateSessionMock.defaultExpectation.paramPtrs
 
-		mm_got := EventsServiceMockEventsParams{ctx, page}
+		mm_got := EventsServiceMockUpdateSessionParams{ctx, userID, urlTitle, session}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmEvents.EventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateSession.t.Errorf("EventsServiceMock.UpdateSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSession.UpdateSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
-				mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmEvents.EventsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateSession.t.Errorf("EventsServiceMock.UpdateSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSession.UpdateSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmUpdateSession.t.Errorf("EventsServiceMock.UpdateSession got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSession.UpdateSessionMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmUpdateSession.t.Errorf("EventsServiceMock.UpdateSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSession.UpdateSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmEvents.t.Errorf("EventsServiceMock.Events got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmEvents.EventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateSession.t.Errorf("EventsServiceMock.UpdateSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSession.UpdateSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmEvents.EventsMock.defaultExpectation.results
+		mm_results := mmUpdateSession.UpdateSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmEvents.t.Fatal("No results are set for the EventsServiceMock.Events")
+			mmUpdateSession.t.Fatal("No results are set for the EventsServiceMock.UpdateSession")
 		}
-		return (*mm_results).epa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmEvents.funcEvents != nil {
-		return mmEvents.funcEvents(ctx, page)
+	if mmUpdateSession.funcUpdateSession != nil {
+		return mmUpdateSession.funcUpdateSession(ctx, userID, urlTitle, session)
 	}
-	mmEvents.t.Fatalf("Unexpected call to EventsServiceMock.Events. %v %v", ctx, page)
+	mmUpdateSession.t.Fatalf("Unexpected call to EventsServiceMock.UpdateSession. %v %v %v %v", ctx, userID, urlTitle, session)
 	return
 }
 
-// EventsAfterCounter returns a count of finished EventsServiceMock.Events invocations
-func (mmEvents *EventsServiceMock) EventsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmEvents.afterEventsCounter)
+// UpdateSessionAfterCounter returns a count of finished EventsServiceMock.UpdateSession invocations
+func (mmUpdateSession *EventsServiceMock) UpdateSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSession.afterUpdateSessionCounter)
 }
 
-// EventsBeforeCounter returns a count of EventsServiceMock.Events invocations
-func (mmEvents *EventsServiceMock) EventsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmEvents.beforeEventsCounter)
+// UpdateSessionBeforeCounter returns a count of EventsServiceMock.UpdateSession invocations
+func (mmUpdateSession *EventsServiceMock) UpdateSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSession.beforeUpdateSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to EventsServiceMock.Events.
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmEvents *mEventsServiceMockEvents) Calls() []*EventsServiceMockEventsParams {
-	mmEvents.mutex.RLock()
+func (mmUpdateSession *mEventsServiceMockUpdateSession) Calls() []*EventsServiceMockUpdateSessionParams {
+	mmUpdateSession.mutex.RLock()
 
-	argCopy := make([]*EventsServiceMockEventsParams, len(mmEvents.callArgs))
-	copy(argCopy, mmEvents.callArgs)
+	argCopy := make([]*EventsServiceMockUpdateSessionParams, len(mmUpdateSession.callArgs))
+	copy(argCopy, mmUpdateSession.callArgs)
 
-	mmEvents.mutex.RUnlock()
+	mmUpdateSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockEventsDone returns true if the count of the Events invocations corresponds
+// MinimockUpdateSessionDone returns true if the count of the UpdateSession invocations corresponds
 // the number of defined expectations
-func (m *EventsServiceMock) MinimockEventsDone() bool {
-	if m.EventsMock.optional {
+func (m *EventsServiceMock) MinimockUpdateSessionDone() bool {
+	if m.UpdateSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.EventsMock.expectations {
+	for _, e := range m.UpdateSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.EventsMock.invocationsDone()
+	return m.UpdateSessionMock.invocationsDone()
 }
 
-// MinimockEventsInspect logs each unmet expectation
-func (m *EventsServiceMock) MinimockEventsInspect() {
-	for _, e := range m.EventsMock.expectations {
+// MinimockUpdateSessionInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateSessionInspect() {
+	for _, e := range m.UpdateSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterEventsCounter := mm_atomic.LoadUint64(&m.afterEventsCounter)
+	afterUpdateSessionCounter := mm_atomic.LoadUint64(&m.afterUpdateSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.EventsMock.defaultExpectation != nil && afterEventsCounter < 1 {
-		if m.EventsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s", m.EventsMock.defaultExpectation.returnOrigin)
+	if m.UpdateSessionMock.defaultExpectation != nil && afterUpdateSessionCounter < 1 {
+		if m.UpdateSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSession at\n%s", m.UpdateSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s with params: %#v", m.EventsMock.defaultExpectation.expectationOrigins.origin, *m.EventsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSession at\n%s with params: %#v", m.UpdateSessionMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcEvents != nil && afterEventsCounter < 1 {
-		m.t.Errorf("Expected call to EventsServiceMock.Events at\n%s", m.funcEventsOrigin)
+	if m.funcUpdateSession != nil && afterUpdateSessionCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateSession at\n%s", m.funcUpdateSessionOrigin)
 	}
 
-	if !m.EventsMock.invocationsDone() && afterEventsCounter > 0 {
-		m.t.Errorf("Expected %d calls to EventsServiceMock.Events at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.EventsMock.expectedInvocations), m.EventsMock.expectedInvocationsOrigin, afterEventsCounter)
+	if !m.UpdateSessionMock.invocationsDone() && afterUpdateSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSessionMock.expectedInvocations), m.UpdateSessionMock.expectedInvocationsOrigin, afterUpdateSessionCounter)
 	}
 }
 
-type mEventsServiceMockUpdateEvent struct {
+type mEventsServiceMockUpdateSpeaker struct {
 	optional           bool
 	mock               *EventsServiceMock
-	defaultExpectation *EventsServiceMockUpdateEventExpectation
-	expectations       []*EventsServiceMockUpdateEventExpectation
+	defaultExpectation *EventsServiceMockUpdateSpeakerExpectation
+	expectations       []*EventsServiceMockUpdateSpeakerExpectation
 
-	callArgs []*EventsServiceMockUpdateEventParams
+	callArgs []*EventsServiceMockUpdateSpeakerParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// EventsServiceMockUpdateEventExpectation specifies expectation struct of the EventsService.UpdateEvent
-type EventsServiceMockUpdateEventExpectation struct {
+// EventsServiceMockUpdateSpeakerExpectation specifies expectation struct of the EventsService.UpdateSpeaker
+type EventsServiceMockUpdateSpeakerExpectation struct {
 	mock               *EventsServiceMock
-	params             *EventsServiceMockUpdateEventParams
-	paramPtrs          *EventsServiceMockUpdateEventParamPtrs
-	expectationOrigins EventsServiceMockUpdateEventExpectationOrigins
-	results            *EventsServiceMockUpdateEventResults
+	params             *EventsServiceMockUpdateSpeakerParams
+	paramPtrs          *EventsServiceMockUpdateSpeakerParamPtrs
+	expectationOrigins EventsServiceMockUpdateSpeakerExpectationOrigins
+	results            *EventsServiceMockUpdateSpeakerResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// EventsServiceMockUpdateEventParams contains parameters of the EventsService.UpdateEvent
-type EventsServiceMockUpdateEventParams struct {
-	ctx    context.Context
-	userID int64
-	event  *models.Event
+// EventsServiceMockUpdateSpeakerParams contains parameters of the EventsService.UpdateSpeaker
+type EventsServiceMockUpdateSpeakerParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	speaker  *models.Speaker
 }
 
-// EventsServiceMockUpdateEventParamPtrs contains pointers to parameters of the EventsService.UpdateEvent
-type EventsServiceMockUpdateEventParamPtrs struct {
-	ctx    *context.Context
-	userID *int64
-	event  **models.Event
+// EventsServiceMockUpdateSpeakerParamPtrs contains pointers to parameters of the EventsService.UpdateSpeaker
+type EventsServiceMockUpdateSpeakerParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	speaker  **models.Speaker
 }
 
-// EventsServiceMockUpdateEventResults contains results of the EventsService.UpdateEvent
-type EventsServiceMockUpdateEventResults struct {
+// EventsServiceMockUpdateSpeakerResults contains results of the EventsService.UpdateSpeaker
+type EventsServiceMockUpdateSpeakerResults struct {
 	err error
 }
 
-// EventsServiceMockUpdateEventOrigins contains origins of expectations of the EventsService.UpdateEvent
-type EventsServiceMockUpdateEventExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originEvent  string
+// EventsServiceMockUpdateSpeakerOrigins contains origins of expectations of the EventsService.UpdateSpeaker
+type EventsServiceMockUpdateSpeakerExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originSpeaker  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1551,320 +15536,348 @@ type EventsServiceMockUpdateEventExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Optional() *mEventsServiceMockUpdateEvent {
-	mmUpdateEvent.optional = true
-	return mmUpdateEvent
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Optional() *mEventsServiceMockUpdateSpeaker {
+	mmUpdateSpeaker.optional = true
+	return mmUpdateSpeaker
 }
 
-// Expect sets up expected params for EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Expect(ctx context.Context, userID int64, event *models.Event) *mEventsServiceMockUpdateEvent {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+// Expect sets up expected params for EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Expect(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	if mmUpdateEvent.defaultExpectation == nil {
-		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{}
 	}
 
-	if mmUpdateEvent.defaultExpectation.paramPtrs != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by ExpectParams functions")
+	if mmUpdateSpeaker.defaultExpectation.paramPtrs != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateEvent.defaultExpectation.params = &EventsServiceMockUpdateEventParams{ctx, userID, event}
-	mmUpdateEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateEvent.expectations {
-		if minimock.Equal(e.params, mmUpdateEvent.defaultExpectation.params) {
-			mmUpdateEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEvent.defaultExpectation.params)
+	mmUpdateSpeaker.defaultExpectation.params = &EventsServiceMockUpdateSpeakerParams{ctx, userID, urlTitle, speaker}
+	mmUpdateSpeaker.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSpeaker.expectations {
+		if minimock.Equal(e.params, mmUpdateSpeaker.defaultExpectation.params) {
+			mmUpdateSpeaker.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSpeaker.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateEvent
+	return mmUpdateSpeaker
 }
 
-// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateEvent {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) ExpectCtxParam1(ctx context.Context) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	if mmUpdateEvent.defaultExpectation == nil {
-		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{}
 	}
 
-	if mmUpdateEvent.defaultExpectation.params != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	if mmUpdateSpeaker.defaultExpectation.params != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Expect")
 	}
 
-	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
-		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	if mmUpdateSpeaker.defaultExpectation.paramPtrs == nil {
+		mmUpdateSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSpeakerParamPtrs{}
 	}
-	mmUpdateEvent.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateSpeaker.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSpeaker.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateEvent
+	return mmUpdateSpeaker
 }
 
-// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateEvent {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) ExpectUserIDParam2(userID int64) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	if mmUpdateEvent.defaultExpectation == nil {
-		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{}
 	}
 
-	if mmUpdateEvent.defaultExpectation.params != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	if mmUpdateSpeaker.defaultExpectation.params != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Expect")
 	}
 
-	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
-		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	if mmUpdateSpeaker.defaultExpectation.paramPtrs == nil {
+		mmUpdateSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSpeakerParamPtrs{}
 	}
-	mmUpdateEvent.defaultExpectation.paramPtrs.userID = &userID
-	mmUpdateEvent.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmUpdateSpeaker.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateSpeaker.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmUpdateEvent
+	return mmUpdateSpeaker
 }
 
-// ExpectEventParam3 sets up expected param event for EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) ExpectEventParam3(event *models.Event) *mEventsServiceMockUpdateEvent {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+// ExpectUrlTitleParam3 sets up expected param urlTitle for EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) ExpectUrlTitleParam3(urlTitle string) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	if mmUpdateEvent.defaultExpectation == nil {
-		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{}
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{}
 	}
 
-	if mmUpdateEvent.defaultExpectation.params != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Expect")
+	if mmUpdateSpeaker.defaultExpectation.params != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Expect")
 	}
 
-	if mmUpdateEvent.defaultExpectation.paramPtrs == nil {
-		mmUpdateEvent.defaultExpectation.paramPtrs = &EventsServiceMockUpdateEventParamPtrs{}
+	if mmUpdateSpeaker.defaultExpectation.paramPtrs == nil {
+		mmUpdateSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSpeakerParamPtrs{}
 	}
-	mmUpdateEvent.defaultExpectation.paramPtrs.event = &event
-	mmUpdateEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+	mmUpdateSpeaker.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmUpdateSpeaker.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
 
-	return mmUpdateEvent
+	return mmUpdateSpeaker
 }
 
-// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Inspect(f func(ctx context.Context, userID int64, event *models.Event)) *mEventsServiceMockUpdateEvent {
-	if mmUpdateEvent.mock.inspectFuncUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateEvent")
+// ExpectSpeakerParam4 sets up expected param speaker for EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) ExpectSpeakerParam4(speaker *models.Speaker) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	mmUpdateEvent.mock.inspectFuncUpdateEvent = f
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{}
+	}
 
-	return mmUpdateEvent
+	if mmUpdateSpeaker.defaultExpectation.params != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Expect")
+	}
+
+	if mmUpdateSpeaker.defaultExpectation.paramPtrs == nil {
+		mmUpdateSpeaker.defaultExpectation.paramPtrs = &EventsServiceMockUpdateSpeakerParamPtrs{}
+	}
+	mmUpdateSpeaker.defaultExpectation.paramPtrs.speaker = &speaker
+	mmUpdateSpeaker.defaultExpectation.expectationOrigins.originSpeaker = minimock.CallerInfo(1)
+
+	return mmUpdateSpeaker
 }
 
-// Return sets up results that will be returned by EventsService.UpdateEvent
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Return(err error) *EventsServiceMock {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Inspect(f func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker)) *mEventsServiceMockUpdateSpeaker {
+	if mmUpdateSpeaker.mock.inspectFuncUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("Inspect function is already set for EventsServiceMock.UpdateSpeaker")
 	}
 
-	if mmUpdateEvent.defaultExpectation == nil {
-		mmUpdateEvent.defaultExpectation = &EventsServiceMockUpdateEventExpectation{mock: mmUpdateEvent.mock}
+	mmUpdateSpeaker.mock.inspectFuncUpdateSpeaker = f
+
+	return mmUpdateSpeaker
+}
+
+// Return sets up results that will be returned by EventsService.UpdateSpeaker
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Return(err error) *EventsServiceMock {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
-	mmUpdateEvent.defaultExpectation.results = &EventsServiceMockUpdateEventResults{err}
-	mmUpdateEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateEvent.mock
+
+	if mmUpdateSpeaker.defaultExpectation == nil {
+		mmUpdateSpeaker.defaultExpectation = &EventsServiceMockUpdateSpeakerExpectation{mock: mmUpdateSpeaker.mock}
+	}
+	mmUpdateSpeaker.defaultExpectation.results = &EventsServiceMockUpdateSpeakerResults{err}
+	mmUpdateSpeaker.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSpeaker.mock
 }
 
-// Set uses given function f to mock the EventsService.UpdateEvent method
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Set(f func(ctx context.Context, userID int64, event *models.Event) (err error)) *EventsServiceMock {
-	if mmUpdateEvent.defaultExpectation != nil {
-		mmUpdateEvent.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateEvent method")
+// Set uses given function f to mock the EventsService.UpdateSpeaker method
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Set(f func(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (err error)) *EventsServiceMock {
+	if mmUpdateSpeaker.defaultExpectation != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("Default expectation is already set for the EventsService.UpdateSpeaker method")
 	}
 
-	if len(mmUpdateEvent.expectations) > 0 {
-		mmUpdateEvent.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateEvent method")
+	if len(mmUpdateSpeaker.expectations) > 0 {
+		mmUpdateSpeaker.mock.t.Fatalf("Some expectations are already set for the EventsService.UpdateSpeaker method")
 	}
 
-	mmUpdateEvent.mock.funcUpdateEvent = f
-	mmUpdateEvent.mock.funcUpdateEventOrigin = minimock.CallerInfo(1)
-	return mmUpdateEvent.mock
+	mmUpdateSpeaker.mock.funcUpdateSpeaker = f
+	mmUpdateSpeaker.mock.funcUpdateSpeakerOrigin = minimock.CallerInfo(1)
+	return mmUpdateSpeaker.mock
 }
 
-// When sets expectation for the EventsService.UpdateEvent which will trigger the result defined by the following
+// When sets expectation for the EventsService.UpdateSpeaker which will trigger the result defined by the following
 // Then helper
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) When(ctx context.Context, userID int64, event *models.Event) *EventsServiceMockUpdateEventExpectation {
-	if mmUpdateEvent.mock.funcUpdateEvent != nil {
-		mmUpdateEvent.mock.t.Fatalf("EventsServiceMock.UpdateEvent mock is already set by Set")
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) When(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) *EventsServiceMockUpdateSpeakerExpectation {
+	if mmUpdateSpeaker.mock.funcUpdateSpeaker != nil {
+		mmUpdateSpeaker.mock.t.Fatalf("EventsServiceMock.UpdateSpeaker mock is already set by Set")
 	}
 
-	expectation := &EventsServiceMockUpdateEventExpectation{
-		mock:               mmUpdateEvent.mock,
-		params:             &EventsServiceMockUpdateEventParams{ctx, userID, event},
-		expectationOrigins: EventsServiceMockUpdateEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &EventsServiceMockUpdateSpeakerExpectation{
+		mock:               mmUpdateSpeaker.mock,
+		params:             &EventsServiceMockUpdateSpeakerParams{ctx, userID, urlTitle, speaker},
+		expectationOrigins: EventsServiceMockUpdateSpeakerExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateEvent.expectations = append(mmUpdateEvent.expectations, expectation)
+	mmUpdateSpeaker.expectations = append(mmUpdateSpeaker.expectations, expectation)
 	return expectation
 }
 
-// Then sets up EventsService.UpdateEvent return parameters for the expectation previously defined by the When method
-func (e *EventsServiceMockUpdateEventExpectation) Then(err error) *EventsServiceMock {
-	e.results = &EventsServiceMockUpdateEventResults{err}
+// Then sets up EventsService.UpdateSpeaker return parameters for the expectation previously defined by the When method
+func (e *EventsServiceMockUpdateSpeakerExpectation) Then(err error) *EventsServiceMock {
+	e.results = &EventsServiceMockUpdateSpeakerResults{err}
 	return e.mock
 }
 
-// Times sets number of times EventsService.UpdateEvent should be invoked
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Times(n uint64) *mEventsServiceMockUpdateEvent {
+// Times sets number of times EventsService.UpdateSpeaker should be invoked
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Times(n uint64) *mEventsServiceMockUpdateSpeaker {
 	if n == 0 {
-		mmUpdateEvent.mock.t.Fatalf("Times of EventsServiceMock.UpdateEvent mock can not be zero")
+		mmUpdateSpeaker.mock.t.Fatalf("Times of EventsServiceMock.UpdateSpeaker mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateEvent.expectedInvocations, n)
-	mmUpdateEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateEvent
+	mm_atomic.StoreUint64(&mmUpdateSpeaker.expectedInvocations, n)
+	mmUpdateSpeaker.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSpeaker
 }
 
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) invocationsDone() bool {
-	if len(mmUpdateEvent.expectations) == 0 && mmUpdateEvent.defaultExpectation == nil && mmUpdateEvent.mock.funcUpdateEvent == nil {
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) invocationsDone() bool {
+	if len(mmUpdateSpeaker.expectations) == 0 && mmUpdateSpeaker.defaultExpectation == nil && mmUpdateSpeaker.mock.funcUpdateSpeaker == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEvent.mock.afterUpdateEventCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEvent.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSpeaker.mock.afterUpdateSpeakerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSpeaker.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateEvent implements mm_service.EventsService
-func (mmUpdateEvent *EventsServiceMock) UpdateEvent(ctx context.Context, userID int64, event *models.Event) (err error) {
-	mm_atomic.AddUint64(&mmUpdateEvent.beforeUpdateEventCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateEvent.afterUpdateEventCounter, 1)
+// UpdateSpeaker implements mm_service.EventsService
+func (mmUpdateSpeaker *EventsServiceMock) UpdateSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSpeaker.beforeUpdateSpeakerCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSpeaker.afterUpdateSpeakerCounter, 1)
 
-	mmUpdateEvent.t.Helper()
+	mmUpdateSpeaker.t.Helper()
 
-	if mmUpdateEvent.inspectFuncUpdateEvent != nil {
-		mmUpdateEvent.inspectFuncUpdateEvent(ctx, userID, event)
+	if mmUpdateSpeaker.inspectFuncUpdateSpeaker != nil {
+		mmUpdateSpeaker.inspectFuncUpdateSpeaker(ctx, userID, urlTitle, speaker)
 	}
 
-	mm_params := EventsServiceMockUpdateEventParams{ctx, userID, event}
+	mm_params := EventsServiceMockUpdateSpeakerParams{ctx, userID, urlTitle, speaker}
 
 	// Record call args
-	mmUpdateEvent.UpdateEventMock.mutex.Lock()
-	mmUpdateEvent.UpdateEventMock.callArgs = append(mmUpdateEvent.UpdateEventMock.callArgs, &mm_params)
-	mmUpdateEvent.UpdateEventMock.mutex.Unlock()
+	mmUpdateSpeaker.UpdateSpeakerMock.mutex.Lock()
+	mmUpdateSpeaker.UpdateSpeakerMock.callArgs = append(mmUpdateSpeaker.UpdateSpeakerMock.callArgs, &mm_params)
+	mmUpdateSpeaker.UpdateSpeakerMock.mutex.Unlock()
 
-	for _, e := range mmUpdateEvent.UpdateEventMock.expectations {
+	for _, e := range mmUpdateSpeaker.UpdateSpeakerMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdateEvent.UpdateEventMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateEvent.UpdateEventMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateEvent.UpdateEventMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateEvent.UpdateEventMock.defaultExpectation.paramPtrs
+	if mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.paramPtrs
 
-		mm_got := EventsServiceMockUpdateEventParams{ctx, userID, event}
+		mm_got := EventsServiceMockUpdateSpeakerParams{ctx, userID, urlTitle, speaker}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateSpeaker.t.Errorf("EventsServiceMock.UpdateSpeaker got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmUpdateSpeaker.t.Errorf("EventsServiceMock.UpdateSpeaker got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
-			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
-				mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmUpdateSpeaker.t.Errorf("EventsServiceMock.UpdateSpeaker got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.speaker != nil && !minimock.Equal(*mm_want_ptrs.speaker, mm_got.speaker) {
+				mmUpdateSpeaker.t.Errorf("EventsServiceMock.UpdateSpeaker got unexpected parameter speaker, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.expectationOrigins.originSpeaker, *mm_want_ptrs.speaker, mm_got.speaker, minimock.Diff(*mm_want_ptrs.speaker, mm_got.speaker))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateEvent.t.Errorf("EventsServiceMock.UpdateEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateEvent.UpdateEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateSpeaker.t.Errorf("EventsServiceMock.UpdateSpeaker got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateEvent.UpdateEventMock.defaultExpectation.results
+		mm_results := mmUpdateSpeaker.UpdateSpeakerMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateEvent.t.Fatal("No results are set for the EventsServiceMock.UpdateEvent")
+			mmUpdateSpeaker.t.Fatal("No results are set for the EventsServiceMock.UpdateSpeaker")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdateEvent.funcUpdateEvent != nil {
-		return mmUpdateEvent.funcUpdateEvent(ctx, userID, event)
+	if mmUpdateSpeaker.funcUpdateSpeaker != nil {
+		return mmUpdateSpeaker.funcUpdateSpeaker(ctx, userID, urlTitle, speaker)
 	}
-	mmUpdateEvent.t.Fatalf("Unexpected call to EventsServiceMock.UpdateEvent. %v %v %v", ctx, userID, event)
+	mmUpdateSpeaker.t.Fatalf("Unexpected call to EventsServiceMock.UpdateSpeaker. %v %v %v %v", ctx, userID, urlTitle, speaker)
 	return
 }
 
-// UpdateEventAfterCounter returns a count of finished EventsServiceMock.UpdateEvent invocations
-func (mmUpdateEvent *EventsServiceMock) UpdateEventAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateEvent.afterUpdateEventCounter)
+// UpdateSpeakerAfterCounter returns a count of finished EventsServiceMock.UpdateSpeaker invocations
+func (mmUpdateSpeaker *EventsServiceMock) UpdateSpeakerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSpeaker.afterUpdateSpeakerCounter)
 }
 
-// UpdateEventBeforeCounter returns a count of EventsServiceMock.UpdateEvent invocations
-func (mmUpdateEvent *EventsServiceMock) UpdateEventBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateEvent.beforeUpdateEventCounter)
+// UpdateSpeakerBeforeCounter returns a count of EventsServiceMock.UpdateSpeaker invocations
+func (mmUpdateSpeaker *EventsServiceMock) UpdateSpeakerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSpeaker.beforeUpdateSpeakerCounter)
 }
 
-// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateEvent.
+// Calls returns a list of arguments used in each call to EventsServiceMock.UpdateSpeaker.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateEvent *mEventsServiceMockUpdateEvent) Calls() []*EventsServiceMockUpdateEventParams {
-	mmUpdateEvent.mutex.RLock()
+func (mmUpdateSpeaker *mEventsServiceMockUpdateSpeaker) Calls() []*EventsServiceMockUpdateSpeakerParams {
+	mmUpdateSpeaker.mutex.RLock()
 
-	argCopy := make([]*EventsServiceMockUpdateEventParams, len(mmUpdateEvent.callArgs))
-	copy(argCopy, mmUpdateEvent.callArgs)
+	argCopy := make([]*EventsServiceMockUpdateSpeakerParams, len(mmUpdateSpeaker.callArgs))
+	copy(argCopy, mmUpdateSpeaker.callArgs)
 
-	mmUpdateEvent.mutex.RUnlock()
+	mmUpdateSpeaker.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateEventDone returns true if the count of the UpdateEvent invocations corresponds
+// MinimockUpdateSpeakerDone returns true if the count of the UpdateSpeaker invocations corresponds
 // the number of defined expectations
-func (m *EventsServiceMock) MinimockUpdateEventDone() bool {
-	if m.UpdateEventMock.optional {
+func (m *EventsServiceMock) MinimockUpdateSpeakerDone() bool {
+	if m.UpdateSpeakerMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateEventMock.expectations {
+	for _, e := range m.UpdateSpeakerMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateEventMock.invocationsDone()
+	return m.UpdateSpeakerMock.invocationsDone()
 }
 
-// MinimockUpdateEventInspect logs each unmet expectation
-func (m *EventsServiceMock) MinimockUpdateEventInspect() {
-	for _, e := range m.UpdateEventMock.expectations {
+// MinimockUpdateSpeakerInspect logs each unmet expectation
+func (m *EventsServiceMock) MinimockUpdateSpeakerInspect() {
+	for _, e := range m.UpdateSpeakerMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSpeaker at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateEventCounter := mm_atomic.LoadUint64(&m.afterUpdateEventCounter)
+	afterUpdateSpeakerCounter := mm_atomic.LoadUint64(&m.afterUpdateSpeakerCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateEventMock.defaultExpectation != nil && afterUpdateEventCounter < 1 {
-		if m.UpdateEventMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s", m.UpdateEventMock.defaultExpectation.returnOrigin)
+	if m.UpdateSpeakerMock.defaultExpectation != nil && afterUpdateSpeakerCounter < 1 {
+		if m.UpdateSpeakerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSpeaker at\n%s", m.UpdateSpeakerMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s with params: %#v", m.UpdateEventMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEventMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to EventsServiceMock.UpdateSpeaker at\n%s with params: %#v", m.UpdateSpeakerMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSpeakerMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateEvent != nil && afterUpdateEventCounter < 1 {
-		m.t.Errorf("Expected call to EventsServiceMock.UpdateEvent at\n%s", m.funcUpdateEventOrigin)
+	if m.funcUpdateSpeaker != nil && afterUpdateSpeakerCounter < 1 {
+		m.t.Errorf("Expected call to EventsServiceMock.UpdateSpeaker at\n%s", m.funcUpdateSpeakerOrigin)
 	}
 
-	if !m.UpdateEventMock.invocationsDone() && afterUpdateEventCounter > 0 {
-		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateEvent at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateEventMock.expectedInvocations), m.UpdateEventMock.expectedInvocationsOrigin, afterUpdateEventCounter)
+	if !m.UpdateSpeakerMock.invocationsDone() && afterUpdateSpeakerCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventsServiceMock.UpdateSpeaker at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSpeakerMock.expectedInvocations), m.UpdateSpeakerMock.expectedInvocationsOrigin, afterUpdateSpeakerCounter)
 	}
 }
 
@@ -2215,16 +16228,86 @@ func (m *EventsServiceMock) MinimockUserEventsInspect() {
 func (m *EventsServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddEventImagesInspect()
+
+			m.MinimockAddQuestionInspect()
+
+			m.MinimockAddReviewInspect()
+
+			m.MinimockAddSessionInspect()
+
+			m.MinimockAddSpeakerInspect()
+
+			m.MinimockBookmarkEventInspect()
+
+			m.MinimockBookmarksInspect()
+
+			m.MinimockCancelEventInspect()
+
+			m.MinimockCancellationProgressInspect()
+
+			m.MinimockCloneEventInspect()
+
 			m.MinimockCreateEventInspect()
 
+			m.MinimockDeleteBookmarkInspect()
+
 			m.MinimockDeleteEventInspect()
 
+			m.MinimockDeleteEventImageInspect()
+
+			m.MinimockDeleteEventMemberInspect()
+
+			m.MinimockDeleteQuestionInspect()
+
+			m.MinimockDeleteSessionInspect()
+
+			m.MinimockDeleteSpeakerInspect()
+
 			m.MinimockEventInspect()
 
+			m.MinimockEventHistoryInspect()
+
+			m.MinimockEventMembersInspect()
+
+			m.MinimockEventRedirectInspect()
+
 			m.MinimockEventsInspect()
 
+			m.MinimockEventsNearInspect()
+
+			m.MinimockFeedInspect()
+
+			m.MinimockFollowOrganizerInspect()
+
+			m.MinimockInviteEventMemberInspect()
+
+			m.MinimockOrganizerProfileInspect()
+
+			m.MinimockRecordViewInspect()
+
+			m.MinimockReorderEventImagesInspect()
+
+			m.MinimockReplyToReviewInspect()
+
+			m.MinimockReviewsInspect()
+
+			m.MinimockUnfollowOrganizerInspect()
+
 			m.MinimockUpdateEventInspect()
 
+			m.MinimockUpdateEventImageInspect()
+
+			m.MinimockUpdateEventSlugInspect()
+
+			m.MinimockUpdateOrganizerProfileInspect()
+
+			m.MinimockUpdateQuestionInspect()
+
+			m.MinimockUpdateSessionInspect()
+
+			m.MinimockUpdateSpeakerInspect()
+
 			m.MinimockUserEventsInspect()
 		}
 	})
@@ -2249,10 +16332,45 @@ func (m *EventsServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *EventsServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddEventImagesDone() &&
+		m.MinimockAddQuestionDone() &&
+		m.MinimockAddReviewDone() &&
+		m.MinimockAddSessionDone() &&
+		m.MinimockAddSpeakerDone() &&
+		m.MinimockBookmarkEventDone() &&
+		m.MinimockBookmarksDone() &&
+		m.MinimockCancelEventDone() &&
+		m.MinimockCancellationProgressDone() &&
+		m.MinimockCloneEventDone() &&
 		m.MinimockCreateEventDone() &&
+		m.MinimockDeleteBookmarkDone() &&
 		m.MinimockDeleteEventDone() &&
+		m.MinimockDeleteEventImageDone() &&
+		m.MinimockDeleteEventMemberDone() &&
+		m.MinimockDeleteQuestionDone() &&
+		m.MinimockDeleteSessionDone() &&
+		m.MinimockDeleteSpeakerDone() &&
 		m.MinimockEventDone() &&
+		m.MinimockEventHistoryDone() &&
+		m.MinimockEventMembersDone() &&
+		m.MinimockEventRedirectDone() &&
 		m.MinimockEventsDone() &&
+		m.MinimockEventsNearDone() &&
+		m.MinimockFeedDone() &&
+		m.MinimockFollowOrganizerDone() &&
+		m.MinimockInviteEventMemberDone() &&
+		m.MinimockOrganizerProfileDone() &&
+		m.MinimockRecordViewDone() &&
+		m.MinimockReorderEventImagesDone() &&
+		m.MinimockReplyToReviewDone() &&
+		m.MinimockReviewsDone() &&
+		m.MinimockUnfollowOrganizerDone() &&
 		m.MinimockUpdateEventDone() &&
+		m.MinimockUpdateEventImageDone() &&
+		m.MinimockUpdateEventSlugDone() &&
+		m.MinimockUpdateOrganizerProfileDone() &&
+		m.MinimockUpdateQuestionDone() &&
+		m.MinimockUpdateSessionDone() &&
+		m.MinimockUpdateSpeakerDone() &&
 		m.MinimockUserEventsDone()
 }
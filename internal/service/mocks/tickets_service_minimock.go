@@ -19,6 +19,13 @@ type TicketsServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAttendees          func(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) (ap1 *models.AttendeesPage, err error)
+	funcAttendeesOrigin    string
+	inspectFuncAttendees   func(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter)
+	afterAttendeesCounter  uint64
+	beforeAttendeesCounter uint64
+	AttendeesMock          mTicketsServiceMockAttendees
+
 	funcBuyTicket          func(ctx context.Context, req *models.BuyTicketRequest) (s1 string, err error)
 	funcBuyTicketOrigin    string
 	inspectFuncBuyTicket   func(ctx context.Context, req *models.BuyTicketRequest)
@@ -26,6 +33,41 @@ type TicketsServiceMock struct {
 	beforeBuyTicketCounter uint64
 	BuyTicketMock          mTicketsServiceMockBuyTicket
 
+	funcCheckIn          func(ctx context.Context, userID int64, urlTitle string, ticketID string) (tp1 *models.Ticket, err error)
+	funcCheckInOrigin    string
+	inspectFuncCheckIn   func(ctx context.Context, userID int64, urlTitle string, ticketID string)
+	afterCheckInCounter  uint64
+	beforeCheckInCounter uint64
+	CheckInMock          mTicketsServiceMockCheckIn
+
+	funcEventStats          func(ctx context.Context, userID int64, urlTitle string, interval string) (ep1 *models.EventStats, err error)
+	funcEventStatsOrigin    string
+	inspectFuncEventStats   func(ctx context.Context, userID int64, urlTitle string, interval string)
+	afterEventStatsCounter  uint64
+	beforeEventStatsCounter uint64
+	EventStatsMock          mTicketsServiceMockEventStats
+
+	funcExportAttendees          func(ctx context.Context, userID int64, urlTitle string, format string, search string) (ba1 []byte, err error)
+	funcExportAttendeesOrigin    string
+	inspectFuncExportAttendees   func(ctx context.Context, userID int64, urlTitle string, format string, search string)
+	afterExportAttendeesCounter  uint64
+	beforeExportAttendeesCounter uint64
+	ExportAttendeesMock          mTicketsServiceMockExportAttendees
+
+	funcFunnel          func(ctx context.Context, userID int64, urlTitle string) (fp1 *models.Funnel, err error)
+	funcFunnelOrigin    string
+	inspectFuncFunnel   func(ctx context.Context, userID int64, urlTitle string)
+	afterFunnelCounter  uint64
+	beforeFunnelCounter uint64
+	FunnelMock          mTicketsServiceMockFunnel
+
+	funcSalesReport          func(ctx context.Context, userID int64, urlTitle string) (sp1 *models.SalesReport, err error)
+	funcSalesReportOrigin    string
+	inspectFuncSalesReport   func(ctx context.Context, userID int64, urlTitle string)
+	afterSalesReportCounter  uint64
+	beforeSalesReportCounter uint64
+	SalesReportMock          mTicketsServiceMockSalesReport
+
 	funcTicket          func(ctx context.Context, ticketID string) (tp1 *models.Ticket, err error)
 	funcTicketOrigin    string
 	inspectFuncTicket   func(ctx context.Context, ticketID string)
@@ -33,6 +75,13 @@ type TicketsServiceMock struct {
 	beforeTicketCounter uint64
 	TicketMock          mTicketsServiceMockTicket
 
+	funcUserStats          func(ctx context.Context, userID int64) (up1 *models.UserStats, err error)
+	funcUserStatsOrigin    string
+	inspectFuncUserStats   func(ctx context.Context, userID int64)
+	afterUserStatsCounter  uint64
+	beforeUserStatsCounter uint64
+	UserStatsMock          mTicketsServiceMockUserStats
+
 	funcUserTickets          func(ctx context.Context, userID int64) (tpa1 []*models.Ticket, err error)
 	funcUserTicketsOrigin    string
 	inspectFuncUserTickets   func(ctx context.Context, userID int64)
@@ -49,12 +98,33 @@ func NewTicketsServiceMock(t minimock.Tester) *TicketsServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AttendeesMock = mTicketsServiceMockAttendees{mock: m}
+	m.AttendeesMock.callArgs = []*TicketsServiceMockAttendeesParams{}
+
 	m.BuyTicketMock = mTicketsServiceMockBuyTicket{mock: m}
 	m.BuyTicketMock.callArgs = []*TicketsServiceMockBuyTicketParams{}
 
+	m.CheckInMock = mTicketsServiceMockCheckIn{mock: m}
+	m.CheckInMock.callArgs = []*TicketsServiceMockCheckInParams{}
+
+	m.EventStatsMock = mTicketsServiceMockEventStats{mock: m}
+	m.EventStatsMock.callArgs = []*TicketsServiceMockEventStatsParams{}
+
+	m.ExportAttendeesMock = mTicketsServiceMockExportAttendees{mock: m}
+	m.ExportAttendeesMock.callArgs = []*TicketsServiceMockExportAttendeesParams{}
+
+	m.FunnelMock = mTicketsServiceMockFunnel{mock: m}
+	m.FunnelMock.callArgs = []*TicketsServiceMockFunnelParams{}
+
+	m.SalesReportMock = mTicketsServiceMockSalesReport{mock: m}
+	m.SalesReportMock.callArgs = []*TicketsServiceMockSalesReportParams{}
+
 	m.TicketMock = mTicketsServiceMockTicket{mock: m}
 	m.TicketMock.callArgs = []*TicketsServiceMockTicketParams{}
 
+	m.UserStatsMock = mTicketsServiceMockUserStats{mock: m}
+	m.UserStatsMock.callArgs = []*TicketsServiceMockUserStatsParams{}
+
 	m.UserTicketsMock = mTicketsServiceMockUserTickets{mock: m}
 	m.UserTicketsMock.callArgs = []*TicketsServiceMockUserTicketsParams{}
 
@@ -63,6 +133,411 @@ func NewTicketsServiceMock(t minimock.Tester) *TicketsServiceMock {
 	return m
 }
 
+type mTicketsServiceMockAttendees struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockAttendeesExpectation
+	expectations       []*TicketsServiceMockAttendeesExpectation
+
+	callArgs []*TicketsServiceMockAttendeesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockAttendeesExpectation specifies expectation struct of the TicketsService.Attendees
+type TicketsServiceMockAttendeesExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockAttendeesParams
+	paramPtrs          *TicketsServiceMockAttendeesParamPtrs
+	expectationOrigins TicketsServiceMockAttendeesExpectationOrigins
+	results            *TicketsServiceMockAttendeesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockAttendeesParams contains parameters of the TicketsService.Attendees
+type TicketsServiceMockAttendeesParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	filter   *models.AttendeesFilter
+}
+
+// TicketsServiceMockAttendeesParamPtrs contains pointers to parameters of the TicketsService.Attendees
+type TicketsServiceMockAttendeesParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	filter   **models.AttendeesFilter
+}
+
+// TicketsServiceMockAttendeesResults contains results of the TicketsService.Attendees
+type TicketsServiceMockAttendeesResults struct {
+	ap1 *models.AttendeesPage
+	err error
+}
+
+// TicketsServiceMockAttendeesOrigins contains origins of expectations of the TicketsService.Attendees
+type TicketsServiceMockAttendeesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originFilter   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAttendees *mTicketsServiceMockAttendees) Optional() *mTicketsServiceMockAttendees {
+	mmAttendees.optional = true
+	return mmAttendees
+}
+
+// Expect sets up expected params for TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) Expect(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{}
+	}
+
+	if mmAttendees.defaultExpectation.paramPtrs != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by ExpectParams functions")
+	}
+
+	mmAttendees.defaultExpectation.params = &TicketsServiceMockAttendeesParams{ctx, userID, urlTitle, filter}
+	mmAttendees.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAttendees.expectations {
+		if minimock.Equal(e.params, mmAttendees.defaultExpectation.params) {
+			mmAttendees.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAttendees.defaultExpectation.params)
+		}
+	}
+
+	return mmAttendees
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{}
+	}
+
+	if mmAttendees.defaultExpectation.params != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Expect")
+	}
+
+	if mmAttendees.defaultExpectation.paramPtrs == nil {
+		mmAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockAttendeesParamPtrs{}
+	}
+	mmAttendees.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAttendees.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAttendees
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) ExpectUserIDParam2(userID int64) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{}
+	}
+
+	if mmAttendees.defaultExpectation.params != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Expect")
+	}
+
+	if mmAttendees.defaultExpectation.paramPtrs == nil {
+		mmAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockAttendeesParamPtrs{}
+	}
+	mmAttendees.defaultExpectation.paramPtrs.userID = &userID
+	mmAttendees.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAttendees
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{}
+	}
+
+	if mmAttendees.defaultExpectation.params != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Expect")
+	}
+
+	if mmAttendees.defaultExpectation.paramPtrs == nil {
+		mmAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockAttendeesParamPtrs{}
+	}
+	mmAttendees.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmAttendees.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmAttendees
+}
+
+// ExpectFilterParam4 sets up expected param filter for TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) ExpectFilterParam4(filter *models.AttendeesFilter) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{}
+	}
+
+	if mmAttendees.defaultExpectation.params != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Expect")
+	}
+
+	if mmAttendees.defaultExpectation.paramPtrs == nil {
+		mmAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockAttendeesParamPtrs{}
+	}
+	mmAttendees.defaultExpectation.paramPtrs.filter = &filter
+	mmAttendees.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmAttendees
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) Inspect(f func(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter)) *mTicketsServiceMockAttendees {
+	if mmAttendees.mock.inspectFuncAttendees != nil {
+		mmAttendees.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.Attendees")
+	}
+
+	mmAttendees.mock.inspectFuncAttendees = f
+
+	return mmAttendees
+}
+
+// Return sets up results that will be returned by TicketsService.Attendees
+func (mmAttendees *mTicketsServiceMockAttendees) Return(ap1 *models.AttendeesPage, err error) *TicketsServiceMock {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	if mmAttendees.defaultExpectation == nil {
+		mmAttendees.defaultExpectation = &TicketsServiceMockAttendeesExpectation{mock: mmAttendees.mock}
+	}
+	mmAttendees.defaultExpectation.results = &TicketsServiceMockAttendeesResults{ap1, err}
+	mmAttendees.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAttendees.mock
+}
+
+// Set uses given function f to mock the TicketsService.Attendees method
+func (mmAttendees *mTicketsServiceMockAttendees) Set(f func(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) (ap1 *models.AttendeesPage, err error)) *TicketsServiceMock {
+	if mmAttendees.defaultExpectation != nil {
+		mmAttendees.mock.t.Fatalf("Default expectation is already set for the TicketsService.Attendees method")
+	}
+
+	if len(mmAttendees.expectations) > 0 {
+		mmAttendees.mock.t.Fatalf("Some expectations are already set for the TicketsService.Attendees method")
+	}
+
+	mmAttendees.mock.funcAttendees = f
+	mmAttendees.mock.funcAttendeesOrigin = minimock.CallerInfo(1)
+	return mmAttendees.mock
+}
+
+// When sets expectation for the TicketsService.Attendees which will trigger the result defined by the following
+// Then helper
+func (mmAttendees *mTicketsServiceMockAttendees) When(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) *TicketsServiceMockAttendeesExpectation {
+	if mmAttendees.mock.funcAttendees != nil {
+		mmAttendees.mock.t.Fatalf("TicketsServiceMock.Attendees mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockAttendeesExpectation{
+		mock:               mmAttendees.mock,
+		params:             &TicketsServiceMockAttendeesParams{ctx, userID, urlTitle, filter},
+		expectationOrigins: TicketsServiceMockAttendeesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAttendees.expectations = append(mmAttendees.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.Attendees return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockAttendeesExpectation) Then(ap1 *models.AttendeesPage, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockAttendeesResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.Attendees should be invoked
+func (mmAttendees *mTicketsServiceMockAttendees) Times(n uint64) *mTicketsServiceMockAttendees {
+	if n == 0 {
+		mmAttendees.mock.t.Fatalf("Times of TicketsServiceMock.Attendees mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAttendees.expectedInvocations, n)
+	mmAttendees.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAttendees
+}
+
+func (mmAttendees *mTicketsServiceMockAttendees) invocationsDone() bool {
+	if len(mmAttendees.expectations) == 0 && mmAttendees.defaultExpectation == nil && mmAttendees.mock.funcAttendees == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAttendees.mock.afterAttendeesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAttendees.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Attendees implements mm_service.TicketsService
+func (mmAttendees *TicketsServiceMock) Attendees(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) (ap1 *models.AttendeesPage, err error) {
+	mm_atomic.AddUint64(&mmAttendees.beforeAttendeesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAttendees.afterAttendeesCounter, 1)
+
+	mmAttendees.t.Helper()
+
+	if mmAttendees.inspectFuncAttendees != nil {
+		mmAttendees.inspectFuncAttendees(ctx, userID, urlTitle, filter)
+	}
+
+	mm_params := TicketsServiceMockAttendeesParams{ctx, userID, urlTitle, filter}
+
+	// Record call args
+	mmAttendees.AttendeesMock.mutex.Lock()
+	mmAttendees.AttendeesMock.callArgs = append(mmAttendees.AttendeesMock.callArgs, &mm_params)
+	mmAttendees.AttendeesMock.mutex.Unlock()
+
+	for _, e := range mmAttendees.AttendeesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmAttendees.AttendeesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAttendees.AttendeesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAttendees.AttendeesMock.defaultExpectation.params
+		mm_want_ptrs := mmAttendees.AttendeesMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockAttendeesParams{ctx, userID, urlTitle, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAttendees.t.Errorf("TicketsServiceMock.Attendees got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttendees.AttendeesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAttendees.t.Errorf("TicketsServiceMock.Attendees got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttendees.AttendeesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmAttendees.t.Errorf("TicketsServiceMock.Attendees got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttendees.AttendeesMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmAttendees.t.Errorf("TicketsServiceMock.Attendees got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttendees.AttendeesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAttendees.t.Errorf("TicketsServiceMock.Attendees got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAttendees.AttendeesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAttendees.AttendeesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAttendees.t.Fatal("No results are set for the TicketsServiceMock.Attendees")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmAttendees.funcAttendees != nil {
+		return mmAttendees.funcAttendees(ctx, userID, urlTitle, filter)
+	}
+	mmAttendees.t.Fatalf("Unexpected call to TicketsServiceMock.Attendees. %v %v %v %v", ctx, userID, urlTitle, filter)
+	return
+}
+
+// AttendeesAfterCounter returns a count of finished TicketsServiceMock.Attendees invocations
+func (mmAttendees *TicketsServiceMock) AttendeesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAttendees.afterAttendeesCounter)
+}
+
+// AttendeesBeforeCounter returns a count of TicketsServiceMock.Attendees invocations
+func (mmAttendees *TicketsServiceMock) AttendeesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAttendees.beforeAttendeesCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.Attendees.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAttendees *mTicketsServiceMockAttendees) Calls() []*TicketsServiceMockAttendeesParams {
+	mmAttendees.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockAttendeesParams, len(mmAttendees.callArgs))
+	copy(argCopy, mmAttendees.callArgs)
+
+	mmAttendees.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAttendeesDone returns true if the count of the Attendees invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockAttendeesDone() bool {
+	if m.AttendeesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AttendeesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AttendeesMock.invocationsDone()
+}
+
+// MinimockAttendeesInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockAttendeesInspect() {
+	for _, e := range m.AttendeesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.Attendees at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAttendeesCounter := mm_atomic.LoadUint64(&m.afterAttendeesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AttendeesMock.defaultExpectation != nil && afterAttendeesCounter < 1 {
+		if m.AttendeesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.Attendees at\n%s", m.AttendeesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.Attendees at\n%s with params: %#v", m.AttendeesMock.defaultExpectation.expectationOrigins.origin, *m.AttendeesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAttendees != nil && afterAttendeesCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.Attendees at\n%s", m.funcAttendeesOrigin)
+	}
+
+	if !m.AttendeesMock.invocationsDone() && afterAttendeesCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.Attendees at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AttendeesMock.expectedInvocations), m.AttendeesMock.expectedInvocationsOrigin, afterAttendeesCounter)
+	}
+}
+
 type mTicketsServiceMockBuyTicket struct {
 	optional           bool
 	mock               *TicketsServiceMock
@@ -255,154 +730,2148 @@ func (e *TicketsServiceMockBuyTicketExpectation) Then(s1 string, err error) *Tic
 	return e.mock
 }
 
-// Times sets number of times TicketsService.BuyTicket should be invoked
-func (mmBuyTicket *mTicketsServiceMockBuyTicket) Times(n uint64) *mTicketsServiceMockBuyTicket {
+// Times sets number of times TicketsService.BuyTicket should be invoked
+func (mmBuyTicket *mTicketsServiceMockBuyTicket) Times(n uint64) *mTicketsServiceMockBuyTicket {
+	if n == 0 {
+		mmBuyTicket.mock.t.Fatalf("Times of TicketsServiceMock.BuyTicket mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBuyTicket.expectedInvocations, n)
+	mmBuyTicket.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBuyTicket
+}
+
+func (mmBuyTicket *mTicketsServiceMockBuyTicket) invocationsDone() bool {
+	if len(mmBuyTicket.expectations) == 0 && mmBuyTicket.defaultExpectation == nil && mmBuyTicket.mock.funcBuyTicket == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBuyTicket.mock.afterBuyTicketCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBuyTicket.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BuyTicket implements mm_service.TicketsService
+func (mmBuyTicket *TicketsServiceMock) BuyTicket(ctx context.Context, req *models.BuyTicketRequest) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmBuyTicket.beforeBuyTicketCounter, 1)
+	defer mm_atomic.AddUint64(&mmBuyTicket.afterBuyTicketCounter, 1)
+
+	mmBuyTicket.t.Helper()
+
+	if mmBuyTicket.inspectFuncBuyTicket != nil {
+		mmBuyTicket.inspectFuncBuyTicket(ctx, req)
+	}
+
+	mm_params := TicketsServiceMockBuyTicketParams{ctx, req}
+
+	// Record call args
+	mmBuyTicket.BuyTicketMock.mutex.Lock()
+	mmBuyTicket.BuyTicketMock.callArgs = append(mmBuyTicket.BuyTicketMock.callArgs, &mm_params)
+	mmBuyTicket.BuyTicketMock.mutex.Unlock()
+
+	for _, e := range mmBuyTicket.BuyTicketMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmBuyTicket.BuyTicketMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBuyTicket.BuyTicketMock.defaultExpectation.Counter, 1)
+		mm_want := mmBuyTicket.BuyTicketMock.defaultExpectation.params
+		mm_want_ptrs := mmBuyTicket.BuyTicketMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockBuyTicketParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBuyTicket.BuyTicketMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBuyTicket.t.Fatal("No results are set for the TicketsServiceMock.BuyTicket")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmBuyTicket.funcBuyTicket != nil {
+		return mmBuyTicket.funcBuyTicket(ctx, req)
+	}
+	mmBuyTicket.t.Fatalf("Unexpected call to TicketsServiceMock.BuyTicket. %v %v", ctx, req)
+	return
+}
+
+// BuyTicketAfterCounter returns a count of finished TicketsServiceMock.BuyTicket invocations
+func (mmBuyTicket *TicketsServiceMock) BuyTicketAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBuyTicket.afterBuyTicketCounter)
+}
+
+// BuyTicketBeforeCounter returns a count of TicketsServiceMock.BuyTicket invocations
+func (mmBuyTicket *TicketsServiceMock) BuyTicketBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBuyTicket.beforeBuyTicketCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.BuyTicket.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBuyTicket *mTicketsServiceMockBuyTicket) Calls() []*TicketsServiceMockBuyTicketParams {
+	mmBuyTicket.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockBuyTicketParams, len(mmBuyTicket.callArgs))
+	copy(argCopy, mmBuyTicket.callArgs)
+
+	mmBuyTicket.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBuyTicketDone returns true if the count of the BuyTicket invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockBuyTicketDone() bool {
+	if m.BuyTicketMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BuyTicketMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BuyTicketMock.invocationsDone()
+}
+
+// MinimockBuyTicketInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockBuyTicketInspect() {
+	for _, e := range m.BuyTicketMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBuyTicketCounter := mm_atomic.LoadUint64(&m.afterBuyTicketCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BuyTicketMock.defaultExpectation != nil && afterBuyTicketCounter < 1 {
+		if m.BuyTicketMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s", m.BuyTicketMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s with params: %#v", m.BuyTicketMock.defaultExpectation.expectationOrigins.origin, *m.BuyTicketMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBuyTicket != nil && afterBuyTicketCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s", m.funcBuyTicketOrigin)
+	}
+
+	if !m.BuyTicketMock.invocationsDone() && afterBuyTicketCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.BuyTicket at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BuyTicketMock.expectedInvocations), m.BuyTicketMock.expectedInvocationsOrigin, afterBuyTicketCounter)
+	}
+}
+
+type mTicketsServiceMockCheckIn struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockCheckInExpectation
+	expectations       []*TicketsServiceMockCheckInExpectation
+
+	callArgs []*TicketsServiceMockCheckInParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockCheckInExpectation specifies expectation struct of the TicketsService.CheckIn
+type TicketsServiceMockCheckInExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockCheckInParams
+	paramPtrs          *TicketsServiceMockCheckInParamPtrs
+	expectationOrigins TicketsServiceMockCheckInExpectationOrigins
+	results            *TicketsServiceMockCheckInResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockCheckInParams contains parameters of the TicketsService.CheckIn
+type TicketsServiceMockCheckInParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	ticketID string
+}
+
+// TicketsServiceMockCheckInParamPtrs contains pointers to parameters of the TicketsService.CheckIn
+type TicketsServiceMockCheckInParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	ticketID *string
+}
+
+// TicketsServiceMockCheckInResults contains results of the TicketsService.CheckIn
+type TicketsServiceMockCheckInResults struct {
+	tp1 *models.Ticket
+	err error
+}
+
+// TicketsServiceMockCheckInOrigins contains origins of expectations of the TicketsService.CheckIn
+type TicketsServiceMockCheckInExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originTicketID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckIn *mTicketsServiceMockCheckIn) Optional() *mTicketsServiceMockCheckIn {
+	mmCheckIn.optional = true
+	return mmCheckIn
+}
+
+// Expect sets up expected params for TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) Expect(ctx context.Context, userID int64, urlTitle string, ticketID string) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{}
+	}
+
+	if mmCheckIn.defaultExpectation.paramPtrs != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by ExpectParams functions")
+	}
+
+	mmCheckIn.defaultExpectation.params = &TicketsServiceMockCheckInParams{ctx, userID, urlTitle, ticketID}
+	mmCheckIn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckIn.expectations {
+		if minimock.Equal(e.params, mmCheckIn.defaultExpectation.params) {
+			mmCheckIn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIn.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckIn
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{}
+	}
+
+	if mmCheckIn.defaultExpectation.params != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Expect")
+	}
+
+	if mmCheckIn.defaultExpectation.paramPtrs == nil {
+		mmCheckIn.defaultExpectation.paramPtrs = &TicketsServiceMockCheckInParamPtrs{}
+	}
+	mmCheckIn.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckIn.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckIn
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) ExpectUserIDParam2(userID int64) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{}
+	}
+
+	if mmCheckIn.defaultExpectation.params != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Expect")
+	}
+
+	if mmCheckIn.defaultExpectation.paramPtrs == nil {
+		mmCheckIn.defaultExpectation.paramPtrs = &TicketsServiceMockCheckInParamPtrs{}
+	}
+	mmCheckIn.defaultExpectation.paramPtrs.userID = &userID
+	mmCheckIn.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCheckIn
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{}
+	}
+
+	if mmCheckIn.defaultExpectation.params != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Expect")
+	}
+
+	if mmCheckIn.defaultExpectation.paramPtrs == nil {
+		mmCheckIn.defaultExpectation.paramPtrs = &TicketsServiceMockCheckInParamPtrs{}
+	}
+	mmCheckIn.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmCheckIn.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmCheckIn
+}
+
+// ExpectTicketIDParam4 sets up expected param ticketID for TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) ExpectTicketIDParam4(ticketID string) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{}
+	}
+
+	if mmCheckIn.defaultExpectation.params != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Expect")
+	}
+
+	if mmCheckIn.defaultExpectation.paramPtrs == nil {
+		mmCheckIn.defaultExpectation.paramPtrs = &TicketsServiceMockCheckInParamPtrs{}
+	}
+	mmCheckIn.defaultExpectation.paramPtrs.ticketID = &ticketID
+	mmCheckIn.defaultExpectation.expectationOrigins.originTicketID = minimock.CallerInfo(1)
+
+	return mmCheckIn
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) Inspect(f func(ctx context.Context, userID int64, urlTitle string, ticketID string)) *mTicketsServiceMockCheckIn {
+	if mmCheckIn.mock.inspectFuncCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.CheckIn")
+	}
+
+	mmCheckIn.mock.inspectFuncCheckIn = f
+
+	return mmCheckIn
+}
+
+// Return sets up results that will be returned by TicketsService.CheckIn
+func (mmCheckIn *mTicketsServiceMockCheckIn) Return(tp1 *models.Ticket, err error) *TicketsServiceMock {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	if mmCheckIn.defaultExpectation == nil {
+		mmCheckIn.defaultExpectation = &TicketsServiceMockCheckInExpectation{mock: mmCheckIn.mock}
+	}
+	mmCheckIn.defaultExpectation.results = &TicketsServiceMockCheckInResults{tp1, err}
+	mmCheckIn.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckIn.mock
+}
+
+// Set uses given function f to mock the TicketsService.CheckIn method
+func (mmCheckIn *mTicketsServiceMockCheckIn) Set(f func(ctx context.Context, userID int64, urlTitle string, ticketID string) (tp1 *models.Ticket, err error)) *TicketsServiceMock {
+	if mmCheckIn.defaultExpectation != nil {
+		mmCheckIn.mock.t.Fatalf("Default expectation is already set for the TicketsService.CheckIn method")
+	}
+
+	if len(mmCheckIn.expectations) > 0 {
+		mmCheckIn.mock.t.Fatalf("Some expectations are already set for the TicketsService.CheckIn method")
+	}
+
+	mmCheckIn.mock.funcCheckIn = f
+	mmCheckIn.mock.funcCheckInOrigin = minimock.CallerInfo(1)
+	return mmCheckIn.mock
+}
+
+// When sets expectation for the TicketsService.CheckIn which will trigger the result defined by the following
+// Then helper
+func (mmCheckIn *mTicketsServiceMockCheckIn) When(ctx context.Context, userID int64, urlTitle string, ticketID string) *TicketsServiceMockCheckInExpectation {
+	if mmCheckIn.mock.funcCheckIn != nil {
+		mmCheckIn.mock.t.Fatalf("TicketsServiceMock.CheckIn mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockCheckInExpectation{
+		mock:               mmCheckIn.mock,
+		params:             &TicketsServiceMockCheckInParams{ctx, userID, urlTitle, ticketID},
+		expectationOrigins: TicketsServiceMockCheckInExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckIn.expectations = append(mmCheckIn.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.CheckIn return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockCheckInExpectation) Then(tp1 *models.Ticket, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockCheckInResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.CheckIn should be invoked
+func (mmCheckIn *mTicketsServiceMockCheckIn) Times(n uint64) *mTicketsServiceMockCheckIn {
+	if n == 0 {
+		mmCheckIn.mock.t.Fatalf("Times of TicketsServiceMock.CheckIn mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckIn.expectedInvocations, n)
+	mmCheckIn.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckIn
+}
+
+func (mmCheckIn *mTicketsServiceMockCheckIn) invocationsDone() bool {
+	if len(mmCheckIn.expectations) == 0 && mmCheckIn.defaultExpectation == nil && mmCheckIn.mock.funcCheckIn == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckIn.mock.afterCheckInCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIn.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckIn implements mm_service.TicketsService
+func (mmCheckIn *TicketsServiceMock) CheckIn(ctx context.Context, userID int64, urlTitle string, ticketID string) (tp1 *models.Ticket, err error) {
+	mm_atomic.AddUint64(&mmCheckIn.beforeCheckInCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckIn.afterCheckInCounter, 1)
+
+	mmCheckIn.t.Helper()
+
+	if mmCheckIn.inspectFuncCheckIn != nil {
+		mmCheckIn.inspectFuncCheckIn(ctx, userID, urlTitle, ticketID)
+	}
+
+	mm_params := TicketsServiceMockCheckInParams{ctx, userID, urlTitle, ticketID}
+
+	// Record call args
+	mmCheckIn.CheckInMock.mutex.Lock()
+	mmCheckIn.CheckInMock.callArgs = append(mmCheckIn.CheckInMock.callArgs, &mm_params)
+	mmCheckIn.CheckInMock.mutex.Unlock()
+
+	for _, e := range mmCheckIn.CheckInMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmCheckIn.CheckInMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckIn.CheckInMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckIn.CheckInMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckIn.CheckInMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockCheckInParams{ctx, userID, urlTitle, ticketID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckIn.t.Errorf("TicketsServiceMock.CheckIn got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIn.CheckInMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheckIn.t.Errorf("TicketsServiceMock.CheckIn got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIn.CheckInMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmCheckIn.t.Errorf("TicketsServiceMock.CheckIn got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIn.CheckInMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.ticketID != nil && !minimock.Equal(*mm_want_ptrs.ticketID, mm_got.ticketID) {
+				mmCheckIn.t.Errorf("TicketsServiceMock.CheckIn got unexpected parameter ticketID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIn.CheckInMock.defaultExpectation.expectationOrigins.originTicketID, *mm_want_ptrs.ticketID, mm_got.ticketID, minimock.Diff(*mm_want_ptrs.ticketID, mm_got.ticketID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckIn.t.Errorf("TicketsServiceMock.CheckIn got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckIn.CheckInMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckIn.CheckInMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckIn.t.Fatal("No results are set for the TicketsServiceMock.CheckIn")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmCheckIn.funcCheckIn != nil {
+		return mmCheckIn.funcCheckIn(ctx, userID, urlTitle, ticketID)
+	}
+	mmCheckIn.t.Fatalf("Unexpected call to TicketsServiceMock.CheckIn. %v %v %v %v", ctx, userID, urlTitle, ticketID)
+	return
+}
+
+// CheckInAfterCounter returns a count of finished TicketsServiceMock.CheckIn invocations
+func (mmCheckIn *TicketsServiceMock) CheckInAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIn.afterCheckInCounter)
+}
+
+// CheckInBeforeCounter returns a count of TicketsServiceMock.CheckIn invocations
+func (mmCheckIn *TicketsServiceMock) CheckInBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIn.beforeCheckInCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.CheckIn.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckIn *mTicketsServiceMockCheckIn) Calls() []*TicketsServiceMockCheckInParams {
+	mmCheckIn.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockCheckInParams, len(mmCheckIn.callArgs))
+	copy(argCopy, mmCheckIn.callArgs)
+
+	mmCheckIn.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckInDone returns true if the count of the CheckIn invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockCheckInDone() bool {
+	if m.CheckInMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckInMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckInMock.invocationsDone()
+}
+
+// MinimockCheckInInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockCheckInInspect() {
+	for _, e := range m.CheckInMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.CheckIn at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckInCounter := mm_atomic.LoadUint64(&m.afterCheckInCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckInMock.defaultExpectation != nil && afterCheckInCounter < 1 {
+		if m.CheckInMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.CheckIn at\n%s", m.CheckInMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.CheckIn at\n%s with params: %#v", m.CheckInMock.defaultExpectation.expectationOrigins.origin, *m.CheckInMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckIn != nil && afterCheckInCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.CheckIn at\n%s", m.funcCheckInOrigin)
+	}
+
+	if !m.CheckInMock.invocationsDone() && afterCheckInCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.CheckIn at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckInMock.expectedInvocations), m.CheckInMock.expectedInvocationsOrigin, afterCheckInCounter)
+	}
+}
+
+type mTicketsServiceMockEventStats struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockEventStatsExpectation
+	expectations       []*TicketsServiceMockEventStatsExpectation
+
+	callArgs []*TicketsServiceMockEventStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockEventStatsExpectation specifies expectation struct of the TicketsService.EventStats
+type TicketsServiceMockEventStatsExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockEventStatsParams
+	paramPtrs          *TicketsServiceMockEventStatsParamPtrs
+	expectationOrigins TicketsServiceMockEventStatsExpectationOrigins
+	results            *TicketsServiceMockEventStatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockEventStatsParams contains parameters of the TicketsService.EventStats
+type TicketsServiceMockEventStatsParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	interval string
+}
+
+// TicketsServiceMockEventStatsParamPtrs contains pointers to parameters of the TicketsService.EventStats
+type TicketsServiceMockEventStatsParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	interval *string
+}
+
+// TicketsServiceMockEventStatsResults contains results of the TicketsService.EventStats
+type TicketsServiceMockEventStatsResults struct {
+	ep1 *models.EventStats
+	err error
+}
+
+// TicketsServiceMockEventStatsOrigins contains origins of expectations of the TicketsService.EventStats
+type TicketsServiceMockEventStatsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originInterval string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEventStats *mTicketsServiceMockEventStats) Optional() *mTicketsServiceMockEventStats {
+	mmEventStats.optional = true
+	return mmEventStats
+}
+
+// Expect sets up expected params for TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) Expect(ctx context.Context, userID int64, urlTitle string, interval string) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{}
+	}
+
+	if mmEventStats.defaultExpectation.paramPtrs != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by ExpectParams functions")
+	}
+
+	mmEventStats.defaultExpectation.params = &TicketsServiceMockEventStatsParams{ctx, userID, urlTitle, interval}
+	mmEventStats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEventStats.expectations {
+		if minimock.Equal(e.params, mmEventStats.defaultExpectation.params) {
+			mmEventStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEventStats.defaultExpectation.params)
+		}
+	}
+
+	return mmEventStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{}
+	}
+
+	if mmEventStats.defaultExpectation.params != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Expect")
+	}
+
+	if mmEventStats.defaultExpectation.paramPtrs == nil {
+		mmEventStats.defaultExpectation.paramPtrs = &TicketsServiceMockEventStatsParamPtrs{}
+	}
+	mmEventStats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEventStats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEventStats
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) ExpectUserIDParam2(userID int64) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{}
+	}
+
+	if mmEventStats.defaultExpectation.params != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Expect")
+	}
+
+	if mmEventStats.defaultExpectation.paramPtrs == nil {
+		mmEventStats.defaultExpectation.paramPtrs = &TicketsServiceMockEventStatsParamPtrs{}
+	}
+	mmEventStats.defaultExpectation.paramPtrs.userID = &userID
+	mmEventStats.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEventStats
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{}
+	}
+
+	if mmEventStats.defaultExpectation.params != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Expect")
+	}
+
+	if mmEventStats.defaultExpectation.paramPtrs == nil {
+		mmEventStats.defaultExpectation.paramPtrs = &TicketsServiceMockEventStatsParamPtrs{}
+	}
+	mmEventStats.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmEventStats.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmEventStats
+}
+
+// ExpectIntervalParam4 sets up expected param interval for TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) ExpectIntervalParam4(interval string) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{}
+	}
+
+	if mmEventStats.defaultExpectation.params != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Expect")
+	}
+
+	if mmEventStats.defaultExpectation.paramPtrs == nil {
+		mmEventStats.defaultExpectation.paramPtrs = &TicketsServiceMockEventStatsParamPtrs{}
+	}
+	mmEventStats.defaultExpectation.paramPtrs.interval = &interval
+	mmEventStats.defaultExpectation.expectationOrigins.originInterval = minimock.CallerInfo(1)
+
+	return mmEventStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) Inspect(f func(ctx context.Context, userID int64, urlTitle string, interval string)) *mTicketsServiceMockEventStats {
+	if mmEventStats.mock.inspectFuncEventStats != nil {
+		mmEventStats.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.EventStats")
+	}
+
+	mmEventStats.mock.inspectFuncEventStats = f
+
+	return mmEventStats
+}
+
+// Return sets up results that will be returned by TicketsService.EventStats
+func (mmEventStats *mTicketsServiceMockEventStats) Return(ep1 *models.EventStats, err error) *TicketsServiceMock {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	if mmEventStats.defaultExpectation == nil {
+		mmEventStats.defaultExpectation = &TicketsServiceMockEventStatsExpectation{mock: mmEventStats.mock}
+	}
+	mmEventStats.defaultExpectation.results = &TicketsServiceMockEventStatsResults{ep1, err}
+	mmEventStats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEventStats.mock
+}
+
+// Set uses given function f to mock the TicketsService.EventStats method
+func (mmEventStats *mTicketsServiceMockEventStats) Set(f func(ctx context.Context, userID int64, urlTitle string, interval string) (ep1 *models.EventStats, err error)) *TicketsServiceMock {
+	if mmEventStats.defaultExpectation != nil {
+		mmEventStats.mock.t.Fatalf("Default expectation is already set for the TicketsService.EventStats method")
+	}
+
+	if len(mmEventStats.expectations) > 0 {
+		mmEventStats.mock.t.Fatalf("Some expectations are already set for the TicketsService.EventStats method")
+	}
+
+	mmEventStats.mock.funcEventStats = f
+	mmEventStats.mock.funcEventStatsOrigin = minimock.CallerInfo(1)
+	return mmEventStats.mock
+}
+
+// When sets expectation for the TicketsService.EventStats which will trigger the result defined by the following
+// Then helper
+func (mmEventStats *mTicketsServiceMockEventStats) When(ctx context.Context, userID int64, urlTitle string, interval string) *TicketsServiceMockEventStatsExpectation {
+	if mmEventStats.mock.funcEventStats != nil {
+		mmEventStats.mock.t.Fatalf("TicketsServiceMock.EventStats mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockEventStatsExpectation{
+		mock:               mmEventStats.mock,
+		params:             &TicketsServiceMockEventStatsParams{ctx, userID, urlTitle, interval},
+		expectationOrigins: TicketsServiceMockEventStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEventStats.expectations = append(mmEventStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.EventStats return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockEventStatsExpectation) Then(ep1 *models.EventStats, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockEventStatsResults{ep1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.EventStats should be invoked
+func (mmEventStats *mTicketsServiceMockEventStats) Times(n uint64) *mTicketsServiceMockEventStats {
+	if n == 0 {
+		mmEventStats.mock.t.Fatalf("Times of TicketsServiceMock.EventStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEventStats.expectedInvocations, n)
+	mmEventStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEventStats
+}
+
+func (mmEventStats *mTicketsServiceMockEventStats) invocationsDone() bool {
+	if len(mmEventStats.expectations) == 0 && mmEventStats.defaultExpectation == nil && mmEventStats.mock.funcEventStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEventStats.mock.afterEventStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEventStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EventStats implements mm_service.TicketsService
+func (mmEventStats *TicketsServiceMock) EventStats(ctx context.Context, userID int64, urlTitle string, interval string) (ep1 *models.EventStats, err error) {
+	mm_atomic.AddUint64(&mmEventStats.beforeEventStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmEventStats.afterEventStatsCounter, 1)
+
+	mmEventStats.t.Helper()
+
+	if mmEventStats.inspectFuncEventStats != nil {
+		mmEventStats.inspectFuncEventStats(ctx, userID, urlTitle, interval)
+	}
+
+	mm_params := TicketsServiceMockEventStatsParams{ctx, userID, urlTitle, interval}
+
+	// Record call args
+	mmEventStats.EventStatsMock.mutex.Lock()
+	mmEventStats.EventStatsMock.callArgs = append(mmEventStats.EventStatsMock.callArgs, &mm_params)
+	mmEventStats.EventStatsMock.mutex.Unlock()
+
+	for _, e := range mmEventStats.EventStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ep1, e.results.err
+		}
+	}
+
+	if mmEventStats.EventStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEventStats.EventStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmEventStats.EventStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmEventStats.EventStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockEventStatsParams{ctx, userID, urlTitle, interval}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEventStats.t.Errorf("TicketsServiceMock.EventStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventStats.EventStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEventStats.t.Errorf("TicketsServiceMock.EventStats got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventStats.EventStatsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmEventStats.t.Errorf("TicketsServiceMock.EventStats got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventStats.EventStatsMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.interval != nil && !minimock.Equal(*mm_want_ptrs.interval, mm_got.interval) {
+				mmEventStats.t.Errorf("TicketsServiceMock.EventStats got unexpected parameter interval, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEventStats.EventStatsMock.defaultExpectation.expectationOrigins.originInterval, *mm_want_ptrs.interval, mm_got.interval, minimock.Diff(*mm_want_ptrs.interval, mm_got.interval))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEventStats.t.Errorf("TicketsServiceMock.EventStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEventStats.EventStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEventStats.EventStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEventStats.t.Fatal("No results are set for the TicketsServiceMock.EventStats")
+		}
+		return (*mm_results).ep1, (*mm_results).err
+	}
+	if mmEventStats.funcEventStats != nil {
+		return mmEventStats.funcEventStats(ctx, userID, urlTitle, interval)
+	}
+	mmEventStats.t.Fatalf("Unexpected call to TicketsServiceMock.EventStats. %v %v %v %v", ctx, userID, urlTitle, interval)
+	return
+}
+
+// EventStatsAfterCounter returns a count of finished TicketsServiceMock.EventStats invocations
+func (mmEventStats *TicketsServiceMock) EventStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventStats.afterEventStatsCounter)
+}
+
+// EventStatsBeforeCounter returns a count of TicketsServiceMock.EventStats invocations
+func (mmEventStats *TicketsServiceMock) EventStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEventStats.beforeEventStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.EventStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEventStats *mTicketsServiceMockEventStats) Calls() []*TicketsServiceMockEventStatsParams {
+	mmEventStats.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockEventStatsParams, len(mmEventStats.callArgs))
+	copy(argCopy, mmEventStats.callArgs)
+
+	mmEventStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventStatsDone returns true if the count of the EventStats invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockEventStatsDone() bool {
+	if m.EventStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EventStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EventStatsMock.invocationsDone()
+}
+
+// MinimockEventStatsInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockEventStatsInspect() {
+	for _, e := range m.EventStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.EventStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEventStatsCounter := mm_atomic.LoadUint64(&m.afterEventStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventStatsMock.defaultExpectation != nil && afterEventStatsCounter < 1 {
+		if m.EventStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.EventStats at\n%s", m.EventStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.EventStats at\n%s with params: %#v", m.EventStatsMock.defaultExpectation.expectationOrigins.origin, *m.EventStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEventStats != nil && afterEventStatsCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.EventStats at\n%s", m.funcEventStatsOrigin)
+	}
+
+	if !m.EventStatsMock.invocationsDone() && afterEventStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.EventStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EventStatsMock.expectedInvocations), m.EventStatsMock.expectedInvocationsOrigin, afterEventStatsCounter)
+	}
+}
+
+type mTicketsServiceMockExportAttendees struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockExportAttendeesExpectation
+	expectations       []*TicketsServiceMockExportAttendeesExpectation
+
+	callArgs []*TicketsServiceMockExportAttendeesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockExportAttendeesExpectation specifies expectation struct of the TicketsService.ExportAttendees
+type TicketsServiceMockExportAttendeesExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockExportAttendeesParams
+	paramPtrs          *TicketsServiceMockExportAttendeesParamPtrs
+	expectationOrigins TicketsServiceMockExportAttendeesExpectationOrigins
+	results            *TicketsServiceMockExportAttendeesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockExportAttendeesParams contains parameters of the TicketsService.ExportAttendees
+type TicketsServiceMockExportAttendeesParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+	format   string
+	search   string
+}
+
+// TicketsServiceMockExportAttendeesParamPtrs contains pointers to parameters of the TicketsService.ExportAttendees
+type TicketsServiceMockExportAttendeesParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+	format   *string
+	search   *string
+}
+
+// TicketsServiceMockExportAttendeesResults contains results of the TicketsService.ExportAttendees
+type TicketsServiceMockExportAttendeesResults struct {
+	ba1 []byte
+	err error
+}
+
+// TicketsServiceMockExportAttendeesOrigins contains origins of expectations of the TicketsService.ExportAttendees
+type TicketsServiceMockExportAttendeesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+	originFormat   string
+	originSearch   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Optional() *mTicketsServiceMockExportAttendees {
+	mmExportAttendees.optional = true
+	return mmExportAttendees
+}
+
+// Expect sets up expected params for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Expect(ctx context.Context, userID int64, urlTitle string, format string, search string) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by ExpectParams functions")
+	}
+
+	mmExportAttendees.defaultExpectation.params = &TicketsServiceMockExportAttendeesParams{ctx, userID, urlTitle, format, search}
+	mmExportAttendees.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportAttendees.expectations {
+		if minimock.Equal(e.params, mmExportAttendees.defaultExpectation.params) {
+			mmExportAttendees.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportAttendees.defaultExpectation.params)
+		}
+	}
+
+	return mmExportAttendees
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.params != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Expect")
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs == nil {
+		mmExportAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockExportAttendeesParamPtrs{}
+	}
+	mmExportAttendees.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportAttendees.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportAttendees
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) ExpectUserIDParam2(userID int64) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.params != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Expect")
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs == nil {
+		mmExportAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockExportAttendeesParamPtrs{}
+	}
+	mmExportAttendees.defaultExpectation.paramPtrs.userID = &userID
+	mmExportAttendees.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmExportAttendees
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.params != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Expect")
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs == nil {
+		mmExportAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockExportAttendeesParamPtrs{}
+	}
+	mmExportAttendees.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmExportAttendees.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmExportAttendees
+}
+
+// ExpectFormatParam4 sets up expected param format for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) ExpectFormatParam4(format string) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.params != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Expect")
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs == nil {
+		mmExportAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockExportAttendeesParamPtrs{}
+	}
+	mmExportAttendees.defaultExpectation.paramPtrs.format = &format
+	mmExportAttendees.defaultExpectation.expectationOrigins.originFormat = minimock.CallerInfo(1)
+
+	return mmExportAttendees
+}
+
+// ExpectSearchParam5 sets up expected param search for TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) ExpectSearchParam5(search string) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{}
+	}
+
+	if mmExportAttendees.defaultExpectation.params != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Expect")
+	}
+
+	if mmExportAttendees.defaultExpectation.paramPtrs == nil {
+		mmExportAttendees.defaultExpectation.paramPtrs = &TicketsServiceMockExportAttendeesParamPtrs{}
+	}
+	mmExportAttendees.defaultExpectation.paramPtrs.search = &search
+	mmExportAttendees.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
+
+	return mmExportAttendees
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Inspect(f func(ctx context.Context, userID int64, urlTitle string, format string, search string)) *mTicketsServiceMockExportAttendees {
+	if mmExportAttendees.mock.inspectFuncExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.ExportAttendees")
+	}
+
+	mmExportAttendees.mock.inspectFuncExportAttendees = f
+
+	return mmExportAttendees
+}
+
+// Return sets up results that will be returned by TicketsService.ExportAttendees
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Return(ba1 []byte, err error) *TicketsServiceMock {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	if mmExportAttendees.defaultExpectation == nil {
+		mmExportAttendees.defaultExpectation = &TicketsServiceMockExportAttendeesExpectation{mock: mmExportAttendees.mock}
+	}
+	mmExportAttendees.defaultExpectation.results = &TicketsServiceMockExportAttendeesResults{ba1, err}
+	mmExportAttendees.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportAttendees.mock
+}
+
+// Set uses given function f to mock the TicketsService.ExportAttendees method
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Set(f func(ctx context.Context, userID int64, urlTitle string, format string, search string) (ba1 []byte, err error)) *TicketsServiceMock {
+	if mmExportAttendees.defaultExpectation != nil {
+		mmExportAttendees.mock.t.Fatalf("Default expectation is already set for the TicketsService.ExportAttendees method")
+	}
+
+	if len(mmExportAttendees.expectations) > 0 {
+		mmExportAttendees.mock.t.Fatalf("Some expectations are already set for the TicketsService.ExportAttendees method")
+	}
+
+	mmExportAttendees.mock.funcExportAttendees = f
+	mmExportAttendees.mock.funcExportAttendeesOrigin = minimock.CallerInfo(1)
+	return mmExportAttendees.mock
+}
+
+// When sets expectation for the TicketsService.ExportAttendees which will trigger the result defined by the following
+// Then helper
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) When(ctx context.Context, userID int64, urlTitle string, format string, search string) *TicketsServiceMockExportAttendeesExpectation {
+	if mmExportAttendees.mock.funcExportAttendees != nil {
+		mmExportAttendees.mock.t.Fatalf("TicketsServiceMock.ExportAttendees mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockExportAttendeesExpectation{
+		mock:               mmExportAttendees.mock,
+		params:             &TicketsServiceMockExportAttendeesParams{ctx, userID, urlTitle, format, search},
+		expectationOrigins: TicketsServiceMockExportAttendeesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportAttendees.expectations = append(mmExportAttendees.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.ExportAttendees return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockExportAttendeesExpectation) Then(ba1 []byte, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockExportAttendeesResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.ExportAttendees should be invoked
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Times(n uint64) *mTicketsServiceMockExportAttendees {
+	if n == 0 {
+		mmExportAttendees.mock.t.Fatalf("Times of TicketsServiceMock.ExportAttendees mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportAttendees.expectedInvocations, n)
+	mmExportAttendees.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportAttendees
+}
+
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) invocationsDone() bool {
+	if len(mmExportAttendees.expectations) == 0 && mmExportAttendees.defaultExpectation == nil && mmExportAttendees.mock.funcExportAttendees == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportAttendees.mock.afterExportAttendeesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportAttendees.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportAttendees implements mm_service.TicketsService
+func (mmExportAttendees *TicketsServiceMock) ExportAttendees(ctx context.Context, userID int64, urlTitle string, format string, search string) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmExportAttendees.beforeExportAttendeesCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportAttendees.afterExportAttendeesCounter, 1)
+
+	mmExportAttendees.t.Helper()
+
+	if mmExportAttendees.inspectFuncExportAttendees != nil {
+		mmExportAttendees.inspectFuncExportAttendees(ctx, userID, urlTitle, format, search)
+	}
+
+	mm_params := TicketsServiceMockExportAttendeesParams{ctx, userID, urlTitle, format, search}
+
+	// Record call args
+	mmExportAttendees.ExportAttendeesMock.mutex.Lock()
+	mmExportAttendees.ExportAttendeesMock.callArgs = append(mmExportAttendees.ExportAttendeesMock.callArgs, &mm_params)
+	mmExportAttendees.ExportAttendeesMock.mutex.Unlock()
+
+	for _, e := range mmExportAttendees.ExportAttendeesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmExportAttendees.ExportAttendeesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportAttendees.ExportAttendeesMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportAttendees.ExportAttendeesMock.defaultExpectation.params
+		mm_want_ptrs := mmExportAttendees.ExportAttendeesMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockExportAttendeesParams{ctx, userID, urlTitle, format, search}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+			if mm_want_ptrs.format != nil && !minimock.Equal(*mm_want_ptrs.format, mm_got.format) {
+				mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameter format, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.originFormat, *mm_want_ptrs.format, mm_got.format, minimock.Diff(*mm_want_ptrs.format, mm_got.format))
+			}
+
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportAttendees.t.Errorf("TicketsServiceMock.ExportAttendees got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportAttendees.ExportAttendeesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportAttendees.ExportAttendeesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportAttendees.t.Fatal("No results are set for the TicketsServiceMock.ExportAttendees")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmExportAttendees.funcExportAttendees != nil {
+		return mmExportAttendees.funcExportAttendees(ctx, userID, urlTitle, format, search)
+	}
+	mmExportAttendees.t.Fatalf("Unexpected call to TicketsServiceMock.ExportAttendees. %v %v %v %v %v", ctx, userID, urlTitle, format, search)
+	return
+}
+
+// ExportAttendeesAfterCounter returns a count of finished TicketsServiceMock.ExportAttendees invocations
+func (mmExportAttendees *TicketsServiceMock) ExportAttendeesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportAttendees.afterExportAttendeesCounter)
+}
+
+// ExportAttendeesBeforeCounter returns a count of TicketsServiceMock.ExportAttendees invocations
+func (mmExportAttendees *TicketsServiceMock) ExportAttendeesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportAttendees.beforeExportAttendeesCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.ExportAttendees.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportAttendees *mTicketsServiceMockExportAttendees) Calls() []*TicketsServiceMockExportAttendeesParams {
+	mmExportAttendees.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockExportAttendeesParams, len(mmExportAttendees.callArgs))
+	copy(argCopy, mmExportAttendees.callArgs)
+
+	mmExportAttendees.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportAttendeesDone returns true if the count of the ExportAttendees invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockExportAttendeesDone() bool {
+	if m.ExportAttendeesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportAttendeesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportAttendeesMock.invocationsDone()
+}
+
+// MinimockExportAttendeesInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockExportAttendeesInspect() {
+	for _, e := range m.ExportAttendeesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.ExportAttendees at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportAttendeesCounter := mm_atomic.LoadUint64(&m.afterExportAttendeesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportAttendeesMock.defaultExpectation != nil && afterExportAttendeesCounter < 1 {
+		if m.ExportAttendeesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.ExportAttendees at\n%s", m.ExportAttendeesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.ExportAttendees at\n%s with params: %#v", m.ExportAttendeesMock.defaultExpectation.expectationOrigins.origin, *m.ExportAttendeesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportAttendees != nil && afterExportAttendeesCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.ExportAttendees at\n%s", m.funcExportAttendeesOrigin)
+	}
+
+	if !m.ExportAttendeesMock.invocationsDone() && afterExportAttendeesCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.ExportAttendees at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportAttendeesMock.expectedInvocations), m.ExportAttendeesMock.expectedInvocationsOrigin, afterExportAttendeesCounter)
+	}
+}
+
+type mTicketsServiceMockFunnel struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockFunnelExpectation
+	expectations       []*TicketsServiceMockFunnelExpectation
+
+	callArgs []*TicketsServiceMockFunnelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockFunnelExpectation specifies expectation struct of the TicketsService.Funnel
+type TicketsServiceMockFunnelExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockFunnelParams
+	paramPtrs          *TicketsServiceMockFunnelParamPtrs
+	expectationOrigins TicketsServiceMockFunnelExpectationOrigins
+	results            *TicketsServiceMockFunnelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockFunnelParams contains parameters of the TicketsService.Funnel
+type TicketsServiceMockFunnelParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// TicketsServiceMockFunnelParamPtrs contains pointers to parameters of the TicketsService.Funnel
+type TicketsServiceMockFunnelParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// TicketsServiceMockFunnelResults contains results of the TicketsService.Funnel
+type TicketsServiceMockFunnelResults struct {
+	fp1 *models.Funnel
+	err error
+}
+
+// TicketsServiceMockFunnelOrigins contains origins of expectations of the TicketsService.Funnel
+type TicketsServiceMockFunnelExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFunnel *mTicketsServiceMockFunnel) Optional() *mTicketsServiceMockFunnel {
+	mmFunnel.optional = true
+	return mmFunnel
+}
+
+// Expect sets up expected params for TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) Expect(ctx context.Context, userID int64, urlTitle string) *mTicketsServiceMockFunnel {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	if mmFunnel.defaultExpectation == nil {
+		mmFunnel.defaultExpectation = &TicketsServiceMockFunnelExpectation{}
+	}
+
+	if mmFunnel.defaultExpectation.paramPtrs != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by ExpectParams functions")
+	}
+
+	mmFunnel.defaultExpectation.params = &TicketsServiceMockFunnelParams{ctx, userID, urlTitle}
+	mmFunnel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFunnel.expectations {
+		if minimock.Equal(e.params, mmFunnel.defaultExpectation.params) {
+			mmFunnel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFunnel.defaultExpectation.params)
+		}
+	}
+
+	return mmFunnel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockFunnel {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	if mmFunnel.defaultExpectation == nil {
+		mmFunnel.defaultExpectation = &TicketsServiceMockFunnelExpectation{}
+	}
+
+	if mmFunnel.defaultExpectation.params != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Expect")
+	}
+
+	if mmFunnel.defaultExpectation.paramPtrs == nil {
+		mmFunnel.defaultExpectation.paramPtrs = &TicketsServiceMockFunnelParamPtrs{}
+	}
+	mmFunnel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFunnel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFunnel
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) ExpectUserIDParam2(userID int64) *mTicketsServiceMockFunnel {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	if mmFunnel.defaultExpectation == nil {
+		mmFunnel.defaultExpectation = &TicketsServiceMockFunnelExpectation{}
+	}
+
+	if mmFunnel.defaultExpectation.params != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Expect")
+	}
+
+	if mmFunnel.defaultExpectation.paramPtrs == nil {
+		mmFunnel.defaultExpectation.paramPtrs = &TicketsServiceMockFunnelParamPtrs{}
+	}
+	mmFunnel.defaultExpectation.paramPtrs.userID = &userID
+	mmFunnel.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmFunnel
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockFunnel {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	if mmFunnel.defaultExpectation == nil {
+		mmFunnel.defaultExpectation = &TicketsServiceMockFunnelExpectation{}
+	}
+
+	if mmFunnel.defaultExpectation.params != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Expect")
+	}
+
+	if mmFunnel.defaultExpectation.paramPtrs == nil {
+		mmFunnel.defaultExpectation.paramPtrs = &TicketsServiceMockFunnelParamPtrs{}
+	}
+	mmFunnel.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmFunnel.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmFunnel
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mTicketsServiceMockFunnel {
+	if mmFunnel.mock.inspectFuncFunnel != nil {
+		mmFunnel.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.Funnel")
+	}
+
+	mmFunnel.mock.inspectFuncFunnel = f
+
+	return mmFunnel
+}
+
+// Return sets up results that will be returned by TicketsService.Funnel
+func (mmFunnel *mTicketsServiceMockFunnel) Return(fp1 *models.Funnel, err error) *TicketsServiceMock {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	if mmFunnel.defaultExpectation == nil {
+		mmFunnel.defaultExpectation = &TicketsServiceMockFunnelExpectation{mock: mmFunnel.mock}
+	}
+	mmFunnel.defaultExpectation.results = &TicketsServiceMockFunnelResults{fp1, err}
+	mmFunnel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFunnel.mock
+}
+
+// Set uses given function f to mock the TicketsService.Funnel method
+func (mmFunnel *mTicketsServiceMockFunnel) Set(f func(ctx context.Context, userID int64, urlTitle string) (fp1 *models.Funnel, err error)) *TicketsServiceMock {
+	if mmFunnel.defaultExpectation != nil {
+		mmFunnel.mock.t.Fatalf("Default expectation is already set for the TicketsService.Funnel method")
+	}
+
+	if len(mmFunnel.expectations) > 0 {
+		mmFunnel.mock.t.Fatalf("Some expectations are already set for the TicketsService.Funnel method")
+	}
+
+	mmFunnel.mock.funcFunnel = f
+	mmFunnel.mock.funcFunnelOrigin = minimock.CallerInfo(1)
+	return mmFunnel.mock
+}
+
+// When sets expectation for the TicketsService.Funnel which will trigger the result defined by the following
+// Then helper
+func (mmFunnel *mTicketsServiceMockFunnel) When(ctx context.Context, userID int64, urlTitle string) *TicketsServiceMockFunnelExpectation {
+	if mmFunnel.mock.funcFunnel != nil {
+		mmFunnel.mock.t.Fatalf("TicketsServiceMock.Funnel mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockFunnelExpectation{
+		mock:               mmFunnel.mock,
+		params:             &TicketsServiceMockFunnelParams{ctx, userID, urlTitle},
+		expectationOrigins: TicketsServiceMockFunnelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFunnel.expectations = append(mmFunnel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.Funnel return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockFunnelExpectation) Then(fp1 *models.Funnel, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockFunnelResults{fp1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.Funnel should be invoked
+func (mmFunnel *mTicketsServiceMockFunnel) Times(n uint64) *mTicketsServiceMockFunnel {
+	if n == 0 {
+		mmFunnel.mock.t.Fatalf("Times of TicketsServiceMock.Funnel mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFunnel.expectedInvocations, n)
+	mmFunnel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFunnel
+}
+
+func (mmFunnel *mTicketsServiceMockFunnel) invocationsDone() bool {
+	if len(mmFunnel.expectations) == 0 && mmFunnel.defaultExpectation == nil && mmFunnel.mock.funcFunnel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFunnel.mock.afterFunnelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFunnel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Funnel implements mm_service.TicketsService
+func (mmFunnel *TicketsServiceMock) Funnel(ctx context.Context, userID int64, urlTitle string) (fp1 *models.Funnel, err error) {
+	mm_atomic.AddUint64(&mmFunnel.beforeFunnelCounter, 1)
+	defer mm_atomic.AddUint64(&mmFunnel.afterFunnelCounter, 1)
+
+	mmFunnel.t.Helper()
+
+	if mmFunnel.inspectFuncFunnel != nil {
+		mmFunnel.inspectFuncFunnel(ctx, userID, urlTitle)
+	}
+
+	mm_params := TicketsServiceMockFunnelParams{ctx, userID, urlTitle}
+
+	// Record call args
+	mmFunnel.FunnelMock.mutex.Lock()
+	mmFunnel.FunnelMock.callArgs = append(mmFunnel.FunnelMock.callArgs, &mm_params)
+	mmFunnel.FunnelMock.mutex.Unlock()
+
+	for _, e := range mmFunnel.FunnelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp1, e.results.err
+		}
+	}
+
+	if mmFunnel.FunnelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFunnel.FunnelMock.defaultExpectation.Counter, 1)
+		mm_want := mmFunnel.FunnelMock.defaultExpectation.params
+		mm_want_ptrs := mmFunnel.FunnelMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockFunnelParams{ctx, userID, urlTitle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFunnel.t.Errorf("TicketsServiceMock.Funnel got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFunnel.FunnelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmFunnel.t.Errorf("TicketsServiceMock.Funnel got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFunnel.FunnelMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmFunnel.t.Errorf("TicketsServiceMock.Funnel got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFunnel.FunnelMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFunnel.t.Errorf("TicketsServiceMock.Funnel got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFunnel.FunnelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFunnel.FunnelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFunnel.t.Fatal("No results are set for the TicketsServiceMock.Funnel")
+		}
+		return (*mm_results).fp1, (*mm_results).err
+	}
+	if mmFunnel.funcFunnel != nil {
+		return mmFunnel.funcFunnel(ctx, userID, urlTitle)
+	}
+	mmFunnel.t.Fatalf("Unexpected call to TicketsServiceMock.Funnel. %v %v %v", ctx, userID, urlTitle)
+	return
+}
+
+// FunnelAfterCounter returns a count of finished TicketsServiceMock.Funnel invocations
+func (mmFunnel *TicketsServiceMock) FunnelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFunnel.afterFunnelCounter)
+}
+
+// FunnelBeforeCounter returns a count of TicketsServiceMock.Funnel invocations
+func (mmFunnel *TicketsServiceMock) FunnelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFunnel.beforeFunnelCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.Funnel.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFunnel *mTicketsServiceMockFunnel) Calls() []*TicketsServiceMockFunnelParams {
+	mmFunnel.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockFunnelParams, len(mmFunnel.callArgs))
+	copy(argCopy, mmFunnel.callArgs)
+
+	mmFunnel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFunnelDone returns true if the count of the Funnel invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockFunnelDone() bool {
+	if m.FunnelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FunnelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FunnelMock.invocationsDone()
+}
+
+// MinimockFunnelInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockFunnelInspect() {
+	for _, e := range m.FunnelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.Funnel at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFunnelCounter := mm_atomic.LoadUint64(&m.afterFunnelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FunnelMock.defaultExpectation != nil && afterFunnelCounter < 1 {
+		if m.FunnelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.Funnel at\n%s", m.FunnelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.Funnel at\n%s with params: %#v", m.FunnelMock.defaultExpectation.expectationOrigins.origin, *m.FunnelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFunnel != nil && afterFunnelCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.Funnel at\n%s", m.funcFunnelOrigin)
+	}
+
+	if !m.FunnelMock.invocationsDone() && afterFunnelCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.Funnel at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FunnelMock.expectedInvocations), m.FunnelMock.expectedInvocationsOrigin, afterFunnelCounter)
+	}
+}
+
+type mTicketsServiceMockSalesReport struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockSalesReportExpectation
+	expectations       []*TicketsServiceMockSalesReportExpectation
+
+	callArgs []*TicketsServiceMockSalesReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockSalesReportExpectation specifies expectation struct of the TicketsService.SalesReport
+type TicketsServiceMockSalesReportExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockSalesReportParams
+	paramPtrs          *TicketsServiceMockSalesReportParamPtrs
+	expectationOrigins TicketsServiceMockSalesReportExpectationOrigins
+	results            *TicketsServiceMockSalesReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockSalesReportParams contains parameters of the TicketsService.SalesReport
+type TicketsServiceMockSalesReportParams struct {
+	ctx      context.Context
+	userID   int64
+	urlTitle string
+}
+
+// TicketsServiceMockSalesReportParamPtrs contains pointers to parameters of the TicketsService.SalesReport
+type TicketsServiceMockSalesReportParamPtrs struct {
+	ctx      *context.Context
+	userID   *int64
+	urlTitle *string
+}
+
+// TicketsServiceMockSalesReportResults contains results of the TicketsService.SalesReport
+type TicketsServiceMockSalesReportResults struct {
+	sp1 *models.SalesReport
+	err error
+}
+
+// TicketsServiceMockSalesReportOrigins contains origins of expectations of the TicketsService.SalesReport
+type TicketsServiceMockSalesReportExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originUrlTitle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSalesReport *mTicketsServiceMockSalesReport) Optional() *mTicketsServiceMockSalesReport {
+	mmSalesReport.optional = true
+	return mmSalesReport
+}
+
+// Expect sets up expected params for TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) Expect(ctx context.Context, userID int64, urlTitle string) *mTicketsServiceMockSalesReport {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	if mmSalesReport.defaultExpectation == nil {
+		mmSalesReport.defaultExpectation = &TicketsServiceMockSalesReportExpectation{}
+	}
+
+	if mmSalesReport.defaultExpectation.paramPtrs != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by ExpectParams functions")
+	}
+
+	mmSalesReport.defaultExpectation.params = &TicketsServiceMockSalesReportParams{ctx, userID, urlTitle}
+	mmSalesReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSalesReport.expectations {
+		if minimock.Equal(e.params, mmSalesReport.defaultExpectation.params) {
+			mmSalesReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSalesReport.defaultExpectation.params)
+		}
+	}
+
+	return mmSalesReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockSalesReport {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	if mmSalesReport.defaultExpectation == nil {
+		mmSalesReport.defaultExpectation = &TicketsServiceMockSalesReportExpectation{}
+	}
+
+	if mmSalesReport.defaultExpectation.params != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Expect")
+	}
+
+	if mmSalesReport.defaultExpectation.paramPtrs == nil {
+		mmSalesReport.defaultExpectation.paramPtrs = &TicketsServiceMockSalesReportParamPtrs{}
+	}
+	mmSalesReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSalesReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSalesReport
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) ExpectUserIDParam2(userID int64) *mTicketsServiceMockSalesReport {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	if mmSalesReport.defaultExpectation == nil {
+		mmSalesReport.defaultExpectation = &TicketsServiceMockSalesReportExpectation{}
+	}
+
+	if mmSalesReport.defaultExpectation.params != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Expect")
+	}
+
+	if mmSalesReport.defaultExpectation.paramPtrs == nil {
+		mmSalesReport.defaultExpectation.paramPtrs = &TicketsServiceMockSalesReportParamPtrs{}
+	}
+	mmSalesReport.defaultExpectation.paramPtrs.userID = &userID
+	mmSalesReport.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSalesReport
+}
+
+// ExpectUrlTitleParam3 sets up expected param urlTitle for TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) ExpectUrlTitleParam3(urlTitle string) *mTicketsServiceMockSalesReport {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	if mmSalesReport.defaultExpectation == nil {
+		mmSalesReport.defaultExpectation = &TicketsServiceMockSalesReportExpectation{}
+	}
+
+	if mmSalesReport.defaultExpectation.params != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Expect")
+	}
+
+	if mmSalesReport.defaultExpectation.paramPtrs == nil {
+		mmSalesReport.defaultExpectation.paramPtrs = &TicketsServiceMockSalesReportParamPtrs{}
+	}
+	mmSalesReport.defaultExpectation.paramPtrs.urlTitle = &urlTitle
+	mmSalesReport.defaultExpectation.expectationOrigins.originUrlTitle = minimock.CallerInfo(1)
+
+	return mmSalesReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) Inspect(f func(ctx context.Context, userID int64, urlTitle string)) *mTicketsServiceMockSalesReport {
+	if mmSalesReport.mock.inspectFuncSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.SalesReport")
+	}
+
+	mmSalesReport.mock.inspectFuncSalesReport = f
+
+	return mmSalesReport
+}
+
+// Return sets up results that will be returned by TicketsService.SalesReport
+func (mmSalesReport *mTicketsServiceMockSalesReport) Return(sp1 *models.SalesReport, err error) *TicketsServiceMock {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	if mmSalesReport.defaultExpectation == nil {
+		mmSalesReport.defaultExpectation = &TicketsServiceMockSalesReportExpectation{mock: mmSalesReport.mock}
+	}
+	mmSalesReport.defaultExpectation.results = &TicketsServiceMockSalesReportResults{sp1, err}
+	mmSalesReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSalesReport.mock
+}
+
+// Set uses given function f to mock the TicketsService.SalesReport method
+func (mmSalesReport *mTicketsServiceMockSalesReport) Set(f func(ctx context.Context, userID int64, urlTitle string) (sp1 *models.SalesReport, err error)) *TicketsServiceMock {
+	if mmSalesReport.defaultExpectation != nil {
+		mmSalesReport.mock.t.Fatalf("Default expectation is already set for the TicketsService.SalesReport method")
+	}
+
+	if len(mmSalesReport.expectations) > 0 {
+		mmSalesReport.mock.t.Fatalf("Some expectations are already set for the TicketsService.SalesReport method")
+	}
+
+	mmSalesReport.mock.funcSalesReport = f
+	mmSalesReport.mock.funcSalesReportOrigin = minimock.CallerInfo(1)
+	return mmSalesReport.mock
+}
+
+// When sets expectation for the TicketsService.SalesReport which will trigger the result defined by the following
+// Then helper
+func (mmSalesReport *mTicketsServiceMockSalesReport) When(ctx context.Context, userID int64, urlTitle string) *TicketsServiceMockSalesReportExpectation {
+	if mmSalesReport.mock.funcSalesReport != nil {
+		mmSalesReport.mock.t.Fatalf("TicketsServiceMock.SalesReport mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockSalesReportExpectation{
+		mock:               mmSalesReport.mock,
+		params:             &TicketsServiceMockSalesReportParams{ctx, userID, urlTitle},
+		expectationOrigins: TicketsServiceMockSalesReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSalesReport.expectations = append(mmSalesReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.SalesReport return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockSalesReportExpectation) Then(sp1 *models.SalesReport, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockSalesReportResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.SalesReport should be invoked
+func (mmSalesReport *mTicketsServiceMockSalesReport) Times(n uint64) *mTicketsServiceMockSalesReport {
 	if n == 0 {
-		mmBuyTicket.mock.t.Fatalf("Times of TicketsServiceMock.BuyTicket mock can not be zero")
+		mmSalesReport.mock.t.Fatalf("Times of TicketsServiceMock.SalesReport mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmBuyTicket.expectedInvocations, n)
-	mmBuyTicket.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmBuyTicket
+	mm_atomic.StoreUint64(&mmSalesReport.expectedInvocations, n)
+	mmSalesReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSalesReport
 }
 
-func (mmBuyTicket *mTicketsServiceMockBuyTicket) invocationsDone() bool {
-	if len(mmBuyTicket.expectations) == 0 && mmBuyTicket.defaultExpectation == nil && mmBuyTicket.mock.funcBuyTicket == nil {
+func (mmSalesReport *mTicketsServiceMockSalesReport) invocationsDone() bool {
+	if len(mmSalesReport.expectations) == 0 && mmSalesReport.defaultExpectation == nil && mmSalesReport.mock.funcSalesReport == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmBuyTicket.mock.afterBuyTicketCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmBuyTicket.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSalesReport.mock.afterSalesReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSalesReport.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// BuyTicket implements mm_service.TicketsService
-func (mmBuyTicket *TicketsServiceMock) BuyTicket(ctx context.Context, req *models.BuyTicketRequest) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmBuyTicket.beforeBuyTicketCounter, 1)
-	defer mm_atomic.AddUint64(&mmBuyTicket.afterBuyTicketCounter, 1)
+// SalesReport implements mm_service.TicketsService
+func (mmSalesReport *TicketsServiceMock) SalesReport(ctx context.Context, userID int64, urlTitle string) (sp1 *models.SalesReport, err error) {
+	mm_atomic.AddUint64(&mmSalesReport.beforeSalesReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmSalesReport.afterSalesReportCounter, 1)
 
-	mmBuyTicket.t.Helper()
+	mmSalesReport.t.Helper()
 
-	if mmBuyTicket.inspectFuncBuyTicket != nil {
-		mmBuyTicket.inspectFuncBuyTicket(ctx, req)
+	if mmSalesReport.inspectFuncSalesReport != nil {
+		mmSalesReport.inspectFuncSalesReport(ctx, userID, urlTitle)
 	}
 
-	mm_params := TicketsServiceMockBuyTicketParams{ctx, req}
+	mm_params := TicketsServiceMockSalesReportParams{ctx, userID, urlTitle}
 
 	// Record call args
-	mmBuyTicket.BuyTicketMock.mutex.Lock()
-	mmBuyTicket.BuyTicketMock.callArgs = append(mmBuyTicket.BuyTicketMock.callArgs, &mm_params)
-	mmBuyTicket.BuyTicketMock.mutex.Unlock()
+	mmSalesReport.SalesReportMock.mutex.Lock()
+	mmSalesReport.SalesReportMock.callArgs = append(mmSalesReport.SalesReportMock.callArgs, &mm_params)
+	mmSalesReport.SalesReportMock.mutex.Unlock()
 
-	for _, e := range mmBuyTicket.BuyTicketMock.expectations {
+	for _, e := range mmSalesReport.SalesReportMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.sp1, e.results.err
 		}
 	}
 
-	if mmBuyTicket.BuyTicketMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmBuyTicket.BuyTicketMock.defaultExpectation.Counter, 1)
-		mm_want := mmBuyTicket.BuyTicketMock.defaultExpectation.params
-		mm_want_ptrs := mmBuyTicket.BuyTicketMock.defaultExpectation.paramPtrs
+	if mmSalesReport.SalesReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSalesReport.SalesReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmSalesReport.SalesReportMock.defaultExpectation.params
+		mm_want_ptrs := mmSalesReport.SalesReportMock.defaultExpectation.paramPtrs
 
-		mm_got := TicketsServiceMockBuyTicketParams{ctx, req}
+		mm_got := TicketsServiceMockSalesReportParams{ctx, userID, urlTitle}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSalesReport.t.Errorf("TicketsServiceMock.SalesReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSalesReport.SalesReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSalesReport.t.Errorf("TicketsServiceMock.SalesReport got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSalesReport.SalesReportMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.urlTitle != nil && !minimock.Equal(*mm_want_ptrs.urlTitle, mm_got.urlTitle) {
+				mmSalesReport.t.Errorf("TicketsServiceMock.SalesReport got unexpected parameter urlTitle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSalesReport.SalesReportMock.defaultExpectation.expectationOrigins.originUrlTitle, *mm_want_ptrs.urlTitle, mm_got.urlTitle, minimock.Diff(*mm_want_ptrs.urlTitle, mm_got.urlTitle))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmBuyTicket.t.Errorf("TicketsServiceMock.BuyTicket got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmBuyTicket.BuyTicketMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSalesReport.t.Errorf("TicketsServiceMock.SalesReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSalesReport.SalesReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmBuyTicket.BuyTicketMock.defaultExpectation.results
+		mm_results := mmSalesReport.SalesReportMock.defaultExpectation.results
 		if mm_results == nil {
-			mmBuyTicket.t.Fatal("No results are set for the TicketsServiceMock.BuyTicket")
+			mmSalesReport.t.Fatal("No results are set for the TicketsServiceMock.SalesReport")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).sp1, (*mm_results).err
 	}
-	if mmBuyTicket.funcBuyTicket != nil {
-		return mmBuyTicket.funcBuyTicket(ctx, req)
+	if mmSalesReport.funcSalesReport != nil {
+		return mmSalesReport.funcSalesReport(ctx, userID, urlTitle)
 	}
-	mmBuyTicket.t.Fatalf("Unexpected call to TicketsServiceMock.BuyTicket. %v %v", ctx, req)
+	mmSalesReport.t.Fatalf("Unexpected call to TicketsServiceMock.SalesReport. %v %v %v", ctx, userID, urlTitle)
 	return
 }
 
-// BuyTicketAfterCounter returns a count of finished TicketsServiceMock.BuyTicket invocations
-func (mmBuyTicket *TicketsServiceMock) BuyTicketAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmBuyTicket.afterBuyTicketCounter)
+// SalesReportAfterCounter returns a count of finished TicketsServiceMock.SalesReport invocations
+func (mmSalesReport *TicketsServiceMock) SalesReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSalesReport.afterSalesReportCounter)
 }
 
-// BuyTicketBeforeCounter returns a count of TicketsServiceMock.BuyTicket invocations
-func (mmBuyTicket *TicketsServiceMock) BuyTicketBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmBuyTicket.beforeBuyTicketCounter)
+// SalesReportBeforeCounter returns a count of TicketsServiceMock.SalesReport invocations
+func (mmSalesReport *TicketsServiceMock) SalesReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSalesReport.beforeSalesReportCounter)
 }
 
-// Calls returns a list of arguments used in each call to TicketsServiceMock.BuyTicket.
+// Calls returns a list of arguments used in each call to TicketsServiceMock.SalesReport.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmBuyTicket *mTicketsServiceMockBuyTicket) Calls() []*TicketsServiceMockBuyTicketParams {
-	mmBuyTicket.mutex.RLock()
+func (mmSalesReport *mTicketsServiceMockSalesReport) Calls() []*TicketsServiceMockSalesReportParams {
+	mmSalesReport.mutex.RLock()
 
-	argCopy := make([]*TicketsServiceMockBuyTicketParams, len(mmBuyTicket.callArgs))
-	copy(argCopy, mmBuyTicket.callArgs)
+	argCopy := make([]*TicketsServiceMockSalesReportParams, len(mmSalesReport.callArgs))
+	copy(argCopy, mmSalesReport.callArgs)
 
-	mmBuyTicket.mutex.RUnlock()
+	mmSalesReport.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockBuyTicketDone returns true if the count of the BuyTicket invocations corresponds
+// MinimockSalesReportDone returns true if the count of the SalesReport invocations corresponds
 // the number of defined expectations
-func (m *TicketsServiceMock) MinimockBuyTicketDone() bool {
-	if m.BuyTicketMock.optional {
+func (m *TicketsServiceMock) MinimockSalesReportDone() bool {
+	if m.SalesReportMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.BuyTicketMock.expectations {
+	for _, e := range m.SalesReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.BuyTicketMock.invocationsDone()
+	return m.SalesReportMock.invocationsDone()
 }
 
-// MinimockBuyTicketInspect logs each unmet expectation
-func (m *TicketsServiceMock) MinimockBuyTicketInspect() {
-	for _, e := range m.BuyTicketMock.expectations {
+// MinimockSalesReportInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockSalesReportInspect() {
+	for _, e := range m.SalesReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to TicketsServiceMock.SalesReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterBuyTicketCounter := mm_atomic.LoadUint64(&m.afterBuyTicketCounter)
+	afterSalesReportCounter := mm_atomic.LoadUint64(&m.afterSalesReportCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.BuyTicketMock.defaultExpectation != nil && afterBuyTicketCounter < 1 {
-		if m.BuyTicketMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s", m.BuyTicketMock.defaultExpectation.returnOrigin)
+	if m.SalesReportMock.defaultExpectation != nil && afterSalesReportCounter < 1 {
+		if m.SalesReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.SalesReport at\n%s", m.SalesReportMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s with params: %#v", m.BuyTicketMock.defaultExpectation.expectationOrigins.origin, *m.BuyTicketMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to TicketsServiceMock.SalesReport at\n%s with params: %#v", m.SalesReportMock.defaultExpectation.expectationOrigins.origin, *m.SalesReportMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcBuyTicket != nil && afterBuyTicketCounter < 1 {
-		m.t.Errorf("Expected call to TicketsServiceMock.BuyTicket at\n%s", m.funcBuyTicketOrigin)
+	if m.funcSalesReport != nil && afterSalesReportCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.SalesReport at\n%s", m.funcSalesReportOrigin)
 	}
 
-	if !m.BuyTicketMock.invocationsDone() && afterBuyTicketCounter > 0 {
-		m.t.Errorf("Expected %d calls to TicketsServiceMock.BuyTicket at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.BuyTicketMock.expectedInvocations), m.BuyTicketMock.expectedInvocationsOrigin, afterBuyTicketCounter)
+	if !m.SalesReportMock.invocationsDone() && afterSalesReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.SalesReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SalesReportMock.expectedInvocations), m.SalesReportMock.expectedInvocationsOrigin, afterSalesReportCounter)
 	}
 }
 
@@ -749,6 +3218,349 @@ func (m *TicketsServiceMock) MinimockTicketInspect() {
 	}
 }
 
+type mTicketsServiceMockUserStats struct {
+	optional           bool
+	mock               *TicketsServiceMock
+	defaultExpectation *TicketsServiceMockUserStatsExpectation
+	expectations       []*TicketsServiceMockUserStatsExpectation
+
+	callArgs []*TicketsServiceMockUserStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TicketsServiceMockUserStatsExpectation specifies expectation struct of the TicketsService.UserStats
+type TicketsServiceMockUserStatsExpectation struct {
+	mock               *TicketsServiceMock
+	params             *TicketsServiceMockUserStatsParams
+	paramPtrs          *TicketsServiceMockUserStatsParamPtrs
+	expectationOrigins TicketsServiceMockUserStatsExpectationOrigins
+	results            *TicketsServiceMockUserStatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TicketsServiceMockUserStatsParams contains parameters of the TicketsService.UserStats
+type TicketsServiceMockUserStatsParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// TicketsServiceMockUserStatsParamPtrs contains pointers to parameters of the TicketsService.UserStats
+type TicketsServiceMockUserStatsParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// TicketsServiceMockUserStatsResults contains results of the TicketsService.UserStats
+type TicketsServiceMockUserStatsResults struct {
+	up1 *models.UserStats
+	err error
+}
+
+// TicketsServiceMockUserStatsOrigins contains origins of expectations of the TicketsService.UserStats
+type TicketsServiceMockUserStatsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUserStats *mTicketsServiceMockUserStats) Optional() *mTicketsServiceMockUserStats {
+	mmUserStats.optional = true
+	return mmUserStats
+}
+
+// Expect sets up expected params for TicketsService.UserStats
+func (mmUserStats *mTicketsServiceMockUserStats) Expect(ctx context.Context, userID int64) *mTicketsServiceMockUserStats {
+	if mmUserStats.mock.funcUserStats != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Set")
+	}
+
+	if mmUserStats.defaultExpectation == nil {
+		mmUserStats.defaultExpectation = &TicketsServiceMockUserStatsExpectation{}
+	}
+
+	if mmUserStats.defaultExpectation.paramPtrs != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by ExpectParams functions")
+	}
+
+	mmUserStats.defaultExpectation.params = &TicketsServiceMockUserStatsParams{ctx, userID}
+	mmUserStats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUserStats.expectations {
+		if minimock.Equal(e.params, mmUserStats.defaultExpectation.params) {
+			mmUserStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUserStats.defaultExpectation.params)
+		}
+	}
+
+	return mmUserStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TicketsService.UserStats
+func (mmUserStats *mTicketsServiceMockUserStats) ExpectCtxParam1(ctx context.Context) *mTicketsServiceMockUserStats {
+	if mmUserStats.mock.funcUserStats != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Set")
+	}
+
+	if mmUserStats.defaultExpectation == nil {
+		mmUserStats.defaultExpectation = &TicketsServiceMockUserStatsExpectation{}
+	}
+
+	if mmUserStats.defaultExpectation.params != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Expect")
+	}
+
+	if mmUserStats.defaultExpectation.paramPtrs == nil {
+		mmUserStats.defaultExpectation.paramPtrs = &TicketsServiceMockUserStatsParamPtrs{}
+	}
+	mmUserStats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUserStats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUserStats
+}
+
+// ExpectUserIDParam2 sets up expected param userID for TicketsService.UserStats
+func (mmUserStats *mTicketsServiceMockUserStats) ExpectUserIDParam2(userID int64) *mTicketsServiceMockUserStats {
+	if mmUserStats.mock.funcUserStats != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Set")
+	}
+
+	if mmUserStats.defaultExpectation == nil {
+		mmUserStats.defaultExpectation = &TicketsServiceMockUserStatsExpectation{}
+	}
+
+	if mmUserStats.defaultExpectation.params != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Expect")
+	}
+
+	if mmUserStats.defaultExpectation.paramPtrs == nil {
+		mmUserStats.defaultExpectation.paramPtrs = &TicketsServiceMockUserStatsParamPtrs{}
+	}
+	mmUserStats.defaultExpectation.paramPtrs.userID = &userID
+	mmUserStats.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUserStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the TicketsService.UserStats
+func (mmUserStats *mTicketsServiceMockUserStats) Inspect(f func(ctx context.Context, userID int64)) *mTicketsServiceMockUserStats {
+	if mmUserStats.mock.inspectFuncUserStats != nil {
+		mmUserStats.mock.t.Fatalf("Inspect function is already set for TicketsServiceMock.UserStats")
+	}
+
+	mmUserStats.mock.inspectFuncUserStats = f
+
+	return mmUserStats
+}
+
+// Return sets up results that will be returned by TicketsService.UserStats
+func (mmUserStats *mTicketsServiceMockUserStats) Return(up1 *models.UserStats, err error) *TicketsServiceMock {
+	if mmUserStats.mock.funcUserStats != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Set")
+	}
+
+	if mmUserStats.defaultExpectation == nil {
+		mmUserStats.defaultExpectation = &TicketsServiceMockUserStatsExpectation{mock: mmUserStats.mock}
+	}
+	mmUserStats.defaultExpectation.results = &TicketsServiceMockUserStatsResults{up1, err}
+	mmUserStats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUserStats.mock
+}
+
+// Set uses given function f to mock the TicketsService.UserStats method
+func (mmUserStats *mTicketsServiceMockUserStats) Set(f func(ctx context.Context, userID int64) (up1 *models.UserStats, err error)) *TicketsServiceMock {
+	if mmUserStats.defaultExpectation != nil {
+		mmUserStats.mock.t.Fatalf("Default expectation is already set for the TicketsService.UserStats method")
+	}
+
+	if len(mmUserStats.expectations) > 0 {
+		mmUserStats.mock.t.Fatalf("Some expectations are already set for the TicketsService.UserStats method")
+	}
+
+	mmUserStats.mock.funcUserStats = f
+	mmUserStats.mock.funcUserStatsOrigin = minimock.CallerInfo(1)
+	return mmUserStats.mock
+}
+
+// When sets expectation for the TicketsService.UserStats which will trigger the result defined by the following
+// Then helper
+func (mmUserStats *mTicketsServiceMockUserStats) When(ctx context.Context, userID int64) *TicketsServiceMockUserStatsExpectation {
+	if mmUserStats.mock.funcUserStats != nil {
+		mmUserStats.mock.t.Fatalf("TicketsServiceMock.UserStats mock is already set by Set")
+	}
+
+	expectation := &TicketsServiceMockUserStatsExpectation{
+		mock:               mmUserStats.mock,
+		params:             &TicketsServiceMockUserStatsParams{ctx, userID},
+		expectationOrigins: TicketsServiceMockUserStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUserStats.expectations = append(mmUserStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TicketsService.UserStats return parameters for the expectation previously defined by the When method
+func (e *TicketsServiceMockUserStatsExpectation) Then(up1 *models.UserStats, err error) *TicketsServiceMock {
+	e.results = &TicketsServiceMockUserStatsResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times TicketsService.UserStats should be invoked
+func (mmUserStats *mTicketsServiceMockUserStats) Times(n uint64) *mTicketsServiceMockUserStats {
+	if n == 0 {
+		mmUserStats.mock.t.Fatalf("Times of TicketsServiceMock.UserStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUserStats.expectedInvocations, n)
+	mmUserStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUserStats
+}
+
+func (mmUserStats *mTicketsServiceMockUserStats) invocationsDone() bool {
+	if len(mmUserStats.expectations) == 0 && mmUserStats.defaultExpectation == nil && mmUserStats.mock.funcUserStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUserStats.mock.afterUserStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUserStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UserStats implements mm_service.TicketsService
+func (mmUserStats *TicketsServiceMock) UserStats(ctx context.Context, userID int64) (up1 *models.UserStats, err error) {
+	mm_atomic.AddUint64(&mmUserStats.beforeUserStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUserStats.afterUserStatsCounter, 1)
+
+	mmUserStats.t.Helper()
+
+	if mmUserStats.inspectFuncUserStats != nil {
+		mmUserStats.inspectFuncUserStats(ctx, userID)
+	}
+
+	mm_params := TicketsServiceMockUserStatsParams{ctx, userID}
+
+	// Record call args
+	mmUserStats.UserStatsMock.mutex.Lock()
+	mmUserStats.UserStatsMock.callArgs = append(mmUserStats.UserStatsMock.callArgs, &mm_params)
+	mmUserStats.UserStatsMock.mutex.Unlock()
+
+	for _, e := range mmUserStats.UserStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmUserStats.UserStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUserStats.UserStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUserStats.UserStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmUserStats.UserStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := TicketsServiceMockUserStatsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUserStats.t.Errorf("TicketsServiceMock.UserStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUserStats.UserStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUserStats.t.Errorf("TicketsServiceMock.UserStats got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUserStats.UserStatsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUserStats.t.Errorf("TicketsServiceMock.UserStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUserStats.UserStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUserStats.UserStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUserStats.t.Fatal("No results are set for the TicketsServiceMock.UserStats")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmUserStats.funcUserStats != nil {
+		return mmUserStats.funcUserStats(ctx, userID)
+	}
+	mmUserStats.t.Fatalf("Unexpected call to TicketsServiceMock.UserStats. %v %v", ctx, userID)
+	return
+}
+
+// UserStatsAfterCounter returns a count of finished TicketsServiceMock.UserStats invocations
+func (mmUserStats *TicketsServiceMock) UserStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserStats.afterUserStatsCounter)
+}
+
+// UserStatsBeforeCounter returns a count of TicketsServiceMock.UserStats invocations
+func (mmUserStats *TicketsServiceMock) UserStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserStats.beforeUserStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TicketsServiceMock.UserStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUserStats *mTicketsServiceMockUserStats) Calls() []*TicketsServiceMockUserStatsParams {
+	mmUserStats.mutex.RLock()
+
+	argCopy := make([]*TicketsServiceMockUserStatsParams, len(mmUserStats.callArgs))
+	copy(argCopy, mmUserStats.callArgs)
+
+	mmUserStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUserStatsDone returns true if the count of the UserStats invocations corresponds
+// the number of defined expectations
+func (m *TicketsServiceMock) MinimockUserStatsDone() bool {
+	if m.UserStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UserStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UserStatsMock.invocationsDone()
+}
+
+// MinimockUserStatsInspect logs each unmet expectation
+func (m *TicketsServiceMock) MinimockUserStatsInspect() {
+	for _, e := range m.UserStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TicketsServiceMock.UserStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUserStatsCounter := mm_atomic.LoadUint64(&m.afterUserStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UserStatsMock.defaultExpectation != nil && afterUserStatsCounter < 1 {
+		if m.UserStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TicketsServiceMock.UserStats at\n%s", m.UserStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TicketsServiceMock.UserStats at\n%s with params: %#v", m.UserStatsMock.defaultExpectation.expectationOrigins.origin, *m.UserStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUserStats != nil && afterUserStatsCounter < 1 {
+		m.t.Errorf("Expected call to TicketsServiceMock.UserStats at\n%s", m.funcUserStatsOrigin)
+	}
+
+	if !m.UserStatsMock.invocationsDone() && afterUserStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TicketsServiceMock.UserStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UserStatsMock.expectedInvocations), m.UserStatsMock.expectedInvocationsOrigin, afterUserStatsCounter)
+	}
+}
+
 type mTicketsServiceMockUserTickets struct {
 	optional           bool
 	mock               *TicketsServiceMock
@@ -1096,10 +3908,24 @@ func (m *TicketsServiceMock) MinimockUserTicketsInspect() {
 func (m *TicketsServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAttendeesInspect()
+
 			m.MinimockBuyTicketInspect()
 
+			m.MinimockCheckInInspect()
+
+			m.MinimockEventStatsInspect()
+
+			m.MinimockExportAttendeesInspect()
+
+			m.MinimockFunnelInspect()
+
+			m.MinimockSalesReportInspect()
+
 			m.MinimockTicketInspect()
 
+			m.MinimockUserStatsInspect()
+
 			m.MinimockUserTicketsInspect()
 		}
 	})
@@ -1124,7 +3950,14 @@ func (m *TicketsServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *TicketsServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAttendeesDone() &&
 		m.MinimockBuyTicketDone() &&
+		m.MinimockCheckInDone() &&
+		m.MinimockEventStatsDone() &&
+		m.MinimockExportAttendeesDone() &&
+		m.MinimockFunnelDone() &&
+		m.MinimockSalesReportDone() &&
 		m.MinimockTicketDone() &&
+		m.MinimockUserStatsDone() &&
 		m.MinimockUserTicketsDone()
 }
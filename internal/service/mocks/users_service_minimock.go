@@ -19,13 +19,27 @@ type UsersServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAccessToken          func(ctx context.Context, refreshToken string) (s1 string, err error)
+	funcAccessToken          func(ctx context.Context, refreshToken string) (s1 string, s2 string, err error)
 	funcAccessTokenOrigin    string
 	inspectFuncAccessToken   func(ctx context.Context, refreshToken string)
 	afterAccessTokenCounter  uint64
 	beforeAccessTokenCounter uint64
 	AccessTokenMock          mUsersServiceMockAccessToken
 
+	funcChangePassword          func(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) (err error)
+	funcChangePasswordOrigin    string
+	inspectFuncChangePassword   func(ctx context.Context, userEmail string, req *models.ChangePasswordRequest)
+	afterChangePasswordCounter  uint64
+	beforeChangePasswordCounter uint64
+	ChangePasswordMock          mUsersServiceMockChangePassword
+
+	funcForgotPassword          func(ctx context.Context, userEmail string) (err error)
+	funcForgotPasswordOrigin    string
+	inspectFuncForgotPassword   func(ctx context.Context, userEmail string)
+	afterForgotPasswordCounter  uint64
+	beforeForgotPasswordCounter uint64
+	ForgotPasswordMock          mUsersServiceMockForgotPassword
+
 	funcLogin          func(ctx context.Context, user *models.User) (s1 string, err error)
 	funcLoginOrigin    string
 	inspectFuncLogin   func(ctx context.Context, user *models.User)
@@ -33,6 +47,20 @@ type UsersServiceMock struct {
 	beforeLoginCounter uint64
 	LoginMock          mUsersServiceMockLogin
 
+	funcLogout          func(ctx context.Context, refreshToken string) (err error)
+	funcLogoutOrigin    string
+	inspectFuncLogout   func(ctx context.Context, refreshToken string)
+	afterLogoutCounter  uint64
+	beforeLogoutCounter uint64
+	LogoutMock          mUsersServiceMockLogout
+
+	funcLogoutAll          func(ctx context.Context, userID int64) (err error)
+	funcLogoutAllOrigin    string
+	inspectFuncLogoutAll   func(ctx context.Context, userID int64)
+	afterLogoutAllCounter  uint64
+	beforeLogoutAllCounter uint64
+	LogoutAllMock          mUsersServiceMockLogoutAll
+
 	funcRegisterUser          func(ctx context.Context, user *models.User) (err error)
 	funcRegisterUserOrigin    string
 	inspectFuncRegisterUser   func(ctx context.Context, user *models.User)
@@ -40,6 +68,20 @@ type UsersServiceMock struct {
 	beforeRegisterUserCounter uint64
 	RegisterUserMock          mUsersServiceMockRegisterUser
 
+	funcResendVerification          func(ctx context.Context, userEmail string) (err error)
+	funcResendVerificationOrigin    string
+	inspectFuncResendVerification   func(ctx context.Context, userEmail string)
+	afterResendVerificationCounter  uint64
+	beforeResendVerificationCounter uint64
+	ResendVerificationMock          mUsersServiceMockResendVerification
+
+	funcResetPassword          func(ctx context.Context, token string, password string) (err error)
+	funcResetPasswordOrigin    string
+	inspectFuncResetPassword   func(ctx context.Context, token string, password string)
+	afterResetPasswordCounter  uint64
+	beforeResetPasswordCounter uint64
+	ResetPasswordMock          mUsersServiceMockResetPassword
+
 	funcUpdateUser          func(ctx context.Context, user *models.User) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, user *models.User)
@@ -53,6 +95,13 @@ type UsersServiceMock struct {
 	afterUserCounter  uint64
 	beforeUserCounter uint64
 	UserMock          mUsersServiceMockUser
+
+	funcVerifyEmail          func(ctx context.Context, token string) (err error)
+	funcVerifyEmailOrigin    string
+	inspectFuncVerifyEmail   func(ctx context.Context, token string)
+	afterVerifyEmailCounter  uint64
+	beforeVerifyEmailCounter uint64
+	VerifyEmailMock          mUsersServiceMockVerifyEmail
 }
 
 // NewUsersServiceMock returns a mock for mm_service.UsersService
@@ -66,18 +115,39 @@ func NewUsersServiceMock(t minimock.Tester) *UsersServiceMock {
 	m.AccessTokenMock = mUsersServiceMockAccessToken{mock: m}
 	m.AccessTokenMock.callArgs = []*UsersServiceMockAccessTokenParams{}
 
+	m.ChangePasswordMock = mUsersServiceMockChangePassword{mock: m}
+	m.ChangePasswordMock.callArgs = []*UsersServiceMockChangePasswordParams{}
+
+	m.ForgotPasswordMock = mUsersServiceMockForgotPassword{mock: m}
+	m.ForgotPasswordMock.callArgs = []*UsersServiceMockForgotPasswordParams{}
+
 	m.LoginMock = mUsersServiceMockLogin{mock: m}
 	m.LoginMock.callArgs = []*UsersServiceMockLoginParams{}
 
+	m.LogoutMock = mUsersServiceMockLogout{mock: m}
+	m.LogoutMock.callArgs = []*UsersServiceMockLogoutParams{}
+
+	m.LogoutAllMock = mUsersServiceMockLogoutAll{mock: m}
+	m.LogoutAllMock.callArgs = []*UsersServiceMockLogoutAllParams{}
+
 	m.RegisterUserMock = mUsersServiceMockRegisterUser{mock: m}
 	m.RegisterUserMock.callArgs = []*UsersServiceMockRegisterUserParams{}
 
+	m.ResendVerificationMock = mUsersServiceMockResendVerification{mock: m}
+	m.ResendVerificationMock.callArgs = []*UsersServiceMockResendVerificationParams{}
+
+	m.ResetPasswordMock = mUsersServiceMockResetPassword{mock: m}
+	m.ResetPasswordMock.callArgs = []*UsersServiceMockResetPasswordParams{}
+
 	m.UpdateUserMock = mUsersServiceMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UsersServiceMockUpdateUserParams{}
 
 	m.UserMock = mUsersServiceMockUser{mock: m}
 	m.UserMock.callArgs = []*UsersServiceMockUserParams{}
 
+	m.VerifyEmailMock = mUsersServiceMockVerifyEmail{mock: m}
+	m.VerifyEmailMock.callArgs = []*UsersServiceMockVerifyEmailParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -122,6 +192,7 @@ type UsersServiceMockAccessTokenParamPtrs struct {
 // UsersServiceMockAccessTokenResults contains results of the UsersService.AccessToken
 type UsersServiceMockAccessTokenResults struct {
 	s1  string
+	s2  string
 	err error
 }
 
@@ -225,7 +296,7 @@ func (mmAccessToken *mUsersServiceMockAccessToken) Inspect(f func(ctx context.Co
 }
 
 // Return sets up results that will be returned by UsersService.AccessToken
-func (mmAccessToken *mUsersServiceMockAccessToken) Return(s1 string, err error) *UsersServiceMock {
+func (mmAccessToken *mUsersServiceMockAccessToken) Return(s1 string, s2 string, err error) *UsersServiceMock {
 	if mmAccessToken.mock.funcAccessToken != nil {
 		mmAccessToken.mock.t.Fatalf("UsersServiceMock.AccessToken mock is already set by Set")
 	}
@@ -233,13 +304,13 @@ func (mmAccessToken *mUsersServiceMockAccessToken) Return(s1 string, err error)
 	if mmAccessToken.defaultExpectation == nil {
 		mmAccessToken.defaultExpectation = &UsersServiceMockAccessTokenExpectation{mock: mmAccessToken.mock}
 	}
-	mmAccessToken.defaultExpectation.results = &UsersServiceMockAccessTokenResults{s1, err}
+	mmAccessToken.defaultExpectation.results = &UsersServiceMockAccessTokenResults{s1, s2, err}
 	mmAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmAccessToken.mock
 }
 
 // Set uses given function f to mock the UsersService.AccessToken method
-func (mmAccessToken *mUsersServiceMockAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *UsersServiceMock {
+func (mmAccessToken *mUsersServiceMockAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, s2 string, err error)) *UsersServiceMock {
 	if mmAccessToken.defaultExpectation != nil {
 		mmAccessToken.mock.t.Fatalf("Default expectation is already set for the UsersService.AccessToken method")
 	}
@@ -270,8 +341,8 @@ func (mmAccessToken *mUsersServiceMockAccessToken) When(ctx context.Context, ref
 }
 
 // Then sets up UsersService.AccessToken return parameters for the expectation previously defined by the When method
-func (e *UsersServiceMockAccessTokenExpectation) Then(s1 string, err error) *UsersServiceMock {
-	e.results = &UsersServiceMockAccessTokenResults{s1, err}
+func (e *UsersServiceMockAccessTokenExpectation) Then(s1 string, s2 string, err error) *UsersServiceMock {
+	e.results = &UsersServiceMockAccessTokenResults{s1, s2, err}
 	return e.mock
 }
 
@@ -297,7 +368,7 @@ func (mmAccessToken *mUsersServiceMockAccessToken) invocationsDone() bool {
 }
 
 // AccessToken implements mm_service.UsersService
-func (mmAccessToken *UsersServiceMock) AccessToken(ctx context.Context, refreshToken string) (s1 string, err error) {
+func (mmAccessToken *UsersServiceMock) AccessToken(ctx context.Context, refreshToken string) (s1 string, s2 string, err error) {
 	mm_atomic.AddUint64(&mmAccessToken.beforeAccessTokenCounter, 1)
 	defer mm_atomic.AddUint64(&mmAccessToken.afterAccessTokenCounter, 1)
 
@@ -317,7 +388,7 @@ func (mmAccessToken *UsersServiceMock) AccessToken(ctx context.Context, refreshT
 	for _, e := range mmAccessToken.AccessTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.s1, e.results.s2, e.results.err
 		}
 	}
 
@@ -349,7 +420,7 @@ func (mmAccessToken *UsersServiceMock) AccessToken(ctx context.Context, refreshT
 		if mm_results == nil {
 			mmAccessToken.t.Fatal("No results are set for the UsersServiceMock.AccessToken")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).s1, (*mm_results).s2, (*mm_results).err
 	}
 	if mmAccessToken.funcAccessToken != nil {
 		return mmAccessToken.funcAccessToken(ctx, refreshToken)
@@ -426,53 +497,55 @@ func (m *UsersServiceMock) MinimockAccessTokenInspect() {
 	}
 }
 
-type mUsersServiceMockLogin struct {
+type mUsersServiceMockChangePassword struct {
 	optional           bool
 	mock               *UsersServiceMock
-	defaultExpectation *UsersServiceMockLoginExpectation
-	expectations       []*UsersServiceMockLoginExpectation
+	defaultExpectation *UsersServiceMockChangePasswordExpectation
+	expectations       []*UsersServiceMockChangePasswordExpectation
 
-	callArgs []*UsersServiceMockLoginParams
+	callArgs []*UsersServiceMockChangePasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersServiceMockLoginExpectation specifies expectation struct of the UsersService.Login
-type UsersServiceMockLoginExpectation struct {
+// UsersServiceMockChangePasswordExpectation specifies expectation struct of the UsersService.ChangePassword
+type UsersServiceMockChangePasswordExpectation struct {
 	mock               *UsersServiceMock
-	params             *UsersServiceMockLoginParams
-	paramPtrs          *UsersServiceMockLoginParamPtrs
-	expectationOrigins UsersServiceMockLoginExpectationOrigins
-	results            *UsersServiceMockLoginResults
+	params             *UsersServiceMockChangePasswordParams
+	paramPtrs          *UsersServiceMockChangePasswordParamPtrs
+	expectationOrigins UsersServiceMockChangePasswordExpectationOrigins
+	results            *UsersServiceMockChangePasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersServiceMockLoginParams contains parameters of the UsersService.Login
-type UsersServiceMockLoginParams struct {
-	ctx  context.Context
-	user *models.User
+// UsersServiceMockChangePasswordParams contains parameters of the UsersService.ChangePassword
+type UsersServiceMockChangePasswordParams struct {
+	ctx       context.Context
+	userEmail string
+	req       *models.ChangePasswordRequest
 }
 
-// UsersServiceMockLoginParamPtrs contains pointers to parameters of the UsersService.Login
-type UsersServiceMockLoginParamPtrs struct {
-	ctx  *context.Context
-	user **models.User
+// UsersServiceMockChangePasswordParamPtrs contains pointers to parameters of the UsersService.ChangePassword
+type UsersServiceMockChangePasswordParamPtrs struct {
+	ctx       *context.Context
+	userEmail *string
+	req       **models.ChangePasswordRequest
 }
 
-// UsersServiceMockLoginResults contains results of the UsersService.Login
-type UsersServiceMockLoginResults struct {
-	s1  string
+// UsersServiceMockChangePasswordResults contains results of the UsersService.ChangePassword
+type UsersServiceMockChangePasswordResults struct {
 	err error
 }
 
-// UsersServiceMockLoginOrigins contains origins of expectations of the UsersService.Login
-type UsersServiceMockLoginExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UsersServiceMockChangePasswordOrigins contains origins of expectations of the UsersService.ChangePassword
+type UsersServiceMockChangePasswordExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserEmail string
+	originReq       string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -480,341 +553,369 @@ type UsersServiceMockLoginExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogin *mUsersServiceMockLogin) Optional() *mUsersServiceMockLogin {
-	mmLogin.optional = true
-	return mmLogin
+func (mmChangePassword *mUsersServiceMockChangePassword) Optional() *mUsersServiceMockChangePassword {
+	mmChangePassword.optional = true
+	return mmChangePassword
 }
 
-// Expect sets up expected params for UsersService.Login
-func (mmLogin *mUsersServiceMockLogin) Expect(ctx context.Context, user *models.User) *mUsersServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+// Expect sets up expected params for UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) Expect(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) *mUsersServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsersServiceMockChangePasswordExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by ExpectParams functions")
+	if mmChangePassword.defaultExpectation.paramPtrs != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by ExpectParams functions")
 	}
 
-	mmLogin.defaultExpectation.params = &UsersServiceMockLoginParams{ctx, user}
-	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogin.expectations {
-		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
-			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+	mmChangePassword.defaultExpectation.params = &UsersServiceMockChangePasswordParams{ctx, userEmail, req}
+	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangePassword.expectations {
+		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
+			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
 		}
 	}
 
-	return mmLogin
+	return mmChangePassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersService.Login
-func (mmLogin *mUsersServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsersServiceMockChangePasswordExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UsersServiceMockLoginParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UsersServiceMockChangePasswordParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmChangePassword
 }
 
-// ExpectUserParam2 sets up expected param user for UsersService.Login
-func (mmLogin *mUsersServiceMockLogin) ExpectUserParam2(user *models.User) *mUsersServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+// ExpectUserEmailParam2 sets up expected param userEmail for UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) ExpectUserEmailParam2(userEmail string) *mUsersServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsersServiceMockChangePasswordExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UsersServiceMockLoginParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UsersServiceMockChangePasswordParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.user = &user
-	mmLogin.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.userEmail = &userEmail
+	mmChangePassword.defaultExpectation.expectationOrigins.originUserEmail = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmChangePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersService.Login
-func (mmLogin *mUsersServiceMockLogin) Inspect(f func(ctx context.Context, user *models.User)) *mUsersServiceMockLogin {
-	if mmLogin.mock.inspectFuncLogin != nil {
-		mmLogin.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.Login")
+// ExpectReqParam3 sets up expected param req for UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) ExpectReqParam3(req *models.ChangePasswordRequest) *mUsersServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	mmLogin.mock.inspectFuncLogin = f
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsersServiceMockChangePasswordExpectation{}
+	}
 
-	return mmLogin
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Expect")
+	}
+
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UsersServiceMockChangePasswordParamPtrs{}
+	}
+	mmChangePassword.defaultExpectation.paramPtrs.req = &req
+	mmChangePassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmChangePassword
 }
 
-// Return sets up results that will be returned by UsersService.Login
-func (mmLogin *mUsersServiceMockLogin) Return(s1 string, err error) *UsersServiceMock {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) Inspect(f func(ctx context.Context, userEmail string, req *models.ChangePasswordRequest)) *mUsersServiceMockChangePassword {
+	if mmChangePassword.mock.inspectFuncChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.ChangePassword")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{mock: mmLogin.mock}
+	mmChangePassword.mock.inspectFuncChangePassword = f
+
+	return mmChangePassword
+}
+
+// Return sets up results that will be returned by UsersService.ChangePassword
+func (mmChangePassword *mUsersServiceMockChangePassword) Return(err error) *UsersServiceMock {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
-	mmLogin.defaultExpectation.results = &UsersServiceMockLoginResults{s1, err}
-	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsersServiceMockChangePasswordExpectation{mock: mmChangePassword.mock}
+	}
+	mmChangePassword.defaultExpectation.results = &UsersServiceMockChangePasswordResults{err}
+	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// Set uses given function f to mock the UsersService.Login method
-func (mmLogin *mUsersServiceMockLogin) Set(f func(ctx context.Context, user *models.User) (s1 string, err error)) *UsersServiceMock {
-	if mmLogin.defaultExpectation != nil {
-		mmLogin.mock.t.Fatalf("Default expectation is already set for the UsersService.Login method")
+// Set uses given function f to mock the UsersService.ChangePassword method
+func (mmChangePassword *mUsersServiceMockChangePassword) Set(f func(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) (err error)) *UsersServiceMock {
+	if mmChangePassword.defaultExpectation != nil {
+		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the UsersService.ChangePassword method")
 	}
 
-	if len(mmLogin.expectations) > 0 {
-		mmLogin.mock.t.Fatalf("Some expectations are already set for the UsersService.Login method")
+	if len(mmChangePassword.expectations) > 0 {
+		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the UsersService.ChangePassword method")
 	}
 
-	mmLogin.mock.funcLogin = f
-	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmChangePassword.mock.funcChangePassword = f
+	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// When sets expectation for the UsersService.Login which will trigger the result defined by the following
+// When sets expectation for the UsersService.ChangePassword which will trigger the result defined by the following
 // Then helper
-func (mmLogin *mUsersServiceMockLogin) When(ctx context.Context, user *models.User) *UsersServiceMockLoginExpectation {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+func (mmChangePassword *mUsersServiceMockChangePassword) When(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) *UsersServiceMockChangePasswordExpectation {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsersServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	expectation := &UsersServiceMockLoginExpectation{
-		mock:               mmLogin.mock,
-		params:             &UsersServiceMockLoginParams{ctx, user},
-		expectationOrigins: UsersServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersServiceMockChangePasswordExpectation{
+		mock:               mmChangePassword.mock,
+		params:             &UsersServiceMockChangePasswordParams{ctx, userEmail, req},
+		expectationOrigins: UsersServiceMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersService.Login return parameters for the expectation previously defined by the When method
-func (e *UsersServiceMockLoginExpectation) Then(s1 string, err error) *UsersServiceMock {
-	e.results = &UsersServiceMockLoginResults{s1, err}
+// Then sets up UsersService.ChangePassword return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockChangePasswordExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockChangePasswordResults{err}
 	return e.mock
 }
 
-// Times sets number of times UsersService.Login should be invoked
-func (mmLogin *mUsersServiceMockLogin) Times(n uint64) *mUsersServiceMockLogin {
+// Times sets number of times UsersService.ChangePassword should be invoked
+func (mmChangePassword *mUsersServiceMockChangePassword) Times(n uint64) *mUsersServiceMockChangePassword {
 	if n == 0 {
-		mmLogin.mock.t.Fatalf("Times of UsersServiceMock.Login mock can not be zero")
+		mmChangePassword.mock.t.Fatalf("Times of UsersServiceMock.ChangePassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
-	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogin
+	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
+	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangePassword
 }
 
-func (mmLogin *mUsersServiceMockLogin) invocationsDone() bool {
-	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+func (mmChangePassword *mUsersServiceMockChangePassword) invocationsDone() bool {
+	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Login implements mm_service.UsersService
-func (mmLogin *UsersServiceMock) Login(ctx context.Context, user *models.User) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+// ChangePassword implements mm_service.UsersService
+func (mmChangePassword *UsersServiceMock) ChangePassword(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) (err error) {
+	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
 
-	mmLogin.t.Helper()
+	mmChangePassword.t.Helper()
 
-	if mmLogin.inspectFuncLogin != nil {
-		mmLogin.inspectFuncLogin(ctx, user)
+	if mmChangePassword.inspectFuncChangePassword != nil {
+		mmChangePassword.inspectFuncChangePassword(ctx, userEmail, req)
 	}
 
-	mm_params := UsersServiceMockLoginParams{ctx, user}
+	mm_params := UsersServiceMockChangePasswordParams{ctx, userEmail, req}
 
 	// Record call args
-	mmLogin.LoginMock.mutex.Lock()
-	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
-	mmLogin.LoginMock.mutex.Unlock()
+	mmChangePassword.ChangePasswordMock.mutex.Lock()
+	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
+	mmChangePassword.ChangePasswordMock.mutex.Unlock()
 
-	for _, e := range mmLogin.LoginMock.expectations {
+	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmLogin.LoginMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogin.LoginMock.defaultExpectation.params
-		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersServiceMockLoginParams{ctx, user}
+		mm_got := UsersServiceMockChangePasswordParams{ctx, userEmail, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmChangePassword.t.Errorf("UsersServiceMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.userEmail != nil && !minimock.Equal(*mm_want_ptrs.userEmail, mm_got.userEmail) {
+				mmChangePassword.t.Errorf("UsersServiceMock.ChangePassword got unexpected parameter userEmail, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originUserEmail, *mm_want_ptrs.userEmail, mm_got.userEmail, minimock.Diff(*mm_want_ptrs.userEmail, mm_got.userEmail))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmChangePassword.t.Errorf("UsersServiceMock.ChangePassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmChangePassword.t.Errorf("UsersServiceMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogin.t.Fatal("No results are set for the UsersServiceMock.Login")
+			mmChangePassword.t.Fatal("No results are set for the UsersServiceMock.ChangePassword")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmLogin.funcLogin != nil {
-		return mmLogin.funcLogin(ctx, user)
+	if mmChangePassword.funcChangePassword != nil {
+		return mmChangePassword.funcChangePassword(ctx, userEmail, req)
 	}
-	mmLogin.t.Fatalf("Unexpected call to UsersServiceMock.Login. %v %v", ctx, user)
+	mmChangePassword.t.Fatalf("Unexpected call to UsersServiceMock.ChangePassword. %v %v %v", ctx, userEmail, req)
 	return
 }
 
-// LoginAfterCounter returns a count of finished UsersServiceMock.Login invocations
-func (mmLogin *UsersServiceMock) LoginAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+// ChangePasswordAfterCounter returns a count of finished UsersServiceMock.ChangePassword invocations
+func (mmChangePassword *UsersServiceMock) ChangePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
 }
 
-// LoginBeforeCounter returns a count of UsersServiceMock.Login invocations
-func (mmLogin *UsersServiceMock) LoginBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+// ChangePasswordBeforeCounter returns a count of UsersServiceMock.ChangePassword invocations
+func (mmChangePassword *UsersServiceMock) ChangePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersServiceMock.Login.
+// Calls returns a list of arguments used in each call to UsersServiceMock.ChangePassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogin *mUsersServiceMockLogin) Calls() []*UsersServiceMockLoginParams {
-	mmLogin.mutex.RLock()
+func (mmChangePassword *mUsersServiceMockChangePassword) Calls() []*UsersServiceMockChangePasswordParams {
+	mmChangePassword.mutex.RLock()
 
-	argCopy := make([]*UsersServiceMockLoginParams, len(mmLogin.callArgs))
-	copy(argCopy, mmLogin.callArgs)
+	argCopy := make([]*UsersServiceMockChangePasswordParams, len(mmChangePassword.callArgs))
+	copy(argCopy, mmChangePassword.callArgs)
 
-	mmLogin.mutex.RUnlock()
+	mmChangePassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
 // the number of defined expectations
-func (m *UsersServiceMock) MinimockLoginDone() bool {
-	if m.LoginMock.optional {
+func (m *UsersServiceMock) MinimockChangePasswordDone() bool {
+	if m.ChangePasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LoginMock.expectations {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoginMock.invocationsDone()
+	return m.ChangePasswordMock.invocationsDone()
 }
 
-// MinimockLoginInspect logs each unmet expectation
-func (m *UsersServiceMock) MinimockLoginInspect() {
-	for _, e := range m.LoginMock.expectations {
+// MinimockChangePasswordInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockChangePasswordInspect() {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersServiceMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
-		if m.LoginMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
+		if m.ChangePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersServiceMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogin != nil && afterLoginCounter < 1 {
-		m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s", m.funcLoginOrigin)
+	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
 	}
 
-	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersServiceMock.Login at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.ChangePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
 	}
 }
 
-type mUsersServiceMockRegisterUser struct {
+type mUsersServiceMockForgotPassword struct {
 	optional           bool
 	mock               *UsersServiceMock
-	defaultExpectation *UsersServiceMockRegisterUserExpectation
-	expectations       []*UsersServiceMockRegisterUserExpectation
+	defaultExpectation *UsersServiceMockForgotPasswordExpectation
+	expectations       []*UsersServiceMockForgotPasswordExpectation
 
-	callArgs []*UsersServiceMockRegisterUserParams
+	callArgs []*UsersServiceMockForgotPasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersServiceMockRegisterUserExpectation specifies expectation struct of the UsersService.RegisterUser
-type UsersServiceMockRegisterUserExpectation struct {
+// UsersServiceMockForgotPasswordExpectation specifies expectation struct of the UsersService.ForgotPassword
+type UsersServiceMockForgotPasswordExpectation struct {
 	mock               *UsersServiceMock
-	params             *UsersServiceMockRegisterUserParams
-	paramPtrs          *UsersServiceMockRegisterUserParamPtrs
-	expectationOrigins UsersServiceMockRegisterUserExpectationOrigins
-	results            *UsersServiceMockRegisterUserResults
+	params             *UsersServiceMockForgotPasswordParams
+	paramPtrs          *UsersServiceMockForgotPasswordParamPtrs
+	expectationOrigins UsersServiceMockForgotPasswordExpectationOrigins
+	results            *UsersServiceMockForgotPasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersServiceMockRegisterUserParams contains parameters of the UsersService.RegisterUser
-type UsersServiceMockRegisterUserParams struct {
-	ctx  context.Context
-	user *models.User
+// UsersServiceMockForgotPasswordParams contains parameters of the UsersService.ForgotPassword
+type UsersServiceMockForgotPasswordParams struct {
+	ctx       context.Context
+	userEmail string
 }
 
-// UsersServiceMockRegisterUserParamPtrs contains pointers to parameters of the UsersService.RegisterUser
-type UsersServiceMockRegisterUserParamPtrs struct {
-	ctx  *context.Context
-	user **models.User
+// UsersServiceMockForgotPasswordParamPtrs contains pointers to parameters of the UsersService.ForgotPassword
+type UsersServiceMockForgotPasswordParamPtrs struct {
+	ctx       *context.Context
+	userEmail *string
 }
 
-// UsersServiceMockRegisterUserResults contains results of the UsersService.RegisterUser
-type UsersServiceMockRegisterUserResults struct {
+// UsersServiceMockForgotPasswordResults contains results of the UsersService.ForgotPassword
+type UsersServiceMockForgotPasswordResults struct {
 	err error
 }
 
-// UsersServiceMockRegisterUserOrigins contains origins of expectations of the UsersService.RegisterUser
-type UsersServiceMockRegisterUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UsersServiceMockForgotPasswordOrigins contains origins of expectations of the UsersService.ForgotPassword
+type UsersServiceMockForgotPasswordExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserEmail string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -822,292 +923,2376 @@ type UsersServiceMockRegisterUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Optional() *mUsersServiceMockRegisterUser {
-	mmRegisterUser.optional = true
-	return mmRegisterUser
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Optional() *mUsersServiceMockForgotPassword {
+	mmForgotPassword.optional = true
+	return mmForgotPassword
 }
 
-// Expect sets up expected params for UsersService.RegisterUser
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Expect(ctx context.Context, user *models.User) *mUsersServiceMockRegisterUser {
-	if mmRegisterUser.mock.funcRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+// Expect sets up expected params for UsersService.ForgotPassword
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Expect(ctx context.Context, userEmail string) *mUsersServiceMockForgotPassword {
+	if mmForgotPassword.mock.funcForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Set")
 	}
 
-	if mmRegisterUser.defaultExpectation == nil {
-		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
+	if mmForgotPassword.defaultExpectation == nil {
+		mmForgotPassword.defaultExpectation = &UsersServiceMockForgotPasswordExpectation{}
+	}
+
+	if mmForgotPassword.defaultExpectation.paramPtrs != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by ExpectParams functions")
+	}
+
+	mmForgotPassword.defaultExpectation.params = &UsersServiceMockForgotPasswordParams{ctx, userEmail}
+	mmForgotPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmForgotPassword.expectations {
+		if minimock.Equal(e.params, mmForgotPassword.defaultExpectation.params) {
+			mmForgotPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForgotPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmForgotPassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.ForgotPassword
+func (mmForgotPassword *mUsersServiceMockForgotPassword) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockForgotPassword {
+	if mmForgotPassword.mock.funcForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Set")
+	}
+
+	if mmForgotPassword.defaultExpectation == nil {
+		mmForgotPassword.defaultExpectation = &UsersServiceMockForgotPasswordExpectation{}
+	}
+
+	if mmForgotPassword.defaultExpectation.params != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Expect")
+	}
+
+	if mmForgotPassword.defaultExpectation.paramPtrs == nil {
+		mmForgotPassword.defaultExpectation.paramPtrs = &UsersServiceMockForgotPasswordParamPtrs{}
+	}
+	mmForgotPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmForgotPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmForgotPassword
+}
+
+// ExpectUserEmailParam2 sets up expected param userEmail for UsersService.ForgotPassword
+func (mmForgotPassword *mUsersServiceMockForgotPassword) ExpectUserEmailParam2(userEmail string) *mUsersServiceMockForgotPassword {
+	if mmForgotPassword.mock.funcForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Set")
+	}
+
+	if mmForgotPassword.defaultExpectation == nil {
+		mmForgotPassword.defaultExpectation = &UsersServiceMockForgotPasswordExpectation{}
+	}
+
+	if mmForgotPassword.defaultExpectation.params != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Expect")
+	}
+
+	if mmForgotPassword.defaultExpectation.paramPtrs == nil {
+		mmForgotPassword.defaultExpectation.paramPtrs = &UsersServiceMockForgotPasswordParamPtrs{}
+	}
+	mmForgotPassword.defaultExpectation.paramPtrs.userEmail = &userEmail
+	mmForgotPassword.defaultExpectation.expectationOrigins.originUserEmail = minimock.CallerInfo(1)
+
+	return mmForgotPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.ForgotPassword
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Inspect(f func(ctx context.Context, userEmail string)) *mUsersServiceMockForgotPassword {
+	if mmForgotPassword.mock.inspectFuncForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.ForgotPassword")
+	}
+
+	mmForgotPassword.mock.inspectFuncForgotPassword = f
+
+	return mmForgotPassword
+}
+
+// Return sets up results that will be returned by UsersService.ForgotPassword
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Return(err error) *UsersServiceMock {
+	if mmForgotPassword.mock.funcForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Set")
+	}
+
+	if mmForgotPassword.defaultExpectation == nil {
+		mmForgotPassword.defaultExpectation = &UsersServiceMockForgotPasswordExpectation{mock: mmForgotPassword.mock}
+	}
+	mmForgotPassword.defaultExpectation.results = &UsersServiceMockForgotPasswordResults{err}
+	mmForgotPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmForgotPassword.mock
+}
+
+// Set uses given function f to mock the UsersService.ForgotPassword method
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Set(f func(ctx context.Context, userEmail string) (err error)) *UsersServiceMock {
+	if mmForgotPassword.defaultExpectation != nil {
+		mmForgotPassword.mock.t.Fatalf("Default expectation is already set for the UsersService.ForgotPassword method")
+	}
+
+	if len(mmForgotPassword.expectations) > 0 {
+		mmForgotPassword.mock.t.Fatalf("Some expectations are already set for the UsersService.ForgotPassword method")
+	}
+
+	mmForgotPassword.mock.funcForgotPassword = f
+	mmForgotPassword.mock.funcForgotPasswordOrigin = minimock.CallerInfo(1)
+	return mmForgotPassword.mock
+}
+
+// When sets expectation for the UsersService.ForgotPassword which will trigger the result defined by the following
+// Then helper
+func (mmForgotPassword *mUsersServiceMockForgotPassword) When(ctx context.Context, userEmail string) *UsersServiceMockForgotPasswordExpectation {
+	if mmForgotPassword.mock.funcForgotPassword != nil {
+		mmForgotPassword.mock.t.Fatalf("UsersServiceMock.ForgotPassword mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockForgotPasswordExpectation{
+		mock:               mmForgotPassword.mock,
+		params:             &UsersServiceMockForgotPasswordParams{ctx, userEmail},
+		expectationOrigins: UsersServiceMockForgotPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmForgotPassword.expectations = append(mmForgotPassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.ForgotPassword return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockForgotPasswordExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockForgotPasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.ForgotPassword should be invoked
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Times(n uint64) *mUsersServiceMockForgotPassword {
+	if n == 0 {
+		mmForgotPassword.mock.t.Fatalf("Times of UsersServiceMock.ForgotPassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmForgotPassword.expectedInvocations, n)
+	mmForgotPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmForgotPassword
+}
+
+func (mmForgotPassword *mUsersServiceMockForgotPassword) invocationsDone() bool {
+	if len(mmForgotPassword.expectations) == 0 && mmForgotPassword.defaultExpectation == nil && mmForgotPassword.mock.funcForgotPassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmForgotPassword.mock.afterForgotPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmForgotPassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ForgotPassword implements mm_service.UsersService
+func (mmForgotPassword *UsersServiceMock) ForgotPassword(ctx context.Context, userEmail string) (err error) {
+	mm_atomic.AddUint64(&mmForgotPassword.beforeForgotPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmForgotPassword.afterForgotPasswordCounter, 1)
+
+	mmForgotPassword.t.Helper()
+
+	if mmForgotPassword.inspectFuncForgotPassword != nil {
+		mmForgotPassword.inspectFuncForgotPassword(ctx, userEmail)
+	}
+
+	mm_params := UsersServiceMockForgotPasswordParams{ctx, userEmail}
+
+	// Record call args
+	mmForgotPassword.ForgotPasswordMock.mutex.Lock()
+	mmForgotPassword.ForgotPasswordMock.callArgs = append(mmForgotPassword.ForgotPasswordMock.callArgs, &mm_params)
+	mmForgotPassword.ForgotPasswordMock.mutex.Unlock()
+
+	for _, e := range mmForgotPassword.ForgotPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmForgotPassword.ForgotPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmForgotPassword.ForgotPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmForgotPassword.ForgotPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmForgotPassword.ForgotPasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockForgotPasswordParams{ctx, userEmail}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmForgotPassword.t.Errorf("UsersServiceMock.ForgotPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmForgotPassword.ForgotPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userEmail != nil && !minimock.Equal(*mm_want_ptrs.userEmail, mm_got.userEmail) {
+				mmForgotPassword.t.Errorf("UsersServiceMock.ForgotPassword got unexpected parameter userEmail, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmForgotPassword.ForgotPasswordMock.defaultExpectation.expectationOrigins.originUserEmail, *mm_want_ptrs.userEmail, mm_got.userEmail, minimock.Diff(*mm_want_ptrs.userEmail, mm_got.userEmail))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmForgotPassword.t.Errorf("UsersServiceMock.ForgotPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmForgotPassword.ForgotPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmForgotPassword.ForgotPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmForgotPassword.t.Fatal("No results are set for the UsersServiceMock.ForgotPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmForgotPassword.funcForgotPassword != nil {
+		return mmForgotPassword.funcForgotPassword(ctx, userEmail)
+	}
+	mmForgotPassword.t.Fatalf("Unexpected call to UsersServiceMock.ForgotPassword. %v %v", ctx, userEmail)
+	return
+}
+
+// ForgotPasswordAfterCounter returns a count of finished UsersServiceMock.ForgotPassword invocations
+func (mmForgotPassword *UsersServiceMock) ForgotPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForgotPassword.afterForgotPasswordCounter)
+}
+
+// ForgotPasswordBeforeCounter returns a count of UsersServiceMock.ForgotPassword invocations
+func (mmForgotPassword *UsersServiceMock) ForgotPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForgotPassword.beforeForgotPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.ForgotPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmForgotPassword *mUsersServiceMockForgotPassword) Calls() []*UsersServiceMockForgotPasswordParams {
+	mmForgotPassword.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockForgotPasswordParams, len(mmForgotPassword.callArgs))
+	copy(argCopy, mmForgotPassword.callArgs)
+
+	mmForgotPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockForgotPasswordDone returns true if the count of the ForgotPassword invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockForgotPasswordDone() bool {
+	if m.ForgotPasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ForgotPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ForgotPasswordMock.invocationsDone()
+}
+
+// MinimockForgotPasswordInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockForgotPasswordInspect() {
+	for _, e := range m.ForgotPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.ForgotPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterForgotPasswordCounter := mm_atomic.LoadUint64(&m.afterForgotPasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ForgotPasswordMock.defaultExpectation != nil && afterForgotPasswordCounter < 1 {
+		if m.ForgotPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.ForgotPassword at\n%s", m.ForgotPasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.ForgotPassword at\n%s with params: %#v", m.ForgotPasswordMock.defaultExpectation.expectationOrigins.origin, *m.ForgotPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcForgotPassword != nil && afterForgotPasswordCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.ForgotPassword at\n%s", m.funcForgotPasswordOrigin)
+	}
+
+	if !m.ForgotPasswordMock.invocationsDone() && afterForgotPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.ForgotPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ForgotPasswordMock.expectedInvocations), m.ForgotPasswordMock.expectedInvocationsOrigin, afterForgotPasswordCounter)
+	}
+}
+
+type mUsersServiceMockLogin struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockLoginExpectation
+	expectations       []*UsersServiceMockLoginExpectation
+
+	callArgs []*UsersServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockLoginExpectation specifies expectation struct of the UsersService.Login
+type UsersServiceMockLoginExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockLoginParams
+	paramPtrs          *UsersServiceMockLoginParamPtrs
+	expectationOrigins UsersServiceMockLoginExpectationOrigins
+	results            *UsersServiceMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockLoginParams contains parameters of the UsersService.Login
+type UsersServiceMockLoginParams struct {
+	ctx  context.Context
+	user *models.User
+}
+
+// UsersServiceMockLoginParamPtrs contains pointers to parameters of the UsersService.Login
+type UsersServiceMockLoginParamPtrs struct {
+	ctx  *context.Context
+	user **models.User
+}
+
+// UsersServiceMockLoginResults contains results of the UsersService.Login
+type UsersServiceMockLoginResults struct {
+	s1  string
+	err error
+}
+
+// UsersServiceMockLoginOrigins contains origins of expectations of the UsersService.Login
+type UsersServiceMockLoginExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mUsersServiceMockLogin) Optional() *mUsersServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for UsersService.Login
+func (mmLogin *mUsersServiceMockLogin) Expect(ctx context.Context, user *models.User) *mUsersServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &UsersServiceMockLoginParams{ctx, user}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.Login
+func (mmLogin *mUsersServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UsersServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectUserParam2 sets up expected param user for UsersService.Login
+func (mmLogin *mUsersServiceMockLogin) ExpectUserParam2(user *models.User) *mUsersServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UsersServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.user = &user
+	mmLogin.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.Login
+func (mmLogin *mUsersServiceMockLogin) Inspect(f func(ctx context.Context, user *models.User)) *mUsersServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by UsersService.Login
+func (mmLogin *mUsersServiceMockLogin) Return(s1 string, err error) *UsersServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsersServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &UsersServiceMockLoginResults{s1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the UsersService.Login method
+func (mmLogin *mUsersServiceMockLogin) Set(f func(ctx context.Context, user *models.User) (s1 string, err error)) *UsersServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the UsersService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the UsersService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the UsersService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mUsersServiceMockLogin) When(ctx context.Context, user *models.User) *UsersServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsersServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &UsersServiceMockLoginParams{ctx, user},
+		expectationOrigins: UsersServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.Login return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockLoginExpectation) Then(s1 string, err error) *UsersServiceMock {
+	e.results = &UsersServiceMockLoginResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.Login should be invoked
+func (mmLogin *mUsersServiceMockLogin) Times(n uint64) *mUsersServiceMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of UsersServiceMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mUsersServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_service.UsersService
+func (mmLogin *UsersServiceMock) Login(ctx context.Context, user *models.User) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, user)
+	}
+
+	mm_params := UsersServiceMockLoginParams{ctx, user}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockLoginParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("UsersServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the UsersServiceMock.Login")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, user)
+	}
+	mmLogin.t.Fatalf("Unexpected call to UsersServiceMock.Login. %v %v", ctx, user)
+	return
+}
+
+// LoginAfterCounter returns a count of finished UsersServiceMock.Login invocations
+func (mmLogin *UsersServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of UsersServiceMock.Login invocations
+func (mmLogin *UsersServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mUsersServiceMockLogin) Calls() []*UsersServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mUsersServiceMockLogout struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockLogoutExpectation
+	expectations       []*UsersServiceMockLogoutExpectation
+
+	callArgs []*UsersServiceMockLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockLogoutExpectation specifies expectation struct of the UsersService.Logout
+type UsersServiceMockLogoutExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockLogoutParams
+	paramPtrs          *UsersServiceMockLogoutParamPtrs
+	expectationOrigins UsersServiceMockLogoutExpectationOrigins
+	results            *UsersServiceMockLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockLogoutParams contains parameters of the UsersService.Logout
+type UsersServiceMockLogoutParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// UsersServiceMockLogoutParamPtrs contains pointers to parameters of the UsersService.Logout
+type UsersServiceMockLogoutParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// UsersServiceMockLogoutResults contains results of the UsersService.Logout
+type UsersServiceMockLogoutResults struct {
+	err error
+}
+
+// UsersServiceMockLogoutOrigins contains origins of expectations of the UsersService.Logout
+type UsersServiceMockLogoutExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogout *mUsersServiceMockLogout) Optional() *mUsersServiceMockLogout {
+	mmLogout.optional = true
+	return mmLogout
+}
+
+// Expect sets up expected params for UsersService.Logout
+func (mmLogout *mUsersServiceMockLogout) Expect(ctx context.Context, refreshToken string) *mUsersServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsersServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by ExpectParams functions")
+	}
+
+	mmLogout.defaultExpectation.params = &UsersServiceMockLogoutParams{ctx, refreshToken}
+	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogout.expectations {
+		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
+			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.Logout
+func (mmLogout *mUsersServiceMockLogout) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsersServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UsersServiceMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for UsersService.Logout
+func (mmLogout *mUsersServiceMockLogout) ExpectRefreshTokenParam2(refreshToken string) *mUsersServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsersServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UsersServiceMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmLogout.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.Logout
+func (mmLogout *mUsersServiceMockLogout) Inspect(f func(ctx context.Context, refreshToken string)) *mUsersServiceMockLogout {
+	if mmLogout.mock.inspectFuncLogout != nil {
+		mmLogout.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.Logout")
+	}
+
+	mmLogout.mock.inspectFuncLogout = f
+
+	return mmLogout
+}
+
+// Return sets up results that will be returned by UsersService.Logout
+func (mmLogout *mUsersServiceMockLogout) Return(err error) *UsersServiceMock {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsersServiceMockLogoutExpectation{mock: mmLogout.mock}
+	}
+	mmLogout.defaultExpectation.results = &UsersServiceMockLogoutResults{err}
+	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// Set uses given function f to mock the UsersService.Logout method
+func (mmLogout *mUsersServiceMockLogout) Set(f func(ctx context.Context, refreshToken string) (err error)) *UsersServiceMock {
+	if mmLogout.defaultExpectation != nil {
+		mmLogout.mock.t.Fatalf("Default expectation is already set for the UsersService.Logout method")
+	}
+
+	if len(mmLogout.expectations) > 0 {
+		mmLogout.mock.t.Fatalf("Some expectations are already set for the UsersService.Logout method")
+	}
+
+	mmLogout.mock.funcLogout = f
+	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// When sets expectation for the UsersService.Logout which will trigger the result defined by the following
+// Then helper
+func (mmLogout *mUsersServiceMockLogout) When(ctx context.Context, refreshToken string) *UsersServiceMockLogoutExpectation {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsersServiceMock.Logout mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockLogoutExpectation{
+		mock:               mmLogout.mock,
+		params:             &UsersServiceMockLogoutParams{ctx, refreshToken},
+		expectationOrigins: UsersServiceMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.Logout return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockLogoutExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.Logout should be invoked
+func (mmLogout *mUsersServiceMockLogout) Times(n uint64) *mUsersServiceMockLogout {
+	if n == 0 {
+		mmLogout.mock.t.Fatalf("Times of UsersServiceMock.Logout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
+	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogout
+}
+
+func (mmLogout *mUsersServiceMockLogout) invocationsDone() bool {
+	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Logout implements mm_service.UsersService
+func (mmLogout *UsersServiceMock) Logout(ctx context.Context, refreshToken string) (err error) {
+	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
+
+	mmLogout.t.Helper()
+
+	if mmLogout.inspectFuncLogout != nil {
+		mmLogout.inspectFuncLogout(ctx, refreshToken)
+	}
+
+	mm_params := UsersServiceMockLogoutParams{ctx, refreshToken}
+
+	// Record call args
+	mmLogout.LogoutMock.mutex.Lock()
+	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
+	mmLogout.LogoutMock.mutex.Unlock()
+
+	for _, e := range mmLogout.LogoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogout.LogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogout.LogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockLogoutParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogout.t.Errorf("UsersServiceMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmLogout.t.Errorf("UsersServiceMock.Logout got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogout.t.Errorf("UsersServiceMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogout.LogoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogout.t.Fatal("No results are set for the UsersServiceMock.Logout")
+		}
+		return (*mm_results).err
+	}
+	if mmLogout.funcLogout != nil {
+		return mmLogout.funcLogout(ctx, refreshToken)
+	}
+	mmLogout.t.Fatalf("Unexpected call to UsersServiceMock.Logout. %v %v", ctx, refreshToken)
+	return
+}
+
+// LogoutAfterCounter returns a count of finished UsersServiceMock.Logout invocations
+func (mmLogout *UsersServiceMock) LogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
+}
+
+// LogoutBeforeCounter returns a count of UsersServiceMock.Logout invocations
+func (mmLogout *UsersServiceMock) LogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.Logout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogout *mUsersServiceMockLogout) Calls() []*UsersServiceMockLogoutParams {
+	mmLogout.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockLogoutParams, len(mmLogout.callArgs))
+	copy(argCopy, mmLogout.callArgs)
+
+	mmLogout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockLogoutDone() bool {
+	if m.LogoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutMock.invocationsDone()
+}
+
+// MinimockLogoutInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockLogoutInspect() {
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
+		if m.LogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogout != nil && afterLogoutCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.Logout at\n%s", m.funcLogoutOrigin)
+	}
+
+	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.Logout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
+	}
+}
+
+type mUsersServiceMockLogoutAll struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockLogoutAllExpectation
+	expectations       []*UsersServiceMockLogoutAllExpectation
+
+	callArgs []*UsersServiceMockLogoutAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockLogoutAllExpectation specifies expectation struct of the UsersService.LogoutAll
+type UsersServiceMockLogoutAllExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockLogoutAllParams
+	paramPtrs          *UsersServiceMockLogoutAllParamPtrs
+	expectationOrigins UsersServiceMockLogoutAllExpectationOrigins
+	results            *UsersServiceMockLogoutAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockLogoutAllParams contains parameters of the UsersService.LogoutAll
+type UsersServiceMockLogoutAllParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UsersServiceMockLogoutAllParamPtrs contains pointers to parameters of the UsersService.LogoutAll
+type UsersServiceMockLogoutAllParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// UsersServiceMockLogoutAllResults contains results of the UsersService.LogoutAll
+type UsersServiceMockLogoutAllResults struct {
+	err error
+}
+
+// UsersServiceMockLogoutAllOrigins contains origins of expectations of the UsersService.LogoutAll
+type UsersServiceMockLogoutAllExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Optional() *mUsersServiceMockLogoutAll {
+	mmLogoutAll.optional = true
+	return mmLogoutAll
+}
+
+// Expect sets up expected params for UsersService.LogoutAll
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Expect(ctx context.Context, userID int64) *mUsersServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &UsersServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by ExpectParams functions")
+	}
+
+	mmLogoutAll.defaultExpectation.params = &UsersServiceMockLogoutAllParams{ctx, userID}
+	mmLogoutAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogoutAll.expectations {
+		if minimock.Equal(e.params, mmLogoutAll.defaultExpectation.params) {
+			mmLogoutAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogoutAll.defaultExpectation.params)
+		}
+	}
+
+	return mmLogoutAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.LogoutAll
+func (mmLogoutAll *mUsersServiceMockLogoutAll) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &UsersServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.params != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Expect")
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs == nil {
+		mmLogoutAll.defaultExpectation.paramPtrs = &UsersServiceMockLogoutAllParamPtrs{}
+	}
+	mmLogoutAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogoutAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogoutAll
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersService.LogoutAll
+func (mmLogoutAll *mUsersServiceMockLogoutAll) ExpectUserIDParam2(userID int64) *mUsersServiceMockLogoutAll {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &UsersServiceMockLogoutAllExpectation{}
+	}
+
+	if mmLogoutAll.defaultExpectation.params != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Expect")
+	}
+
+	if mmLogoutAll.defaultExpectation.paramPtrs == nil {
+		mmLogoutAll.defaultExpectation.paramPtrs = &UsersServiceMockLogoutAllParamPtrs{}
+	}
+	mmLogoutAll.defaultExpectation.paramPtrs.userID = &userID
+	mmLogoutAll.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmLogoutAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.LogoutAll
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Inspect(f func(ctx context.Context, userID int64)) *mUsersServiceMockLogoutAll {
+	if mmLogoutAll.mock.inspectFuncLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.LogoutAll")
+	}
+
+	mmLogoutAll.mock.inspectFuncLogoutAll = f
+
+	return mmLogoutAll
+}
+
+// Return sets up results that will be returned by UsersService.LogoutAll
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Return(err error) *UsersServiceMock {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	if mmLogoutAll.defaultExpectation == nil {
+		mmLogoutAll.defaultExpectation = &UsersServiceMockLogoutAllExpectation{mock: mmLogoutAll.mock}
+	}
+	mmLogoutAll.defaultExpectation.results = &UsersServiceMockLogoutAllResults{err}
+	mmLogoutAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll.mock
+}
+
+// Set uses given function f to mock the UsersService.LogoutAll method
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Set(f func(ctx context.Context, userID int64) (err error)) *UsersServiceMock {
+	if mmLogoutAll.defaultExpectation != nil {
+		mmLogoutAll.mock.t.Fatalf("Default expectation is already set for the UsersService.LogoutAll method")
+	}
+
+	if len(mmLogoutAll.expectations) > 0 {
+		mmLogoutAll.mock.t.Fatalf("Some expectations are already set for the UsersService.LogoutAll method")
+	}
+
+	mmLogoutAll.mock.funcLogoutAll = f
+	mmLogoutAll.mock.funcLogoutAllOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll.mock
+}
+
+// When sets expectation for the UsersService.LogoutAll which will trigger the result defined by the following
+// Then helper
+func (mmLogoutAll *mUsersServiceMockLogoutAll) When(ctx context.Context, userID int64) *UsersServiceMockLogoutAllExpectation {
+	if mmLogoutAll.mock.funcLogoutAll != nil {
+		mmLogoutAll.mock.t.Fatalf("UsersServiceMock.LogoutAll mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockLogoutAllExpectation{
+		mock:               mmLogoutAll.mock,
+		params:             &UsersServiceMockLogoutAllParams{ctx, userID},
+		expectationOrigins: UsersServiceMockLogoutAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogoutAll.expectations = append(mmLogoutAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.LogoutAll return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockLogoutAllExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockLogoutAllResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.LogoutAll should be invoked
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Times(n uint64) *mUsersServiceMockLogoutAll {
+	if n == 0 {
+		mmLogoutAll.mock.t.Fatalf("Times of UsersServiceMock.LogoutAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogoutAll.expectedInvocations, n)
+	mmLogoutAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogoutAll
+}
+
+func (mmLogoutAll *mUsersServiceMockLogoutAll) invocationsDone() bool {
+	if len(mmLogoutAll.expectations) == 0 && mmLogoutAll.defaultExpectation == nil && mmLogoutAll.mock.funcLogoutAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogoutAll.mock.afterLogoutAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogoutAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LogoutAll implements mm_service.UsersService
+func (mmLogoutAll *UsersServiceMock) LogoutAll(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmLogoutAll.beforeLogoutAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogoutAll.afterLogoutAllCounter, 1)
+
+	mmLogoutAll.t.Helper()
+
+	if mmLogoutAll.inspectFuncLogoutAll != nil {
+		mmLogoutAll.inspectFuncLogoutAll(ctx, userID)
+	}
+
+	mm_params := UsersServiceMockLogoutAllParams{ctx, userID}
+
+	// Record call args
+	mmLogoutAll.LogoutAllMock.mutex.Lock()
+	mmLogoutAll.LogoutAllMock.callArgs = append(mmLogoutAll.LogoutAllMock.callArgs, &mm_params)
+	mmLogoutAll.LogoutAllMock.mutex.Unlock()
+
+	for _, e := range mmLogoutAll.LogoutAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogoutAll.LogoutAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogoutAll.LogoutAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogoutAll.LogoutAllMock.defaultExpectation.params
+		mm_want_ptrs := mmLogoutAll.LogoutAllMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockLogoutAllParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogoutAll.t.Errorf("UsersServiceMock.LogoutAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLogoutAll.t.Errorf("UsersServiceMock.LogoutAll got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogoutAll.t.Errorf("UsersServiceMock.LogoutAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogoutAll.LogoutAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogoutAll.LogoutAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogoutAll.t.Fatal("No results are set for the UsersServiceMock.LogoutAll")
+		}
+		return (*mm_results).err
+	}
+	if mmLogoutAll.funcLogoutAll != nil {
+		return mmLogoutAll.funcLogoutAll(ctx, userID)
+	}
+	mmLogoutAll.t.Fatalf("Unexpected call to UsersServiceMock.LogoutAll. %v %v", ctx, userID)
+	return
+}
+
+// LogoutAllAfterCounter returns a count of finished UsersServiceMock.LogoutAll invocations
+func (mmLogoutAll *UsersServiceMock) LogoutAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogoutAll.afterLogoutAllCounter)
+}
+
+// LogoutAllBeforeCounter returns a count of UsersServiceMock.LogoutAll invocations
+func (mmLogoutAll *UsersServiceMock) LogoutAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogoutAll.beforeLogoutAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.LogoutAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogoutAll *mUsersServiceMockLogoutAll) Calls() []*UsersServiceMockLogoutAllParams {
+	mmLogoutAll.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockLogoutAllParams, len(mmLogoutAll.callArgs))
+	copy(argCopy, mmLogoutAll.callArgs)
+
+	mmLogoutAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutAllDone returns true if the count of the LogoutAll invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockLogoutAllDone() bool {
+	if m.LogoutAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutAllMock.invocationsDone()
+}
+
+// MinimockLogoutAllInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockLogoutAllInspect() {
+	for _, e := range m.LogoutAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.LogoutAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutAllCounter := mm_atomic.LoadUint64(&m.afterLogoutAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutAllMock.defaultExpectation != nil && afterLogoutAllCounter < 1 {
+		if m.LogoutAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.LogoutAll at\n%s", m.LogoutAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.LogoutAll at\n%s with params: %#v", m.LogoutAllMock.defaultExpectation.expectationOrigins.origin, *m.LogoutAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogoutAll != nil && afterLogoutAllCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.LogoutAll at\n%s", m.funcLogoutAllOrigin)
+	}
+
+	if !m.LogoutAllMock.invocationsDone() && afterLogoutAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.LogoutAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutAllMock.expectedInvocations), m.LogoutAllMock.expectedInvocationsOrigin, afterLogoutAllCounter)
+	}
+}
+
+type mUsersServiceMockRegisterUser struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockRegisterUserExpectation
+	expectations       []*UsersServiceMockRegisterUserExpectation
+
+	callArgs []*UsersServiceMockRegisterUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockRegisterUserExpectation specifies expectation struct of the UsersService.RegisterUser
+type UsersServiceMockRegisterUserExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockRegisterUserParams
+	paramPtrs          *UsersServiceMockRegisterUserParamPtrs
+	expectationOrigins UsersServiceMockRegisterUserExpectationOrigins
+	results            *UsersServiceMockRegisterUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockRegisterUserParams contains parameters of the UsersService.RegisterUser
+type UsersServiceMockRegisterUserParams struct {
+	ctx  context.Context
+	user *models.User
+}
+
+// UsersServiceMockRegisterUserParamPtrs contains pointers to parameters of the UsersService.RegisterUser
+type UsersServiceMockRegisterUserParamPtrs struct {
+	ctx  *context.Context
+	user **models.User
+}
+
+// UsersServiceMockRegisterUserResults contains results of the UsersService.RegisterUser
+type UsersServiceMockRegisterUserResults struct {
+	err error
+}
+
+// UsersServiceMockRegisterUserOrigins contains origins of expectations of the UsersService.RegisterUser
+type UsersServiceMockRegisterUserExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Optional() *mUsersServiceMockRegisterUser {
+	mmRegisterUser.optional = true
+	return mmRegisterUser
+}
+
+// Expect sets up expected params for UsersService.RegisterUser
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Expect(ctx context.Context, user *models.User) *mUsersServiceMockRegisterUser {
+	if mmRegisterUser.mock.funcRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+	}
+
+	if mmRegisterUser.defaultExpectation == nil {
+		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
 	}
 
 	if mmRegisterUser.defaultExpectation.paramPtrs != nil {
 		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by ExpectParams functions")
 	}
 
-	mmRegisterUser.defaultExpectation.params = &UsersServiceMockRegisterUserParams{ctx, user}
-	mmRegisterUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRegisterUser.expectations {
-		if minimock.Equal(e.params, mmRegisterUser.defaultExpectation.params) {
-			mmRegisterUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterUser.defaultExpectation.params)
+	mmRegisterUser.defaultExpectation.params = &UsersServiceMockRegisterUserParams{ctx, user}
+	mmRegisterUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegisterUser.expectations {
+		if minimock.Equal(e.params, mmRegisterUser.defaultExpectation.params) {
+			mmRegisterUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.RegisterUser
+func (mmRegisterUser *mUsersServiceMockRegisterUser) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockRegisterUser {
+	if mmRegisterUser.mock.funcRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+	}
+
+	if mmRegisterUser.defaultExpectation == nil {
+		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
+	}
+
+	if mmRegisterUser.defaultExpectation.params != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Expect")
+	}
+
+	if mmRegisterUser.defaultExpectation.paramPtrs == nil {
+		mmRegisterUser.defaultExpectation.paramPtrs = &UsersServiceMockRegisterUserParamPtrs{}
+	}
+	mmRegisterUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegisterUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegisterUser
+}
+
+// ExpectUserParam2 sets up expected param user for UsersService.RegisterUser
+func (mmRegisterUser *mUsersServiceMockRegisterUser) ExpectUserParam2(user *models.User) *mUsersServiceMockRegisterUser {
+	if mmRegisterUser.mock.funcRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+	}
+
+	if mmRegisterUser.defaultExpectation == nil {
+		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
+	}
+
+	if mmRegisterUser.defaultExpectation.params != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Expect")
+	}
+
+	if mmRegisterUser.defaultExpectation.paramPtrs == nil {
+		mmRegisterUser.defaultExpectation.paramPtrs = &UsersServiceMockRegisterUserParamPtrs{}
+	}
+	mmRegisterUser.defaultExpectation.paramPtrs.user = &user
+	mmRegisterUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmRegisterUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.RegisterUser
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Inspect(f func(ctx context.Context, user *models.User)) *mUsersServiceMockRegisterUser {
+	if mmRegisterUser.mock.inspectFuncRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.RegisterUser")
+	}
+
+	mmRegisterUser.mock.inspectFuncRegisterUser = f
+
+	return mmRegisterUser
+}
+
+// Return sets up results that will be returned by UsersService.RegisterUser
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Return(err error) *UsersServiceMock {
+	if mmRegisterUser.mock.funcRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+	}
+
+	if mmRegisterUser.defaultExpectation == nil {
+		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{mock: mmRegisterUser.mock}
+	}
+	mmRegisterUser.defaultExpectation.results = &UsersServiceMockRegisterUserResults{err}
+	mmRegisterUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegisterUser.mock
+}
+
+// Set uses given function f to mock the UsersService.RegisterUser method
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Set(f func(ctx context.Context, user *models.User) (err error)) *UsersServiceMock {
+	if mmRegisterUser.defaultExpectation != nil {
+		mmRegisterUser.mock.t.Fatalf("Default expectation is already set for the UsersService.RegisterUser method")
+	}
+
+	if len(mmRegisterUser.expectations) > 0 {
+		mmRegisterUser.mock.t.Fatalf("Some expectations are already set for the UsersService.RegisterUser method")
+	}
+
+	mmRegisterUser.mock.funcRegisterUser = f
+	mmRegisterUser.mock.funcRegisterUserOrigin = minimock.CallerInfo(1)
+	return mmRegisterUser.mock
+}
+
+// When sets expectation for the UsersService.RegisterUser which will trigger the result defined by the following
+// Then helper
+func (mmRegisterUser *mUsersServiceMockRegisterUser) When(ctx context.Context, user *models.User) *UsersServiceMockRegisterUserExpectation {
+	if mmRegisterUser.mock.funcRegisterUser != nil {
+		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockRegisterUserExpectation{
+		mock:               mmRegisterUser.mock,
+		params:             &UsersServiceMockRegisterUserParams{ctx, user},
+		expectationOrigins: UsersServiceMockRegisterUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegisterUser.expectations = append(mmRegisterUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.RegisterUser return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockRegisterUserExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockRegisterUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.RegisterUser should be invoked
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Times(n uint64) *mUsersServiceMockRegisterUser {
+	if n == 0 {
+		mmRegisterUser.mock.t.Fatalf("Times of UsersServiceMock.RegisterUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterUser.expectedInvocations, n)
+	mmRegisterUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegisterUser
+}
+
+func (mmRegisterUser *mUsersServiceMockRegisterUser) invocationsDone() bool {
+	if len(mmRegisterUser.expectations) == 0 && mmRegisterUser.defaultExpectation == nil && mmRegisterUser.mock.funcRegisterUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterUser.mock.afterRegisterUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterUser implements mm_service.UsersService
+func (mmRegisterUser *UsersServiceMock) RegisterUser(ctx context.Context, user *models.User) (err error) {
+	mm_atomic.AddUint64(&mmRegisterUser.beforeRegisterUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterUser.afterRegisterUserCounter, 1)
+
+	mmRegisterUser.t.Helper()
+
+	if mmRegisterUser.inspectFuncRegisterUser != nil {
+		mmRegisterUser.inspectFuncRegisterUser(ctx, user)
+	}
+
+	mm_params := UsersServiceMockRegisterUserParams{ctx, user}
+
+	// Record call args
+	mmRegisterUser.RegisterUserMock.mutex.Lock()
+	mmRegisterUser.RegisterUserMock.callArgs = append(mmRegisterUser.RegisterUserMock.callArgs, &mm_params)
+	mmRegisterUser.RegisterUserMock.mutex.Unlock()
+
+	for _, e := range mmRegisterUser.RegisterUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRegisterUser.RegisterUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterUser.RegisterUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterUser.RegisterUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterUser.RegisterUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockRegisterUserParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterUser.RegisterUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterUser.t.Fatal("No results are set for the UsersServiceMock.RegisterUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRegisterUser.funcRegisterUser != nil {
+		return mmRegisterUser.funcRegisterUser(ctx, user)
+	}
+	mmRegisterUser.t.Fatalf("Unexpected call to UsersServiceMock.RegisterUser. %v %v", ctx, user)
+	return
+}
+
+// RegisterUserAfterCounter returns a count of finished UsersServiceMock.RegisterUser invocations
+func (mmRegisterUser *UsersServiceMock) RegisterUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterUser.afterRegisterUserCounter)
+}
+
+// RegisterUserBeforeCounter returns a count of UsersServiceMock.RegisterUser invocations
+func (mmRegisterUser *UsersServiceMock) RegisterUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterUser.beforeRegisterUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.RegisterUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterUser *mUsersServiceMockRegisterUser) Calls() []*UsersServiceMockRegisterUserParams {
+	mmRegisterUser.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockRegisterUserParams, len(mmRegisterUser.callArgs))
+	copy(argCopy, mmRegisterUser.callArgs)
+
+	mmRegisterUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterUserDone returns true if the count of the RegisterUser invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockRegisterUserDone() bool {
+	if m.RegisterUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterUserMock.invocationsDone()
+}
+
+// MinimockRegisterUserInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockRegisterUserInspect() {
+	for _, e := range m.RegisterUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterUserCounter := mm_atomic.LoadUint64(&m.afterRegisterUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterUserMock.defaultExpectation != nil && afterRegisterUserCounter < 1 {
+		if m.RegisterUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s", m.RegisterUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s with params: %#v", m.RegisterUserMock.defaultExpectation.expectationOrigins.origin, *m.RegisterUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterUser != nil && afterRegisterUserCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s", m.funcRegisterUserOrigin)
+	}
+
+	if !m.RegisterUserMock.invocationsDone() && afterRegisterUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.RegisterUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterUserMock.expectedInvocations), m.RegisterUserMock.expectedInvocationsOrigin, afterRegisterUserCounter)
+	}
+}
+
+type mUsersServiceMockResendVerification struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockResendVerificationExpectation
+	expectations       []*UsersServiceMockResendVerificationExpectation
+
+	callArgs []*UsersServiceMockResendVerificationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockResendVerificationExpectation specifies expectation struct of the UsersService.ResendVerification
+type UsersServiceMockResendVerificationExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockResendVerificationParams
+	paramPtrs          *UsersServiceMockResendVerificationParamPtrs
+	expectationOrigins UsersServiceMockResendVerificationExpectationOrigins
+	results            *UsersServiceMockResendVerificationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockResendVerificationParams contains parameters of the UsersService.ResendVerification
+type UsersServiceMockResendVerificationParams struct {
+	ctx       context.Context
+	userEmail string
+}
+
+// UsersServiceMockResendVerificationParamPtrs contains pointers to parameters of the UsersService.ResendVerification
+type UsersServiceMockResendVerificationParamPtrs struct {
+	ctx       *context.Context
+	userEmail *string
+}
+
+// UsersServiceMockResendVerificationResults contains results of the UsersService.ResendVerification
+type UsersServiceMockResendVerificationResults struct {
+	err error
+}
+
+// UsersServiceMockResendVerificationOrigins contains origins of expectations of the UsersService.ResendVerification
+type UsersServiceMockResendVerificationExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResendVerification *mUsersServiceMockResendVerification) Optional() *mUsersServiceMockResendVerification {
+	mmResendVerification.optional = true
+	return mmResendVerification
+}
+
+// Expect sets up expected params for UsersService.ResendVerification
+func (mmResendVerification *mUsersServiceMockResendVerification) Expect(ctx context.Context, userEmail string) *mUsersServiceMockResendVerification {
+	if mmResendVerification.mock.funcResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Set")
+	}
+
+	if mmResendVerification.defaultExpectation == nil {
+		mmResendVerification.defaultExpectation = &UsersServiceMockResendVerificationExpectation{}
+	}
+
+	if mmResendVerification.defaultExpectation.paramPtrs != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by ExpectParams functions")
+	}
+
+	mmResendVerification.defaultExpectation.params = &UsersServiceMockResendVerificationParams{ctx, userEmail}
+	mmResendVerification.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResendVerification.expectations {
+		if minimock.Equal(e.params, mmResendVerification.defaultExpectation.params) {
+			mmResendVerification.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResendVerification.defaultExpectation.params)
 		}
 	}
 
-	return mmRegisterUser
+	return mmResendVerification
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersService.RegisterUser
-func (mmRegisterUser *mUsersServiceMockRegisterUser) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockRegisterUser {
-	if mmRegisterUser.mock.funcRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UsersService.ResendVerification
+func (mmResendVerification *mUsersServiceMockResendVerification) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockResendVerification {
+	if mmResendVerification.mock.funcResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Set")
 	}
 
-	if mmRegisterUser.defaultExpectation == nil {
-		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
+	if mmResendVerification.defaultExpectation == nil {
+		mmResendVerification.defaultExpectation = &UsersServiceMockResendVerificationExpectation{}
 	}
 
-	if mmRegisterUser.defaultExpectation.params != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Expect")
+	if mmResendVerification.defaultExpectation.params != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Expect")
 	}
 
-	if mmRegisterUser.defaultExpectation.paramPtrs == nil {
-		mmRegisterUser.defaultExpectation.paramPtrs = &UsersServiceMockRegisterUserParamPtrs{}
+	if mmResendVerification.defaultExpectation.paramPtrs == nil {
+		mmResendVerification.defaultExpectation.paramPtrs = &UsersServiceMockResendVerificationParamPtrs{}
 	}
-	mmRegisterUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRegisterUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmResendVerification.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResendVerification.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRegisterUser
+	return mmResendVerification
 }
 
-// ExpectUserParam2 sets up expected param user for UsersService.RegisterUser
-func (mmRegisterUser *mUsersServiceMockRegisterUser) ExpectUserParam2(user *models.User) *mUsersServiceMockRegisterUser {
-	if mmRegisterUser.mock.funcRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+// ExpectUserEmailParam2 sets up expected param userEmail for UsersService.ResendVerification
+func (mmResendVerification *mUsersServiceMockResendVerification) ExpectUserEmailParam2(userEmail string) *mUsersServiceMockResendVerification {
+	if mmResendVerification.mock.funcResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Set")
 	}
 
-	if mmRegisterUser.defaultExpectation == nil {
-		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{}
+	if mmResendVerification.defaultExpectation == nil {
+		mmResendVerification.defaultExpectation = &UsersServiceMockResendVerificationExpectation{}
 	}
 
-	if mmRegisterUser.defaultExpectation.params != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Expect")
+	if mmResendVerification.defaultExpectation.params != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Expect")
 	}
 
-	if mmRegisterUser.defaultExpectation.paramPtrs == nil {
-		mmRegisterUser.defaultExpectation.paramPtrs = &UsersServiceMockRegisterUserParamPtrs{}
+	if mmResendVerification.defaultExpectation.paramPtrs == nil {
+		mmResendVerification.defaultExpectation.paramPtrs = &UsersServiceMockResendVerificationParamPtrs{}
 	}
-	mmRegisterUser.defaultExpectation.paramPtrs.user = &user
-	mmRegisterUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmResendVerification.defaultExpectation.paramPtrs.userEmail = &userEmail
+	mmResendVerification.defaultExpectation.expectationOrigins.originUserEmail = minimock.CallerInfo(1)
 
-	return mmRegisterUser
+	return mmResendVerification
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersService.RegisterUser
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Inspect(f func(ctx context.Context, user *models.User)) *mUsersServiceMockRegisterUser {
-	if mmRegisterUser.mock.inspectFuncRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.RegisterUser")
+// Inspect accepts an inspector function that has same arguments as the UsersService.ResendVerification
+func (mmResendVerification *mUsersServiceMockResendVerification) Inspect(f func(ctx context.Context, userEmail string)) *mUsersServiceMockResendVerification {
+	if mmResendVerification.mock.inspectFuncResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.ResendVerification")
 	}
 
-	mmRegisterUser.mock.inspectFuncRegisterUser = f
+	mmResendVerification.mock.inspectFuncResendVerification = f
 
-	return mmRegisterUser
+	return mmResendVerification
 }
 
-// Return sets up results that will be returned by UsersService.RegisterUser
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Return(err error) *UsersServiceMock {
-	if mmRegisterUser.mock.funcRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+// Return sets up results that will be returned by UsersService.ResendVerification
+func (mmResendVerification *mUsersServiceMockResendVerification) Return(err error) *UsersServiceMock {
+	if mmResendVerification.mock.funcResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Set")
 	}
 
-	if mmRegisterUser.defaultExpectation == nil {
-		mmRegisterUser.defaultExpectation = &UsersServiceMockRegisterUserExpectation{mock: mmRegisterUser.mock}
+	if mmResendVerification.defaultExpectation == nil {
+		mmResendVerification.defaultExpectation = &UsersServiceMockResendVerificationExpectation{mock: mmResendVerification.mock}
 	}
-	mmRegisterUser.defaultExpectation.results = &UsersServiceMockRegisterUserResults{err}
-	mmRegisterUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRegisterUser.mock
+	mmResendVerification.defaultExpectation.results = &UsersServiceMockResendVerificationResults{err}
+	mmResendVerification.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResendVerification.mock
 }
 
-// Set uses given function f to mock the UsersService.RegisterUser method
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Set(f func(ctx context.Context, user *models.User) (err error)) *UsersServiceMock {
-	if mmRegisterUser.defaultExpectation != nil {
-		mmRegisterUser.mock.t.Fatalf("Default expectation is already set for the UsersService.RegisterUser method")
+// Set uses given function f to mock the UsersService.ResendVerification method
+func (mmResendVerification *mUsersServiceMockResendVerification) Set(f func(ctx context.Context, userEmail string) (err error)) *UsersServiceMock {
+	if mmResendVerification.defaultExpectation != nil {
+		mmResendVerification.mock.t.Fatalf("Default expectation is already set for the UsersService.ResendVerification method")
 	}
 
-	if len(mmRegisterUser.expectations) > 0 {
-		mmRegisterUser.mock.t.Fatalf("Some expectations are already set for the UsersService.RegisterUser method")
+	if len(mmResendVerification.expectations) > 0 {
+		mmResendVerification.mock.t.Fatalf("Some expectations are already set for the UsersService.ResendVerification method")
 	}
 
-	mmRegisterUser.mock.funcRegisterUser = f
-	mmRegisterUser.mock.funcRegisterUserOrigin = minimock.CallerInfo(1)
-	return mmRegisterUser.mock
+	mmResendVerification.mock.funcResendVerification = f
+	mmResendVerification.mock.funcResendVerificationOrigin = minimock.CallerInfo(1)
+	return mmResendVerification.mock
 }
 
-// When sets expectation for the UsersService.RegisterUser which will trigger the result defined by the following
+// When sets expectation for the UsersService.ResendVerification which will trigger the result defined by the following
 // Then helper
-func (mmRegisterUser *mUsersServiceMockRegisterUser) When(ctx context.Context, user *models.User) *UsersServiceMockRegisterUserExpectation {
-	if mmRegisterUser.mock.funcRegisterUser != nil {
-		mmRegisterUser.mock.t.Fatalf("UsersServiceMock.RegisterUser mock is already set by Set")
+func (mmResendVerification *mUsersServiceMockResendVerification) When(ctx context.Context, userEmail string) *UsersServiceMockResendVerificationExpectation {
+	if mmResendVerification.mock.funcResendVerification != nil {
+		mmResendVerification.mock.t.Fatalf("UsersServiceMock.ResendVerification mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockResendVerificationExpectation{
+		mock:               mmResendVerification.mock,
+		params:             &UsersServiceMockResendVerificationParams{ctx, userEmail},
+		expectationOrigins: UsersServiceMockResendVerificationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResendVerification.expectations = append(mmResendVerification.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.ResendVerification return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockResendVerificationExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockResendVerificationResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.ResendVerification should be invoked
+func (mmResendVerification *mUsersServiceMockResendVerification) Times(n uint64) *mUsersServiceMockResendVerification {
+	if n == 0 {
+		mmResendVerification.mock.t.Fatalf("Times of UsersServiceMock.ResendVerification mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResendVerification.expectedInvocations, n)
+	mmResendVerification.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResendVerification
+}
+
+func (mmResendVerification *mUsersServiceMockResendVerification) invocationsDone() bool {
+	if len(mmResendVerification.expectations) == 0 && mmResendVerification.defaultExpectation == nil && mmResendVerification.mock.funcResendVerification == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResendVerification.mock.afterResendVerificationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResendVerification.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResendVerification implements mm_service.UsersService
+func (mmResendVerification *UsersServiceMock) ResendVerification(ctx context.Context, userEmail string) (err error) {
+	mm_atomic.AddUint64(&mmResendVerification.beforeResendVerificationCounter, 1)
+	defer mm_atomic.AddUint64(&mmResendVerification.afterResendVerificationCounter, 1)
+
+	mmResendVerification.t.Helper()
+
+	if mmResendVerification.inspectFuncResendVerification != nil {
+		mmResendVerification.inspectFuncResendVerification(ctx, userEmail)
+	}
+
+	mm_params := UsersServiceMockResendVerificationParams{ctx, userEmail}
+
+	// Record call args
+	mmResendVerification.ResendVerificationMock.mutex.Lock()
+	mmResendVerification.ResendVerificationMock.callArgs = append(mmResendVerification.ResendVerificationMock.callArgs, &mm_params)
+	mmResendVerification.ResendVerificationMock.mutex.Unlock()
+
+	for _, e := range mmResendVerification.ResendVerificationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResendVerification.ResendVerificationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResendVerification.ResendVerificationMock.defaultExpectation.Counter, 1)
+		mm_want := mmResendVerification.ResendVerificationMock.defaultExpectation.params
+		mm_want_ptrs := mmResendVerification.ResendVerificationMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockResendVerificationParams{ctx, userEmail}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResendVerification.t.Errorf("UsersServiceMock.ResendVerification got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResendVerification.ResendVerificationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userEmail != nil && !minimock.Equal(*mm_want_ptrs.userEmail, mm_got.userEmail) {
+				mmResendVerification.t.Errorf("UsersServiceMock.ResendVerification got unexpected parameter userEmail, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResendVerification.ResendVerificationMock.defaultExpectation.expectationOrigins.originUserEmail, *mm_want_ptrs.userEmail, mm_got.userEmail, minimock.Diff(*mm_want_ptrs.userEmail, mm_got.userEmail))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResendVerification.t.Errorf("UsersServiceMock.ResendVerification got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResendVerification.ResendVerificationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResendVerification.ResendVerificationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResendVerification.t.Fatal("No results are set for the UsersServiceMock.ResendVerification")
+		}
+		return (*mm_results).err
+	}
+	if mmResendVerification.funcResendVerification != nil {
+		return mmResendVerification.funcResendVerification(ctx, userEmail)
+	}
+	mmResendVerification.t.Fatalf("Unexpected call to UsersServiceMock.ResendVerification. %v %v", ctx, userEmail)
+	return
+}
+
+// ResendVerificationAfterCounter returns a count of finished UsersServiceMock.ResendVerification invocations
+func (mmResendVerification *UsersServiceMock) ResendVerificationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResendVerification.afterResendVerificationCounter)
+}
+
+// ResendVerificationBeforeCounter returns a count of UsersServiceMock.ResendVerification invocations
+func (mmResendVerification *UsersServiceMock) ResendVerificationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResendVerification.beforeResendVerificationCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.ResendVerification.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResendVerification *mUsersServiceMockResendVerification) Calls() []*UsersServiceMockResendVerificationParams {
+	mmResendVerification.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockResendVerificationParams, len(mmResendVerification.callArgs))
+	copy(argCopy, mmResendVerification.callArgs)
+
+	mmResendVerification.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResendVerificationDone returns true if the count of the ResendVerification invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockResendVerificationDone() bool {
+	if m.ResendVerificationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResendVerificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResendVerificationMock.invocationsDone()
+}
+
+// MinimockResendVerificationInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockResendVerificationInspect() {
+	for _, e := range m.ResendVerificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.ResendVerification at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResendVerificationCounter := mm_atomic.LoadUint64(&m.afterResendVerificationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResendVerificationMock.defaultExpectation != nil && afterResendVerificationCounter < 1 {
+		if m.ResendVerificationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.ResendVerification at\n%s", m.ResendVerificationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.ResendVerification at\n%s with params: %#v", m.ResendVerificationMock.defaultExpectation.expectationOrigins.origin, *m.ResendVerificationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResendVerification != nil && afterResendVerificationCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.ResendVerification at\n%s", m.funcResendVerificationOrigin)
+	}
+
+	if !m.ResendVerificationMock.invocationsDone() && afterResendVerificationCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.ResendVerification at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResendVerificationMock.expectedInvocations), m.ResendVerificationMock.expectedInvocationsOrigin, afterResendVerificationCounter)
+	}
+}
+
+type mUsersServiceMockResetPassword struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockResetPasswordExpectation
+	expectations       []*UsersServiceMockResetPasswordExpectation
+
+	callArgs []*UsersServiceMockResetPasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockResetPasswordExpectation specifies expectation struct of the UsersService.ResetPassword
+type UsersServiceMockResetPasswordExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockResetPasswordParams
+	paramPtrs          *UsersServiceMockResetPasswordParamPtrs
+	expectationOrigins UsersServiceMockResetPasswordExpectationOrigins
+	results            *UsersServiceMockResetPasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockResetPasswordParams contains parameters of the UsersService.ResetPassword
+type UsersServiceMockResetPasswordParams struct {
+	ctx      context.Context
+	token    string
+	password string
+}
+
+// UsersServiceMockResetPasswordParamPtrs contains pointers to parameters of the UsersService.ResetPassword
+type UsersServiceMockResetPasswordParamPtrs struct {
+	ctx      *context.Context
+	token    *string
+	password *string
+}
+
+// UsersServiceMockResetPasswordResults contains results of the UsersService.ResetPassword
+type UsersServiceMockResetPasswordResults struct {
+	err error
+}
+
+// UsersServiceMockResetPasswordOrigins contains origins of expectations of the UsersService.ResetPassword
+type UsersServiceMockResetPasswordExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originToken    string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResetPassword *mUsersServiceMockResetPassword) Optional() *mUsersServiceMockResetPassword {
+	mmResetPassword.optional = true
+	return mmResetPassword
+}
+
+// Expect sets up expected params for UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) Expect(ctx context.Context, token string, password string) *mUsersServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsersServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by ExpectParams functions")
+	}
+
+	mmResetPassword.defaultExpectation.params = &UsersServiceMockResetPasswordParams{ctx, token, password}
+	mmResetPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResetPassword.expectations {
+		if minimock.Equal(e.params, mmResetPassword.defaultExpectation.params) {
+			mmResetPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmResetPassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsersServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UsersServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResetPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// ExpectTokenParam2 sets up expected param token for UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) ExpectTokenParam2(token string) *mUsersServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsersServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UsersServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.token = &token
+	mmResetPassword.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// ExpectPasswordParam3 sets up expected param password for UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) ExpectPasswordParam3(password string) *mUsersServiceMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsersServiceMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UsersServiceMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.password = &password
+	mmResetPassword.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) Inspect(f func(ctx context.Context, token string, password string)) *mUsersServiceMockResetPassword {
+	if mmResetPassword.mock.inspectFuncResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.ResetPassword")
+	}
+
+	mmResetPassword.mock.inspectFuncResetPassword = f
+
+	return mmResetPassword
+}
+
+// Return sets up results that will be returned by UsersService.ResetPassword
+func (mmResetPassword *mUsersServiceMockResetPassword) Return(err error) *UsersServiceMock {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsersServiceMockResetPasswordExpectation{mock: mmResetPassword.mock}
+	}
+	mmResetPassword.defaultExpectation.results = &UsersServiceMockResetPasswordResults{err}
+	mmResetPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// Set uses given function f to mock the UsersService.ResetPassword method
+func (mmResetPassword *mUsersServiceMockResetPassword) Set(f func(ctx context.Context, token string, password string) (err error)) *UsersServiceMock {
+	if mmResetPassword.defaultExpectation != nil {
+		mmResetPassword.mock.t.Fatalf("Default expectation is already set for the UsersService.ResetPassword method")
+	}
+
+	if len(mmResetPassword.expectations) > 0 {
+		mmResetPassword.mock.t.Fatalf("Some expectations are already set for the UsersService.ResetPassword method")
+	}
+
+	mmResetPassword.mock.funcResetPassword = f
+	mmResetPassword.mock.funcResetPasswordOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// When sets expectation for the UsersService.ResetPassword which will trigger the result defined by the following
+// Then helper
+func (mmResetPassword *mUsersServiceMockResetPassword) When(ctx context.Context, token string, password string) *UsersServiceMockResetPasswordExpectation {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsersServiceMock.ResetPassword mock is already set by Set")
 	}
 
-	expectation := &UsersServiceMockRegisterUserExpectation{
-		mock:               mmRegisterUser.mock,
-		params:             &UsersServiceMockRegisterUserParams{ctx, user},
-		expectationOrigins: UsersServiceMockRegisterUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersServiceMockResetPasswordExpectation{
+		mock:               mmResetPassword.mock,
+		params:             &UsersServiceMockResetPasswordParams{ctx, token, password},
+		expectationOrigins: UsersServiceMockResetPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRegisterUser.expectations = append(mmRegisterUser.expectations, expectation)
+	mmResetPassword.expectations = append(mmResetPassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersService.RegisterUser return parameters for the expectation previously defined by the When method
-func (e *UsersServiceMockRegisterUserExpectation) Then(err error) *UsersServiceMock {
-	e.results = &UsersServiceMockRegisterUserResults{err}
+// Then sets up UsersService.ResetPassword return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockResetPasswordExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockResetPasswordResults{err}
 	return e.mock
 }
 
-// Times sets number of times UsersService.RegisterUser should be invoked
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Times(n uint64) *mUsersServiceMockRegisterUser {
+// Times sets number of times UsersService.ResetPassword should be invoked
+func (mmResetPassword *mUsersServiceMockResetPassword) Times(n uint64) *mUsersServiceMockResetPassword {
 	if n == 0 {
-		mmRegisterUser.mock.t.Fatalf("Times of UsersServiceMock.RegisterUser mock can not be zero")
+		mmResetPassword.mock.t.Fatalf("Times of UsersServiceMock.ResetPassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRegisterUser.expectedInvocations, n)
-	mmRegisterUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRegisterUser
+	mm_atomic.StoreUint64(&mmResetPassword.expectedInvocations, n)
+	mmResetPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResetPassword
 }
 
-func (mmRegisterUser *mUsersServiceMockRegisterUser) invocationsDone() bool {
-	if len(mmRegisterUser.expectations) == 0 && mmRegisterUser.defaultExpectation == nil && mmRegisterUser.mock.funcRegisterUser == nil {
+func (mmResetPassword *mUsersServiceMockResetPassword) invocationsDone() bool {
+	if len(mmResetPassword.expectations) == 0 && mmResetPassword.defaultExpectation == nil && mmResetPassword.mock.funcResetPassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRegisterUser.mock.afterRegisterUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmResetPassword.mock.afterResetPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetPassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RegisterUser implements mm_service.UsersService
-func (mmRegisterUser *UsersServiceMock) RegisterUser(ctx context.Context, user *models.User) (err error) {
-	mm_atomic.AddUint64(&mmRegisterUser.beforeRegisterUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmRegisterUser.afterRegisterUserCounter, 1)
+// ResetPassword implements mm_service.UsersService
+func (mmResetPassword *UsersServiceMock) ResetPassword(ctx context.Context, token string, password string) (err error) {
+	mm_atomic.AddUint64(&mmResetPassword.beforeResetPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetPassword.afterResetPasswordCounter, 1)
 
-	mmRegisterUser.t.Helper()
+	mmResetPassword.t.Helper()
 
-	if mmRegisterUser.inspectFuncRegisterUser != nil {
-		mmRegisterUser.inspectFuncRegisterUser(ctx, user)
+	if mmResetPassword.inspectFuncResetPassword != nil {
+		mmResetPassword.inspectFuncResetPassword(ctx, token, password)
 	}
 
-	mm_params := UsersServiceMockRegisterUserParams{ctx, user}
+	mm_params := UsersServiceMockResetPasswordParams{ctx, token, password}
 
 	// Record call args
-	mmRegisterUser.RegisterUserMock.mutex.Lock()
-	mmRegisterUser.RegisterUserMock.callArgs = append(mmRegisterUser.RegisterUserMock.callArgs, &mm_params)
-	mmRegisterUser.RegisterUserMock.mutex.Unlock()
+	mmResetPassword.ResetPasswordMock.mutex.Lock()
+	mmResetPassword.ResetPasswordMock.callArgs = append(mmResetPassword.ResetPasswordMock.callArgs, &mm_params)
+	mmResetPassword.ResetPasswordMock.mutex.Unlock()
 
-	for _, e := range mmRegisterUser.RegisterUserMock.expectations {
+	for _, e := range mmResetPassword.ResetPasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmRegisterUser.RegisterUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRegisterUser.RegisterUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmRegisterUser.RegisterUserMock.defaultExpectation.params
-		mm_want_ptrs := mmRegisterUser.RegisterUserMock.defaultExpectation.paramPtrs
+	if mmResetPassword.ResetPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetPassword.ResetPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetPassword.ResetPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmResetPassword.ResetPasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersServiceMockRegisterUserParams{ctx, user}
+		mm_got := UsersServiceMockResetPasswordParams{ctx, token, password}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmResetPassword.t.Errorf("UsersServiceMock.ResetPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmResetPassword.t.Errorf("UsersServiceMock.ResetPassword got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmResetPassword.t.Errorf("UsersServiceMock.ResetPassword got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRegisterUser.t.Errorf("UsersServiceMock.RegisterUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRegisterUser.RegisterUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmResetPassword.t.Errorf("UsersServiceMock.ResetPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRegisterUser.RegisterUserMock.defaultExpectation.results
+		mm_results := mmResetPassword.ResetPasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRegisterUser.t.Fatal("No results are set for the UsersServiceMock.RegisterUser")
+			mmResetPassword.t.Fatal("No results are set for the UsersServiceMock.ResetPassword")
 		}
 		return (*mm_results).err
 	}
-	if mmRegisterUser.funcRegisterUser != nil {
-		return mmRegisterUser.funcRegisterUser(ctx, user)
+	if mmResetPassword.funcResetPassword != nil {
+		return mmResetPassword.funcResetPassword(ctx, token, password)
 	}
-	mmRegisterUser.t.Fatalf("Unexpected call to UsersServiceMock.RegisterUser. %v %v", ctx, user)
+	mmResetPassword.t.Fatalf("Unexpected call to UsersServiceMock.ResetPassword. %v %v %v", ctx, token, password)
 	return
 }
 
-// RegisterUserAfterCounter returns a count of finished UsersServiceMock.RegisterUser invocations
-func (mmRegisterUser *UsersServiceMock) RegisterUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegisterUser.afterRegisterUserCounter)
+// ResetPasswordAfterCounter returns a count of finished UsersServiceMock.ResetPassword invocations
+func (mmResetPassword *UsersServiceMock) ResetPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.afterResetPasswordCounter)
 }
 
-// RegisterUserBeforeCounter returns a count of UsersServiceMock.RegisterUser invocations
-func (mmRegisterUser *UsersServiceMock) RegisterUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegisterUser.beforeRegisterUserCounter)
+// ResetPasswordBeforeCounter returns a count of UsersServiceMock.ResetPassword invocations
+func (mmResetPassword *UsersServiceMock) ResetPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.beforeResetPasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersServiceMock.RegisterUser.
+// Calls returns a list of arguments used in each call to UsersServiceMock.ResetPassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRegisterUser *mUsersServiceMockRegisterUser) Calls() []*UsersServiceMockRegisterUserParams {
-	mmRegisterUser.mutex.RLock()
+func (mmResetPassword *mUsersServiceMockResetPassword) Calls() []*UsersServiceMockResetPasswordParams {
+	mmResetPassword.mutex.RLock()
 
-	argCopy := make([]*UsersServiceMockRegisterUserParams, len(mmRegisterUser.callArgs))
-	copy(argCopy, mmRegisterUser.callArgs)
+	argCopy := make([]*UsersServiceMockResetPasswordParams, len(mmResetPassword.callArgs))
+	copy(argCopy, mmResetPassword.callArgs)
 
-	mmRegisterUser.mutex.RUnlock()
+	mmResetPassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRegisterUserDone returns true if the count of the RegisterUser invocations corresponds
+// MinimockResetPasswordDone returns true if the count of the ResetPassword invocations corresponds
 // the number of defined expectations
-func (m *UsersServiceMock) MinimockRegisterUserDone() bool {
-	if m.RegisterUserMock.optional {
+func (m *UsersServiceMock) MinimockResetPasswordDone() bool {
+	if m.ResetPasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RegisterUserMock.expectations {
+	for _, e := range m.ResetPasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RegisterUserMock.invocationsDone()
+	return m.ResetPasswordMock.invocationsDone()
 }
 
-// MinimockRegisterUserInspect logs each unmet expectation
-func (m *UsersServiceMock) MinimockRegisterUserInspect() {
-	for _, e := range m.RegisterUserMock.expectations {
+// MinimockResetPasswordInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockResetPasswordInspect() {
+	for _, e := range m.ResetPasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersServiceMock.ResetPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRegisterUserCounter := mm_atomic.LoadUint64(&m.afterRegisterUserCounter)
+	afterResetPasswordCounter := mm_atomic.LoadUint64(&m.afterResetPasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RegisterUserMock.defaultExpectation != nil && afterRegisterUserCounter < 1 {
-		if m.RegisterUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s", m.RegisterUserMock.defaultExpectation.returnOrigin)
+	if m.ResetPasswordMock.defaultExpectation != nil && afterResetPasswordCounter < 1 {
+		if m.ResetPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.ResetPassword at\n%s", m.ResetPasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s with params: %#v", m.RegisterUserMock.defaultExpectation.expectationOrigins.origin, *m.RegisterUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersServiceMock.ResetPassword at\n%s with params: %#v", m.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *m.ResetPasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRegisterUser != nil && afterRegisterUserCounter < 1 {
-		m.t.Errorf("Expected call to UsersServiceMock.RegisterUser at\n%s", m.funcRegisterUserOrigin)
+	if m.funcResetPassword != nil && afterResetPasswordCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.ResetPassword at\n%s", m.funcResetPasswordOrigin)
 	}
 
-	if !m.RegisterUserMock.invocationsDone() && afterRegisterUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersServiceMock.RegisterUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RegisterUserMock.expectedInvocations), m.RegisterUserMock.expectedInvocationsOrigin, afterRegisterUserCounter)
+	if !m.ResetPasswordMock.invocationsDone() && afterResetPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.ResetPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetPasswordMock.expectedInvocations), m.ResetPasswordMock.expectedInvocationsOrigin, afterResetPasswordCounter)
 	}
 }
 
@@ -1796,19 +3981,375 @@ func (m *UsersServiceMock) MinimockUserInspect() {
 	}
 }
 
+type mUsersServiceMockVerifyEmail struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockVerifyEmailExpectation
+	expectations       []*UsersServiceMockVerifyEmailExpectation
+
+	callArgs []*UsersServiceMockVerifyEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockVerifyEmailExpectation specifies expectation struct of the UsersService.VerifyEmail
+type UsersServiceMockVerifyEmailExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockVerifyEmailParams
+	paramPtrs          *UsersServiceMockVerifyEmailParamPtrs
+	expectationOrigins UsersServiceMockVerifyEmailExpectationOrigins
+	results            *UsersServiceMockVerifyEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockVerifyEmailParams contains parameters of the UsersService.VerifyEmail
+type UsersServiceMockVerifyEmailParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UsersServiceMockVerifyEmailParamPtrs contains pointers to parameters of the UsersService.VerifyEmail
+type UsersServiceMockVerifyEmailParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// UsersServiceMockVerifyEmailResults contains results of the UsersService.VerifyEmail
+type UsersServiceMockVerifyEmailResults struct {
+	err error
+}
+
+// UsersServiceMockVerifyEmailOrigins contains origins of expectations of the UsersService.VerifyEmail
+type UsersServiceMockVerifyEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Optional() *mUsersServiceMockVerifyEmail {
+	mmVerifyEmail.optional = true
+	return mmVerifyEmail
+}
+
+// Expect sets up expected params for UsersService.VerifyEmail
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Expect(ctx context.Context, token string) *mUsersServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UsersServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyEmail.defaultExpectation.params = &UsersServiceMockVerifyEmailParams{ctx, token}
+	mmVerifyEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyEmail.expectations {
+		if minimock.Equal(e.params, mmVerifyEmail.defaultExpectation.params) {
+			mmVerifyEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.VerifyEmail
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UsersServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UsersServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// ExpectTokenParam2 sets up expected param token for UsersService.VerifyEmail
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) ExpectTokenParam2(token string) *mUsersServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UsersServiceMockVerifyEmailExpectation{}
+	}
+
+	if mmVerifyEmail.defaultExpectation.params != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Expect")
+	}
+
+	if mmVerifyEmail.defaultExpectation.paramPtrs == nil {
+		mmVerifyEmail.defaultExpectation.paramPtrs = &UsersServiceMockVerifyEmailParamPtrs{}
+	}
+	mmVerifyEmail.defaultExpectation.paramPtrs.token = &token
+	mmVerifyEmail.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmVerifyEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.VerifyEmail
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Inspect(f func(ctx context.Context, token string)) *mUsersServiceMockVerifyEmail {
+	if mmVerifyEmail.mock.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.VerifyEmail")
+	}
+
+	mmVerifyEmail.mock.inspectFuncVerifyEmail = f
+
+	return mmVerifyEmail
+}
+
+// Return sets up results that will be returned by UsersService.VerifyEmail
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Return(err error) *UsersServiceMock {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	if mmVerifyEmail.defaultExpectation == nil {
+		mmVerifyEmail.defaultExpectation = &UsersServiceMockVerifyEmailExpectation{mock: mmVerifyEmail.mock}
+	}
+	mmVerifyEmail.defaultExpectation.results = &UsersServiceMockVerifyEmailResults{err}
+	mmVerifyEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// Set uses given function f to mock the UsersService.VerifyEmail method
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Set(f func(ctx context.Context, token string) (err error)) *UsersServiceMock {
+	if mmVerifyEmail.defaultExpectation != nil {
+		mmVerifyEmail.mock.t.Fatalf("Default expectation is already set for the UsersService.VerifyEmail method")
+	}
+
+	if len(mmVerifyEmail.expectations) > 0 {
+		mmVerifyEmail.mock.t.Fatalf("Some expectations are already set for the UsersService.VerifyEmail method")
+	}
+
+	mmVerifyEmail.mock.funcVerifyEmail = f
+	mmVerifyEmail.mock.funcVerifyEmailOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail.mock
+}
+
+// When sets expectation for the UsersService.VerifyEmail which will trigger the result defined by the following
+// Then helper
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) When(ctx context.Context, token string) *UsersServiceMockVerifyEmailExpectation {
+	if mmVerifyEmail.mock.funcVerifyEmail != nil {
+		mmVerifyEmail.mock.t.Fatalf("UsersServiceMock.VerifyEmail mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockVerifyEmailExpectation{
+		mock:               mmVerifyEmail.mock,
+		params:             &UsersServiceMockVerifyEmailParams{ctx, token},
+		expectationOrigins: UsersServiceMockVerifyEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyEmail.expectations = append(mmVerifyEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.VerifyEmail return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockVerifyEmailExpectation) Then(err error) *UsersServiceMock {
+	e.results = &UsersServiceMockVerifyEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.VerifyEmail should be invoked
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Times(n uint64) *mUsersServiceMockVerifyEmail {
+	if n == 0 {
+		mmVerifyEmail.mock.t.Fatalf("Times of UsersServiceMock.VerifyEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyEmail.expectedInvocations, n)
+	mmVerifyEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyEmail
+}
+
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) invocationsDone() bool {
+	if len(mmVerifyEmail.expectations) == 0 && mmVerifyEmail.defaultExpectation == nil && mmVerifyEmail.mock.funcVerifyEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.mock.afterVerifyEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyEmail implements mm_service.UsersService
+func (mmVerifyEmail *UsersServiceMock) VerifyEmail(ctx context.Context, token string) (err error) {
+	mm_atomic.AddUint64(&mmVerifyEmail.beforeVerifyEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyEmail.afterVerifyEmailCounter, 1)
+
+	mmVerifyEmail.t.Helper()
+
+	if mmVerifyEmail.inspectFuncVerifyEmail != nil {
+		mmVerifyEmail.inspectFuncVerifyEmail(ctx, token)
+	}
+
+	mm_params := UsersServiceMockVerifyEmailParams{ctx, token}
+
+	// Record call args
+	mmVerifyEmail.VerifyEmailMock.mutex.Lock()
+	mmVerifyEmail.VerifyEmailMock.callArgs = append(mmVerifyEmail.VerifyEmailMock.callArgs, &mm_params)
+	mmVerifyEmail.VerifyEmailMock.mutex.Unlock()
+
+	for _, e := range mmVerifyEmail.VerifyEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmVerifyEmail.VerifyEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyEmail.VerifyEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyEmail.VerifyEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyEmail.VerifyEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockVerifyEmailParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerifyEmail.t.Errorf("UsersServiceMock.VerifyEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmVerifyEmail.t.Errorf("UsersServiceMock.VerifyEmail got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyEmail.t.Errorf("UsersServiceMock.VerifyEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyEmail.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyEmail.VerifyEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyEmail.t.Fatal("No results are set for the UsersServiceMock.VerifyEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmVerifyEmail.funcVerifyEmail != nil {
+		return mmVerifyEmail.funcVerifyEmail(ctx, token)
+	}
+	mmVerifyEmail.t.Fatalf("Unexpected call to UsersServiceMock.VerifyEmail. %v %v", ctx, token)
+	return
+}
+
+// VerifyEmailAfterCounter returns a count of finished UsersServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UsersServiceMock) VerifyEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.afterVerifyEmailCounter)
+}
+
+// VerifyEmailBeforeCounter returns a count of UsersServiceMock.VerifyEmail invocations
+func (mmVerifyEmail *UsersServiceMock) VerifyEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyEmail.beforeVerifyEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.VerifyEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyEmail *mUsersServiceMockVerifyEmail) Calls() []*UsersServiceMockVerifyEmailParams {
+	mmVerifyEmail.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockVerifyEmailParams, len(mmVerifyEmail.callArgs))
+	copy(argCopy, mmVerifyEmail.callArgs)
+
+	mmVerifyEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyEmailDone returns true if the count of the VerifyEmail invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockVerifyEmailDone() bool {
+	if m.VerifyEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyEmailMock.invocationsDone()
+}
+
+// MinimockVerifyEmailInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockVerifyEmailInspect() {
+	for _, e := range m.VerifyEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.VerifyEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyEmailCounter := mm_atomic.LoadUint64(&m.afterVerifyEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyEmailMock.defaultExpectation != nil && afterVerifyEmailCounter < 1 {
+		if m.VerifyEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.VerifyEmail at\n%s", m.VerifyEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.VerifyEmail at\n%s with params: %#v", m.VerifyEmailMock.defaultExpectation.expectationOrigins.origin, *m.VerifyEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyEmail != nil && afterVerifyEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.VerifyEmail at\n%s", m.funcVerifyEmailOrigin)
+	}
+
+	if !m.VerifyEmailMock.invocationsDone() && afterVerifyEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.VerifyEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyEmailMock.expectedInvocations), m.VerifyEmailMock.expectedInvocationsOrigin, afterVerifyEmailCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UsersServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockAccessTokenInspect()
 
+			m.MinimockChangePasswordInspect()
+
+			m.MinimockForgotPasswordInspect()
+
 			m.MinimockLoginInspect()
 
+			m.MinimockLogoutInspect()
+
+			m.MinimockLogoutAllInspect()
+
 			m.MinimockRegisterUserInspect()
 
+			m.MinimockResendVerificationInspect()
+
+			m.MinimockResetPasswordInspect()
+
 			m.MinimockUpdateUserInspect()
 
 			m.MinimockUserInspect()
+
+			m.MinimockVerifyEmailInspect()
 		}
 	})
 }
@@ -1833,8 +4374,15 @@ func (m *UsersServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAccessTokenDone() &&
+		m.MinimockChangePasswordDone() &&
+		m.MinimockForgotPasswordDone() &&
 		m.MinimockLoginDone() &&
+		m.MinimockLogoutDone() &&
+		m.MinimockLogoutAllDone() &&
 		m.MinimockRegisterUserDone() &&
+		m.MinimockResendVerificationDone() &&
+		m.MinimockResetPasswordDone() &&
 		m.MinimockUpdateUserDone() &&
-		m.MinimockUserDone()
+		m.MinimockUserDone() &&
+		m.MinimockVerifyEmailDone()
 }
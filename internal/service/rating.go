@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+)
+
+// OrganizerRatingPeriod is a period within which reviews are counted in organizer's rating
+const OrganizerRatingPeriod = 365 * 24 * time.Hour
+
+// OrganizerRating returns rating of the organizer over the last OrganizerRatingPeriod,
+// or nil if there are no reviews within it
+func OrganizerRating(ctx context.Context, repo repository.Repository, userID int64) (*models.Rating, error) {
+	rating, err := repo.OrganizerRating(ctx, userID, time.Now().UTC().Add(-OrganizerRatingPeriod))
+	if err != nil {
+		return nil, err
+	}
+	if rating.Count == 0 {
+		return nil, nil
+	}
+
+	return rating, nil
+}
@@ -7,24 +7,79 @@ import (
 )
 
 type EventsService interface {
-	Event(ctx context.Context, urlTitle string) (*models.Event, error)
+	Event(ctx context.Context, userID int64, urlTitle string) (*models.Event, error)
 	Events(ctx context.Context, page int) ([]*models.Event, error)
+	EventsNear(ctx context.Context, lat float64, lng float64, radiusKm float64, page int) ([]*models.Event, error)
 	UserEvents(ctx context.Context, userID int64) ([]*models.Event, error)
 	CreateEvent(ctx context.Context, event *models.Event) (int64, error)
 	DeleteEvent(ctx context.Context, userID int64, urlTitle string) error
+	EventHistory(ctx context.Context, userID int64, urlTitle string) ([]*models.EventRevision, error)
 	UpdateEvent(ctx context.Context, userID int64, event *models.Event) error
+	UpdateEventSlug(ctx context.Context, userID int64, urlTitle string, slug string) (string, error)
+	EventRedirect(ctx context.Context, urlTitle string) (string, error)
+	RecordView(event *models.Event, visitor string)
+	CloneEvent(ctx context.Context, userID int64, urlTitle string, req *models.CloneEventRequest) (*models.Event, error)
+	CancelEvent(ctx context.Context, userID int64, urlTitle string, reason string) error
+	CancellationProgress(ctx context.Context, userID int64, urlTitle string) (*models.CancellationProgress, error)
+
+	AddEventImages(ctx context.Context, userID int64, urlTitle string, images []*models.EventImage) error
+	UpdateEventImage(ctx context.Context, userID int64, urlTitle string, imageID int64, req *models.UpdateEventImageRequest) error
+	ReorderEventImages(ctx context.Context, userID int64, urlTitle string, ids []int64) error
+	DeleteEventImage(ctx context.Context, userID int64, urlTitle string, imageID int64) error
+
+	EventMembers(ctx context.Context, userID int64, urlTitle string) ([]*models.EventMember, error)
+	InviteEventMember(ctx context.Context, userID int64, urlTitle string, member *models.EventMember) (int64, error)
+	DeleteEventMember(ctx context.Context, userID int64, urlTitle string, memberID int64) error
+
+	AddSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) (int64, error)
+	UpdateSpeaker(ctx context.Context, userID int64, urlTitle string, speaker *models.Speaker) error
+	DeleteSpeaker(ctx context.Context, userID int64, urlTitle string, speakerID int64) error
+	AddSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) (int64, error)
+	UpdateSession(ctx context.Context, userID int64, urlTitle string, session *models.Session) error
+	DeleteSession(ctx context.Context, userID int64, urlTitle string, sessionID int64) error
+
+	Reviews(ctx context.Context, urlTitle string) ([]*models.Review, error)
+	AddReview(ctx context.Context, userID int64, urlTitle string, review *models.Review) (int64, error)
+	ReplyToReview(ctx context.Context, userID int64, urlTitle string, reviewID int64, reply string) error
+
+	BookmarkEvent(ctx context.Context, userID int64, urlTitle string) error
+	DeleteBookmark(ctx context.Context, userID int64, urlTitle string) error
+	Bookmarks(ctx context.Context, userID int64) ([]*models.Event, error)
+	FollowOrganizer(ctx context.Context, userID int64, organizerID int64) error
+	UnfollowOrganizer(ctx context.Context, userID int64, organizerID int64) error
+	Feed(ctx context.Context, userID int64, page int) ([]*models.Event, error)
+	OrganizerProfile(ctx context.Context, handle string) (*models.OrganizerProfile, error)
+	UpdateOrganizerProfile(ctx context.Context, userID int64, profile *models.OrganizerProfile) (*models.OrganizerProfile, error)
+
+	AddQuestion(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) (int64, error)
+	UpdateQuestion(ctx context.Context, userID int64, urlTitle string, question *models.EventQuestion) error
+	DeleteQuestion(ctx context.Context, userID int64, urlTitle string, questionID int64) error
 }
 
 type TicketsService interface {
 	Ticket(ctx context.Context, ticketID string) (*models.Ticket, error)
 	UserTickets(ctx context.Context, userID int64) ([]*models.Ticket, error)
 	BuyTicket(ctx context.Context, req *models.BuyTicketRequest) (string, error)
+	CheckIn(ctx context.Context, userID int64, urlTitle string, ticketID string) (*models.Ticket, error)
+	SalesReport(ctx context.Context, userID int64, urlTitle string) (*models.SalesReport, error)
+	Funnel(ctx context.Context, userID int64, urlTitle string) (*models.Funnel, error)
+	EventStats(ctx context.Context, userID int64, urlTitle string, interval string) (*models.EventStats, error)
+	UserStats(ctx context.Context, userID int64) (*models.UserStats, error)
+	Attendees(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) (*models.AttendeesPage, error)
+	ExportAttendees(ctx context.Context, userID int64, urlTitle string, format string, search string) ([]byte, error)
 }
 
 type UsersService interface {
 	RegisterUser(ctx context.Context, user *models.User) error
 	Login(ctx context.Context, user *models.User) (string, error)
-	AccessToken(ctx context.Context, refreshToken string) (string, error)
+	AccessToken(ctx context.Context, refreshToken string) (string, string, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context, userID int64) error
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, userEmail string) error
+	ForgotPassword(ctx context.Context, userEmail string) error
+	ResetPassword(ctx context.Context, token string, password string) error
+	ChangePassword(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) error
 	User(ctx context.Context, userEmail string) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 }
@@ -0,0 +1,95 @@
+package ticketsService
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+const maxAnswerLength = 1000
+
+// validateAnswers checks answers against questions of the event and returns
+// normalized answers without empty ones
+func validateAnswers(questions []*models.EventQuestion, answers []*models.TicketAnswer) ([]*models.TicketAnswer, error) {
+	byQuestion := make(map[int64]*models.TicketAnswer, len(answers))
+	for _, answer := range answers {
+		if _, ok := byQuestion[answer.QuestionID]; ok {
+			return nil, service.ErrWrongAnswer
+		}
+
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	var valid []*models.TicketAnswer
+	for _, question := range questions {
+		var values []string
+		if answer, ok := byQuestion[question.ID]; ok {
+			delete(byQuestion, question.ID)
+
+			for _, value := range answer.Values {
+				value = strings.TrimSpace(value)
+				if value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+
+		if len(values) == 0 {
+			if question.Required {
+				return nil, service.ErrRequiredAnswer
+			}
+
+			continue
+		}
+
+		err := validateAnswer(question, values)
+		if err != nil {
+			return nil, err
+		}
+
+		valid = append(valid, &models.TicketAnswer{
+			QuestionID: question.ID,
+			Values:     values,
+		})
+	}
+
+	// answers to unknown questions
+	if len(byQuestion) > 0 {
+		return nil, service.ErrWrongAnswer
+	}
+
+	return valid, nil
+}
+
+func validateAnswer(question *models.EventQuestion, values []string) error {
+	switch question.Type {
+	case models.QuestionTypeText:
+		if len(values) != 1 || utf8.RuneCountInString(values[0]) > maxAnswerLength {
+			return service.ErrWrongAnswer
+		}
+	case models.QuestionTypeSingleChoice:
+		if len(values) != 1 || !slices.Contains(question.Options, values[0]) {
+			return service.ErrWrongAnswer
+		}
+	case models.QuestionTypeMultiChoice:
+		for i, value := range values {
+			if !slices.Contains(question.Options, value) || slices.Contains(values[:i], value) {
+				return service.ErrWrongAnswer
+			}
+		}
+	case models.QuestionTypeCheckbox:
+		if len(values) != 1 || (values[0] != "true" && values[0] != "false") {
+			return service.ErrWrongAnswer
+		}
+		if question.Required && values[0] != "true" {
+			return service.ErrRequiredAnswer
+		}
+	default:
+		return service.ErrWrongAnswer
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package ticketsService
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+const (
+	defaultAttendeesPerPage = 50
+	maxAttendeesPerPage     = 200
+)
+
+// Attendees returns page of the event's attendees
+func (s *ticketsServ) Attendees(ctx context.Context, userID int64, urlTitle string, filter *models.AttendeesFilter) (*models.AttendeesPage, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewAttendees)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = defaultAttendeesPerPage
+	}
+	if filter.PerPage > maxAttendeesPerPage {
+		filter.PerPage = maxAttendeesPerPage
+	}
+
+	attendees, total, err := s.repo.EventAttendees(ctx, event.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if attendees == nil {
+		attendees = []*models.Attendee{}
+	}
+
+	return &models.AttendeesPage{
+		Attendees: attendees,
+		Total:     total,
+		Page:      filter.Page,
+		PerPage:   filter.PerPage,
+	}, nil
+}
+
+// ExportAttendees returns all attendees of the event matching search as csv or xlsx file
+// with a column for every event question
+func (s *ticketsServ) ExportAttendees(ctx context.Context, userID int64, urlTitle string, format string, search string) ([]byte, error) {
+	if format != models.ExportFormatCSV && format != models.ExportFormatXLSX {
+		return nil, service.ErrWrongExportFormat
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewAttendees)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.repo.EventQuestions(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendees, _, err := s.repo.EventAttendees(ctx, event.ID, &models.AttendeesFilter{Search: search})
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := utils.LoadTimeZone(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	rows := attendeesTable(attendees, questions, loc)
+
+	var buf bytes.Buffer
+	if format == models.ExportFormatCSV {
+		err = utils.WriteCSV(&buf, rows)
+	} else {
+		err = utils.WriteXLSX(&buf, rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// attendeesTable makes rows of attendees export, the first row is a header
+func attendeesTable(attendees []*models.Attendee, questions []*models.EventQuestion, loc *time.Location) [][]string {
+	header := []string{
+		"Ticket",
+		"First name",
+		"Last name",
+		"Email",
+		"Price",
+		"Currency",
+		"Checked in",
+		"Purchased at",
+	}
+	for _, question := range questions {
+		header = append(header, question.Label)
+	}
+
+	rows := make([][]string, 0, len(attendees)+1)
+	rows = append(rows, header)
+
+	for _, attendee := range attendees {
+		checkedIn := "no"
+		if attendee.IsUsed {
+			checkedIn = "yes"
+		}
+
+		row := []string{
+			attendee.TicketID,
+			attendee.FirstName,
+			attendee.LastName,
+			attendee.Email,
+			strconv.FormatInt(attendee.Price, 10),
+			attendee.Currency,
+			checkedIn,
+			utils.FormatEventTime(attendee.PurchasedAt, loc),
+		}
+
+		answers := make(map[int64][]string, len(attendee.Answers))
+		for _, answer := range attendee.Answers {
+			answers[answer.QuestionID] = answer.Values
+		}
+		for _, question := range questions {
+			row = append(row, strings.Join(answers[question.ID], ", "))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
@@ -0,0 +1,74 @@
+package ticketsService
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+)
+
+// CheckIn marks ticket of the event as used
+func (s *ticketsServ) CheckIn(ctx context.Context, userID int64, urlTitle string, ticketID string) (*models.Ticket, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionCheckIn)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := s.repo.Ticket(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrTicketNotFound
+		}
+
+		return nil, err
+	}
+
+	if ticket.EventID != event.ID {
+		return nil, service.ErrTicketNotFound
+	}
+
+	if ticket.IsUsed {
+		return nil, service.ErrTicketUsed
+	}
+
+	err = s.repo.UseTicket(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrTicketUsed
+		}
+
+		return nil, err
+	}
+
+	ticket.IsUsed = true
+
+	return ticket, nil
+}
+
+func (s *ticketsServ) SalesReport(ctx context.Context, userID int64, urlTitle string) (*models.SalesReport, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewSales)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.SalesReport(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
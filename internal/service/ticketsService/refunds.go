@@ -0,0 +1,193 @@
+package ticketsService
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wDRxxx/yookassa-go-sdk/yookassa"
+	yoorefund "github.com/wDRxxx/yookassa-go-sdk/yookassa/models/refund"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+const (
+	refundsInterval      = time.Minute
+	refundsBatchSize     = 50
+	refundsMaxRetryDelay = time.Hour
+
+	yookassaRefundsURL = "https://api.yookassa.ru/v3/refunds"
+)
+
+// yookassa error codes which won't change on retry
+var permanentRefundErrors = map[string]bool{
+	"invalid_request":     true,
+	"invalid_credentials": true,
+	"forbidden":           true,
+	"not_found":           true,
+}
+
+// runRefunds periodically pushes refunds of cancelled events through yookassa
+func (s *ticketsServ) runRefunds() {
+	ticker := time.NewTicker(refundsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.processRefunds(context.Background())
+		case <-s.refundsDoneChan:
+			return
+		}
+	}
+}
+
+// processRefunds moves refunds forward. Refund fails only when yookassa rejects it,
+// transient errors are retried with growing delay
+func (s *ticketsServ) processRefunds(ctx context.Context) {
+	now := time.Now().UTC()
+	refunds, err := s.repo.ActiveRefunds(ctx, now, refundsBatchSize)
+	if err != nil {
+		slog.Error("error getting active refunds", slog.Any("error", err))
+		return
+	}
+
+	for _, refund := range refunds {
+		refund.NextAttemptAt = nil
+
+		err = s.processRefund(ctx, refund)
+		if err != nil {
+			slog.Error("error processing refund", slog.Any("error", err), slog.Int64("refund_id", refund.ID))
+
+			refund.Error = err.Error()
+			if isPermanentRefundError(err) {
+				refund.Status = models.RefundStatusFailed
+			} else {
+				refund.Attempts++
+				next := now.Add(refundRetryDelay(refund.Attempts))
+				refund.NextAttemptAt = &next
+			}
+		}
+
+		err = s.repo.UpdateRefund(ctx, refund)
+		if err != nil {
+			slog.Error("error updating refund", slog.Any("error", err), slog.Int64("refund_id", refund.ID))
+		}
+	}
+}
+
+// processRefund creates refund of the whole payment in yookassa
+// or checks status of already created one
+func (s *ticketsServ) processRefund(ctx context.Context, refund *models.Refund) error {
+	yooClient := yookassa.NewClient(refund.ShopID, refund.ShopKey)
+
+	var (
+		resp *yoorefund.Refund
+		err  error
+	)
+	if refund.RefundID == "" {
+		payment, err := yooClient.PaymentInfo(refund.PaymentID)
+		if err != nil {
+			return err
+		}
+
+		resp, err = createYookassaRefund(ctx, refund, &yoorefund.Refund{
+			PaymentID: refund.PaymentID,
+			Amount:    payment.Amount,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		resp, err = yooClient.RefundInfo(refund.RefundID)
+		if err != nil {
+			return err
+		}
+	}
+
+	refund.RefundID = resp.ID
+	refund.Error = ""
+	switch resp.Status {
+	case "succeeded":
+		refund.Status = models.RefundStatusSucceeded
+	case "canceled":
+		refund.Status = models.RefundStatusFailed
+		refund.Error = "refund was canceled by yookassa"
+		if resp.CancellationDetails != nil {
+			refund.Error += ": " + resp.CancellationDetails.Reason
+		}
+	default:
+		refund.Status = models.RefundStatusProcessing
+	}
+
+	return nil
+}
+
+// createYookassaRefund creates refund with idempotence key derived from the refund's id,
+// so retry after timeout returns the same refund instead of refunding the payment twice.
+// Yookassa client generates new key for every request, that's why it isn't used here
+func createYookassaRefund(ctx context.Context, refund *models.Refund, body *yoorefund.Refund) (*yoorefund.Refund, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, yookassaRefundsURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(refund.ShopID, refund.ShopKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotence-Key", fmt.Sprintf("eventflow-refund-%d", refund.ID))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var respErr yookassa.ResponseError
+		err = json.NewDecoder(resp.Body).Decode(&respErr)
+		if err != nil || respErr.Code == "" {
+			return nil, fmt.Errorf("yookassa responded with status %d", resp.StatusCode)
+		}
+
+		return nil, &respErr
+	}
+
+	var respRefund yoorefund.Refund
+	err = json.NewDecoder(resp.Body).Decode(&respRefund)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respRefund, nil
+}
+
+func isPermanentRefundError(err error) bool {
+	var respErr *yookassa.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+
+	return permanentRefundErrors[respErr.Code]
+}
+
+// refundRetryDelay doubles with every failed attempt up to refundsMaxRetryDelay
+func refundRetryDelay(attempts int) time.Duration {
+	delay := refundsInterval
+	for i := 1; i < attempts && delay < refundsMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, refundsMaxRetryDelay)
+}
@@ -0,0 +1,108 @@
+package ticketsService
+
+import (
+	"context"
+	"time"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// EventStats returns sales analytics of the event, sales time series is bucketed by hour or day
+// in the event's time zone
+func (s *ticketsServ) EventStats(ctx context.Context, userID int64, urlTitle string, interval string) (*models.EventStats, error) {
+	if interval == "" {
+		interval = models.StatsIntervalDay
+	}
+	if interval != models.StatsIntervalHour && interval != models.StatsIntervalDay {
+		return nil, service.ErrWrongInterval
+	}
+
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewSales)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := utils.LoadTimeZone(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	stats, err := s.repo.EventStats(ctx, event.ID, interval, loc.String())
+	if err != nil {
+		return nil, err
+	}
+
+	stats.Interval = interval
+	stats.CheckInRate = rate(stats.CheckedIn, stats.TicketsSold)
+	for _, bucket := range stats.Sales {
+		bucket.Time = utils.WallClockIn(bucket.Time, loc)
+	}
+
+	if stats.Tiers == nil {
+		stats.Tiers = []*models.TierStats{}
+	}
+	if stats.Revenue == nil {
+		stats.Revenue = []*models.Revenue{}
+	}
+	if stats.Sales == nil {
+		stats.Sales = []*models.SalesBucket{}
+	}
+
+	return stats, nil
+}
+
+// UserStats returns sales summary across all events created by the user
+func (s *ticketsServ) UserStats(ctx context.Context, userID int64) (*models.UserStats, error) {
+	stats, err := s.repo.UserStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.CheckInRate = rate(stats.CheckedIn, stats.TicketsSold)
+	if stats.Revenue == nil {
+		stats.Revenue = []*models.Revenue{}
+	}
+
+	return stats, nil
+}
+
+// Funnel returns views of the event page, started and completed purchases
+func (s *ticketsServ) Funnel(ctx context.Context, userID int64, urlTitle string) (*models.Funnel, error) {
+	event, err := s.repo.EventByURLTitle(ctx, urlTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizer.Authorize(ctx, userID, event, authz.ActionViewSales)
+	if err != nil {
+		return nil, err
+	}
+
+	funnel, err := s.repo.EventFunnel(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	funnel.StartRate = rate(funnel.PurchasesStarted, funnel.Views)
+	funnel.CompletionRate = rate(funnel.PurchasesCompleted, funnel.PurchasesStarted)
+	funnel.ConversionRate = rate(funnel.PurchasesCompleted, funnel.Views)
+
+	return funnel, nil
+}
+
+// rate returns share of part in total, or zero if total is zero
+func rate(part int64, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total)
+}
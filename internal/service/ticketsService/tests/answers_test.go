@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
+)
+
+func TestBuyTicketAnswers(t *testing.T) {
+	t.Parallel()
+
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		user = &models.User{
+			ID:    gofakeit.Int64(),
+			Email: gofakeit.Email(),
+		}
+		event = &models.Event{
+			ID:       gofakeit.Int64(),
+			Title:    gofakeit.BeerName(),
+			URLTitle: gofakeit.UUID(),
+			IsFree:   true,
+			TimeZone: "Europe/Moscow",
+			Status:   models.EventStatusPublished,
+		}
+		questions = []*models.EventQuestion{
+			{ID: 1, Label: "Company", Type: models.QuestionTypeText},
+			{ID: 2, Label: "T-shirt size", Type: models.QuestionTypeSingleChoice, Options: []string{"S", "M", "L"}, Required: true},
+			{ID: 3, Label: "Diet", Type: models.QuestionTypeMultiChoice, Options: []string{"vegan", "halal", "gluten free"}},
+			{ID: 4, Label: "I agree with the rules", Type: models.QuestionTypeCheckbox, Required: true},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	noMail := func(mc *minimock.Controller) mailer.Mailer {
+		return mailerMocks.NewMailerMock(mc)
+	}
+
+	tests := []struct {
+		name       string
+		answers    []*models.TicketAnswer
+		want       []*models.TicketAnswer
+		err        error
+		mailerMock mailerMockFunc
+	}{
+		{
+			name: "success case",
+			answers: []*models.TicketAnswer{
+				{QuestionID: 1, Values: []string{" "}},
+				{QuestionID: 2, Values: []string{"M"}},
+				{QuestionID: 3, Values: []string{"vegan", "gluten free"}},
+				{QuestionID: 4, Values: []string{"true"}},
+			},
+			want: []*models.TicketAnswer{
+				{QuestionID: 2, Values: []string{"M"}},
+				{QuestionID: 3, Values: []string{"vegan", "gluten free"}},
+				{QuestionID: 4, Values: []string{"true"}},
+			},
+			err: nil,
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendOrderMailMock.Return()
+				return mock
+			},
+		},
+		{
+			name: "missing required answer case",
+			answers: []*models.TicketAnswer{
+				{QuestionID: 4, Values: []string{"true"}},
+			},
+			err:        service.ErrRequiredAnswer,
+			mailerMock: noMail,
+		},
+		{
+			name: "unchecked required checkbox case",
+			answers: []*models.TicketAnswer{
+				{QuestionID: 2, Values: []string{"S"}},
+				{QuestionID: 4, Values: []string{"false"}},
+			},
+			err:        service.ErrRequiredAnswer,
+			mailerMock: noMail,
+		},
+		{
+			name: "unknown option case",
+			answers: []*models.TicketAnswer{
+				{QuestionID: 2, Values: []string{"XXL"}},
+				{QuestionID: 4, Values: []string{"true"}},
+			},
+			err:        service.ErrWrongAnswer,
+			mailerMock: noMail,
+		},
+		{
+			name: "unknown question case",
+			answers: []*models.TicketAnswer{
+				{QuestionID: 2, Values: []string{"S"}},
+				{QuestionID: 4, Values: []string{"true"}},
+				{QuestionID: 5, Values: []string{"answer"}},
+			},
+			err:        service.ErrWrongAnswer,
+			mailerMock: noMail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := mocks.NewRepositoryMock(mc)
+			repositoryMock.UserMock.Expect(ctx, user.Email).Return(user, nil)
+			repositoryMock.EventByURLTitleMock.Expect(ctx, event.URLTitle).Return(event, nil)
+			repositoryMock.EventQuestionsMock.Expect(ctx, event.ID).Return(questions, nil)
+			if tt.err == nil {
+				repositoryMock.InsertCheckoutMock.Expect(ctx, event.ID, user.ID).Return(nil)
+				repositoryMock.InsertTicketMock.Set(func(_ context.Context, ticket *models.Ticket) (string, error) {
+					require.Equal(t, tt.want, ticket.Answers)
+					return ticket.ID, nil
+				})
+			}
+
+			var repo repository.Repository = repositoryMock
+			service := ticketsService.NewTicketsService(wg, repo, tt.mailerMock(mc), authCfg, authz.NewAuthorizer(repo))
+			_, err := service.BuyTicket(ctx, &models.BuyTicketRequest{
+				EventUrlTitle: event.URLTitle,
+				FirstName:     gofakeit.FirstName(),
+				LastName:      gofakeit.LastName(),
+				UserEmail:     user.Email,
+				Answers:       tt.answers,
+			})
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestBuyTicketUnverifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		user = &models.User{
+			ID:    gofakeit.Int64(),
+			Email: gofakeit.Email(),
+		}
+		event = &models.Event{
+			ID:       gofakeit.Int64(),
+			URLTitle: gofakeit.UUID(),
+			IsFree:   false,
+			Status:   models.EventStatusPublished,
+			Prices:   []*models.Price{{ID: 1, Price: 100, Currency: "RUB"}},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	repositoryMock := mocks.NewRepositoryMock(mc)
+	repositoryMock.UserMock.Expect(ctx, user.Email).Return(user, nil)
+	repositoryMock.EventByURLTitleMock.Expect(ctx, event.URLTitle).Return(event, nil)
+
+	var repo repository.Repository = repositoryMock
+	ticketsServ := ticketsService.NewTicketsService(wg, repo, mailerMocks.NewMailerMock(mc), authCfg, authz.NewAuthorizer(repo))
+	_, err := ticketsServ.BuyTicket(ctx, &models.BuyTicketRequest{
+		EventUrlTitle: event.URLTitle,
+		PriceID:       1,
+		UserEmail:     user.Email,
+	})
+
+	require.Equal(t, service.ErrEmailNotVerified, err)
+}
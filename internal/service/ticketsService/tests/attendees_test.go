@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
+)
+
+func TestExportAttendees(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		creatorID = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+			TimeZone:  "Europe/Moscow",
+		}
+		questions = []*models.EventQuestion{
+			{ID: 1, Label: "Company", Type: models.QuestionTypeText},
+			{ID: 2, Label: "Diet", Type: models.QuestionTypeMultiChoice, Options: []string{"vegan", "halal"}},
+		}
+		attendees = []*models.Attendee{
+			{
+				TicketID:    "t1",
+				FirstName:   "Ivan",
+				LastName:    "Petrov",
+				Email:       "ivan@example.com",
+				PriceID:     5,
+				Price:       1500,
+				Currency:    "RUB",
+				IsUsed:      true,
+				PurchasedAt: time.Date(2030, 6, 1, 9, 30, 0, 0, time.UTC),
+				Answers: []*models.TicketAnswer{
+					{TicketID: "t1", QuestionID: 2, Values: []string{"vegan", "halal"}},
+					{TicketID: "t1", QuestionID: 1, Values: []string{"Acme, Inc"}},
+				},
+			},
+			{
+				TicketID:    "t2",
+				FirstName:   "Anna",
+				LastName:    "Smirnova",
+				Email:       "anna@example.com",
+				PurchasedAt: time.Date(2030, 6, 2, 21, 0, 0, 0, time.UTC),
+			},
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		userID         int64
+		format         string
+		want           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			userID: creatorID,
+			format: models.ExportFormatCSV,
+			want: "Ticket,First name,Last name,Email,Price,Currency,Checked in,Purchased at,Company,Diet\n" +
+				"t1,Ivan,Petrov,ivan@example.com,1500,RUB,yes,01.06.2030 12:30 MSK,\"Acme, Inc\",\"vegan, halal\"\n" +
+				"t2,Anna,Smirnova,anna@example.com,0,,no,03.06.2030 00:00 MSK,,\n",
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventQuestionsMock.Expect(ctx, event.ID).Return(questions, nil)
+				mock.EventAttendeesMock.Expect(ctx, event.ID, &models.AttendeesFilter{Search: "a"}).Return(attendees, 2, nil)
+				return mock
+			},
+		},
+		{
+			name:   "wrong format case",
+			userID: creatorID,
+			format: "pdf",
+			want:   "",
+			err:    service.ErrWrongExportFormat,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+		{
+			name:   "finance member case",
+			userID: creatorID + 1,
+			format: models.ExportFormatXLSX,
+			want:   "",
+			err:    service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, creatorID+1).Return(models.EventRoleFinance, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := ticketsService.NewTicketsService(wg, repositoryMock, nil, authCfg, authz.NewAuthorizer(repositoryMock))
+			file, err := service.ExportAttendees(ctx, tt.userID, urlTitle, tt.format, "a")
+
+			require.Equal(t, tt.want, string(file))
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
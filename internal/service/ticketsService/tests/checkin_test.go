@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
+)
+
+func TestCheckIn(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		staffID  = gofakeit.Int64()
+		urlTitle = gofakeit.UUID()
+		ticketID = gofakeit.UUID()
+		event    = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: gofakeit.Int64(),
+		}
+		ticket = func(eventID int64, isUsed bool) *models.Ticket {
+			return &models.Ticket{
+				ID:      ticketID,
+				EventID: eventID,
+				IsUsed:  isUsed,
+			}
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		want           *models.Ticket
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: ticket(event.ID, true),
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, staffID).Return(models.EventRoleCheckIn, nil)
+				mock.TicketMock.Expect(ctx, ticketID).Return(ticket(event.ID, false), nil)
+				mock.UseTicketMock.Expect(ctx, ticketID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "finance member case",
+			want: nil,
+			err:  service.ErrPermissionDenied,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, staffID).Return(models.EventRoleFinance, nil)
+				return mock
+			},
+		},
+		{
+			name: "ticket of another event case",
+			want: nil,
+			err:  service.ErrTicketNotFound,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, staffID).Return(models.EventRoleCheckIn, nil)
+				mock.TicketMock.Expect(ctx, ticketID).Return(ticket(event.ID+1, false), nil)
+				return mock
+			},
+		},
+		{
+			name: "used ticket case",
+			want: nil,
+			err:  service.ErrTicketUsed,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventMemberRoleMock.Expect(ctx, event.ID, staffID).Return(models.EventRoleCheckIn, nil)
+				mock.TicketMock.Expect(ctx, ticketID).Return(ticket(event.ID, false), nil)
+				mock.UseTicketMock.Expect(ctx, ticketID).Return(pgx.ErrNoRows)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := ticketsService.NewTicketsService(wg, repositoryMock, nil, authCfg, authz.NewAuthorizer(repositoryMock))
+			res, err := service.CheckIn(ctx, staffID, urlTitle, ticketID)
+
+			require.Equal(t, tt.want, res)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
+)
+
+func TestEventStats(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		creatorID = gofakeit.Int64()
+		urlTitle  = gofakeit.UUID()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  urlTitle,
+			CreatorID: creatorID,
+			TimeZone:  "Asia/Tokyo",
+		}
+		tokyo, _ = time.LoadLocation("Asia/Tokyo")
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		interval       string
+		want           *models.EventStats
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:     "success case",
+			interval: "",
+			want: &models.EventStats{
+				TicketsSold:  4,
+				CapacityLeft: 96,
+				CheckedIn:    1,
+				CheckInRate:  0.25,
+				Tiers:        []*models.TierStats{{PriceID: 1, Price: 500, Currency: "RUB", TicketsSold: 4}},
+				Revenue:      []*models.Revenue{{Currency: "RUB", Amount: 2000}},
+				Interval:     models.StatsIntervalDay,
+				Sales: []*models.SalesBucket{
+					{Time: time.Date(2030, 6, 1, 0, 0, 0, 0, tokyo), TicketsSold: 4},
+				},
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventStatsMock.Expect(ctx, event.ID, models.StatsIntervalDay, "Asia/Tokyo").Return(&models.EventStats{
+					TicketsSold:  4,
+					CapacityLeft: 96,
+					CheckedIn:    1,
+					Tiers:        []*models.TierStats{{PriceID: 1, Price: 500, Currency: "RUB", TicketsSold: 4}},
+					Revenue:      []*models.Revenue{{Currency: "RUB", Amount: 2000}},
+					Sales: []*models.SalesBucket{
+						{Time: time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC), TicketsSold: 4},
+					},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name:     "no sales case",
+			interval: models.StatsIntervalHour,
+			want: &models.EventStats{
+				CapacityLeft: 100,
+				Tiers:        []*models.TierStats{},
+				Revenue:      []*models.Revenue{},
+				Interval:     models.StatsIntervalHour,
+				Sales:        []*models.SalesBucket{},
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.EventByURLTitleMock.Expect(ctx, urlTitle).Return(event, nil)
+				mock.EventStatsMock.Expect(ctx, event.ID, models.StatsIntervalHour, "Asia/Tokyo").Return(&models.EventStats{
+					CapacityLeft: 100,
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name:     "wrong interval case",
+			interval: "week",
+			want:     nil,
+			err:      service.ErrWrongInterval,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := ticketsService.NewTicketsService(wg, repositoryMock, nil, authCfg, authz.NewAuthorizer(repositoryMock))
+			stats, err := service.EventStats(ctx, creatorID, urlTitle, tt.interval)
+
+			require.Equal(t, tt.want, stats)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFunnel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		creatorID = gofakeit.Int64()
+		event     = &models.Event{
+			ID:        gofakeit.Int64(),
+			URLTitle:  gofakeit.UUID(),
+			CreatorID: creatorID,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	repositoryMock := mocks.NewRepositoryMock(mc)
+	repositoryMock.EventByURLTitleMock.Expect(ctx, event.URLTitle).Return(event, nil)
+	repositoryMock.EventFunnelMock.Expect(ctx, event.ID).Return(&models.Funnel{
+		Views:              200,
+		PurchasesStarted:   20,
+		PurchasesCompleted: 5,
+	}, nil)
+
+	var repo repository.Repository = repositoryMock
+	service := ticketsService.NewTicketsService(wg, repo, nil, authCfg, authz.NewAuthorizer(repo))
+	funnel, err := service.Funnel(ctx, creatorID, event.URLTitle)
+
+	require.NoError(t, err)
+	require.Equal(t, &models.Funnel{
+		Views:              200,
+		PurchasesStarted:   20,
+		PurchasesCompleted: 5,
+		StartRate:          0.1,
+		CompletionRate:     0.25,
+		ConversionRate:     0.025,
+	}, funnel)
+}
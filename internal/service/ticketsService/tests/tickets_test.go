@@ -8,15 +8,20 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
 	"github.com/wDRxxx/eventflow-backend/internal/mailer/smtp"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
 	"github.com/wDRxxx/eventflow-backend/internal/service/ticketsService"
 )
 
@@ -32,6 +37,7 @@ func _TestBuyTicket(t *testing.T) {
 
 		mailerCfg = config.NewMailerConfig()
 		mail, _   = smtp.NewSMTPMailer(mailerCfg, wg)
+		authCfg   = config.NewAuthConfig()
 
 		ticketID = gofakeit.UUID()
 		//repoErr  = errors.New("repo err")
@@ -62,7 +68,7 @@ func _TestBuyTicket(t *testing.T) {
 			Location:      gofakeit.City(),
 			IsFree:        true,
 			PreviewImage:  gofakeit.UUID(),
-			UTCOffset:     gofakeit.Int64(),
+			TimeZone:      "Europe/Moscow",
 			Capacity:      gofakeit.Int64(),
 			MinimalAge:    gofakeit.Int64(),
 			Prices:        nil,
@@ -104,7 +110,7 @@ func _TestBuyTicket(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := ticketsService.NewTicketsService(wg, repositoryMock, mail)
+			service := ticketsService.NewTicketsService(wg, repositoryMock, mail, authCfg, authz.NewAuthorizer(repositoryMock))
 			id, err := service.BuyTicket(ctx, req)
 
 			require.Equal(t, tt.want, id)
@@ -125,6 +131,7 @@ func TestTicket(t *testing.T) {
 
 		mailerCfg = config.NewMailerConfig()
 		mail, _   = smtp.NewSMTPMailer(mailerCfg, wg)
+		authCfg   = config.NewAuthConfig()
 
 		repoErr = errors.New("repo err")
 
@@ -173,7 +180,7 @@ func TestTicket(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := ticketsService.NewTicketsService(wg, repositoryMock, mail)
+			service := ticketsService.NewTicketsService(wg, repositoryMock, mail, authCfg, authz.NewAuthorizer(repositoryMock))
 			ticket, err := service.Ticket(ctx, ticketID)
 
 			require.Equal(t, tt.want, ticket)
@@ -194,6 +201,7 @@ func TestUserTickets(t *testing.T) {
 
 		mailerCfg = config.NewMailerConfig()
 		mail, _   = smtp.NewSMTPMailer(mailerCfg, wg)
+		authCfg   = config.NewAuthConfig()
 
 		repoErr = errors.New("repo err")
 
@@ -246,7 +254,7 @@ func TestUserTickets(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := ticketsService.NewTicketsService(wg, repositoryMock, mail)
+			service := ticketsService.NewTicketsService(wg, repositoryMock, mail, authCfg, authz.NewAuthorizer(repositoryMock))
 			ticket, err := service.UserTickets(ctx, userID)
 
 			require.Equal(t, tt.want, ticket)
@@ -254,3 +262,92 @@ func TestUserTickets(t *testing.T) {
 		})
 	}
 }
+
+func TestBuyTicketCancelledEvent(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		user = &models.User{
+			ID:    gofakeit.Int64(),
+			Email: gofakeit.Email(),
+		}
+		event = &models.Event{
+			ID:       gofakeit.Int64(),
+			Title:    gofakeit.BeerName(),
+			URLTitle: gofakeit.UUID(),
+			IsFree:   true,
+			TimeZone: "Europe/Moscow",
+			Status:   models.EventStatusPublished,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name: "cancelled before insert case",
+			err:  service.ErrEventNotPublished,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.InsertTicketMock.Return("", pgx.ErrNoRows)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name: "refund pending case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.InsertTicketMock.Set(func(_ context.Context, ticket *models.Ticket) (string, error) {
+					ticket.RefundPending = true
+					return ticket.ID, nil
+				})
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, []string{user.Email}, msg.To)
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc).(*mocks.RepositoryMock)
+			repositoryMock.UserMock.Expect(ctx, user.Email).Return(user, nil)
+			repositoryMock.EventByURLTitleMock.Expect(ctx, event.URLTitle).Return(event, nil)
+			repositoryMock.EventQuestionsMock.Expect(ctx, event.ID).Return(nil, nil)
+			repositoryMock.InsertCheckoutMock.Expect(ctx, event.ID, user.ID).Return(nil)
+
+			var repo repository.Repository = repositoryMock
+			ticketsServ := ticketsService.NewTicketsService(wg, repo, tt.mailerMock(mc), authCfg, authz.NewAuthorizer(repo))
+			_, err := ticketsServ.BuyTicket(ctx, &models.BuyTicketRequest{
+				EventUrlTitle: event.URLTitle,
+				FirstName:     gofakeit.FirstName(),
+				LastName:      gofakeit.LastName(),
+				UserEmail:     user.Email,
+			})
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -8,17 +8,20 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/wDRxxx/yookassa-go-sdk/yookassa"
 	yoomodels "github.com/wDRxxx/yookassa-go-sdk/yookassa/models"
 	yoopayment "github.com/wDRxxx/yookassa-go-sdk/yookassa/models/payment"
 
+	"github.com/wDRxxx/eventflow-backend/internal/authz"
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
 	"github.com/wDRxxx/eventflow-backend/internal/mailer"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
 type ticketsServ struct {
@@ -26,9 +29,11 @@ type ticketsServ struct {
 
 	repo       repository.Repository
 	authConfig *config.AuthConfig
+	authorizer authz.Authorizer
 
-	paymentsChan chan *models.TicketPayment
-	doneChan     chan struct{}
+	paymentsChan    chan *models.TicketPayment
+	doneChan        chan struct{}
+	refundsDoneChan chan struct{}
 
 	mailer mailer.Mailer
 }
@@ -37,18 +42,24 @@ func NewTicketsService(
 	wg *sync.WaitGroup,
 	repo repository.Repository,
 	mailer mailer.Mailer,
+	authConfig *config.AuthConfig,
+	authorizer authz.Authorizer,
 ) service.TicketsService {
 	s := &ticketsServ{
-		wg:           wg,
-		repo:         repo,
-		paymentsChan: make(chan *models.TicketPayment),
-		doneChan:     make(chan struct{}),
-		mailer:       mailer,
+		wg:              wg,
+		repo:            repo,
+		authConfig:      authConfig,
+		authorizer:      authorizer,
+		paymentsChan:    make(chan *models.TicketPayment),
+		doneChan:        make(chan struct{}),
+		refundsDoneChan: make(chan struct{}),
+		mailer:          mailer,
 	}
 
 	closer.Add(1, func() error {
 		slog.Info("sending done signal to tickets service...")
 		s.doneChan <- struct{}{}
+		s.refundsDoneChan <- struct{}{}
 
 		return nil
 	})
@@ -57,11 +68,13 @@ func NewTicketsService(
 		slog.Info("closing tickets service channels...")
 		close(s.paymentsChan)
 		close(s.doneChan)
+		close(s.refundsDoneChan)
 
 		return nil
 	})
 
 	go s.listenForPayments()
+	go s.runRefunds()
 
 	return s
 }
@@ -114,6 +127,12 @@ func (s *ticketsServ) checkPaymentStatus(ticketPayment *models.TicketPayment) er
 					FirstName: ticketPayment.BuyTicketRequest.FirstName,
 					LastName:  ticketPayment.BuyTicketRequest.LastName,
 					PaymentID: payment.ID,
+					Answers:   ticketPayment.BuyTicketRequest.Answers,
+				}
+				if ticketPayment.Price != nil {
+					ticket.PriceID = ticketPayment.Price.ID
+					ticket.Price = ticketPayment.Price.Price
+					ticket.Currency = ticketPayment.Price.Currency
 				}
 
 				err = s.createTicket(ctx, ticket)
@@ -140,6 +159,29 @@ func (s *ticketsServ) BuyTicket(ctx context.Context, req *models.BuyTicketReques
 		return "", err
 	}
 
+	if event.Status != models.EventStatusPublished {
+		return "", service.ErrEventNotPublished
+	}
+
+	if !event.IsFree && !user.EmailVerified {
+		return "", service.ErrEmailNotVerified
+	}
+
+	questions, err := s.repo.EventQuestions(ctx, event.ID)
+	if err != nil {
+		return "", err
+	}
+
+	req.Answers, err = validateAnswers(questions, req.Answers)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.repo.InsertCheckout(ctx, event.ID, user.ID)
+	if err != nil {
+		slog.Error("error recording checkout", slog.Any("error", err), slog.Int64("event_id", event.ID))
+	}
+
 	if event.IsFree {
 		ticket := &models.Ticket{
 			ID:        uuid.NewString(),
@@ -150,14 +192,21 @@ func (s *ticketsServ) BuyTicket(ctx context.Context, req *models.BuyTicketReques
 			IsUsed:    false,
 			FirstName: req.FirstName,
 			LastName:  req.LastName,
+			Answers:   req.Answers,
 		}
 
 		err = s.createTicket(ctx, ticket)
+		if err != nil {
+			return "", err
+		}
+
 		return "", nil
 	}
+	var price *models.Price
 	var amount *yoomodels.Amount
 	for _, p := range event.Prices {
 		if p.ID == req.PriceID {
+			price = p
 			amount = &yoomodels.Amount{
 				Value:    fmt.Sprintf("%d.00", p.Price),
 				Currency: p.Currency,
@@ -198,6 +247,7 @@ func (s *ticketsServ) BuyTicket(ctx context.Context, req *models.BuyTicketReques
 	s.paymentsChan <- &models.TicketPayment{
 		Payment:          respPayment,
 		BuyTicketRequest: req,
+		Price:            price,
 		User:             user,
 		Event:            event,
 		Ctx:              ctx,
@@ -210,13 +260,32 @@ func (s *ticketsServ) BuyTicket(ctx context.Context, req *models.BuyTicketReques
 func (s *ticketsServ) createTicket(ctx context.Context, ticket *models.Ticket) error {
 	id, err := s.repo.InsertTicket(ctx, ticket)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrEventNotPublished
+		}
+
 		return err
 	}
 
+	// event was cancelled while the ticket was being paid, so buyer only gets the money back
+	if ticket.RefundPending {
+		s.mailer.SendNotificationMail(&models.NotificationMessage{
+			To:         []string{ticket.User.Email},
+			Subject:    fmt.Sprintf("\"%s\" is cancelled", ticket.Event.Title),
+			Title:      fmt.Sprintf("\"%s\" is cancelled", ticket.Event.Title),
+			Lines:      []string{"Unfortunately, the event was cancelled by the organizer while your payment was processed. The money for your ticket will be refunded automatically."},
+			ButtonText: "Open my tickets",
+			ButtonURL:  s.authConfig.Domain() + "/user/profile",
+		})
+
+		return nil
+	}
+
 	msg := &models.OrderMessage{
 		To:          []string{ticket.User.Email},
 		TicketID:    id,
 		EventTitle:  ticket.Event.Title,
+		EventTime:   eventTime(ticket.Event),
 		ImageURL:    s.authConfig.Domain() + "/api/static/" + ticket.Event.PreviewImage,
 		RedirectURL: s.authConfig.Domain() + "/user/profile",
 	}
@@ -241,5 +310,21 @@ func (s *ticketsServ) UserTickets(ctx context.Context, userID int64) ([]*models.
 		return nil, err
 	}
 
+	for _, ticket := range tickets {
+		if ticket.Event != nil {
+			utils.LocalizeEventTimes(ticket.Event)
+		}
+	}
+
 	return tickets, nil
 }
+
+// eventTime returns beginning time of the event formatted in its time zone
+func eventTime(event *models.Event) string {
+	loc, err := utils.LoadTimeZone(event.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return utils.FormatEventTime(event.BeginningTime, loc)
+}
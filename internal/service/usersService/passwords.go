@@ -0,0 +1,113 @@
+package usersService
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// password reset link is valid for this period
+const passwordResetTTL = time.Hour
+
+// ForgotPassword emails password reset link to the user, at most once per verificationResendInterval.
+// Unknown email and too frequent requests aren't reported, so emails of users can't be discovered this way
+func (s *usersServ) ForgotPassword(ctx context.Context, userEmail string) error {
+	user, err := s.repo.User(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	now := time.Now().UTC()
+	err = s.repo.MarkPasswordResetSent(ctx, user.ID, now, now.Add(-verificationResendInterval))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.InsertPasswordReset(ctx, &models.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(passwordResetTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:      []string{user.Email},
+		Subject: "Password reset",
+		Title:   "Password reset",
+		Lines: []string{
+			"Somebody has requested password reset for your account. If it wasn't you, just ignore this mail.",
+			"The link is valid for 1 hour and can be used only once.",
+		},
+		ButtonText: "Set new password",
+		ButtonURL:  s.authConfig.Domain() + "/reset-password?token=" + url.QueryEscape(token),
+	})
+
+	return nil
+}
+
+// ResetPassword sets new password using the token from reset link and signs the user out everywhere
+func (s *usersServ) ResetPassword(ctx context.Context, token string, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), 12)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.ResetPassword(ctx, utils.HashToken(token), string(hash), time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrInvalidResetToken
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ChangePassword sets new password if the current one is right and signs the user out everywhere
+func (s *usersServ) ChangePassword(ctx context.Context, userEmail string, req *models.ChangePasswordRequest) error {
+	user, err := s.repo.User(ctx, userEmail)
+	if err != nil {
+		return err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword))
+	if err != nil {
+		return service.ErrWrongPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), 12)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateUserPassword(ctx, user.ID, string(hash), time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package usersService
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+const (
+	// refresh token can be used again shortly after rotation, since concurrent requests
+	// of the same client may refresh with the same token
+	refreshReuseGrace = 30 * time.Second
+	// expired and revoked sessions are deleted this often
+	sessionsCleanupInterval = time.Hour
+)
+
+// AccessToken issues access token for the refresh token and rotates the refresh token.
+// Refresh token which was rotated earlier than refreshReuseGrace ago means it was stolen,
+// so the whole family of the token is revoked
+func (s *usersServ) AccessToken(ctx context.Context, refreshToken string) (string, string, error) {
+	session, err := s.repo.UserSessionByTokenHash(ctx, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", "", service.ErrInvalidRefreshToken
+		}
+
+		return "", "", err
+	}
+
+	now := time.Now().UTC()
+	if session.RevokedAt != nil || !session.ExpiresAt.After(now) {
+		return "", "", service.ErrInvalidRefreshToken
+	}
+
+	nextToken, next, err := s.sessionToken(session.UserID, session.FamilyID, now)
+	if err != nil {
+		return "", "", err
+	}
+
+	if session.RotatedAt == nil {
+		err = s.repo.RotateUserSession(ctx, session.ID, next, now)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// concurrent refresh has just used this token
+			session.RotatedAt = &now
+		} else if err != nil {
+			return "", "", err
+		}
+	}
+
+	if session.RotatedAt != nil {
+		err = s.reuseRotatedSession(ctx, session, next, now)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	accessToken, err := utils.GenerateToken(
+		&models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: fmt.Sprint(session.UserID),
+			},
+			Email: session.Email,
+		},
+		s.authConfig.AccessTokenSecret(),
+		s.authConfig.AccessTokenTTL(),
+	)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, nextToken, nil
+}
+
+// Logout revokes session of the refresh token on this device
+func (s *usersServ) Logout(ctx context.Context, refreshToken string) error {
+	session, err := s.repo.UserSessionByTokenHash(ctx, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	err = s.repo.RevokeSessionFamily(ctx, session.FamilyID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LogoutAll revokes sessions of the user on all devices
+func (s *usersServ) LogoutAll(ctx context.Context, userID int64) error {
+	err := s.repo.RevokeUserSessions(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newSession starts session in the family and returns its refresh token
+func (s *usersServ) newSession(ctx context.Context, userID int64, familyID string) (string, *models.UserSession, error) {
+	token, session, err := s.sessionToken(userID, familyID, time.Now().UTC())
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = s.repo.InsertUserSession(ctx, session)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, session, nil
+}
+
+// sessionToken generates refresh token and session storing its hash
+func (s *usersServ) sessionToken(userID int64, familyID string, now time.Time) (string, *models.UserSession, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, &models.UserSession{
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(s.authConfig.RefreshTokenTTL()),
+	}, nil
+}
+
+// reuseRotatedSession continues the family of the rotated session with the next one,
+// if the session is the latest rotated one of the family and was rotated within refreshReuseGrace
+func (s *usersServ) reuseRotatedSession(
+	ctx context.Context,
+	session *models.UserSession,
+	next *models.UserSession,
+	now time.Time,
+) error {
+	if now.Sub(*session.RotatedAt) > refreshReuseGrace {
+		return s.revokeReusedFamily(ctx, session, now)
+	}
+
+	err := s.repo.ReissueUserSession(ctx, next, *session.RotatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.revokeReusedFamily(ctx, session, now)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// runSessionsCleanup periodically deletes sessions, which can't be used anymore
+func (s *usersServ) runSessionsCleanup() {
+	ticker := time.NewTicker(sessionsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			deleted, err := s.repo.DeleteStaleUserSessions(context.Background(), time.Now().UTC())
+			if err != nil {
+				slog.Error("error deleting stale user sessions", slog.Any("error", err))
+				continue
+			}
+
+			slog.Info("stale user sessions deleted", slog.Int64("count", deleted))
+		case <-s.doneChan:
+			return
+		}
+	}
+}
+
+func (s *usersServ) revokeReusedFamily(ctx context.Context, session *models.UserSession, now time.Time) error {
+	slog.Warn(
+		"refresh token reuse detected, revoking session family",
+		slog.Int64("user_id", session.UserID),
+		slog.String("family_id", session.FamilyID),
+	)
+
+	err := s.repo.RevokeSessionFamily(ctx, session.FamilyID, now)
+	if err != nil {
+		return err
+	}
+
+	return service.ErrInvalidRefreshToken
+}
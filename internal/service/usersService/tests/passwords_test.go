@@ -0,0 +1,250 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/usersService"
+)
+
+func TestForgotPassword(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		userEmail = gofakeit.Email()
+		user      = &models.User{ID: gofakeit.Int64(), Email: userEmail}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.MarkPasswordResetSentMock.Set(func(_ context.Context, id int64, now time.Time, notBefore time.Time) error {
+					require.Equal(t, user.ID, id)
+					require.Equal(t, time.Minute, now.Sub(notBefore))
+					return nil
+				})
+				mock.InsertPasswordResetMock.Set(func(_ context.Context, reset *models.PasswordReset) error {
+					require.Equal(t, user.ID, reset.UserID)
+					require.Len(t, reset.TokenHash, 64)
+					require.True(t, reset.ExpiresAt.After(time.Now()))
+					return nil
+				})
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, []string{userEmail}, msg.To)
+					require.Contains(t, msg.ButtonURL, "/reset-password?token=")
+				})
+				return mock
+			},
+		},
+		{
+			name: "requested recently case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.MarkPasswordResetSentMock.Return(pgx.ErrNoRows)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name: "unknown email case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(nil, pgx.ErrNoRows)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, mailerMock, authCfg)
+			err := service.ForgotPassword(ctx, userEmail)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		token    = gofakeit.UUID()
+		password = gofakeit.Password(true, true, true, false, false, 12)
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.ResetPasswordMock.Set(func(_ context.Context, _ string, hash string, _ time.Time) error {
+					require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)))
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "used token case",
+			err:  service.ErrInvalidResetToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.ResetPasswordMock.Return(pgx.ErrNoRows)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			err := service.ResetPassword(ctx, token, password)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+		repoErr = errors.New("repo err")
+
+		userEmail   = gofakeit.Email()
+		pass        = gofakeit.Password(true, true, true, false, false, 12)
+		hashPass, _ = bcrypt.GenerateFromPassword([]byte(pass), bcrypt.MinCost)
+		user        = &models.User{ID: gofakeit.Int64(), Email: userEmail, Password: string(hashPass)}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		req            *models.ChangePasswordRequest
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			req:  &models.ChangePasswordRequest{CurrentPassword: pass, NewPassword: "new password"},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.UpdateUserPasswordMock.Set(func(_ context.Context, userID int64, hash string, _ time.Time) error {
+					require.Equal(t, user.ID, userID)
+					require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("new password")))
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "failure case",
+			req:  &models.ChangePasswordRequest{CurrentPassword: pass, NewPassword: "new password"},
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.UpdateUserPasswordMock.Return(repoErr)
+				return mock
+			},
+		},
+		{
+			name: "wrong password case",
+			req:  &models.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "new password"},
+			err:  service.ErrWrongPassword,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			err := service.ChangePassword(ctx, userEmail, tt.req)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -2,13 +2,13 @@ package tests
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/gojuno/minimock/v3"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
@@ -17,6 +17,8 @@ import (
 
 	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
@@ -28,6 +30,7 @@ func TestRegisterUser(t *testing.T) {
 	t.Parallel()
 
 	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
 
 	var (
 		wg  = &sync.WaitGroup{}
@@ -40,17 +43,15 @@ func TestRegisterUser(t *testing.T) {
 
 		userID    = gofakeit.Int64()
 		userEmail = gofakeit.Email()
-		user      = &models.User{
-			ID:    userID,
-			Email: userEmail,
-		}
 	)
 	closer.SetGlobalCloser(closer.New(wg))
 
 	tests := []struct {
 		name           string
+		isOAuth        bool
 		err            error
 		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
 	}{
 		{
 			name: "success case",
@@ -58,18 +59,50 @@ func TestRegisterUser(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.UserMock.Expect(ctx, userEmail).Return(nil, repoErr)
-				mock.InsertUserMock.Expect(ctx, user).Return(userID, nil)
+				mock.InsertUserMock.Set(func(_ context.Context, user *models.User) (int64, error) {
+					require.Nil(t, user.EmailVerifiedAt)
+					require.NotNil(t, user.VerificationSentAt)
+					return userID, nil
+				})
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, []string{userEmail}, msg.To)
+					require.Contains(t, msg.ButtonURL, "/verify-email?token=")
+				})
+				return mock
+			},
+		},
+		{
+			name:    "oauth case",
+			isOAuth: true,
+			err:     nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(nil, repoErr)
+				mock.InsertUserMock.Set(func(_ context.Context, user *models.User) (int64, error) {
+					require.NotNil(t, user.EmailVerifiedAt)
+					return userID, nil
+				})
 				return mock
 			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
 		},
 		{
 			name: "user exists case",
 			err:  service.ErrUserAlreadyExists,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
-				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.UserMock.Expect(ctx, userEmail).Return(&models.User{ID: userID, Email: userEmail}, nil)
 				return mock
 			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
 		},
 		{
 			name: "failure case",
@@ -77,18 +110,26 @@ func TestRegisterUser(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.UserMock.Expect(ctx, userEmail).Return(nil, repoErr)
-				mock.InsertUserMock.Expect(ctx, user).Return(0, repoErr)
+				mock.InsertUserMock.Return(0, repoErr)
 				return mock
 			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
 
-			service := usersService.NewUsersService(repositoryMock, authCfg)
-			err := service.RegisterUser(ctx, user)
+			service := usersService.NewUsersService(repositoryMock, mailerMock, authCfg)
+			err := service.RegisterUser(ctx, &models.User{
+				Email:    userEmail,
+				Password: gofakeit.Password(true, true, true, false, false, 12),
+				IsOAuth:  tt.isOAuth,
+			})
 
 			require.Equal(t, tt.err, err)
 		})
@@ -139,6 +180,12 @@ func TestLogin(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.UserMock.Expect(ctx, userEmail).Return(dbUser, nil)
+				mock.InsertUserSessionMock.Set(func(_ context.Context, session *models.UserSession) error {
+					require.Equal(t, userID, session.UserID)
+					require.NotEmpty(t, session.FamilyID)
+					require.Len(t, session.TokenHash, 64)
+					return nil
+				})
 				return mock
 			},
 		},
@@ -168,7 +215,7 @@ func TestLogin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := usersService.NewUsersService(repositoryMock, authCfg)
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
 			_, err := service.Login(ctx, user)
 
 			require.Equal(t, tt.err, err)
@@ -176,7 +223,7 @@ func TestLogin(t *testing.T) {
 	}
 }
 
-func TestAccessToken(t *testing.T) {
+func TestOAuthLogin(t *testing.T) {
 	t.Parallel()
 
 	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
@@ -190,43 +237,221 @@ func TestAccessToken(t *testing.T) {
 
 		repoErr = errors.New("repo err")
 
-		userID    = gofakeit.Int64()
-		userEmail = gofakeit.Email()
-		user      = &models.User{
-			ID:    userID,
-			Email: userEmail,
+		userID     = gofakeit.Int64()
+		userEmail  = gofakeit.Email()
+		verifiedAt = time.Now().UTC()
+
+		user = &models.User{
+			Email:   userEmail,
+			IsOAuth: true,
 		}
 	)
 	closer.SetGlobalCloser(closer.New(wg))
 
-	refreshToken, _ := utils.GenerateToken(&models.UserClaims{
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject: fmt.Sprint(user.ID),
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "verified account case",
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(&models.User{
+					ID:              userID,
+					Email:           userEmail,
+					EmailVerifiedAt: &verifiedAt,
+				}, nil)
+				mock.InsertUserSessionMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "unverified account case",
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(&models.User{ID: userID, Email: userEmail}, nil)
+				mock.ClaimUnverifiedUserMock.Set(func(_ context.Context, id int64, password string, _ time.Time) error {
+					require.Equal(t, userID, id)
+					require.NotEmpty(t, password)
+					return nil
+				})
+				mock.InsertUserSessionMock.Return(nil)
+				return mock
+			},
 		},
-		Email: userEmail,
-	}, authCfg.RefreshTokenSecret(), authCfg.RefreshTokenTTL())
+		{
+			name: "claim failure case",
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(&models.User{ID: userID, Email: userEmail}, nil)
+				mock.ClaimUnverifiedUserMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			_, err := service.Login(ctx, user)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestAccessToken(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		repoErr = errors.New("repo err")
+
+		refreshToken = gofakeit.UUID()
+		tokenHash    = utils.HashToken(refreshToken)
+		rotatedAt    = time.Now().Add(-time.Minute)
+		revokedAt    = time.Now().Add(-time.Minute)
+
+		recentlyRotatedAt = time.Now().Add(-5 * time.Second)
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	newSession := func() *models.UserSession {
+		return &models.UserSession{
+			ID:        gofakeit.Int64(),
+			FamilyID:  gofakeit.UUID(),
+			UserID:    gofakeit.Int64(),
+			Email:     gofakeit.Email(),
+			TokenHash: tokenHash,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
 
 	tests := []struct {
 		name           string
-		token          string
 		err            error
 		repositoryMock repositoryMockFunc
 	}{
 		{
-			name:  "success case",
-			token: refreshToken,
-			err:   nil,
+			name: "success case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
+				mock.RotateUserSessionMock.Set(func(_ context.Context, sessionID int64, next *models.UserSession, _ time.Time) error {
+					require.Equal(t, session.ID, sessionID)
+					require.Equal(t, session.FamilyID, next.FamilyID)
+					require.Equal(t, session.UserID, next.UserID)
+					require.NotEqual(t, tokenHash, next.TokenHash)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "unknown token case",
+			err:  service.ErrInvalidRefreshToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(nil, pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name: "expired token case",
+			err:  service.ErrInvalidRefreshToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				session.ExpiresAt = time.Now().Add(-time.Minute)
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
+				return mock
+			},
+		},
+		{
+			name: "revoked token case",
+			err:  service.ErrInvalidRefreshToken,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				session.RevokedAt = &revokedAt
 				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
 				return mock
 			},
 		},
 		{
-			name:  "failure case",
-			token: "",
-			err:   repoErr,
+			name: "reused token case",
+			err:  service.ErrInvalidRefreshToken,
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				session.RotatedAt = &rotatedAt
 				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
+				mock.RevokeSessionFamilyMock.Set(func(_ context.Context, familyID string, _ time.Time) error {
+					require.Equal(t, session.FamilyID, familyID)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "recently rotated token case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				session.RotatedAt = &recentlyRotatedAt
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
+				mock.ReissueUserSessionMock.Set(func(_ context.Context, next *models.UserSession, rotatedAt time.Time) error {
+					require.Equal(t, session.FamilyID, next.FamilyID)
+					require.Equal(t, recentlyRotatedAt, rotatedAt)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "superseded token case",
+			err:  service.ErrInvalidRefreshToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				session := newSession()
+				session.RotatedAt = &recentlyRotatedAt
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(session, nil)
+				mock.ReissueUserSessionMock.Return(pgx.ErrNoRows)
+				mock.RevokeSessionFamilyMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "concurrent rotation case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(newSession(), nil)
+				mock.RotateUserSessionMock.Return(pgx.ErrNoRows)
+				mock.ReissueUserSessionMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "failure case",
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserSessionByTokenHashMock.Expect(ctx, tokenHash).Return(nil, repoErr)
 				return mock
 			},
 		},
@@ -236,18 +461,105 @@ func TestAccessToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := usersService.NewUsersService(repositoryMock, authCfg)
-			_, err := service.AccessToken(ctx, tt.token)
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			accessToken, nextToken, err := service.AccessToken(ctx, refreshToken)
 
-			if tt.err != nil {
-				require.Error(t, err)
-			} else {
-				require.Equal(t, tt.err, err)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.NotEmpty(t, accessToken)
+				require.NotEmpty(t, nextToken)
+				require.NotEqual(t, refreshToken, nextToken)
 			}
 		})
 	}
 }
 
+func TestLogout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		refreshToken = gofakeit.UUID()
+		session      = &models.UserSession{ID: gofakeit.Int64(), FamilyID: gofakeit.UUID()}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	mock := mocks.NewRepositoryMock(mc)
+	mock.UserSessionByTokenHashMock.Expect(ctx, utils.HashToken(refreshToken)).Return(session, nil)
+	mock.RevokeSessionFamilyMock.Set(func(_ context.Context, familyID string, _ time.Time) error {
+		require.Equal(t, session.FamilyID, familyID)
+		return nil
+	})
+
+	service := usersService.NewUsersService(mock, nil, authCfg)
+	err := service.Logout(ctx, refreshToken)
+
+	require.NoError(t, err)
+}
+
+func TestLogoutAll(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		repoErr = errors.New("repo err")
+
+		userID = gofakeit.Int64()
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.RevokeUserSessionsMock.Set(func(_ context.Context, id int64, _ time.Time) error {
+					require.Equal(t, userID, id)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "failure case",
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.RevokeUserSessionsMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			err := service.LogoutAll(ctx, userID)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
 func TestUser(t *testing.T) {
 	t.Parallel()
 
@@ -289,6 +601,7 @@ func TestUser(t *testing.T) {
 			repositoryMock: func(mc *minimock.Controller) repository.Repository {
 				mock := mocks.NewRepositoryMock(mc)
 				mock.UserMock.Expect(ctx, userEmail).Return(dbUser, nil)
+				mock.OrganizerRatingMock.Return(&models.Rating{}, nil)
 				return mock
 			},
 		},
@@ -308,7 +621,7 @@ func TestUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := usersService.NewUsersService(repositoryMock, authCfg)
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
 			u, err := service.User(ctx, userEmail)
 
 			require.Equal(t, tt.want, u)
@@ -390,7 +703,7 @@ func TestUpdateUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			repositoryMock := tt.repositoryMock(mc)
 
-			service := usersService.NewUsersService(repositoryMock, authCfg)
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
 			err := service.UpdateUser(ctx, user)
 
 			require.Equal(t, tt.err, err)
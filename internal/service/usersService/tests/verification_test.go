@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
+	mailerMocks "github.com/wDRxxx/eventflow-backend/internal/mailer/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/repository"
+	"github.com/wDRxxx/eventflow-backend/internal/repository/mocks"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/service/usersService"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		repoErr = errors.New("repo err")
+
+		userID    = gofakeit.Int64()
+		userEmail = gofakeit.Email()
+		claims    = &models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: fmt.Sprint(userID)},
+			Email:            userEmail,
+		}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	token, _ := utils.GenerateToken(claims, authCfg.VerifyTokenSecret(), authCfg.VerifyTokenTTL())
+	expiredToken, _ := utils.GenerateToken(claims, authCfg.VerifyTokenSecret(), -time.Minute)
+	// access token must not verify email
+	accessToken, _ := utils.GenerateToken(claims, authCfg.AccessTokenSecret(), authCfg.AccessTokenTTL())
+
+	tests := []struct {
+		name           string
+		token          string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:  "success case",
+			token: token,
+			err:   nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.VerifyUserEmailMock.Set(func(_ context.Context, id int64, email string, _ time.Time) error {
+					require.Equal(t, userID, id)
+					require.Equal(t, userEmail, email)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:  "expired token case",
+			token: expiredToken,
+			err:   service.ErrInvalidVerifyToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+		{
+			name:  "foreign token case",
+			token: accessToken,
+			err:   service.ErrInvalidVerifyToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				return mocks.NewRepositoryMock(mc)
+			},
+		},
+		{
+			name:  "email changed case",
+			token: token,
+			err:   service.ErrInvalidVerifyToken,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.VerifyUserEmailMock.Return(pgx.ErrNoRows)
+				return mock
+			},
+		},
+		{
+			name:  "failure case",
+			token: token,
+			err:   repoErr,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.VerifyUserEmailMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, nil, authCfg)
+			err := service.VerifyEmail(ctx, tt.token)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	t.Parallel()
+
+	type repositoryMockFunc func(mc *minimock.Controller) repository.Repository
+	type mailerMockFunc func(mc *minimock.Controller) mailer.Mailer
+
+	var (
+		wg  = &sync.WaitGroup{}
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		authCfg = config.NewAuthConfig()
+
+		userEmail = gofakeit.Email()
+		user      = &models.User{ID: gofakeit.Int64(), Email: userEmail}
+	)
+	closer.SetGlobalCloser(closer.New(wg))
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+		mailerMock     mailerMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.MarkVerificationSentMock.Set(func(_ context.Context, userID int64, now time.Time, notBefore time.Time) error {
+					require.Equal(t, user.ID, userID)
+					require.Equal(t, time.Minute, now.Sub(notBefore))
+					return nil
+				})
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendNotificationMailMock.Set(func(msg *models.NotificationMessage) {
+					require.Equal(t, []string{userEmail}, msg.To)
+				})
+				return mock
+			},
+		},
+		{
+			name: "already verified case",
+			err:  service.ErrEmailAlreadyVerified,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(&models.User{ID: user.ID, Email: userEmail, EmailVerified: true}, nil)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+		{
+			name: "rate limited case",
+			err:  service.ErrVerificationRateLimit,
+			repositoryMock: func(mc *minimock.Controller) repository.Repository {
+				mock := mocks.NewRepositoryMock(mc)
+				mock.UserMock.Expect(ctx, userEmail).Return(user, nil)
+				mock.MarkVerificationSentMock.Return(pgx.ErrNoRows)
+				return mock
+			},
+			mailerMock: func(mc *minimock.Controller) mailer.Mailer {
+				return mailerMocks.NewMailerMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repositoryMock := tt.repositoryMock(mc)
+			mailerMock := tt.mailerMock(mc)
+
+			service := usersService.NewUsersService(repositoryMock, mailerMock, authCfg)
+			err := service.ResendVerification(ctx, userEmail)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
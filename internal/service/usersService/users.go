@@ -2,33 +2,60 @@ package usersService
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/wDRxxx/eventflow-backend/internal/closer"
 	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/mailer"
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 	"github.com/wDRxxx/eventflow-backend/internal/repository"
 	"github.com/wDRxxx/eventflow-backend/internal/service"
-	"github.com/wDRxxx/eventflow-backend/internal/utils"
 )
 
 type usersServ struct {
 	repo       repository.Repository
+	mailer     mailer.Mailer
 	authConfig *config.AuthConfig
+
+	doneChan chan struct{}
 }
 
 func NewUsersService(
 	repo repository.Repository,
+	mailer mailer.Mailer,
 	authConfig *config.AuthConfig,
 ) service.UsersService {
 	s := &usersServ{
 		repo:       repo,
+		mailer:     mailer,
 		authConfig: authConfig,
+
+		doneChan: make(chan struct{}),
 	}
 
+	closer.Add(1, func() error {
+		slog.Info("sending done signal to sessions cleanup...")
+		s.doneChan <- struct{}{}
+
+		return nil
+	})
+
+	closer.Add(2, func() error {
+		slog.Info("closing users service channels...")
+		close(s.doneChan)
+
+		return nil
+	})
+
+	go s.runSessionsCleanup()
+
 	return s
 }
 
@@ -38,21 +65,33 @@ func (s *usersServ) RegisterUser(ctx context.Context, user *models.User) error {
 		return service.ErrUserAlreadyExists
 	}
 
+	now := time.Now().UTC()
 	if !user.IsOAuth {
 		pass, err := bcrypt.GenerateFromPassword([]byte(user.Password), 12)
 		if err != nil {
 			return err
 		}
 		user.Password = string(pass)
+		user.EmailVerifiedAt = nil
+		user.VerificationSentAt = &now
 	} else {
+		// email of oauth account is verified by the provider
 		user.Password = gofakeit.Password(true, true, true, true, false, 20)
+		user.EmailVerifiedAt = &now
 	}
 
-	_, err = s.repo.InsertUser(ctx, user)
+	user.ID, err = s.repo.InsertUser(ctx, user)
 	if err != nil {
 		return err
 	}
 
+	if !user.IsOAuth {
+		err = s.sendVerificationMail(user)
+		if err != nil {
+			slog.Error("error sending verification mail", slog.Any("error", err), slog.Int64("user_id", user.ID))
+		}
+	}
+
 	return nil
 }
 
@@ -67,45 +106,31 @@ func (s *usersServ) Login(ctx context.Context, user *models.User) (string, error
 		if err != nil {
 			return "", service.ErrWrongCredentials
 		}
+	} else if u.EmailVerifiedAt == nil {
+		// provider has proven the email, so the unverified account is taken over
+		// from whoever registered it with a password
+		password := gofakeit.Password(true, true, true, true, false, 20)
+		err = s.repo.ClaimUnverifiedUser(ctx, u.ID, password, time.Now().UTC())
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return "", err
+		}
 	}
 
-	accessToken, err := utils.GenerateToken(
-		&models.UserClaims{
-			RegisteredClaims: jwt.RegisteredClaims{
-				Subject: fmt.Sprint(u.ID),
-			},
-			Email: u.Email,
-		},
-		s.authConfig.RefreshTokenSecret(),
-		s.authConfig.RefreshTokenTTL(),
-	)
+	refreshToken, _, err := s.newSession(ctx, u.ID, uuid.NewString())
 	if err != nil {
 		return "", err
 	}
 
-	return accessToken, nil
+	return refreshToken, nil
 }
 
-func (s *usersServ) AccessToken(ctx context.Context, refreshToken string) (string, error) {
-	claims, err := utils.VerifyToken(refreshToken, s.authConfig.RefreshTokenSecret())
-	if err != nil {
-		return "", err
-	}
-
-	accessToken, err := utils.GenerateToken(
-		claims,
-		s.authConfig.AccessTokenSecret(),
-		s.authConfig.AccessTokenTTL(),
-	)
+func (s *usersServ) User(ctx context.Context, userEmail string) (*models.User, error) {
+	user, err := s.repo.User(ctx, userEmail)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return accessToken, nil
-}
-
-func (s *usersServ) User(ctx context.Context, userEmail string) (*models.User, error) {
-	user, err := s.repo.User(ctx, userEmail)
+	user.OrganizerRating, err = service.OrganizerRating(ctx, s.repo, user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +146,13 @@ func (s *usersServ) UpdateUser(ctx context.Context, user *models.User) error {
 		}
 	}
 
+	if user.NotifyFollowed != nil {
+		err := s.repo.UpdateUserNotifyFollowed(ctx, user.ID, *user.NotifyFollowed)
+		if err != nil {
+			return err
+		}
+	}
+
 	if user.YookassaSettings.ShopID != "" || user.YookassaSettings.ShopKey != "" {
 		user.YookassaSettings.UserID = user.ID
 		err := s.repo.UpdateYookassaSettings(ctx, &user.YookassaSettings)
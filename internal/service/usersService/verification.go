@@ -0,0 +1,103 @@
+package usersService
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/service"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+// verification and password reset mails can't be resent more often than this
+const verificationResendInterval = time.Minute
+
+// VerifyEmail verifies email of the user from the token of verification link.
+// Token is valid only while the user has the same email it was issued for
+func (s *usersServ) VerifyEmail(ctx context.Context, token string) error {
+	claims, err := utils.VerifyToken(token, s.authConfig.VerifyTokenSecret())
+	if err != nil {
+		return service.ErrInvalidVerifyToken
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return service.ErrInvalidVerifyToken
+	}
+
+	err = s.repo.VerifyUserEmail(ctx, userID, claims.Email, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrInvalidVerifyToken
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *usersServ) ResendVerification(ctx context.Context, userEmail string) error {
+	user, err := s.repo.User(ctx, userEmail)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return service.ErrEmailAlreadyVerified
+	}
+
+	now := time.Now().UTC()
+	err = s.repo.MarkVerificationSent(ctx, user.ID, now, now.Add(-verificationResendInterval))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrVerificationRateLimit
+		}
+
+		return err
+	}
+
+	err = s.sendVerificationMail(user)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *usersServ) sendVerificationMail(user *models.User) error {
+	token, err := utils.GenerateToken(
+		&models.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: fmt.Sprint(user.ID),
+			},
+			Email: user.Email,
+		},
+		s.authConfig.VerifyTokenSecret(),
+		s.authConfig.VerifyTokenTTL(),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.mailer.SendNotificationMail(&models.NotificationMessage{
+		To:      []string{user.Email},
+		Subject: "Confirm your email",
+		Title:   "Confirm your email",
+		Lines: []string{
+			"Please confirm your email to create events and buy tickets.",
+			fmt.Sprintf("The link is valid for %s.", s.authConfig.VerifyTokenTTL()),
+		},
+		ButtonText: "Confirm email",
+		ButtonURL:  s.authConfig.Domain() + "/verify-email?token=" + url.QueryEscape(token),
+	})
+
+	return nil
+}
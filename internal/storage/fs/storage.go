@@ -0,0 +1,103 @@
+package fs
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
+)
+
+type fsStorage struct {
+	dir       string
+	urlPrefix string
+}
+
+// NewFSStorage creates storage which keeps objects in dir on local disk,
+// urls of objects are made by joining urlPrefix and object name
+func NewFSStorage(dir string, urlPrefix string) storage.Storage {
+	return &fsStorage{
+		dir:       dir,
+		urlPrefix: urlPrefix,
+	}
+}
+
+func (s *fsStorage) Put(ctx context.Context, name string, data io.Reader, size int64, contentType string) error {
+	file, err := os.Create(s.path(name))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *fsStorage) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, storage.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *fsStorage) Delete(ctx context.Context, name string) error {
+	err := os.Remove(s.path(name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return storage.ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *fsStorage) URL(ctx context.Context, name string) (string, error) {
+	return s.urlPrefix + name, nil
+}
+
+func (s *fsStorage) List(ctx context.Context) ([]*storage.Object, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*storage.Object, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, &storage.Object{
+			Name:      entry.Name(),
+			Size:      info.Size(),
+			UpdatedAt: info.ModTime(),
+		})
+	}
+
+	return objects, nil
+}
+
+// path returns path of object on disk, name is cleaned so it can't point outside of storage dir
+func (s *fsStorage) path(name string) string {
+	return filepath.Join(s.dir, filepath.Base(filepath.Clean("/"+name)))
+}
@@ -0,0 +1,113 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/wDRxxx/eventflow-backend/internal/config"
+	"github.com/wDRxxx/eventflow-backend/internal/storage"
+)
+
+type s3Storage struct {
+	client *minio.Client
+	config *config.StorageConfig
+}
+
+// NewS3Storage creates storage on top of any S3-compatible service, MinIO included.
+// Bucket is created if it doesn't exist yet
+func NewS3Storage(ctx context.Context, config *config.StorageConfig) (storage.Storage, error) {
+	client, err := minio.New(config.S3Endpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4(config.S3AccessKey(), config.S3SecretKey(), ""),
+		Secure: config.S3UseSSL(),
+		Region: config.S3Region(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, config.S3Bucket())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		err = client.MakeBucket(ctx, config.S3Bucket(), minio.MakeBucketOptions{Region: config.S3Region()})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &s3Storage{
+		client: client,
+		config: config,
+	}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, name string, data io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.config.S3Bucket(), name, data, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	_, err := s.client.StatObject(ctx, s.config.S3Bucket(), name, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, storage.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	object, err := s.client.GetObject(ctx, s.config.S3Bucket(), name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, name string) error {
+	err := s.client.RemoveObject(ctx, s.config.S3Bucket(), name, minio.RemoveObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *s3Storage) URL(ctx context.Context, name string) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, s.config.S3Bucket(), name, s.config.S3URLTTL(), url.Values{})
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (s *s3Storage) List(ctx context.Context) ([]*storage.Object, error) {
+	var objects []*storage.Object
+
+	for info := range s.client.ListObjects(ctx, s.config.S3Bucket(), minio.ListObjectsOptions{}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+
+		objects = append(objects, &storage.Object{
+			Name:      info.Key,
+			Size:      info.Size,
+			UpdatedAt: info.LastModified,
+		})
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps static assets such as uploaded images
+type Storage interface {
+	Put(ctx context.Context, name string, data io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	Delete(ctx context.Context, name string) error
+	URL(ctx context.Context, name string) (string, error)
+	List(ctx context.Context) ([]*Object, error)
+}
+
+// Object describes stored object
+type Object struct {
+	Name      string
+	Size      int64
+	UpdatedAt time.Time
+}
@@ -0,0 +1,297 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	MaxImageSize      = 10 << 20 // 10mb
+	MaxImageDimension = 6000
+
+	ImageVariantOriginal  = "original"
+	ImageVariantCard      = "card"
+	ImageVariantThumbnail = "thumbnail"
+)
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image, only jpeg, png and webp are allowed")
+	ErrImageTooLarge    = errors.New("image is too large")
+)
+
+// imageVariants is a list of resized copies made for every uploaded image
+// with maximal width of each one
+var imageVariants = []struct {
+	name  string
+	width int
+}{
+	{name: ImageVariantCard, width: 800},
+	{name: ImageVariantThumbnail, width: 320},
+}
+
+// ProcessedImage is a validated and re-encoded image with all its variants
+type ProcessedImage struct {
+	Name     string
+	Variants map[string][]byte
+}
+
+// ProcessImage decodes and validates image from r, rotates it according to EXIF orientation,
+// re-encodes it without metadata and makes resized variants of it
+func ProcessImage(r io.Reader) (*ProcessedImage, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if format != "jpeg" && format != "png" && format != "webp" {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width > MaxImageDimension || cfg.Height > MaxImageDimension {
+		return nil, ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	// orientation is lost together with EXIF, so it's applied to pixels
+	if format == "jpeg" {
+		img = orientImage(img, jpegOrientation(data))
+	}
+
+	// there is no webp encoder in standard library, so such images are stored as jpeg
+	extension := ".jpg"
+	if format == "png" {
+		extension = ".png"
+	}
+
+	processed := &ProcessedImage{
+		Name:     uuid.NewString() + extension,
+		Variants: make(map[string][]byte, len(imageVariants)+1),
+	}
+
+	// encoding decoded image drops all metadata of the original file, EXIF included
+	processed.Variants[ImageVariantOriginal], err = encodeImage(img, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variant := range imageVariants {
+		processed.Variants[variant.name], err = encodeImage(resizeImage(img, variant.width), extension)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return processed, nil
+}
+
+// ImageVariantName returns filename of the given variant of image
+func ImageVariantName(filename string, variant string) string {
+	if variant == ImageVariantOriginal {
+		return filename
+	}
+
+	extension := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, extension) + "_" + variant + extension
+}
+
+// ImageContentType returns mime type of processed image by its filename
+func ImageContentType(filename string) string {
+	if filepath.Ext(filename) == ".png" {
+		return "image/png"
+	}
+
+	return "image/jpeg"
+}
+
+// ImageOriginalName returns filename of the original image by filename of any its variant
+func ImageOriginalName(filename string) string {
+	extension := filepath.Ext(filename)
+	name := strings.TrimSuffix(filename, extension)
+
+	for _, variant := range imageVariants {
+		trimmed, found := strings.CutSuffix(name, "_"+variant.name)
+		if found {
+			return trimmed + extension
+		}
+	}
+
+	return filename
+}
+
+// ImageVariantNames returns filenames of all variants of image
+func ImageVariantNames(filename string) map[string]string {
+	names := map[string]string{
+		ImageVariantOriginal: filename,
+	}
+	for _, variant := range imageVariants {
+		names[variant.name] = ImageVariantName(filename, variant.name)
+	}
+
+	return names
+}
+
+// jpegOrientation returns EXIF orientation of jpeg image from 1 to 8, or 1 if it isn't set
+func jpegOrientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// segments go one by one until the start of the image data
+	for pos := 2; pos+4 <= len(data) && data[pos] == 0xFF; {
+		marker := data[pos+1]
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if marker == 0xDA || length < 2 || pos+2+length > len(data) {
+			break
+		}
+
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+
+		pos += 2 + length
+	}
+
+	return 1
+}
+
+// exifOrientation reads orientation tag from the first IFD of EXIF TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+
+			return orientation
+		}
+	}
+
+	return 1
+}
+
+// orientImage flips and rotates image, so it's shown upright without EXIF orientation
+func orientImage(img image.Image, orientation int) image.Image {
+	if orientation <= 1 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	src := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for dy := 0; dy < dh; dy++ {
+		for dx := 0; dx < dw; dx++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-dx, dy
+			case 3:
+				sx, sy = w-1-dx, h-1-dy
+			case 4:
+				sx, sy = dx, h-1-dy
+			case 5:
+				sx, sy = dy, dx
+			case 6:
+				sx, sy = dy, h-1-dx
+			case 7:
+				sx, sy = w-1-dy, h-1-dx
+			case 8:
+				sx, sy = w-1-dy, dx
+			}
+
+			copy(dst.Pix[dst.PixOffset(dx, dy):][:4], src.Pix[src.PixOffset(sx, sy):][:4])
+		}
+	}
+
+	return dst
+}
+
+func resizeImage(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		return img
+	}
+
+	height := bounds.Dy() * width / bounds.Dx()
+	if height == 0 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+func encodeImage(img image.Image, extension string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	switch extension {
+	case ".png":
+		err = png.Encode(&buf, img)
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
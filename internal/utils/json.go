@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"reflect"
+	"slices"
 
 	"github.com/wDRxxx/eventflow-backend/internal/models"
 )
@@ -77,3 +79,47 @@ func ReadJSON(reader io.Reader, data any) error {
 
 	return nil
 }
+
+// DiffJSON compares top-level fields of two json objects and returns changed ones sorted by name.
+// Empty old means that every field of new is added
+func DiffJSON(old []byte, new []byte) ([]*models.FieldChange, error) {
+	oldFields := map[string]any{}
+	if len(old) > 0 {
+		err := json.Unmarshal(old, &oldFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newFields := map[string]any{}
+	err := json.Unmarshal(new, &newFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for field := range oldFields {
+		fields = append(fields, field)
+	}
+	for field := range newFields {
+		if _, ok := oldFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	changes := []*models.FieldChange{}
+	for _, field := range fields {
+		if reflect.DeepEqual(oldFields[field], newFields[field]) {
+			continue
+		}
+
+		changes = append(changes, &models.FieldChange{
+			Field: field,
+			Old:   oldFields[field],
+			New:   newFields[field],
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const maxSlugLength = 80
+
+// cyrillicTranslit maps lowercase cyrillic letters to their latin transliteration
+var cyrillicTranslit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// Slugify makes url friendly slug from the text, e.g. "Летний Фест 2025!" becomes "letniy-fest-2025".
+// Returns empty string if text has no letters or digits
+func Slugify(text string) string {
+	var b strings.Builder
+
+	dash := false
+	for _, r := range norm.NFC.String(strings.ToLower(text)) {
+		part := transliterate(r)
+		if part == "" {
+			dash = dash || (b.Len() > 0 && !unicode.Is(unicode.Cyrillic, r))
+			continue
+		}
+
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteString(part)
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+
+	return slug
+}
+
+// transliterate returns latin letters and digits of the rune, dropping diacritics, e.g. "é" becomes "e"
+func transliterate(r rune) string {
+	if unicode.Is(unicode.Cyrillic, r) {
+		return cyrillicTranslit[r]
+	}
+
+	var b strings.Builder
+	for _, d := range norm.NFD.String(string(r)) {
+		if (d >= 'a' && d <= 'z') || (d >= '0' && d <= '9') {
+			b.WriteRune(d)
+		}
+	}
+
+	return b.String()
+}
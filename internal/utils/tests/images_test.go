@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+// twoColorImage makes image with red left half and blue right half
+func twoColorImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < width/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes img as jpeg with EXIF segment holding given orientation, zero orientation means no EXIF
+func encodeJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	data := buf.Bytes()
+	if orientation == 0 {
+		return data
+	}
+
+	// little endian TIFF header followed by IFD0 with the single orientation entry
+	tiff := []byte{'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00}
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:], 0x0112)
+	binary.LittleEndian.PutUint16(entry[2:], 3)
+	binary.LittleEndian.PutUint32(entry[4:], 1)
+	binary.LittleEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0x00, 0x00}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func decodeVariant(t *testing.T, data []byte) image.Image {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	return img
+}
+
+func requireColor(t *testing.T, want color.RGBA, got color.Color) {
+	r, g, b, _ := got.RGBA()
+	require.InDelta(t, want.R, r>>8, 32)
+	require.InDelta(t, want.G, g>>8, 32)
+	require.InDelta(t, want.B, b>>8, 32)
+}
+
+func TestProcessImage(t *testing.T) {
+	t.Parallel()
+
+	var gifData bytes.Buffer
+	require.NoError(t, gif.Encode(&gifData, twoColorImage(10, 10), nil))
+
+	tests := []struct {
+		name      string
+		data      []byte
+		extension string
+		sizes     map[string]image.Point
+		check     func(t *testing.T, variants map[string][]byte)
+		err       error
+	}{
+		{
+			name:      "png variants case",
+			data:      encodePNG(t, twoColorImage(1000, 500)),
+			extension: ".png",
+			sizes: map[string]image.Point{
+				utils.ImageVariantOriginal:  {X: 1000, Y: 500},
+				utils.ImageVariantCard:      {X: 800, Y: 400},
+				utils.ImageVariantThumbnail: {X: 320, Y: 160},
+			},
+		},
+		{
+			name:      "small image case",
+			data:      encodeJPEG(t, twoColorImage(200, 100), 0),
+			extension: ".jpg",
+			sizes: map[string]image.Point{
+				utils.ImageVariantOriginal:  {X: 200, Y: 100},
+				utils.ImageVariantCard:      {X: 200, Y: 100},
+				utils.ImageVariantThumbnail: {X: 200, Y: 100},
+			},
+		},
+		{
+			name:      "exif stripped case",
+			data:      encodeJPEG(t, twoColorImage(40, 20), 1),
+			extension: ".jpg",
+			sizes: map[string]image.Point{
+				utils.ImageVariantOriginal: {X: 40, Y: 20},
+			},
+			check: func(t *testing.T, variants map[string][]byte) {
+				for _, data := range variants {
+					require.NotContains(t, string(data), "Exif")
+				}
+			},
+		},
+		{
+			name:      "rotated exif orientation case",
+			data:      encodeJPEG(t, twoColorImage(40, 20), 6),
+			extension: ".jpg",
+			sizes: map[string]image.Point{
+				utils.ImageVariantOriginal: {X: 20, Y: 40},
+			},
+			check: func(t *testing.T, variants map[string][]byte) {
+				// rotated clockwise, so the left half becomes the top one
+				img := decodeVariant(t, variants[utils.ImageVariantOriginal])
+				requireColor(t, red, img.At(10, 5))
+				requireColor(t, blue, img.At(10, 35))
+				require.NotContains(t, string(variants[utils.ImageVariantOriginal]), "Exif")
+			},
+		},
+		{
+			name:      "mirrored exif orientation case",
+			data:      encodeJPEG(t, twoColorImage(40, 20), 2),
+			extension: ".jpg",
+			sizes: map[string]image.Point{
+				utils.ImageVariantOriginal: {X: 40, Y: 20},
+			},
+			check: func(t *testing.T, variants map[string][]byte) {
+				img := decodeVariant(t, variants[utils.ImageVariantOriginal])
+				requireColor(t, blue, img.At(5, 10))
+				requireColor(t, red, img.At(35, 10))
+			},
+		},
+		{
+			name: "too large dimension case",
+			data: encodePNG(t, image.NewGray(image.Rect(0, 0, utils.MaxImageDimension+1, 1))),
+			err:  utils.ErrImageTooLarge,
+		},
+		{
+			name: "unsupported format case",
+			data: gifData.Bytes(),
+			err:  utils.ErrUnsupportedImage,
+		},
+		{
+			name: "not an image case",
+			data: []byte("definitely not an image"),
+			err:  utils.ErrUnsupportedImage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := utils.ProcessImage(bytes.NewReader(tt.data))
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			require.Contains(t, res.Name, tt.extension)
+			require.Len(t, res.Variants, 3)
+			for variant, size := range tt.sizes {
+				img := decodeVariant(t, res.Variants[variant])
+				require.Equal(t, size, img.Bounds().Size(), variant)
+			}
+
+			if tt.check != nil {
+				tt.check(t, res.Variants)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rows [][]string
+		want string
+	}{
+		{
+			name: "plain cells case",
+			rows: [][]string{{"Ticket", "First name"}, {"abc", "Ivan"}},
+			want: "Ticket,First name\nabc,Ivan\n",
+		},
+		{
+			name: "formula cells case",
+			rows: [][]string{{"=HYPERLINK(\"http://evil\")", "+1", "-1", "@SUM(A1)", "\tcmd", "\rcmd", "a=b"}},
+			want: "\"'=HYPERLINK(\"\"http://evil\"\")\",'+1,'-1,'@SUM(A1),'\tcmd,\"'\rcmd\",a=b\n",
+		},
+		{
+			name: "empty cell case",
+			rows: [][]string{{"", "x"}},
+			want: ",x\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := utils.WriteCSV(&buf, tt.rows)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"time"
+	// runtime image has no zoneinfo, so time zones database is embedded into binary
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+)
+
+var ErrWrongTimeZone = errors.New("wrong time zone, IANA name such as Europe/Moscow is expected")
+
+// LoadTimeZone loads location by IANA time zone name
+func LoadTimeZone(name string) (*time.Location, error) {
+	// time.LoadLocation treats empty name as UTC and "Local" as server's zone
+	if name == "" || name == "Local" {
+		return nil, ErrWrongTimeZone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrWrongTimeZone
+	}
+
+	return loc, nil
+}
+
+// WallClockIn returns time with the same wall clock as t in given location.
+// Offset of t is ignored
+func WallClockIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// FormatEventTime formats time for humans in given location, e.g. 02.01.2006 15:04 MSK
+func FormatEventTime(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("02.01.2006 15:04 MST")
+}
+
+// LocalizeEventTimes converts stored in UTC times of the event into its time zone
+func LocalizeEventTimes(event *models.Event) {
+	loc, err := LoadTimeZone(event.TimeZone)
+	if err != nil {
+		return
+	}
+
+	event.BeginningTime = event.BeginningTime.In(loc)
+	event.EndTime = event.EndTime.In(loc)
+}
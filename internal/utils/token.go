@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -44,3 +48,20 @@ func VerifyToken(token string, secretKey string) (*models.UserClaims, error) {
 
 	return claims, nil
 }
+
+// GenerateOpaqueToken returns random url safe token, which is meaningful only for the server
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken returns hash of opaque token to store it instead of the token itself
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/validation"
+)
+
+func TestStruct(t *testing.T) {
+	t.Parallel()
+
+	var (
+		beginning  = time.Date(2030, 5, 1, 16, 0, 0, 0, time.UTC)
+		validEvent = func() *models.Event {
+			return &models.Event{
+				Title:         "Go meetup",
+				Description:   "Talks about Go",
+				Location:      "Moscow",
+				BeginningTime: beginning,
+				EndTime:       beginning.Add(2 * time.Hour),
+				TimeZone:      "Europe/Moscow",
+				Capacity:      100,
+				Prices:        []*models.Price{{Price: 1000, Currency: "RUB"}},
+			}
+		}
+	)
+
+	tests := []struct {
+		name    string
+		event   func() *models.Event
+		partial bool
+		want    []*models.FieldError
+	}{
+		{
+			name:  "valid case",
+			event: validEvent,
+		},
+		{
+			name: "invalid fields case",
+			event: func() *models.Event {
+				event := validEvent()
+				event.Title = " "
+				event.EndTime = beginning.Add(-time.Hour)
+				event.Capacity = -1
+				event.TimeZone = "Mars/Olympus"
+				event.Prices[0].Currency = "rub"
+				return event
+			},
+			want: []*models.FieldError{
+				{Field: "title", Code: validation.CodeRequired, Message: "is required"},
+				{Field: "end_time", Code: validation.CodeGTField, Message: "must be after beginning_time"},
+				{Field: "time_zone", Code: validation.CodeTimeZone, Message: "must be IANA time zone, e.g. Europe/Moscow"},
+				{Field: "capacity", Code: validation.CodeMin, Message: "must be at least 0"},
+				{Field: "prices[0].currency", Code: validation.CodeCurrency, Message: "must be ISO 4217 currency code, e.g. RUB"},
+			},
+		},
+		{
+			name:    "partial update case",
+			partial: true,
+			event: func() *models.Event {
+				return &models.Event{Location: "Saint Petersburg"}
+			},
+		},
+		{
+			name:    "invalid partial update case",
+			partial: true,
+			event: func() *models.Event {
+				return &models.Event{MinimalAge: 200}
+			},
+			want: []*models.FieldError{
+				{Field: "minimal_age", Code: validation.CodeMax, Message: "must be at most 150"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validation.Struct
+			if tt.partial {
+				validate = validation.Partial
+			}
+
+			err := validate(tt.event())
+			if tt.want == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs validation.Errors
+			require.True(t, errors.As(err, &errs))
+			require.Equal(t, tt.want, []*models.FieldError(errs))
+		})
+	}
+}
+
+func TestPasswordBytes(t *testing.T) {
+	t.Parallel()
+
+	// 40 cyrillic letters are 80 bytes, which bcrypt can't hash
+	err := validation.Struct(&models.User{Email: "user@mail.com", Password: strings.Repeat("п", 40)})
+
+	var errs validation.Errors
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, []*models.FieldError{
+		{Field: "password", Code: validation.CodeMaxBytes, Message: "must be at most 72 bytes long"},
+	}, []*models.FieldError(errs))
+
+	err = validation.Struct(&models.User{Email: "user@mail.com", Password: strings.Repeat("п", 36)})
+	require.NoError(t, err)
+}
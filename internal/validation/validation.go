@@ -0,0 +1,262 @@
+// Package validation checks request models declaratively by their "validate" struct tags.
+//
+// Supported rules, separated by commas:
+//   - required: value must not be zero, strings must not be blank
+//   - min=N, max=N: bounds of numbers, length of strings in characters and length of slices
+//   - maxbytes=N: length of string in bytes, e.g. for passwords, since bcrypt accepts at most 72 bytes
+//   - email: value must be an email
+//   - currency: value must be ISO 4217 currency code
+//   - timezone: value must be IANA time zone name
+//   - oneof=a b c: value must be one of space separated values
+//   - gtfield=Field: time must be after the time in another field of the struct
+//
+// Rules except required are skipped for zero values. Slices of structs are validated element by element
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+
+	"github.com/wDRxxx/eventflow-backend/internal/models"
+	"github.com/wDRxxx/eventflow-backend/internal/utils"
+)
+
+const tagName = "validate"
+
+const (
+	CodeRequired = "required"
+	CodeMin      = "min"
+	CodeMax      = "max"
+	CodeMaxBytes = "maxbytes"
+	CodeEmail    = "email"
+	CodeCurrency = "currency"
+	CodeTimeZone = "timezone"
+	CodeOneOf    = "oneof"
+	CodeGTField  = "gtfield"
+)
+
+// Errors is a list of field errors of invalid input
+type Errors []*models.FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		messages = append(messages, fieldErr.Field+": "+fieldErr.Message)
+	}
+
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// Struct validates every rule of the struct and returns Errors if any of them fails
+func Struct(v any) error {
+	return validate(v, false)
+}
+
+// Partial validates the struct used as partial update, where zero fields are left unchanged,
+// so required rules are skipped
+func Partial(v any) error {
+	return validate(v, true)
+}
+
+func validate(v any, partial bool) error {
+	var errs Errors
+	validateStruct(reflect.ValueOf(v), "", partial, &errs)
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func validateStruct(val reflect.Value, prefix string, partial bool, errs *Errors) {
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := prefix + fieldName(field)
+		value := val.Field(i)
+
+		if tag := field.Tag.Get(tagName); tag != "" && tag != "-" {
+			for _, rule := range strings.Split(tag, ",") {
+				fieldErr := checkRule(rule, value, val, partial)
+				if fieldErr != nil {
+					fieldErr.Field = name
+					*errs = append(*errs, fieldErr)
+					break
+				}
+			}
+		}
+
+		if value.Kind() == reflect.Slice && isStruct(value.Type().Elem()) {
+			for j := 0; j < value.Len(); j++ {
+				validateStruct(value.Index(j), fmt.Sprintf("%s[%d].", name, j), partial, errs)
+			}
+		}
+	}
+}
+
+func checkRule(rule string, value reflect.Value, parent reflect.Value, partial bool) *models.FieldError {
+	code, param, _ := strings.Cut(rule, "=")
+	value = indirect(value)
+
+	if code == CodeRequired {
+		if !partial && isZero(value) {
+			return &models.FieldError{Code: code, Message: "is required"}
+		}
+
+		return nil
+	}
+
+	if isZero(value) {
+		return nil
+	}
+
+	switch code {
+	case CodeMin, CodeMax:
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			panic(fmt.Sprintf("validation: wrong %s rule %q", code, rule))
+		}
+
+		size, isLength := measure(value)
+		if code == CodeMin && size < limit {
+			return &models.FieldError{Code: code, Message: boundMessage("at least", limit, isLength)}
+		}
+		if code == CodeMax && size > limit {
+			return &models.FieldError{Code: code, Message: boundMessage("at most", limit, isLength)}
+		}
+	case CodeMaxBytes:
+		limit, err := strconv.Atoi(param)
+		if err != nil || value.Kind() != reflect.String {
+			panic(fmt.Sprintf("validation: wrong %s rule %q", code, rule))
+		}
+
+		if len(value.String()) > limit {
+			return &models.FieldError{Code: code, Message: fmt.Sprintf("must be at most %d bytes long", limit)}
+		}
+	case CodeEmail:
+		if !utils.IsEmail(value.String()) {
+			return &models.FieldError{Code: code, Message: "must be a valid email"}
+		}
+	case CodeCurrency:
+		unit, err := currency.ParseISO(value.String())
+		if err != nil || unit.String() != value.String() {
+			return &models.FieldError{Code: code, Message: "must be ISO 4217 currency code, e.g. RUB"}
+		}
+	case CodeTimeZone:
+		if _, err := time.LoadLocation(value.String()); err != nil {
+			return &models.FieldError{Code: code, Message: "must be IANA time zone, e.g. Europe/Moscow"}
+		}
+	case CodeOneOf:
+		options := strings.Fields(param)
+		if !slices.Contains(options, fmt.Sprint(value.Interface())) {
+			return &models.FieldError{Code: code, Message: "must be one of: " + strings.Join(options, ", ")}
+		}
+	case CodeGTField:
+		other := indirect(parent.FieldByName(param))
+		t, ok := value.Interface().(time.Time)
+		otherT, otherOk := other.Interface().(time.Time)
+		if !ok || !otherOk {
+			panic(fmt.Sprintf("validation: gtfield rule %q is supported only for times", rule))
+		}
+
+		if !otherT.IsZero() && !t.After(otherT) {
+			field, _ := parent.Type().FieldByName(param)
+			return &models.FieldError{Code: code, Message: "must be after " + fieldName(field)}
+		}
+	default:
+		panic(fmt.Sprintf("validation: unknown rule %q", rule))
+	}
+
+	return nil
+}
+
+// measure returns number to compare with bounds and whether it's a length
+func measure(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), true
+	case reflect.Slice, reflect.Map:
+		return float64(value.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), false
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), false
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), false
+	default:
+		panic(fmt.Sprintf("validation: bounds aren't supported for %s", value.Kind()))
+	}
+}
+
+func boundMessage(bound string, limit float64, isLength bool) string {
+	if isLength {
+		return fmt.Sprintf("must contain %s %s characters or items", bound, strconv.FormatFloat(limit, 'f', -1, 64))
+	}
+
+	return fmt.Sprintf("must be %s %s", bound, strconv.FormatFloat(limit, 'f', -1, 64))
+}
+
+func isZero(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	if value.Kind() == reflect.String {
+		return strings.TrimSpace(value.String()) == ""
+	}
+	if t, ok := value.Interface().(time.Time); ok {
+		return t.IsZero()
+	}
+
+	return value.IsZero()
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+
+	return value
+}
+
+func isStruct(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct && typ != reflect.TypeOf(time.Time{})
+}
+
+// fieldName returns json name of the field, since errors are shown to API clients
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
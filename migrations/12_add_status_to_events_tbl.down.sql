@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_event_status;
+
+ALTER TABLE "events"
+    DROP COLUMN status,
+    DROP COLUMN publish_at;
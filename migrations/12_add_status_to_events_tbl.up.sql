@@ -0,0 +1,6 @@
+ALTER TABLE "events"
+    ADD COLUMN status VARCHAR NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_event_status
+    ON "events"(status);
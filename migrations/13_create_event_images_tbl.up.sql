@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "event_images" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "image" VARCHAR NOT NULL,
+    "caption" VARCHAR NOT NULL DEFAULT '',
+    "position" INTEGER NOT NULL DEFAULT 0,
+    "is_cover" BOOLEAN NOT NULL DEFAULT false,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+ALTER TABLE "event_images"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_event_images_event_id
+    ON "event_images"(event_id);
+
+INSERT INTO "event_images" (event_id, image, position, is_cover)
+SELECT id, preview_image, 0, true FROM "events"
+WHERE preview_image IS NOT NULL AND preview_image != '';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_event_coordinates;
+
+ALTER TABLE "events"
+    DROP COLUMN latitude,
+    DROP COLUMN longitude;
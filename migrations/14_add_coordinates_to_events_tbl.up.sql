@@ -0,0 +1,7 @@
+ALTER TABLE "events"
+    ADD COLUMN latitude DOUBLE PRECISION,
+    ADD COLUMN longitude DOUBLE PRECISION;
+
+CREATE INDEX IF NOT EXISTS idx_event_coordinates
+    ON "events"(latitude, longitude)
+    WHERE latitude IS NOT NULL AND longitude IS NOT NULL;
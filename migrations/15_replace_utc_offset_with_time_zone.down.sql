@@ -0,0 +1,12 @@
+ALTER TABLE "events"
+    ADD COLUMN utc_offset SMALLINT;
+
+UPDATE "events"
+SET utc_offset = extract(epoch FROM (beginning_time AT TIME ZONE 'UTC' AT TIME ZONE time_zone) - beginning_time) / 3600;
+
+UPDATE "events"
+SET beginning_time = beginning_time + make_interval(hours => coalesce(utc_offset, 0)),
+    end_time = end_time + make_interval(hours => coalesce(utc_offset, 0));
+
+ALTER TABLE "events"
+    DROP COLUMN time_zone;
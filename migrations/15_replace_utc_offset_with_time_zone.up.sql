@@ -0,0 +1,19 @@
+ALTER TABLE "events"
+    ADD COLUMN time_zone VARCHAR NOT NULL DEFAULT 'UTC';
+
+UPDATE "events"
+SET time_zone = CASE
+    WHEN utc_offset IS NULL OR utc_offset = 0 THEN 'UTC'
+    WHEN utc_offset = 3 THEN 'Europe/Moscow'
+    -- Etc/GMT zones have inverted sign: Etc/GMT-5 is UTC+5
+    WHEN utc_offset > 0 THEN 'Etc/GMT-' || utc_offset
+    ELSE 'Etc/GMT+' || abs(utc_offset)
+END;
+
+-- times were stored as local wall clock of the event, from now on they are stored in UTC
+UPDATE "events"
+SET beginning_time = beginning_time - make_interval(hours => coalesce(utc_offset, 0)),
+    end_time = end_time - make_interval(hours => coalesce(utc_offset, 0));
+
+ALTER TABLE "events"
+    DROP COLUMN utc_offset;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "event_members" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "email" VARCHAR NOT NULL,
+    "role" VARCHAR NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id"),
+    UNIQUE("event_id", "email")
+);
+
+ALTER TABLE "event_members"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_event_members_email
+    ON "event_members"(email);
+
+INSERT INTO "event_members" (event_id, email, role)
+SELECT e.id, lower(u.email), 'owner' FROM "events" e
+JOIN "users" u ON u.id = e.creator_id;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "refunds";
+
+ALTER TABLE "events"
+    DROP COLUMN cancel_reason,
+    DROP COLUMN cancelled_at;
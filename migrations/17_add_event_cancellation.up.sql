@@ -0,0 +1,30 @@
+ALTER TABLE "events"
+    ADD COLUMN cancel_reason VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN cancelled_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS "refunds" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "ticket_id" VARCHAR NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "payment_id" VARCHAR NOT NULL,
+    "refund_id" VARCHAR NOT NULL DEFAULT '',
+    "status" VARCHAR NOT NULL DEFAULT 'pending',
+    "error" VARCHAR NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+ALTER TABLE "refunds"
+    ADD FOREIGN KEY("ticket_id") REFERENCES "tickets"("id")
+        ON UPDATE NO ACTION ON DELETE NO ACTION;
+
+ALTER TABLE "refunds"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE NO ACTION;
+
+CREATE INDEX IF NOT EXISTS idx_refunds_event_id
+    ON "refunds"(event_id);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_status
+    ON "refunds"(status);
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS "speakers" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "name" VARCHAR NOT NULL,
+    "bio" VARCHAR NOT NULL DEFAULT '',
+    "photo" VARCHAR NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+CREATE TABLE IF NOT EXISTS "sessions" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "title" VARCHAR NOT NULL,
+    "description" VARCHAR NOT NULL DEFAULT '',
+    "room" VARCHAR NOT NULL DEFAULT '',
+    "beginning_time" TIMESTAMP NOT NULL,
+    "end_time" TIMESTAMP NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+CREATE TABLE IF NOT EXISTS "session_speakers" (
+    "session_id" INTEGER NOT NULL,
+    "speaker_id" INTEGER NOT NULL,
+    PRIMARY KEY("session_id", "speaker_id")
+);
+
+ALTER TABLE "speakers"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "sessions"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "session_speakers"
+    ADD FOREIGN KEY("session_id") REFERENCES "sessions"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "session_speakers"
+    ADD FOREIGN KEY("speaker_id") REFERENCES "speakers"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_speakers_event_id
+    ON "speakers"(event_id);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_event_id_beginning_time
+    ON "sessions"(event_id, beginning_time);
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS "event_questions" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "label" VARCHAR NOT NULL,
+    "type" VARCHAR NOT NULL,
+    "options" VARCHAR[] NOT NULL DEFAULT '{}',
+    "required" BOOLEAN NOT NULL DEFAULT false,
+    "position" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+CREATE TABLE IF NOT EXISTS "ticket_answers" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "ticket_id" VARCHAR NOT NULL,
+    "question_id" INTEGER NOT NULL,
+    "answer" VARCHAR[] NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id"),
+    UNIQUE("ticket_id", "question_id")
+);
+
+ALTER TABLE "event_questions"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "ticket_answers"
+    ADD FOREIGN KEY("ticket_id") REFERENCES "tickets"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "ticket_answers"
+    ADD FOREIGN KEY("question_id") REFERENCES "event_questions"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_event_questions_event_id
+    ON "event_questions"(event_id);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "event_slugs" (
+    "slug" VARCHAR NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("slug")
+);
+
+ALTER TABLE "event_slugs"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_event_slugs_event_id
+    ON "event_slugs"(event_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tickets_event_id;
+
+ALTER TABLE "tickets"
+    DROP COLUMN price_id,
+    DROP COLUMN price,
+    DROP COLUMN currency;
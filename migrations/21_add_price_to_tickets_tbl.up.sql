@@ -0,0 +1,11 @@
+ALTER TABLE "tickets"
+    ADD COLUMN price_id INTEGER,
+    ADD COLUMN price INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN currency VARCHAR NOT NULL DEFAULT '';
+
+ALTER TABLE "tickets"
+    ADD FOREIGN KEY("price_id") REFERENCES "prices"("id")
+        ON UPDATE NO ACTION ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tickets_event_id
+    ON "tickets"(event_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "event_checkouts";
+DROP TABLE IF EXISTS "event_views";
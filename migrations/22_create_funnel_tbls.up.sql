@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "event_views" (
+    "event_id" INTEGER NOT NULL,
+    "visitor" VARCHAR(64) NOT NULL,
+    "day" DATE NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("event_id", "visitor", "day")
+);
+
+CREATE TABLE IF NOT EXISTS "event_checkouts" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "user_id" INTEGER NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+ALTER TABLE "event_views"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "event_checkouts"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "event_checkouts"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_event_checkouts_event_id
+    ON "event_checkouts"(event_id);
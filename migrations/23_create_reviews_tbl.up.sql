@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "reviews" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "user_id" INTEGER NOT NULL,
+    "rating" SMALLINT NOT NULL CHECK ("rating" BETWEEN 1 AND 5),
+    "comment" TEXT NOT NULL DEFAULT '',
+    "reply" TEXT NOT NULL DEFAULT '',
+    "replied_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id"),
+    UNIQUE("event_id", "user_id")
+);
+
+ALTER TABLE "reviews"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "reviews"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "follows";
+DROP TABLE IF EXISTS "bookmarks";
+
+ALTER TABLE "users"
+    DROP COLUMN notify_followed;
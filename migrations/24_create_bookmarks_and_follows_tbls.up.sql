@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "bookmarks" (
+    "user_id" INTEGER NOT NULL,
+    "event_id" INTEGER NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("user_id", "event_id")
+);
+
+CREATE TABLE IF NOT EXISTS "follows" (
+    "follower_id" INTEGER NOT NULL,
+    "organizer_id" INTEGER NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("follower_id", "organizer_id")
+);
+
+ALTER TABLE "users"
+    ADD COLUMN notify_followed BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE "bookmarks"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "bookmarks"
+    ADD FOREIGN KEY("event_id") REFERENCES "events"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "follows"
+    ADD FOREIGN KEY("follower_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+ALTER TABLE "follows"
+    ADD FOREIGN KEY("organizer_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_follows_organizer_id
+    ON "follows"(organizer_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "organizer_profiles" (
+    "user_id" INTEGER NOT NULL UNIQUE,
+    "handle" VARCHAR NOT NULL UNIQUE,
+    "display_name" VARCHAR NOT NULL,
+    "avatar" VARCHAR NOT NULL DEFAULT '',
+    "bio" TEXT NOT NULL DEFAULT '',
+    "website" VARCHAR NOT NULL DEFAULT '',
+    "social_links" JSONB NOT NULL DEFAULT '{}',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("user_id")
+);
+
+ALTER TABLE "organizer_profiles"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "event_revisions";
+
+ALTER TABLE "users"
+    DROP COLUMN is_admin;
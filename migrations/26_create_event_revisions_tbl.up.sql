@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "event_revisions" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "event_id" INTEGER NOT NULL,
+    "version" INTEGER NOT NULL,
+    "action" VARCHAR NOT NULL,
+    "actor_id" INTEGER,
+    "snapshot" JSONB NOT NULL,
+    "diff" JSONB NOT NULL DEFAULT '[]',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id"),
+    UNIQUE("event_id", "version")
+);
+
+ALTER TABLE "users"
+    ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT false;
+
+-- revisions outlive deleted events, so event_id has no foreign key
+ALTER TABLE "event_revisions"
+    ADD FOREIGN KEY("actor_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "user_sessions" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "family_id" UUID NOT NULL,
+    "user_id" INTEGER NOT NULL,
+    "token_hash" VARCHAR NOT NULL UNIQUE,
+    "expires_at" TIMESTAMP NOT NULL,
+    "rotated_at" TIMESTAMP,
+    "revoked_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+ALTER TABLE "user_sessions"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_family_id
+    ON "user_sessions"(family_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id
+    ON "user_sessions"(user_id);
@@ -0,0 +1,6 @@
+ALTER TABLE "users"
+    ADD COLUMN email_verified_at TIMESTAMP,
+    ADD COLUMN verification_sent_at TIMESTAMP;
+
+-- accounts created before verification was introduced are trusted
+UPDATE "users" SET email_verified_at = created_at;
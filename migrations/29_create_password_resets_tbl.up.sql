@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "password_resets" (
+    "id" SERIAL NOT NULL UNIQUE,
+    "user_id" INTEGER NOT NULL,
+    "token_hash" VARCHAR NOT NULL UNIQUE,
+    "expires_at" TIMESTAMP NOT NULL,
+    "used_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY("id")
+);
+
+ALTER TABLE "password_resets"
+    ADD FOREIGN KEY("user_id") REFERENCES "users"("id")
+        ON UPDATE NO ACTION ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_password_resets_user_id
+    ON "password_resets"(user_id);
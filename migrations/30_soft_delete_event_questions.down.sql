@@ -0,0 +1,4 @@
+DELETE FROM "event_questions" WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE "event_questions"
+    DROP COLUMN deleted_at;
@@ -0,0 +1,3 @@
+-- deleted questions are kept, so answers collected for them and answers of pending payments stay valid
+ALTER TABLE "event_questions"
+    ADD COLUMN deleted_at TIMESTAMP;
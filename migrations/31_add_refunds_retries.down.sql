@@ -0,0 +1,3 @@
+ALTER TABLE "refunds"
+    DROP COLUMN attempts,
+    DROP COLUMN next_attempt_at;
@@ -0,0 +1,3 @@
+ALTER TABLE "refunds"
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMP;